@@ -33,6 +33,14 @@ type Publisher interface {
 	Publish(event beat.Event, cursor interface{}) error
 }
 
+// CursorUpdater is implemented by Publishers that can update the cursor
+// without publishing an event. If there are pending updates waiting for
+// their events to be ACKed, the new cursor is persisted after them.
+// Inputs needing it check whether the Publisher they are given implements it.
+type CursorUpdater interface {
+	UpdateCursor(cursor interface{}) error
+}
+
 // cursorPublisher implements the Publisher interface and used internally by the managedInput.
 // When publishing an event with cursor state updates, the cursorPublisher
 // updates the in memory state and create an updateOp that is used to schedule
@@ -78,6 +86,35 @@ func (c *cursorPublisher) Publish(event beat.Event, cursorUpdate interface{}) er
 	return c.forward(event)
 }
 
+var _ CursorUpdater = (*cursorPublisher)(nil)
+
+// UpdateCursor updates the in memory state. The update is written to the
+// persistent store immediately if there are no pending update operations.
+// Otherwise it is merged into the pending state, which is persisted when the
+// last pending operation is ACKed.
+func (c *cursorPublisher) UpdateCursor(cursorUpdate interface{}) error {
+	op, err := createUpdateOp(c.cursor.store, c.cursor.resource, cursorUpdate)
+	if err != nil {
+		return err
+	}
+
+	resource := op.resource
+	resource.stateMutex.Lock()
+	if resource.activeCursorOperations > 1 {
+		resource.activeCursorOperations--
+		resource.stateMutex.Unlock()
+		op.done(1)
+	} else {
+		resource.stateMutex.Unlock()
+		op.Execute(1)
+	}
+
+	if c.canceler == nil {
+		return nil
+	}
+	return c.canceler.Err()
+}
+
 func (c *cursorPublisher) forward(event beat.Event) error {
 	c.client.Publish(event)
 	if c.canceler == nil {
@@ -72,6 +72,49 @@ func TestPublish(t *testing.T) {
 	})
 }
 
+func TestUpdateCursor(t *testing.T) {
+	t.Run("cursor is persisted if there are no pending updates", func(t *testing.T) {
+		store := testOpenStore(t, "test", createSampleStore(t, nil))
+		defer store.Release()
+		res := store.Get("test::key")
+		cursor := makeCursor(store, res)
+
+		publisher := cursorPublisher{nil, &pubtest.FakeClient{}, &cursor}
+		require.NoError(t, publisher.UpdateCursor("test-cursor-only"))
+		res.Release()
+		require.True(t, res.Finished())
+
+		assert.Equal(t, "test-cursor-only", storeInSyncSnapshot(store)["test::key"].Cursor)
+		assert.Equal(t, "test-cursor-only", storeMemorySnapshot(store)["test::key"].Cursor)
+	})
+
+	t.Run("cursor is persisted after pending updates are ACKed", func(t *testing.T) {
+		store := testOpenStore(t, "test", createSampleStore(t, nil))
+		defer store.Release()
+		res := store.Get("test::key")
+		cursor := makeCursor(store, res)
+
+		var ops []*updateOp
+		client := &pubtest.FakeClient{
+			PublishFunc: func(event beat.Event) { ops = append(ops, event.Private.(*updateOp)) },
+		}
+		publisher := cursorPublisher{nil, client, &cursor}
+		require.NoError(t, publisher.Publish(beat.Event{}, "test-event-cursor"))
+		require.NoError(t, publisher.UpdateCursor("test-cursor-only"))
+		res.Release()
+		require.False(t, res.Finished())
+
+		// the pending event has not been ACKed yet
+		assert.Nil(t, storeInSyncSnapshot(store)["test::key"].Cursor)
+		assert.Equal(t, "test-cursor-only", storeMemorySnapshot(store)["test::key"].Cursor)
+
+		require.Len(t, ops, 1)
+		ops[0].Execute(1)
+		require.True(t, res.Finished())
+		assert.Equal(t, "test-cursor-only", storeInSyncSnapshot(store)["test::key"].Cursor)
+	})
+}
+
 func TestOp_Execute(t *testing.T) {
 	t.Run("applying final op marks the key as finished", func(t *testing.T) {
 		store := testOpenStore(t, "test", createSampleStore(t, nil))
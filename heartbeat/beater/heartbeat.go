@@ -25,6 +25,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/config"
 	"github.com/elastic/beats/v7/heartbeat/hbregistry"
 	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/monitorstate"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -33,6 +34,9 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/reload"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/management"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend/memlog"
 
 	_ "github.com/elastic/beats/v7/libbeat/processors/script"
 )
@@ -46,6 +50,9 @@ type Heartbeat struct {
 	monitorReloader *cfgfile.Reloader
 	dynamicFactory  *monitors.RunnerFactory
 	autodiscover    *autodiscover.Autodiscover
+	stateTracker    *monitorstate.Tracker
+	stateRegistry   *statestore.Registry
+	stateStore      *statestore.Store
 }
 
 // New creates a new heartbeat.
@@ -71,15 +78,57 @@ func New(b *beat.Beat, rawConfig *common.Config) (beat.Beater, error) {
 		done:      make(chan struct{}),
 		config:    parsedConfig,
 		scheduler: scheduler,
-		// dynamicFactory is the factory used for dynamic configs, e.g. autodiscover / reload
-		dynamicFactory: monitors.NewFactory(b.Info, scheduler),
 	}
+
+	if parsedConfig.State.Enabled {
+		if err := bt.openStateStore(b.Info); err != nil {
+			return nil, fmt.Errorf("could not open monitor state store: %w", err)
+		}
+	}
+
+	// dynamicFactory is the factory used for dynamic configs, e.g. autodiscover / reload
+	bt.dynamicFactory = monitors.NewFactory(b.Info, scheduler, bt.stateTracker)
 	return bt, nil
 }
 
+// openStateStore opens the store persisting the state of monitors across restarts.
+func (bt *Heartbeat) openStateStore(info beat.Info) error {
+	cfg := bt.config.State
+	backend, err := memlog.New(logp.NewLogger("monitorstate"), memlog.Settings{
+		Root:     paths.Resolve(paths.Data, cfg.Path),
+		FileMode: cfg.Permissions,
+	})
+	if err != nil {
+		return err
+	}
+
+	registry := statestore.NewRegistry(backend)
+	store, err := registry.Get(info.Beat)
+	if err != nil {
+		registry.Close()
+		return err
+	}
+
+	bt.stateRegistry = registry
+	bt.stateStore = store
+	bt.stateTracker = monitorstate.NewTracker(store, cfg.Flapping)
+	return nil
+}
+
+// closeStateStore closes the monitor state store, if any.
+func (bt *Heartbeat) closeStateStore() {
+	if bt.stateStore != nil {
+		bt.stateStore.Close()
+	}
+	if bt.stateRegistry != nil {
+		bt.stateRegistry.Close()
+	}
+}
+
 // Run executes the beat.
 func (bt *Heartbeat) Run(b *beat.Beat) error {
 	logp.Info("heartbeat is running! Hit CTRL-C to stop it.")
+	defer bt.closeStateStore()
 
 	stopStaticMonitors, err := bt.RunStaticMonitors(b)
 	if err != nil {
@@ -119,12 +168,16 @@ func (bt *Heartbeat) Run(b *beat.Beat) error {
 	<-bt.done
 
 	logp.Info("Shutting down.")
+	if bt.stateTracker != nil {
+		// Monitors are stopped on shutdown, their states must be kept
+		bt.stateTracker.Shutdown()
+	}
 	return nil
 }
 
 // RunStaticMonitors runs the `heartbeat.monitors` portion of the yaml config if present.
 func (bt *Heartbeat) RunStaticMonitors(b *beat.Beat) (stop func(), err error) {
-	factory := monitors.NewFactory(b.Info, bt.scheduler)
+	factory := monitors.NewFactory(b.Info, bt.scheduler, bt.stateTracker)
 
 	var runners []cfgfile.Runner
 	for _, cfg := range bt.config.Monitors {
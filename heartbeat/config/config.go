@@ -21,6 +21,9 @@
 package config
 
 import (
+	"os"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors/monitorstate"
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
 	"github.com/elastic/beats/v7/libbeat/common"
 )
@@ -34,6 +37,7 @@ type Config struct {
 	Autodiscover    *autodiscover.Config `config:"autodiscover"`
 	SyntheticSuites []*common.Config     `config:"synthetic_suites"`
 	Jobs            map[string]JobLimit  `config:"jobs"`
+	State           State                `config:"state"`
 }
 
 type JobLimit struct {
@@ -46,5 +50,21 @@ type Scheduler struct {
 	Location string `config:"location"`
 }
 
+// State defines the syntax of a heartbeat.yml state block, configuring how
+// the state of monitors is tracked and persisted across checks.
+type State struct {
+	Enabled     bool                        `config:"enabled"`
+	Path        string                      `config:"path"`
+	Permissions os.FileMode                 `config:"file_permissions"`
+	Flapping    monitorstate.FlappingConfig `config:"flapping"`
+}
+
 // DefaultConfig is the canonical instantiation of Config.
-var DefaultConfig = Config{}
+var DefaultConfig = Config{
+	State: State{
+		Enabled:     false,
+		Path:        "state",
+		Permissions: 0600,
+		Flapping:    monitorstate.DefaultFlappingConfig,
+	},
+}
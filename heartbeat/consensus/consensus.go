@@ -0,0 +1,234 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package consensus summarizes the results of checks of the same monitor run by
+// heartbeat instances in several locations, as identified by their
+// `observer.geo.name`. It is meant for components collecting the events of
+// multiple instances, reporting a monitor down only when enough locations agree.
+package consensus
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const (
+	statusUp   = "up"
+	statusDown = "down"
+)
+
+// Config configures how results are summarized.
+type Config struct {
+	// Quorum is the number of locations that must report a monitor down for it
+	// to be considered down. If 0, a majority of the reporting locations is required.
+	Quorum int `config:"quorum" validate:"min=0"`
+	// MaxAge is the maximum age of the results taken into account.
+	MaxAge time.Duration `config:"max_age" validate:"min=0"`
+}
+
+// DefaultConfig is the canonical instantiation of Config.
+var DefaultConfig = Config{
+	Quorum: 0,
+	MaxAge: 5 * time.Minute,
+}
+
+// Result is the outcome of a check of a monitor in one location.
+type Result struct {
+	MonitorID string
+	Location  string
+	Status    string
+	Timestamp time.Time
+}
+
+// ErrNotSummary is returned by ResultFromEvent for events not concluding a check.
+var ErrNotSummary = errors.New("event has no summary")
+
+// ResultFromEvent returns the result of a check from its summary event.
+func ResultFromEvent(event beat.Event) (Result, error) {
+	summary, err := event.GetValue("summary.down")
+	if err != nil {
+		return Result{}, ErrNotSummary
+	}
+
+	monitorID, err := event.GetValue("monitor.id")
+	if err != nil {
+		return Result{}, err
+	}
+	location, err := event.GetValue("observer.geo.name")
+	if err != nil {
+		return Result{}, err
+	}
+
+	status := statusUp
+	if count(summary) > 0 {
+		status = statusDown
+	}
+
+	id, _ := monitorID.(string)
+	loc, _ := location.(string)
+	if id == "" || loc == "" {
+		return Result{}, errors.New("event has no monitor.id or observer.geo.name")
+	}
+
+	return Result{
+		MonitorID: id,
+		Location:  loc,
+		Status:    status,
+		Timestamp: event.Timestamp,
+	}, nil
+}
+
+// count converts the counts of summaries, which may be of any integer type
+// depending on whether events were decoded or created by heartbeat.
+func count(v interface{}) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int64:
+		return n
+	case uint16:
+		return int64(n)
+	case uint64:
+		return int64(n)
+	case float64:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
+// Summarizer keeps the latest result of each monitor in each location.
+type Summarizer struct {
+	config  Config
+	mtx     sync.Mutex
+	results map[string]map[string]Result
+}
+
+// NewSummarizer creates a new Summarizer.
+func NewSummarizer(config Config) *Summarizer {
+	return &Summarizer{
+		config:  config,
+		results: map[string]map[string]Result{},
+	}
+}
+
+// Add records a result, replacing any older result of the same monitor and location.
+func (s *Summarizer) Add(r Result) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	byLocation, ok := s.results[r.MonitorID]
+	if !ok {
+		byLocation = map[string]Result{}
+		s.results[r.MonitorID] = byLocation
+	}
+	if prev, ok := byLocation[r.Location]; ok && prev.Timestamp.After(r.Timestamp) {
+		return
+	}
+	byLocation[r.Location] = r
+}
+
+// Summarize returns one summary event per monitor with recent results, dropping
+// the results older than the configured max age.
+func (s *Summarizer) Summarize(now time.Time) []beat.Event {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var ids []string
+	for id := range s.results {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var events []beat.Event
+	for _, id := range ids {
+		if event, ok := s.summarize(id, now); ok {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// SummarizeMonitor returns the summary event of a single monitor, dropping its
+// results older than the configured max age. It returns false if the monitor has
+// no recent results.
+func (s *Summarizer) SummarizeMonitor(monitorID string, now time.Time) (beat.Event, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.summarize(monitorID, now)
+}
+
+func (s *Summarizer) summarize(monitorID string, now time.Time) (beat.Event, bool) {
+	up, down := []string{}, []string{}
+	for location, r := range s.results[monitorID] {
+		if s.config.MaxAge > 0 && now.Sub(r.Timestamp) > s.config.MaxAge {
+			delete(s.results[monitorID], location)
+			continue
+		}
+		if r.Status == statusDown {
+			down = append(down, location)
+		} else {
+			up = append(up, location)
+		}
+	}
+	if len(s.results[monitorID]) == 0 {
+		delete(s.results, monitorID)
+		return beat.Event{}, false
+	}
+	return s.summaryEvent(monitorID, up, down, now), true
+}
+
+func (s *Summarizer) summaryEvent(monitorID string, up, down []string, now time.Time) beat.Event {
+	sort.Strings(up)
+	sort.Strings(down)
+
+	quorum := s.config.Quorum
+	if quorum == 0 {
+		quorum = (len(up)+len(down))/2 + 1
+	}
+	status := statusUp
+	if len(down) >= quorum {
+		status = statusDown
+	}
+
+	return beat.Event{
+		Timestamp: now,
+		Fields: common.MapStr{
+			"monitor": common.MapStr{
+				"id":     monitorID,
+				"status": status,
+			},
+			"consensus": common.MapStr{
+				"status": status,
+				"quorum": quorum,
+				"locations": common.MapStr{
+					"up":   up,
+					"down": down,
+				},
+				"summary": common.MapStr{
+					"up":   len(up),
+					"down": len(down),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,134 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func summaryEvent(monitorID, location string, down int, ts time.Time) beat.Event {
+	return beat.Event{
+		Timestamp: ts,
+		Fields: common.MapStr{
+			"monitor":  common.MapStr{"id": monitorID},
+			"observer": common.MapStr{"geo": common.MapStr{"name": location}},
+			"summary":  common.MapStr{"up": uint16(1 - down), "down": uint16(down)},
+		},
+	}
+}
+
+func TestResultFromEvent(t *testing.T) {
+	now := time.Now()
+
+	r, err := ResultFromEvent(summaryEvent("mon", "us-east", 1, now))
+	require.NoError(t, err)
+	require.Equal(t, Result{MonitorID: "mon", Location: "us-east", Status: "down", Timestamp: now}, r)
+
+	// Events decoded from JSON have float counts
+	event := summaryEvent("mon", "us-east", 0, now)
+	event.Fields.Put("summary.down", float64(0))
+	r, err = ResultFromEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, "up", r.Status)
+
+	event = summaryEvent("mon", "us-east", 0, now)
+	event.Fields.Delete("summary")
+	_, err = ResultFromEvent(event)
+	require.Equal(t, ErrNotSummary, err)
+
+	event = summaryEvent("mon", "us-east", 0, now)
+	event.Fields.Delete("observer")
+	_, err = ResultFromEvent(event)
+	require.Error(t, err)
+}
+
+func addAll(t *testing.T, s *Summarizer, events ...beat.Event) {
+	for _, e := range events {
+		r, err := ResultFromEvent(e)
+		require.NoError(t, err)
+		s.Add(r)
+	}
+}
+
+func TestSummarizeMajority(t *testing.T) {
+	now := time.Now()
+	s := NewSummarizer(DefaultConfig)
+	addAll(t, s,
+		summaryEvent("a", "us-east", 1, now),
+		summaryEvent("a", "eu-west", 0, now),
+		summaryEvent("a", "ap-south", 0, now),
+		summaryEvent("b", "us-east", 1, now),
+		summaryEvent("b", "eu-west", 1, now),
+		summaryEvent("b", "ap-south", 0, now),
+	)
+
+	events := s.Summarize(now)
+	require.Len(t, events, 2)
+
+	a := events[0].Fields
+	require.Equal(t, common.MapStr{
+		"monitor": common.MapStr{"id": "a", "status": "up"},
+		"consensus": common.MapStr{
+			"status":    "up",
+			"quorum":    2,
+			"locations": common.MapStr{"up": []string{"ap-south", "eu-west"}, "down": []string{"us-east"}},
+			"summary":   common.MapStr{"up": 2, "down": 1},
+		},
+	}, a)
+
+	status, _ := events[1].GetValue("monitor.status")
+	require.Equal(t, "down", status)
+}
+
+func TestSummarizeQuorum(t *testing.T) {
+	now := time.Now()
+	s := NewSummarizer(Config{Quorum: 1})
+	addAll(t, s,
+		summaryEvent("a", "us-east", 1, now),
+		summaryEvent("a", "eu-west", 0, now),
+		summaryEvent("a", "ap-south", 0, now),
+	)
+
+	status, _ := s.Summarize(now)[0].GetValue("monitor.status")
+	require.Equal(t, "down", status)
+}
+
+func TestSummarizeLatestAndMaxAge(t *testing.T) {
+	now := time.Now()
+	s := NewSummarizer(Config{MaxAge: time.Minute})
+	addAll(t, s,
+		summaryEvent("a", "us-east", 0, now),
+		// Older results don't replace newer ones
+		summaryEvent("a", "us-east", 1, now.Add(-time.Second)),
+		summaryEvent("a", "eu-west", 1, now.Add(-2*time.Minute)),
+		summaryEvent("b", "eu-west", 1, now.Add(-2*time.Minute)),
+	)
+
+	events := s.Summarize(now)
+	require.Len(t, events, 1)
+	up, _ := events[0].GetValue("consensus.locations.up")
+	down, _ := events[0].GetValue("consensus.locations.down")
+	require.Equal(t, []string{"us-east"}, up)
+	require.Equal(t, []string{}, down)
+}
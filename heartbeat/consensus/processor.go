@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+)
+
+const processorName = "heartbeat_consensus"
+
+func init() {
+	processors.RegisterPlugin(processorName, NewProcessor)
+}
+
+// processor annotates the summary events of heartbeat instances with the
+// consensus of all the locations checking the same monitor. It is meant to run
+// in the component collecting the events of all the locations.
+type processor struct {
+	summarizer *Summarizer
+	log        *logp.Logger
+}
+
+// NewProcessor creates a processor adding the `consensus.*` fields to summary
+// events, events not concluding a check are left untouched.
+func NewProcessor(cfg *common.Config) (processors.Processor, error) {
+	config := DefaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("fail to unpack the %s configuration: %w", processorName, err)
+	}
+	return &processor{
+		summarizer: NewSummarizer(config),
+		log:        logp.NewLogger(processorName),
+	}, nil
+}
+
+// Run records the result of the summary event and sets the consensus of the
+// monitor, including this result, in its `consensus.*` fields. The
+// `monitor.status` of the event remains the status in its location.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	r, err := ResultFromEvent(*event)
+	if err == ErrNotSummary {
+		return event, nil
+	}
+	if err != nil {
+		p.log.Debugf("Ignoring summary event: %v", err)
+		return event, nil
+	}
+
+	p.summarizer.Add(r)
+	summary, ok := p.summarizer.SummarizeMonitor(r.MonitorID, time.Now())
+	if !ok {
+		// the result is already older than max_age
+		return event, nil
+	}
+	consensus, err := summary.GetValue("consensus")
+	if err != nil {
+		return event, nil
+	}
+	if _, err := event.PutValue("consensus", consensus); err != nil {
+		return event, fmt.Errorf("failed to set the consensus fields: %w", err)
+	}
+	return event, nil
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("%s=[quorum=%d, max_age=%v]", processorName, p.summarizer.config.Quorum, p.summarizer.config.MaxAge)
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestProcessor(t *testing.T) {
+	p, err := NewProcessor(common.MustNewConfigFrom(common.MapStr{"quorum": 2}))
+	require.NoError(t, err)
+
+	now := time.Now()
+	for _, testCase := range []struct {
+		location string
+		down     int
+		status   string
+		downs    []string
+	}{
+		{location: "us-east", down: 1, status: "up", downs: []string{"us-east"}},
+		{location: "eu-west", down: 0, status: "up", downs: []string{"us-east"}},
+		{location: "ap-south", down: 1, status: "down", downs: []string{"ap-south", "us-east"}},
+	} {
+		event := summaryEvent("mon", testCase.location, testCase.down, now)
+		out, err := p.Run(&event)
+		require.NoError(t, err)
+
+		status, _ := out.GetValue("consensus.status")
+		require.Equal(t, testCase.status, status, testCase.location)
+		down, _ := out.GetValue("consensus.locations.down")
+		require.Equal(t, testCase.downs, down, testCase.location)
+		// The result of the location itself is not altered
+		_, err = out.GetValue("monitor.status")
+		require.Error(t, err)
+	}
+
+	// Events not concluding a check are left untouched
+	event := summaryEvent("mon", "us-east", 0, now)
+	event.Fields.Delete("summary")
+	out, err := p.Run(&event)
+	require.NoError(t, err)
+	require.Equal(t, common.MapStr{
+		"monitor":  common.MapStr{"id": "mon"},
+		"observer": common.MapStr{"geo": common.MapStr{"name": "us-east"}},
+	}, out.Fields)
+}
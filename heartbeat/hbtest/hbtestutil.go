@@ -189,6 +189,18 @@ func SummaryChecks(up int, down int) validator.Validator {
 	})
 }
 
+// SummaryWarnChecks validates the "summary" field and its subfields when some
+// of the endpoints reported warnings.
+func SummaryWarnChecks(up int, down int, warn int) validator.Validator {
+	return lookslike.MustCompile(map[string]interface{}{
+		"summary": map[string]interface{}{
+			"up":   uint16(up),
+			"down": uint16(down),
+			"warn": uint16(warn),
+		},
+	})
+}
+
 // ResolveChecks returns a lookslike matcher for the 'resolve' fields.
 func ResolveChecks(ip string) validator.Validator {
 	return lookslike.MustCompile(map[string]interface{}{
@@ -64,5 +64,8 @@ func Status(err error) string {
 	if err == nil {
 		return "up"
 	}
+	if reason.IsWarning(err) {
+		return "warn"
+	}
 	return "down"
 }
@@ -75,3 +75,8 @@ func TestStatusNil(t *testing.T) {
 func TestStatusErr(t *testing.T) {
 	assert.Equal(t, "down", Status(fmt.Errorf("something")))
 }
+
+func TestStatusWarn(t *testing.T) {
+	assert.Equal(t, "warn", Status(reason2.Warn(reason2.ValidateFailed(fmt.Errorf("something")))))
+	assert.Equal(t, "down", Status(reason2.ValidateFailed(fmt.Errorf("something"))))
+}
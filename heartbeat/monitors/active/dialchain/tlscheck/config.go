@@ -0,0 +1,94 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlscheck
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// Config configures the checks of the TLS connections established by a monitor.
+type Config struct {
+	Expiry       ExpiryConfig   `config:"expiry"`
+	Pinned       PinnedConfig   `config:"pinned"`
+	Protocol     ProtocolConfig `config:"protocol"`
+	OCSPStapling Status         `config:"ocsp_stapling"`
+}
+
+// ExpiryConfig configures how many days before the expiry of a certificate
+// of the chain the monitor warns or is down. Zero disables the check.
+type ExpiryConfig struct {
+	Warn int `config:"warn" validate:"min=0"`
+	Down int `config:"down" validate:"min=0"`
+}
+
+// PinnedConfig configures the certificate authorities or public keys the
+// certificate chain must match.
+type PinnedConfig struct {
+	CertificateAuthorities []string `config:"certificate_authorities"`
+	SPKISHA256             []string `config:"spki_sha256"`
+	Status                 Status   `config:"status"`
+}
+
+// ProtocolConfig configures the TLS versions and cipher suites that can be
+// negotiated.
+type ProtocolConfig struct {
+	MinVersion                 *tlscommon.TLSVersion `config:"min_version"`
+	RejectInsecureCipherSuites bool                  `config:"reject_insecure_cipher_suites"`
+	Status                     Status                `config:"status"`
+}
+
+// Status is the status of a monitor failing a check, empty means down.
+type Status string
+
+const (
+	StatusDown Status = "down"
+	StatusWarn Status = "warn"
+)
+
+// Unpack validates the status of a check.
+func (s *Status) Unpack(str string) error {
+	switch status := Status(str); status {
+	case StatusDown, StatusWarn:
+		*s = status
+	default:
+		return fmt.Errorf("invalid status '%s', expected one of 'down' or 'warn'", str)
+	}
+	return nil
+}
+
+// Validate validates the expiry thresholds.
+func (c *ExpiryConfig) Validate() error {
+	if c.Warn > 0 && c.Down > c.Warn {
+		return fmt.Errorf("expiry.down (%d days) must not be greater than expiry.warn (%d days)", c.Down, c.Warn)
+	}
+	return nil
+}
+
+// Validate validates the pinned public key hashes.
+func (c *PinnedConfig) Validate() error {
+	for _, pin := range c.SPKISHA256 {
+		hash, err := base64.StdEncoding.DecodeString(pin)
+		if err != nil || len(hash) != 32 {
+			return fmt.Errorf("invalid SPKI SHA-256 pin '%s', expected a base64 encoded SHA-256 hash", pin)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package tlscheck checks the TLS connections established by monitors, for
+// certificates about to expire, unexpected certificate chains, weak protocols
+// and missing OCSP stapling.
+package tlscheck
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+const day = 24 * time.Hour
+
+// Checker runs the configured checks against established TLS connections.
+// A nil Checker runs no checks.
+type Checker struct {
+	config     Config
+	pinnedCAs  *x509.CertPool
+	pinnedSPKI map[string]bool
+	insecure   map[uint16]bool
+	now        func() time.Time
+}
+
+// NewChecker creates a Checker from the given configuration, it returns nil if
+// no check is enabled.
+func NewChecker(config Config) (*Checker, error) {
+	c := &Checker{
+		config:     config,
+		pinnedSPKI: map[string]bool{},
+		insecure:   map[uint16]bool{},
+		now:        time.Now,
+	}
+
+	if len(config.Pinned.CertificateAuthorities) > 0 {
+		pool, errs := tlscommon.LoadCertificateAuthorities(config.Pinned.CertificateAuthorities)
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("failed to load pinned certificate authorities: %v", errs)
+		}
+		c.pinnedCAs = pool
+	}
+	for _, pin := range config.Pinned.SPKISHA256 {
+		c.pinnedSPKI[pin] = true
+	}
+	for _, suite := range tls.InsecureCipherSuites() {
+		c.insecure[suite.ID] = true
+	}
+
+	if !c.enabled() {
+		return nil, nil
+	}
+	return c, nil
+}
+
+func (c *Checker) enabled() bool {
+	return c.config.Expiry.Warn > 0 || c.config.Expiry.Down > 0 ||
+		c.pinnedCAs != nil || len(c.pinnedSPKI) > 0 ||
+		c.config.Protocol.MinVersion != nil || c.config.Protocol.RejectInsecureCipherSuites ||
+		c.config.OCSPStapling != ""
+}
+
+// results collects the failures of the checks.
+type results struct {
+	down, warn []string
+}
+
+func (r *results) add(status Status, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if status == StatusWarn {
+		r.warn = append(r.warn, msg)
+	} else {
+		r.down = append(r.down, msg)
+	}
+}
+
+// Check checks the state of an established TLS connection. It returns a
+// validation failure if any of the failed checks makes the monitor down, or a
+// warning if all of them only warn.
+func (c *Checker) Check(state *tls.ConnectionState) reason.Reason {
+	if c == nil || state == nil {
+		return nil
+	}
+
+	var r results
+	c.checkExpiry(state, &r)
+	c.checkPinned(state, &r)
+	c.checkProtocol(state, &r)
+	c.checkOCSPStapling(state, &r)
+
+	if len(r.down) > 0 {
+		return reason.ValidateFailed(errors.New(strings.Join(append(r.down, r.warn...), "; ")))
+	}
+	if len(r.warn) > 0 {
+		return reason.Warn(reason.ValidateFailed(errors.New(strings.Join(r.warn, "; "))))
+	}
+	return nil
+}
+
+func (c *Checker) checkExpiry(state *tls.ConnectionState, r *results) {
+	expiry := c.config.Expiry
+	if expiry.Warn == 0 && expiry.Down == 0 {
+		return
+	}
+
+	now := c.now()
+	for _, cert := range state.PeerCertificates {
+		remaining := cert.NotAfter.Sub(now)
+
+		var status Status
+		switch {
+		case expiry.Down > 0 && remaining < time.Duration(expiry.Down)*day:
+			status = StatusDown
+		case expiry.Warn > 0 && remaining < time.Duration(expiry.Warn)*day:
+			status = StatusWarn
+		default:
+			continue
+		}
+
+		notAfter := cert.NotAfter.UTC().Format(time.RFC3339)
+		if remaining < 0 {
+			r.add(status, "certificate '%s' expired on %s", certName(cert), notAfter)
+		} else {
+			r.add(status, "certificate '%s' expires in %d days, on %s", certName(cert), int(remaining/day), notAfter)
+		}
+	}
+}
+
+func (c *Checker) checkPinned(state *tls.ConnectionState, r *results) {
+	status := c.config.Pinned.Status
+	if c.pinnedCAs == nil && len(c.pinnedSPKI) == 0 {
+		return
+	}
+	if len(state.PeerCertificates) == 0 {
+		r.add(status, "no certificate presented to match the pinned certificates")
+		return
+	}
+
+	if c.pinnedCAs != nil {
+		intermediates := x509.NewCertPool()
+		for _, cert := range state.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+		_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+			Roots:         c.pinnedCAs,
+			Intermediates: intermediates,
+			CurrentTime:   c.now(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err != nil {
+			r.add(status, "certificate chain doesn't match the pinned certificate authorities: %v", err)
+		}
+	}
+
+	if len(c.pinnedSPKI) > 0 && !c.matchesSPKI(state) {
+		r.add(status, "no certificate of the chain matches the pinned public keys")
+	}
+}
+
+// matchesSPKI checks the hashes of the public keys of the presented and
+// verified chains, so roots not sent by the server can be pinned too.
+func (c *Checker) matchesSPKI(state *tls.ConnectionState) bool {
+	certs := state.PeerCertificates
+	for _, chain := range state.VerifiedChains {
+		certs = append(certs, chain...)
+	}
+
+	for _, cert := range certs {
+		hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+		if c.pinnedSPKI[base64.StdEncoding.EncodeToString(hash[:])] {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Checker) checkProtocol(state *tls.ConnectionState, r *results) {
+	protocol := c.config.Protocol
+
+	if protocol.MinVersion != nil && state.Version < uint16(*protocol.MinVersion) {
+		r.add(protocol.Status, "negotiated %v, lower than %v", tlscommon.TLSVersion(state.Version), *protocol.MinVersion)
+	}
+	if protocol.RejectInsecureCipherSuites && c.insecure[state.CipherSuite] {
+		r.add(protocol.Status, "negotiated insecure cipher suite %s", tls.CipherSuiteName(state.CipherSuite))
+	}
+}
+
+// checkOCSPStapling requires a stapled OCSP response reporting the certificate
+// as good. A revoked certificate always makes the monitor down.
+func (c *Checker) checkOCSPStapling(state *tls.ConnectionState, r *results) {
+	status := c.config.OCSPStapling
+	if status == "" {
+		return
+	}
+	if len(state.OCSPResponse) == 0 {
+		r.add(status, "no OCSP response stapled")
+		return
+	}
+	if len(state.PeerCertificates) == 0 {
+		return
+	}
+
+	var issuer *x509.Certificate
+	if len(state.PeerCertificates) > 1 {
+		issuer = state.PeerCertificates[1]
+	}
+	resp, err := ocsp.ParseResponseForCert(state.OCSPResponse, state.PeerCertificates[0], issuer)
+	if err != nil {
+		r.add(status, "invalid stapled OCSP response: %v", err)
+		return
+	}
+
+	switch resp.Status {
+	case ocsp.Good:
+	case ocsp.Revoked:
+		r.add(StatusDown, "stapled OCSP response reports certificate '%s' as revoked on %s",
+			certName(state.PeerCertificates[0]), resp.RevokedAt.UTC().Format(time.RFC3339))
+	default:
+		r.add(status, "stapled OCSP response doesn't know the status of certificate '%s'", certName(state.PeerCertificates[0]))
+	}
+}
+
+func certName(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	return cert.Subject.String()
+}
@@ -0,0 +1,299 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlscheck
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, name string, notAfter time.Time, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil || name != "leaf",
+	}
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key}
+}
+
+// testChain creates a leaf certificate signed by an intermediate signed by a root.
+func testChain(t *testing.T, leafNotAfter time.Time) (leaf, intermediate, root *testCert) {
+	root = newTestCert(t, "root", time.Now().Add(10*365*day), nil)
+	intermediate = newTestCert(t, "intermediate", time.Now().Add(5*365*day), root)
+	leaf = newTestCert(t, "leaf", leafNotAfter, intermediate)
+	return leaf, intermediate, root
+}
+
+func connState(leaf, intermediate *testCert) *tls.ConnectionState {
+	return &tls.ConnectionState{
+		Version:          tls.VersionTLS12,
+		CipherSuite:      tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		PeerCertificates: []*x509.Certificate{leaf.cert, intermediate.cert},
+	}
+}
+
+func newTestChecker(t *testing.T, cfg map[string]interface{}) *Checker {
+	config := Config{}
+	require.NoError(t, common.MustNewConfigFrom(cfg).Unpack(&config))
+
+	checker, err := NewChecker(config)
+	require.NoError(t, err)
+	return checker
+}
+
+func assertResult(t *testing.T, r reason.Reason, status string, msg string) {
+	t.Helper()
+
+	switch status {
+	case "up":
+		assert.NoError(t, r)
+		return
+	case "warn":
+		assert.True(t, reason.IsWarning(r), "expected a warning, got %v", r)
+	case "down":
+		require.Error(t, r)
+		assert.False(t, reason.IsWarning(r), "expected a failure, got a warning: %v", r)
+	}
+	assert.Equal(t, "validate", r.Type())
+	assert.Contains(t, r.Error(), msg)
+}
+
+func TestNoChecks(t *testing.T) {
+	checker := newTestChecker(t, map[string]interface{}{})
+	assert.Nil(t, checker)
+
+	leaf, intermediate, _ := testChain(t, time.Now().Add(day))
+	assert.NoError(t, checker.Check(connState(leaf, intermediate)))
+}
+
+func TestExpiry(t *testing.T) {
+	config := map[string]interface{}{"expiry.warn": 30, "expiry.down": 7}
+
+	tests := map[string]struct {
+		notAfter time.Time
+		status   string
+		msg      string
+	}{
+		"valid":   {time.Now().Add(60 * day), "up", ""},
+		"warn":    {time.Now().Add(20*day + time.Hour), "warn", "certificate 'leaf' expires in 20 days"},
+		"down":    {time.Now().Add(3*day + time.Hour), "down", "certificate 'leaf' expires in 3 days"},
+		"expired": {time.Now().Add(-time.Hour), "down", "certificate 'leaf' expired on"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			leaf, intermediate, _ := testChain(t, test.notAfter)
+			assertResult(t, newTestChecker(t, config).Check(connState(leaf, intermediate)), test.status, test.msg)
+		})
+	}
+
+	t.Run("intermediate", func(t *testing.T) {
+		root := newTestCert(t, "root", time.Now().Add(10*365*day), nil)
+		intermediate := newTestCert(t, "intermediate", time.Now().Add(10*day), root)
+		leaf := newTestCert(t, "leaf", time.Now().Add(5*day), intermediate)
+
+		r := newTestChecker(t, map[string]interface{}{"expiry.warn": 30}).Check(connState(leaf, intermediate))
+		assertResult(t, r, "warn", "certificate 'leaf' expires in 4 days")
+		assert.Contains(t, r.Error(), "certificate 'intermediate' expires in 9 days")
+	})
+}
+
+func TestPinned(t *testing.T) {
+	leaf, intermediate, root := testChain(t, time.Now().Add(365*day))
+	_, _, otherRoot := testChain(t, time.Now().Add(365*day))
+
+	writeCA := func(t *testing.T, cert *testCert) string {
+		path := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.cert.Raw}), 0600))
+		return path
+	}
+	spki := func(cert *testCert) string {
+		hash := sha256.Sum256(cert.cert.RawSubjectPublicKeyInfo)
+		return base64.StdEncoding.EncodeToString(hash[:])
+	}
+
+	tests := map[string]struct {
+		config map[string]interface{}
+		status string
+		msg    string
+	}{
+		"matching CA": {
+			config: map[string]interface{}{"pinned.certificate_authorities": []string{writeCA(t, root)}},
+			status: "up",
+		},
+		"other CA": {
+			config: map[string]interface{}{"pinned.certificate_authorities": []string{writeCA(t, otherRoot)}},
+			status: "down",
+			msg:    "certificate chain doesn't match the pinned certificate authorities",
+		},
+		"other CA warn": {
+			config: map[string]interface{}{
+				"pinned.certificate_authorities": []string{writeCA(t, otherRoot)},
+				"pinned.status":                  "warn",
+			},
+			status: "warn",
+			msg:    "certificate chain doesn't match the pinned certificate authorities",
+		},
+		"matching SPKI": {
+			config: map[string]interface{}{"pinned.spki_sha256": []string{spki(otherRoot), spki(intermediate)}},
+			status: "up",
+		},
+		"other SPKI": {
+			config: map[string]interface{}{"pinned.spki_sha256": []string{spki(otherRoot)}},
+			status: "down",
+			msg:    "no certificate of the chain matches the pinned public keys",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assertResult(t, newTestChecker(t, test.config).Check(connState(leaf, intermediate)), test.status, test.msg)
+		})
+	}
+
+	t.Run("verified root SPKI", func(t *testing.T) {
+		state := connState(leaf, intermediate)
+		state.VerifiedChains = [][]*x509.Certificate{{leaf.cert, intermediate.cert, root.cert}}
+		checker := newTestChecker(t, map[string]interface{}{"pinned.spki_sha256": []string{spki(root)}})
+		assert.NoError(t, checker.Check(state))
+	})
+}
+
+func TestProtocol(t *testing.T) {
+	leaf, intermediate, _ := testChain(t, time.Now().Add(365*day))
+	config := map[string]interface{}{
+		"protocol.min_version":                   "TLSv1.2",
+		"protocol.reject_insecure_cipher_suites": true,
+		"protocol.status":                        "warn",
+	}
+
+	assertResult(t, newTestChecker(t, config).Check(connState(leaf, intermediate)), "up", "")
+
+	state := connState(leaf, intermediate)
+	state.Version = tls.VersionTLS11
+	assertResult(t, newTestChecker(t, config).Check(state), "warn", "negotiated TLSv1.1, lower than TLSv1.2")
+
+	state = connState(leaf, intermediate)
+	state.CipherSuite = tls.TLS_RSA_WITH_RC4_128_SHA
+	assertResult(t, newTestChecker(t, config).Check(state), "warn", "negotiated insecure cipher suite TLS_RSA_WITH_RC4_128_SHA")
+}
+
+func TestOCSPStapling(t *testing.T) {
+	leaf, intermediate, _ := testChain(t, time.Now().Add(365*day))
+
+	staple := func(t *testing.T, status int) []byte {
+		resp, err := ocsp.CreateResponse(intermediate.cert, intermediate.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: leaf.cert.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Hour),
+			NextUpdate:   time.Now().Add(day),
+			RevokedAt:    time.Now().Add(-time.Hour),
+		}, intermediate.key)
+		require.NoError(t, err)
+		return resp
+	}
+
+	tests := map[string]struct {
+		response []byte
+		status   string
+		msg      string
+	}{
+		"missing": {nil, "warn", "no OCSP response stapled"},
+		"good":    {staple(t, ocsp.Good), "up", ""},
+		"revoked": {staple(t, ocsp.Revoked), "down", "stapled OCSP response reports certificate 'leaf' as revoked"},
+		"unknown": {staple(t, ocsp.Unknown), "warn", "stapled OCSP response doesn't know the status of certificate 'leaf'"},
+		"invalid": {[]byte("invalid"), "warn", "invalid stapled OCSP response"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := connState(leaf, intermediate)
+			state.OCSPResponse = test.response
+			assertResult(t, newTestChecker(t, map[string]interface{}{"ocsp_stapling": "warn"}).Check(state), test.status, test.msg)
+		})
+	}
+}
+
+func TestDownAndWarn(t *testing.T) {
+	leaf, intermediate, _ := testChain(t, time.Now().Add(20*day+time.Hour))
+	checker := newTestChecker(t, map[string]interface{}{
+		"expiry.warn":   30,
+		"ocsp_stapling": "down",
+	})
+
+	r := checker.Check(connState(leaf, intermediate))
+	assertResult(t, r, "down", "no OCSP response stapled; certificate 'leaf' expires in 20 days")
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"negative expiry":     {"expiry.warn": -1},
+		"down after warn":     {"expiry.warn": 7, "expiry.down": 30},
+		"invalid status":      {"ocsp_stapling": "up"},
+		"invalid pin":         {"pinned.spki_sha256": []string{"not a hash"}},
+		"invalid TLS version": {"protocol.min_version": "TLSv0.9"},
+	}
+
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := Config{}
+			assert.Error(t, common.MustNewConfigFrom(cfg).Unpack(&config))
+		})
+	}
+}
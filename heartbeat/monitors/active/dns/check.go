@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// checkResponse validates a response with the configured checks
+func (jf *jobFactory) checkResponse(response *dns.Msg) error {
+	if !jf.rcodes[response.Rcode] {
+		return fmt.Errorf("received response code %s, expected %s",
+			dns.RcodeToString[response.Rcode], strings.Join(jf.config.Check.RCode, " or "))
+	}
+
+	if jf.config.DNSSEC && !response.AuthenticatedData {
+		return fmt.Errorf("response is not authenticated with DNSSEC by the resolver")
+	}
+
+	// Answers of other types, like the CNAMEs leading to the queried
+	// records, are not checked
+	var answers []dns.RR
+	for _, rr := range response.Answer {
+		if rr.Header().Rrtype == jf.queryType {
+			answers = append(answers, rr)
+		}
+	}
+
+	if err := checkAnswers(answers, jf.config.Check.Answers); err != nil {
+		return err
+	}
+
+	return checkTTL(answers, jf.config.Check.TTL)
+}
+
+// checkAnswers checks that all the expected answers are in the response. An
+// expected answer matches when it is the data of an answer, or its leading
+// fields, like the priority of MX records or the primary name server of SOA records.
+func checkAnswers(answers []dns.RR, expected []string) error {
+	var data []string
+	for _, rr := range answers {
+		data = append(data, strings.ToLower(answerData(rr)))
+	}
+
+	for _, e := range expected {
+		e = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(e), "."))
+		found := false
+		for _, d := range data {
+			if d == e || strings.HasPrefix(d, e+" ") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("expected answer '%s' not found in %v", e, data)
+		}
+	}
+	return nil
+}
+
+// checkTTL checks that the TTL of all the answers is in the configured range
+func checkTTL(answers []dns.RR, config ttlCheckConfig) error {
+	for _, rr := range answers {
+		ttl := time.Duration(rr.Header().Ttl) * time.Second
+		if ttl < config.Min {
+			return fmt.Errorf("TTL of answer '%s' is %v, lower than %v", answerData(rr), ttl, config.Min)
+		}
+		if config.Max > 0 && ttl > config.Max {
+			return fmt.Errorf("TTL of answer '%s' is %v, greater than %v", answerData(rr), ttl, config.Max)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+type config struct {
+	// names to query
+	Hosts []string `config:"hosts" validate:"required"`
+
+	// resolvers to query, as `[udp|tcp|tls]://host[:port]` or DNS over HTTPS URLs.
+	// The resolvers of the host are used if none is configured.
+	Resolvers []string `config:"resolvers"`
+
+	QueryType string `config:"query_type"`
+
+	// request DNSSEC records and require the response to be validated by the resolver
+	DNSSEC bool `config:"dnssec"`
+
+	// configure tls, for DNS over TLS and DNS over HTTPS resolvers
+	TLS *tlscommon.Config `config:"ssl"`
+
+	Timeout time.Duration `config:"timeout"`
+
+	// validate response
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	RCode   []string       `config:"rcode"`
+	Answers []string       `config:"answers"`
+	TTL     ttlCheckConfig `config:"ttl"`
+}
+
+type ttlCheckConfig struct {
+	Min time.Duration `config:"min"`
+	Max time.Duration `config:"max"`
+}
+
+var queryTypes = map[string]uint16{
+	"A":     dns.TypeA,
+	"AAAA":  dns.TypeAAAA,
+	"CNAME": dns.TypeCNAME,
+	"MX":    dns.TypeMX,
+	"TXT":   dns.TypeTXT,
+	"SOA":   dns.TypeSOA,
+}
+
+func defaultConfig() config {
+	return config{
+		QueryType: "A",
+		Timeout:   16 * time.Second,
+		Check: checkConfig{
+			RCode: []string{"NOERROR"},
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	if _, ok := queryTypes[strings.ToUpper(c.QueryType)]; !ok {
+		return fmt.Errorf("unsupported query_type '%s'", c.QueryType)
+	}
+
+	for _, rcode := range c.Check.RCode {
+		if _, ok := dns.StringToRcode[strings.ToUpper(rcode)]; !ok {
+			return fmt.Errorf("unknown response code '%s' in check.rcode", rcode)
+		}
+	}
+
+	if c.Check.TTL.Min < 0 || c.Check.TTL.Max < 0 {
+		return fmt.Errorf("check.ttl values must be positive")
+	}
+	if c.Check.TTL.Max > 0 && c.Check.TTL.Min > c.Check.TTL.Max {
+		return fmt.Errorf("check.ttl.min (%v) is greater than check.ttl.max (%v)", c.Check.TTL.Min, c.Check.TTL.Max)
+	}
+
+	for _, resolver := range c.Resolvers {
+		if _, err := parseResolver(resolver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	plugin.Register("dns", create, "synthetics/dns")
+}
+
+var debugf = logp.MakeDebug("dns")
+
+func create(
+	name string,
+	cfg *common.Config,
+) (p plugin.Plugin, err error) {
+	jf, err := newJobFactory(cfg)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	js, err := jf.makeJobs()
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(js)}, nil
+}
+
+// jobFactory creates the jobs querying every configured name to every resolver.
+type jobFactory struct {
+	config    config
+	tlsConfig *tlscommon.TLSConfig
+	queryType uint16
+	rcodes    map[int]bool
+	resolvers []*url.URL
+}
+
+func newJobFactory(commonCfg *common.Config) (*jobFactory, error) {
+	jf := &jobFactory{config: defaultConfig()}
+	if err := jf.loadConfig(commonCfg); err != nil {
+		return nil, err
+	}
+	return jf, nil
+}
+
+// loadConfig parses the YAML config and populates the jobFactory fields.
+func (jf *jobFactory) loadConfig(commonCfg *common.Config) error {
+	var err error
+	if err = commonCfg.Unpack(&jf.config); err != nil {
+		return err
+	}
+
+	jf.tlsConfig, err = tlscommon.LoadTLSConfig(jf.config.TLS)
+	if err != nil {
+		return err
+	}
+
+	jf.queryType = queryTypes[strings.ToUpper(jf.config.QueryType)]
+
+	jf.rcodes = make(map[int]bool, len(jf.config.Check.RCode))
+	for _, rcode := range jf.config.Check.RCode {
+		jf.rcodes[dns.StringToRcode[strings.ToUpper(rcode)]] = true
+	}
+
+	resolvers := jf.config.Resolvers
+	if len(resolvers) == 0 {
+		if resolvers, err = systemResolvers(); err != nil {
+			return err
+		}
+	}
+	for _, resolver := range resolvers {
+		u, err := parseResolver(resolver)
+		if err != nil {
+			return err
+		}
+		jf.resolvers = append(jf.resolvers, u)
+	}
+
+	return nil
+}
+
+// makeJobs returns a job for each name and resolver.
+func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
+	var js []jobs.Job
+	for _, resolver := range jf.resolvers {
+		exchange := newExchanger(resolver, jf.tlsConfig, jf.config.Timeout)
+		for _, host := range jf.config.Hosts {
+			if _, ok := dns.IsDomainName(host); !ok {
+				return nil, fmt.Errorf("invalid name to query '%s'", host)
+			}
+			js = append(js, wrappers.WithURLField(resolver, jf.makeQueryJob(host, exchange)))
+		}
+	}
+	return js, nil
+}
+
+// makeQueryJob makes a job querying the given name, its duration is the time
+// needed to resolve it.
+func (jf *jobFactory) makeQueryJob(name string, exchange exchanger) jobs.Job {
+	return jobs.MakeSimpleJob(func(event *beat.Event) error {
+		query := new(dns.Msg)
+		query.SetQuestion(dns.Fqdn(name), jf.queryType)
+		if jf.config.DNSSEC {
+			query.SetEdns0(4096, true)
+			query.AuthenticatedData = true
+		}
+
+		eventext.MergeEventFields(event, common.MapStr{
+			"dns": common.MapStr{
+				"question": common.MapStr{
+					"name":  strings.TrimSuffix(dns.Fqdn(name), "."),
+					"type":  dns.TypeToString[jf.queryType],
+					"class": "IN",
+				},
+			},
+		})
+
+		response, err := exchange(query)
+		if err != nil {
+			debugf("query of %s failed: %v", name, err)
+			return reason.IOFailed(err)
+		}
+
+		eventext.MergeEventFields(event, common.MapStr{"dns": responseFields(response)})
+
+		return reason.ValidateFailed(jf.checkResponse(response))
+	})
+}
+
+// responseFields returns the ECS fields describing a response
+func responseFields(response *dns.Msg) common.MapStr {
+	fields := common.MapStr{
+		"id":            response.Id,
+		"type":          "answer",
+		"response_code": dns.RcodeToString[response.Rcode],
+		"header_flags":  headerFlags(response),
+	}
+
+	var answers []common.MapStr
+	var resolvedIPs []string
+	for _, rr := range response.Answer {
+		header := rr.Header()
+		answers = append(answers, common.MapStr{
+			"name":  strings.TrimSuffix(header.Name, "."),
+			"type":  dns.TypeToString[header.Rrtype],
+			"class": dns.ClassToString[header.Class],
+			"ttl":   header.Ttl,
+			"data":  answerData(rr),
+		})
+
+		switch rr := rr.(type) {
+		case *dns.A:
+			resolvedIPs = append(resolvedIPs, rr.A.String())
+		case *dns.AAAA:
+			resolvedIPs = append(resolvedIPs, rr.AAAA.String())
+		}
+	}
+	if len(answers) > 0 {
+		fields["answers"] = answers
+	}
+	if len(resolvedIPs) > 0 {
+		fields["resolved_ip"] = resolvedIPs
+	}
+
+	return fields
+}
+
+func headerFlags(response *dns.Msg) []string {
+	flags := []string{}
+	for _, flag := range []struct {
+		name string
+		set  bool
+	}{
+		{"AA", response.Authoritative},
+		{"TC", response.Truncated},
+		{"RD", response.RecursionDesired},
+		{"RA", response.RecursionAvailable},
+		{"AD", response.AuthenticatedData},
+		{"CD", response.CheckingDisabled},
+	} {
+		if flag.set {
+			flags = append(flags, flag.name)
+		}
+	}
+	if opt := response.IsEdns0(); opt != nil && opt.Do() {
+		flags = append(flags, "DO")
+	}
+	return flags
+}
+
+// answerData formats the data of an answer as `dig +short` does, without the
+// trailing dots of names and with the strings of TXT records joined
+func answerData(rr dns.RR) string {
+	switch rr := rr.(type) {
+	case *dns.A:
+		return rr.A.String()
+	case *dns.AAAA:
+		return rr.AAAA.String()
+	case *dns.CNAME:
+		return strings.TrimSuffix(rr.Target, ".")
+	case *dns.MX:
+		return fmt.Sprintf("%d %s", rr.Preference, strings.TrimSuffix(rr.Mx, "."))
+	case *dns.TXT:
+		return strings.Join(rr.Txt, "")
+	case *dns.SOA:
+		return fmt.Sprintf("%s %s %d %d %d %d %d",
+			strings.TrimSuffix(rr.Ns, "."), strings.TrimSuffix(rr.Mbox, "."),
+			rr.Serial, rr.Refresh, rr.Retry, rr.Expire, rr.Minttl)
+	default:
+		return strings.TrimSpace(strings.TrimPrefix(rr.String(), rr.Header().String()))
+	}
+}
@@ -0,0 +1,508 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+var testRecords = []string{
+	"example.com. 300 IN A 192.0.2.10",
+	"example.com. 300 IN A 192.0.2.11",
+	"example.com. 300 IN AAAA 2001:db8::10",
+	"example.com. 3600 IN MX 10 mail.example.com.",
+	"example.com. 3600 IN MX 20 backup.example.com.",
+	`example.com. 3600 IN TXT "v=spf1 " "-all"`,
+	"example.com. 3600 IN SOA ns1.example.com. hostmaster.example.com. 2021091401 7200 3600 1209600 300",
+	"www.example.com. 60 IN CNAME example.com.",
+}
+
+// testServer is an in-process DNS server answering the test records over
+// UDP, TCP, TLS and HTTPS.
+type testServer struct {
+	testServerOptions
+	zone map[string][]dns.RR
+
+	udp, tcp, tls string
+	https         string
+	caFile        string
+	closers       []func()
+}
+
+type testServerOptions struct {
+	// signed makes the server authenticate responses of DNSSEC queries
+	signed bool
+	// truncate makes the server truncate responses over UDP
+	truncate bool
+}
+
+func newTestServer(t *testing.T, options testServerOptions, records ...string) *testServer {
+	s := &testServer{testServerOptions: options, zone: map[string][]dns.RR{}}
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		require.NoError(t, err)
+		s.zone[rr.Header().Name] = append(s.zone[rr.Header().Name], rr)
+	}
+
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, query *dns.Msg) {
+		response := s.answer(query)
+		if _, isUDP := w.RemoteAddr().(*net.UDPAddr); isUDP && s.truncate {
+			response.Answer = nil
+			response.Truncated = true
+		}
+		w.WriteMsg(response)
+	})
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s.udp = pc.LocalAddr().String()
+	s.serve(&dns.Server{PacketConn: pc, Handler: handler})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s.tcp = l.Addr().String()
+	s.serve(&dns.Server{Listener: l, Handler: handler})
+
+	doh := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		query := new(dns.Msg)
+		if r.Method != "POST" || r.Header.Get("Content-Type") != dohMediaType || err != nil || query.Unpack(body) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		response, _ := s.answer(query).Pack()
+		w.Header().Set("Content-Type", dohMediaType)
+		w.Write(response)
+	}))
+	s.https = doh.URL + "/dns-query"
+	s.closers = append(s.closers, doh.Close)
+
+	// DNS over TLS uses the same certificate than the DNS over HTTPS server
+	tl, err := tls.Listen("tcp", "127.0.0.1:0", doh.TLS)
+	require.NoError(t, err)
+	s.tls = tl.Addr().String()
+	s.serve(&dns.Server{Listener: tl, Net: "tcp-tls", Handler: handler})
+
+	caFile := hbtest.CertToTempFile(t, doh.Certificate())
+	caFile.Close()
+	s.caFile = caFile.Name()
+	s.closers = append(s.closers, func() { os.Remove(s.caFile) })
+
+	return s
+}
+
+func (s *testServer) serve(server *dns.Server) {
+	started := make(chan struct{})
+	server.NotifyStartedFunc = func() { close(started) }
+	go server.ActivateAndServe()
+	<-started
+	s.closers = append(s.closers, func() { server.Shutdown() })
+}
+
+func (s *testServer) Close() {
+	for _, closer := range s.closers {
+		closer()
+	}
+}
+
+func (s *testServer) answer(query *dns.Msg) *dns.Msg {
+	response := new(dns.Msg)
+	response.SetReply(query)
+	response.RecursionAvailable = true
+
+	question := query.Question[0]
+	name := question.Name
+	records, found := s.zone[name]
+	if !found {
+		response.Rcode = dns.RcodeNameError
+		return response
+	}
+
+	for i := 0; i < len(records); i++ {
+		rr := records[i]
+		if cname, ok := rr.(*dns.CNAME); ok && question.Qtype != dns.TypeCNAME {
+			// Follow the alias as recursive resolvers do
+			response.Answer = append(response.Answer, rr)
+			records = append(records, s.zone[cname.Target]...)
+			continue
+		}
+		if rr.Header().Rrtype == question.Qtype {
+			response.Answer = append(response.Answer, rr)
+		}
+	}
+
+	if opt := query.IsEdns0(); opt != nil {
+		response.SetEdns0(opt.UDPSize(), opt.Do())
+		response.AuthenticatedData = opt.Do() && s.signed
+	}
+	return response
+}
+
+func runChecks(t *testing.T, configMap common.MapStr) ([]*beat.Event, int) {
+	config, err := common.NewConfigFrom(configMap)
+	require.NoError(t, err)
+
+	p, err := create("dns", config)
+	require.NoError(t, err)
+
+	sched := schedule.MustParse("@every 1s")
+	js := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "dns", Schedule: sched, Timeout: 1})
+
+	var events []*beat.Event
+	for _, job := range js {
+		event := &beat.Event{}
+		_, err = job(event)
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	return events, p.Endpoints
+}
+
+func resolverChecks(t *testing.T, resolver string) validator.Validator {
+	u, err := parseResolver(resolver)
+	require.NoError(t, err)
+	return hbtest.URLChecks(t, u)
+}
+
+func TestResolvers(t *testing.T) {
+	server := newTestServer(t, testServerOptions{}, testRecords...)
+	defer server.Close()
+
+	resolvers := []string{
+		server.udp,
+		"udp://" + server.udp,
+		"tcp://" + server.tcp,
+		"tls://" + server.tls,
+		server.https,
+	}
+	for _, resolver := range resolvers {
+		t.Run(resolver, func(t *testing.T) {
+			events, endpoints := runChecks(t, common.MapStr{
+				"hosts":                       []string{"example.com"},
+				"resolvers":                   []string{resolver},
+				"timeout":                     "1s",
+				"ssl.certificate_authorities": []string{server.caFile},
+			})
+			require.Equal(t, 1, endpoints)
+			require.Len(t, events, 1)
+
+			testslike.Test(
+				t,
+				lookslike.Strict(lookslike.Compose(
+					hbtest.BaseChecks("", "up", "dns"),
+					hbtest.SummaryChecks(1, 0),
+					resolverChecks(t, resolver),
+					lookslike.MustCompile(map[string]interface{}{
+						"dns": map[string]interface{}{
+							"id":             isdef.KeyPresent,
+							"type":           "answer",
+							"question.name":  "example.com",
+							"question.type":  "A",
+							"question.class": "IN",
+							"response_code":  "NOERROR",
+							"header_flags":   []string{"RD", "RA"},
+							"resolved_ip":    []string{"192.0.2.10", "192.0.2.11"},
+							"answers": []common.MapStr{
+								{"name": "example.com", "type": "A", "class": "IN", "ttl": uint32(300), "data": "192.0.2.10"},
+								{"name": "example.com", "type": "A", "class": "IN", "ttl": uint32(300), "data": "192.0.2.11"},
+							},
+						},
+					}),
+				)),
+				events[0].Fields,
+			)
+		})
+	}
+}
+
+func TestQueryTypes(t *testing.T) {
+	server := newTestServer(t, testServerOptions{}, testRecords...)
+	defer server.Close()
+
+	tests := []struct {
+		host      string
+		queryType string
+		answers   []string
+	}{
+		{"example.com", "AAAA", []string{"2001:db8::10"}},
+		{"example.com", "MX", []string{"10 mail.example.com", "20 backup.example.com"}},
+		{"example.com", "TXT", []string{"v=spf1 -all"}},
+		{"example.com", "SOA", []string{"ns1.example.com hostmaster.example.com 2021091401 7200 3600 1209600 300"}},
+		{"www.example.com", "CNAME", []string{"example.com"}},
+		{"www.example.com", "A", []string{"example.com", "192.0.2.10", "192.0.2.11"}},
+	}
+	for _, test := range tests {
+		t.Run(test.host+" "+test.queryType, func(t *testing.T) {
+			events, _ := runChecks(t, common.MapStr{
+				"hosts":      []string{test.host},
+				"resolvers":  []string{server.udp},
+				"query_type": strings.ToLower(test.queryType),
+				"timeout":    "1s",
+			})
+			require.Len(t, events, 1)
+
+			status, _ := events[0].GetValue("monitor.status")
+			assert.Equal(t, "up", status)
+			questionType, _ := events[0].GetValue("dns.question.type")
+			assert.Equal(t, test.queryType, questionType)
+
+			answers, err := events[0].GetValue("dns.answers")
+			require.NoError(t, err)
+			var data []string
+			for _, answer := range answers.([]common.MapStr) {
+				data = append(data, answer["data"].(string))
+			}
+			assert.Equal(t, test.answers, data)
+		})
+	}
+}
+
+func TestChecks(t *testing.T) {
+	tests := []struct {
+		name   string
+		config common.MapStr
+		signed bool
+		status string
+		error  string
+	}{
+		{
+			name:   "expected answers",
+			config: common.MapStr{"check.answers": []string{"192.0.2.11", "192.0.2.10"}},
+			status: "up",
+		},
+		{
+			name:   "missing answer",
+			config: common.MapStr{"check.answers": []string{"192.0.2.10", "192.0.2.12"}},
+			status: "down",
+			error:  "expected answer '192.0.2.12' not found",
+		},
+		{
+			name:   "answer prefix",
+			config: common.MapStr{"query_type": "SOA", "check.answers": []string{"NS1.example.com."}},
+			status: "up",
+		},
+		{
+			name:   "aliases are not checked",
+			config: common.MapStr{"hosts": []string{"www.example.com"}, "check.answers": []string{"example.com"}},
+			status: "down",
+			error:  "expected answer 'example.com' not found",
+		},
+		{
+			name:   "unknown name",
+			config: common.MapStr{"hosts": []string{"unknown.example.com"}},
+			status: "down",
+			error:  "received response code NXDOMAIN, expected NOERROR",
+		},
+		{
+			name:   "expected unknown name",
+			config: common.MapStr{"hosts": []string{"unknown.example.com"}, "check.rcode": []string{"nxdomain"}},
+			status: "up",
+		},
+		{
+			name:   "ttl in range",
+			config: common.MapStr{"check.ttl.min": "1m", "check.ttl.max": "5m"},
+			status: "up",
+		},
+		{
+			name:   "ttl too low",
+			config: common.MapStr{"check.ttl.min": "10m"},
+			status: "down",
+			error:  "TTL of answer '192.0.2.10' is 5m0s, lower than 10m0s",
+		},
+		{
+			name:   "ttl too high",
+			config: common.MapStr{"query_type": "MX", "check.ttl.max": "10m"},
+			status: "down",
+			error:  "TTL of answer '10 mail.example.com' is 1h0m0s, greater than 10m0s",
+		},
+		{
+			name:   "dnssec validated",
+			config: common.MapStr{"dnssec": true},
+			signed: true,
+			status: "up",
+		},
+		{
+			name:   "dnssec not validated",
+			config: common.MapStr{"dnssec": true},
+			status: "down",
+			error:  "response is not authenticated with DNSSEC",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, testServerOptions{signed: test.signed}, testRecords...)
+			defer server.Close()
+
+			config := common.MapStr{
+				"hosts":     []string{"example.com"},
+				"resolvers": []string{server.udp},
+				"timeout":   "1s",
+			}
+			config.DeepUpdate(test.config)
+
+			events, _ := runChecks(t, config)
+			require.Len(t, events, 1)
+
+			status, _ := events[0].GetValue("monitor.status")
+			assert.Equal(t, test.status, status)
+			if test.error != "" {
+				testslike.Test(t, hbtest.ErrorChecks(test.error, "validate"), events[0].Fields)
+			} else {
+				_, err := events[0].GetValue("error")
+				assert.Error(t, err, "no error expected")
+			}
+		})
+	}
+}
+
+func TestTruncatedUDPResponse(t *testing.T) {
+	server := newTestServer(t, testServerOptions{truncate: true}, testRecords...)
+	defer server.Close()
+
+	// The UDP and TCP servers listen in different ports in tests, serve the
+	// TCP retry of the query in the port used for UDP
+	l, err := net.Listen("tcp", server.udp)
+	require.NoError(t, err)
+	server.serve(&dns.Server{Listener: l, Handler: dns.HandlerFunc(func(w dns.ResponseWriter, query *dns.Msg) {
+		w.WriteMsg(server.answer(query))
+	})})
+
+	events, _ := runChecks(t, common.MapStr{
+		"hosts":         []string{"example.com"},
+		"resolvers":     []string{server.udp},
+		"timeout":       "1s",
+		"check.answers": []string{"192.0.2.10"},
+	})
+	require.Len(t, events, 1)
+
+	status, _ := events[0].GetValue("monitor.status")
+	assert.Equal(t, "up", status)
+}
+
+func TestDownResolver(t *testing.T) {
+	// Nothing listens in the port of a closed listener
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l.Close()
+
+	events, _ := runChecks(t, common.MapStr{
+		"hosts":     []string{"example.com"},
+		"resolvers": []string{"tcp://" + l.Addr().String()},
+		"timeout":   "1s",
+	})
+	require.Len(t, events, 1)
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("", "down", "dns"),
+			hbtest.ErrorChecks("connection refused", "io"),
+			lookslike.MustCompile(map[string]interface{}{
+				"dns.question.name": "example.com",
+			}),
+		),
+		events[0].Fields,
+	)
+}
+
+func TestJobPerHostAndResolver(t *testing.T) {
+	server := newTestServer(t, testServerOptions{}, testRecords...)
+	defer server.Close()
+
+	events, endpoints := runChecks(t, common.MapStr{
+		"hosts":     []string{"example.com", "www.example.com"},
+		"resolvers": []string{server.udp, "tcp://" + server.tcp},
+		"timeout":   "1s",
+	})
+	assert.Equal(t, 4, endpoints)
+	require.Len(t, events, 4)
+
+	for _, event := range events {
+		status, _ := event.GetValue("monitor.status")
+		assert.Equal(t, "up", status)
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := map[string]common.MapStr{
+		"query type":   {"query_type": "PTR"},
+		"rcode":        {"check.rcode": []string{"NOTANRCODE"}},
+		"ttl range":    {"check.ttl.min": "10m", "check.ttl.max": "1m"},
+		"resolver":     {"resolvers": []string{"quic://127.0.0.1"}},
+		"invalid name": {"hosts": []string{"exa mple..com"}},
+		"no hosts":     {"hosts": nil},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := common.MapStr{
+				"hosts":     []string{"example.com"},
+				"resolvers": []string{"127.0.0.1"},
+			}
+			config.DeepUpdate(test)
+
+			cfg, err := common.NewConfigFrom(config)
+			require.NoError(t, err)
+			_, err = create("dns", cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseResolver(t *testing.T) {
+	tests := map[string]string{
+		"192.0.2.53":                   "udp://192.0.2.53:53",
+		"192.0.2.53:5353":              "udp://192.0.2.53:5353",
+		"[2001:db8::53]":               "udp://[2001:db8::53]:53",
+		"tcp://192.0.2.53":             "tcp://192.0.2.53:53",
+		"tls://dns.example.com":        "tls://dns.example.com:853",
+		"https://dns.example.com":      "https://dns.example.com/dns-query",
+		"https://dns.example.com/q":    "https://dns.example.com/q",
+		"https://dns.example.com:8443": "https://dns.example.com:8443/dns-query",
+	}
+	for resolver, expected := range tests {
+		u, err := parseResolver(resolver)
+		if assert.NoError(t, err, resolver) {
+			assert.Equal(t, expected, u.String())
+		}
+	}
+
+	_, err := parseResolver("udp://")
+	assert.Error(t, err)
+}
@@ -0,0 +1,183 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+const (
+	dohMediaType = "application/dns-message"
+
+	// maxMessageSize limits the DNS over HTTPS responses read, it is the
+	// maximum size of a DNS message over TCP.
+	maxMessageSize = 65535
+)
+
+var defaultPorts = map[string]string{
+	"udp": "53",
+	"tcp": "53",
+	"tls": "853",
+}
+
+// exchanger sends a query to a resolver and returns its response
+type exchanger func(query *dns.Msg) (*dns.Msg, error)
+
+// parseResolver parses the address of a resolver. Addresses without scheme are
+// queried over UDP, and the default port of the scheme is used if none is set.
+func parseResolver(resolver string) (*url.URL, error) {
+	if !strings.Contains(resolver, "://") {
+		resolver = "udp://" + resolver
+	}
+
+	u, err := url.Parse(resolver)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resolver '%s': %v", resolver, err)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid resolver '%s': missing host", resolver)
+	}
+
+	switch u.Scheme {
+	case "udp", "tcp", "tls":
+		if u.Port() == "" {
+			u.Host = net.JoinHostPort(u.Hostname(), defaultPorts[u.Scheme])
+		}
+	case "https":
+		if u.Path == "" {
+			u.Path = "/dns-query"
+		}
+	default:
+		return nil, fmt.Errorf("invalid resolver '%s': unsupported scheme '%s'", resolver, u.Scheme)
+	}
+	return u, nil
+}
+
+// systemResolvers returns the resolvers configured in the host
+func systemResolvers() ([]string, error) {
+	conf, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+	if err != nil {
+		return nil, fmt.Errorf("no resolvers configured and failed to read the system ones: %v", err)
+	}
+
+	var resolvers []string
+	for _, server := range conf.Servers {
+		resolvers = append(resolvers, net.JoinHostPort(server, conf.Port))
+	}
+	return resolvers, nil
+}
+
+func newExchanger(resolver *url.URL, tlsConfig *tlscommon.TLSConfig, timeout time.Duration) exchanger {
+	switch resolver.Scheme {
+	case "tcp":
+		return clientExchanger(&dns.Client{Net: "tcp", Timeout: timeout}, resolver.Host)
+	case "tls":
+		client := &dns.Client{
+			Net:       "tcp-tls",
+			Timeout:   timeout,
+			TLSConfig: tlsConfig.BuildModuleClientConfig(resolver.Hostname()),
+		}
+		return clientExchanger(client, resolver.Host)
+	case "https":
+		return httpsExchanger(resolver, tlsConfig, timeout)
+	default:
+		udp := clientExchanger(&dns.Client{Net: "udp", Timeout: timeout}, resolver.Host)
+		tcp := clientExchanger(&dns.Client{Net: "tcp", Timeout: timeout}, resolver.Host)
+		return func(query *dns.Msg) (*dns.Msg, error) {
+			response, err := udp(query)
+			if err == nil && response.Truncated {
+				// Response doesn't fit in a datagram, retry over TCP as stub resolvers do
+				return tcp(query)
+			}
+			return response, err
+		}
+	}
+}
+
+func clientExchanger(client *dns.Client, address string) exchanger {
+	return func(query *dns.Msg) (*dns.Msg, error) {
+		response, _, err := client.Exchange(query, address)
+		return response, err
+	}
+}
+
+// httpsExchanger sends queries as DNS over HTTPS POST requests (RFC 8484)
+func httpsExchanger(resolver *url.URL, tlsConfig *tlscommon.TLSConfig, timeout time.Duration) exchanger {
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig.BuildModuleClientConfig(resolver.Hostname()),
+			// Each check measures the complete resolution, including the connection
+			DisableKeepAlives: true,
+		},
+	}
+	endpoint := resolver.String()
+
+	return func(query *dns.Msg) (*dns.Msg, error) {
+		// The ID is zero in DNS over HTTPS queries to make them cache friendly
+		id := query.Id
+		query.Id = 0
+		defer func() { query.Id = id }()
+
+		body, err := query.Pack()
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", dohMediaType)
+		req.Header.Set("Accept", dohMediaType)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("DNS over HTTPS request failed with status %d", resp.StatusCode)
+		}
+
+		content, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxMessageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		response := new(dns.Msg)
+		if err := response.Unpack(content); err != nil {
+			return nil, fmt.Errorf("invalid DNS over HTTPS response: %v", err)
+		}
+		return response, nil
+	}
+}
@@ -15,27 +15,30 @@
 // specific language governing permissions and limitations
 // under the License.
 
-package tcp
+// Package endpoint expands the hosts and ports of dialer based monitors, like
+// tcp and udp, into the URLs of the endpoints to check.
+package endpoint
 
 import (
 	"fmt"
 	"net"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
 
-// endpoint configures a host with all port numbers to be monitored by a dialer
+// Endpoint configures a host with all port numbers to be monitored by a dialer
 // based job.
-type endpoint struct {
+type Endpoint struct {
 	Scheme   string
 	Hostname string
 	Ports    []uint16
 }
 
-// perPortURLs returns a list containing one URL per port
-func (e endpoint) perPortURLs() (urls []*url.URL) {
+// PerPortURLs returns a list containing one URL per port
+func (e Endpoint) PerPortURLs() (urls []*url.URL) {
 	for _, port := range e.Ports {
 		urls = append(urls, &url.URL{
 			Scheme: e.Scheme,
@@ -46,23 +49,24 @@ func (e endpoint) perPortURLs() (urls []*url.URL) {
 	return urls
 }
 
-// makeEndpoints creates a single endpoint struct for each host/port permutation.
-// Set `defaultScheme` to choose which scheme is used if not explicit in the host config.
-func makeEndpoints(hosts []string, ports []uint16, defaultScheme string) (endpoints []endpoint, err error) {
+// MakeEndpoints creates a single endpoint struct for each host/port permutation.
+// Set `defaultScheme` to choose which scheme is used if not explicit in the host config,
+// hosts with a scheme must use one of the given supported schemes.
+func MakeEndpoints(hosts []string, ports []uint16, defaultScheme string, schemes ...string) (endpoints []Endpoint, err error) {
 	for _, h := range hosts {
 		u, err := url.Parse(h)
 
 		// If h is just a bare hostname like 'localhost' it will be parsed as the URL path, and host will
 		// be blank
-		var ep endpoint
+		var ep Endpoint
 		if err == nil && u.Host != "" {
-			ep, err = makeURLEndpoint(u, ports)
+			ep, err = makeURLEndpoint(u, ports, defaultScheme, schemes)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			u := &url.URL{Scheme: defaultScheme, Host: h}
-			ep, err = makeURLEndpoint(u, ports)
+			ep, err = makeURLEndpoint(u, ports, defaultScheme, schemes)
 			if err != nil {
 				return nil, err
 			}
@@ -72,37 +76,54 @@ func makeEndpoints(hosts []string, ports []uint16, defaultScheme string) (endpoi
 	return endpoints, nil
 }
 
-func makeURLEndpoint(u *url.URL, ports []uint16) (endpoint, error) {
-	switch u.Scheme {
-	case "tcp", "plain", "tls", "ssl":
-	default:
+func makeURLEndpoint(u *url.URL, ports []uint16, defaultScheme string, schemes []string) (Endpoint, error) {
+	if !supportedScheme(u.Scheme, schemes) {
 		err := fmt.Errorf(
-			"'%s' is not a supported connection scheme in '%s', supported schemes are tcp, plain, tls, and ssl",
+			"'%s' is not a supported connection scheme in '%s', supported schemes are %s",
 			u.Scheme,
 			u,
+			joinSchemes(schemes),
 		)
-		return endpoint{}, err
+		return Endpoint{}, err
 	}
 
 	if u.Port() != "" {
 		pUint, err := strconv.ParseUint(u.Port(), 10, 16)
 		if err != nil {
-			return endpoint{}, errors.Wrapf(err, "no port(s) defined for TCP endpoint %s", u)
+			return Endpoint{}, errors.Wrapf(err, "no port(s) defined for %s endpoint %s", strings.ToUpper(defaultScheme), u)
 		}
 		ports = []uint16{uint16(pUint)}
 	}
 
 	if len(ports) == 0 {
-		return endpoint{}, fmt.Errorf("host '%s' missing port number", u)
+		return Endpoint{}, fmt.Errorf("host '%s' missing port number", u)
 	}
 
 	if u.Hostname() == "" || u.Hostname() == ":" {
-		return endpoint{}, fmt.Errorf("could not parse tcp host '%s'", u)
+		return Endpoint{}, fmt.Errorf("could not parse %s host '%s'", defaultScheme, u)
 	}
 
-	return endpoint{
+	return Endpoint{
 		Scheme:   u.Scheme,
 		Hostname: u.Hostname(),
 		Ports:    ports,
 	}, nil
 }
+
+func supportedScheme(scheme string, schemes []string) bool {
+	for _, s := range schemes {
+		if s == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// joinSchemes lists schemes as in "tcp, plain, tls, and ssl".
+func joinSchemes(schemes []string) string {
+	if len(schemes) < 2 {
+		return strings.Join(schemes, "")
+	}
+	last := len(schemes) - 1
+	return strings.Join(schemes[:last], ", ") + ", and " + schemes[last]
+}
@@ -15,7 +15,7 @@
 // specific language governing permissions and limitations
 // under the License.
 
-package tcp
+package endpoint
 
 import (
 	"testing"
@@ -32,51 +32,57 @@ func TestMakeEndpoints(t *testing.T) {
 	tests := []struct {
 		name          string
 		args          args
-		wantEndpoints []endpoint
+		wantEndpoints []Endpoint
 		wantErr       bool
 	}{
 		{
 			"hostname",
 			args{[]string{"localhost"}, []uint16{123}, "tcp"},
-			[]endpoint{{Scheme: "tcp", Hostname: "localhost", Ports: []uint16{123}}},
+			[]Endpoint{{Scheme: "tcp", Hostname: "localhost", Ports: []uint16{123}}},
 			false,
 		},
 		{
 			"ipv4",
 			args{[]string{"1.2.3.4"}, []uint16{123}, "tcp"},
-			[]endpoint{{Scheme: "tcp", Hostname: "1.2.3.4", Ports: []uint16{123}}},
+			[]Endpoint{{Scheme: "tcp", Hostname: "1.2.3.4", Ports: []uint16{123}}},
 			false,
 		},
 		{
 			"unbracketed ipv6",
 			args{[]string{"::1"}, []uint16{123}, "tcp"},
-			[]endpoint{},
+			[]Endpoint{},
 			true,
 		},
 		{
 			"bracketed ipv6",
 			args{[]string{"[::1]"}, []uint16{123}, "tcp"},
-			[]endpoint{{Scheme: "tcp", Hostname: "::1", Ports: []uint16{123}}},
+			[]Endpoint{{Scheme: "tcp", Hostname: "::1", Ports: []uint16{123}}},
 			false,
 		},
 		{
 			"url",
 			args{[]string{"tls://example.net"}, []uint16{123}, "tcp"},
-			[]endpoint{{Scheme: "tls", Hostname: "example.net", Ports: []uint16{123}}},
+			[]Endpoint{{Scheme: "tls", Hostname: "example.net", Ports: []uint16{123}}},
 			false,
 		},
 		{
 			"url:port",
 			args{[]string{"example.net:999"}, []uint16{123}, "tcp"},
-			[]endpoint{{Scheme: "tcp", Hostname: "example.net", Ports: []uint16{999}}},
+			[]Endpoint{{Scheme: "tcp", Hostname: "example.net", Ports: []uint16{999}}},
 			false,
 		},
 		{
 			"scheme://url:port",
 			args{[]string{"tls://example.net:999"}, []uint16{123}, "tcp"},
-			[]endpoint{{Scheme: "tls", Hostname: "example.net", Ports: []uint16{999}}},
+			[]Endpoint{{Scheme: "tls", Hostname: "example.net", Ports: []uint16{999}}},
 			false,
 		},
+		{
+			"unsupported scheme",
+			args{[]string{"udp://example.net:999"}, []uint16{123}, "tcp"},
+			[]Endpoint{},
+			true,
+		},
 		{
 			"hybrid",
 			args{[]string{
@@ -86,7 +92,7 @@ func TestMakeEndpoints(t *testing.T) {
 				"example.net:999",
 				"tls://example.net:999",
 			}, []uint16{123}, "tcp"},
-			[]endpoint{
+			[]Endpoint{
 				{Scheme: "tcp", Hostname: "localhost", Ports: []uint16{123}},
 				{Scheme: "tcp", Hostname: "192.168.0.1", Ports: []uint16{123}},
 				{Scheme: "tcp", Hostname: "2607:f8b0:4004:814::200e", Ports: []uint16{123}},
@@ -98,7 +104,7 @@ func TestMakeEndpoints(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotEndpoints, err := makeEndpoints(tt.args.hosts, tt.args.ports, tt.args.defaultScheme)
+			gotEndpoints, err := MakeEndpoints(tt.args.hosts, tt.args.ports, tt.args.defaultScheme, "tcp", "plain", "tls", "ssl")
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -0,0 +1,242 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gogrpc "google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+)
+
+// unaryCall performs a unary call to a method whose message types are
+// resolved through the server reflection service.
+type unaryCall struct {
+	service string
+	method  string
+	request string
+	checks  []compiledCheck
+}
+
+type compiledCheck struct {
+	description string
+	condition   conditions.Condition
+}
+
+func newUnaryCall(config *callConfig) (*unaryCall, error) {
+	service, method, err := splitMethod(config.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	request := config.Request
+	if strings.TrimSpace(request) == "" {
+		request = "{}"
+	}
+	if !json.Valid([]byte(request)) {
+		return nil, fmt.Errorf("call request for '%s' is not valid JSON", config.Method)
+	}
+
+	call := &unaryCall{service: service, method: method, request: request}
+	for _, check := range config.Check.JSON {
+		cond, err := conditions.NewCondition(check.Condition)
+		if err != nil {
+			return nil, err
+		}
+		call.checks = append(call.checks, compiledCheck{check.Description, cond})
+	}
+	return call, nil
+}
+
+func (c *unaryCall) fullMethod() string {
+	return "/" + c.service + "/" + c.method
+}
+
+// exec performs the call, validating the response against the JSON checks.
+func (c *unaryCall) exec(ctx context.Context, conn *gogrpc.ClientConn, fields common.MapStr) reason.Reason {
+	callFields := common.MapStr{"method": c.service + "/" + c.method}
+	fields["call"] = callFields
+
+	md, err := resolveMethod(ctx, conn, c.service, c.method)
+	if err != nil {
+		return reason.IOFailed(err)
+	}
+
+	req := dynamicpb.NewMessage(md.Input())
+	if err := protojson.Unmarshal([]byte(c.request), req); err != nil {
+		return reason.ValidateFailed(fmt.Errorf("could not encode request for %s: %w", md.FullName(), err))
+	}
+	resp := dynamicpb.NewMessage(md.Output())
+
+	start := time.Now()
+	err = conn.Invoke(ctx, c.fullMethod(), req, resp)
+	fields.Put("rtt.call", look.RTT(time.Since(start)))
+	callFields["status_code"] = status.Code(err).String()
+	if err != nil {
+		return reason.IOFailed(err)
+	}
+
+	if len(c.checks) == 0 {
+		return nil
+	}
+	return reason.ValidateFailed(c.checkResponse(resp))
+}
+
+func (c *unaryCall) checkResponse(resp proto.Message) error {
+	body, err := protojson.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	decoded := common.MapStr{}
+	decoder := json.NewDecoder(strings.NewReader(string(body)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return fmt.Errorf("could not parse JSON response for checks: %w", err)
+	}
+	jsontransform.TransformNumbers(decoded)
+
+	var errorDescs []string
+	for _, check := range c.checks {
+		if !check.condition.Check(decoded) {
+			errorDescs = append(errorDescs, check.description)
+		}
+	}
+
+	if len(errorDescs) > 0 {
+		return fmt.Errorf(
+			"JSON response did not match %d conditions '%s' for monitor. Received JSON %s",
+			len(errorDescs),
+			strings.Join(errorDescs, ","),
+			body,
+		)
+	}
+	return nil
+}
+
+// resolveMethod looks up the descriptor of a unary method through the
+// server reflection service, fetching the file declaring the service and all
+// of its dependencies.
+func resolveMethod(ctx context.Context, conn *gogrpc.ClientConn, service, method string) (protoreflect.MethodDescriptor, error) {
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.CloseSend()
+
+	fetched := map[string]*descriptorpb.FileDescriptorProto{}
+	var pending []string
+
+	request := &rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: service},
+	}
+	for request != nil {
+		files, err := reflectFiles(stream, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if _, ok := fetched[file.GetName()]; ok {
+				continue
+			}
+			fetched[file.GetName()] = file
+			pending = append(pending, file.Dependency...)
+		}
+
+		request = nil
+		for len(pending) > 0 && request == nil {
+			name := pending[0]
+			pending = pending[1:]
+			if _, ok := fetched[name]; !ok {
+				request = &rpb.ServerReflectionRequest{
+					MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: name},
+				}
+			}
+		}
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	for _, file := range fetched {
+		set.File = append(set.File, file)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid descriptors for service '%s': %w", service, err)
+	}
+
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("service '%s' not found: %w", service, err)
+	}
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a service", service)
+	}
+
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, fmt.Errorf("method '%s' not found in service '%s'", method, service)
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return nil, fmt.Errorf("method '%s/%s' is not unary", service, method)
+	}
+	return md, nil
+}
+
+func reflectFiles(
+	stream rpb.ServerReflection_ServerReflectionInfoClient,
+	request *rpb.ServerReflectionRequest,
+) ([]*descriptorpb.FileDescriptorProto, error) {
+	if err := stream.Send(request); err != nil {
+		return nil, err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if errResp := resp.GetErrorResponse(); errResp != nil {
+		return nil, fmt.Errorf("reflection request failed: %s", errResp.GetErrorMessage())
+	}
+
+	var files []*descriptorpb.FileDescriptorProto
+	for _, raw := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		file := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(raw, file); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+)
+
+type config struct {
+	// check all ports if host does not contain port
+	Hosts []string `config:"hosts" validate:"required"`
+	Ports []uint16 `config:"ports"`
+
+	Mode monitors.IPSettings `config:",inline"`
+
+	Socks5 transport.ProxyConfig `config:",inline"`
+
+	// configure tls
+	TLS *tlscommon.Config `config:"ssl"`
+
+	Timeout time.Duration `config:"timeout"`
+
+	// metadata headers sent with every call
+	Metadata map[string]string `config:"metadata"`
+
+	Health healthConfig `config:"health"`
+
+	// optional unary call performed after the health checks
+	Call *callConfig `config:"call"`
+}
+
+type healthConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// services to check, the empty name checking the server as a whole
+	Services []string `config:"services"`
+
+	// use the streaming Watch call, waiting for the services to become
+	// SERVING until the timeout expires
+	Watch bool `config:"watch"`
+}
+
+type callConfig struct {
+	// fully qualified method in the form package.Service/Method
+	Method string `config:"method" validate:"required"`
+
+	// request message encoded as JSON
+	Request string `config:"request"`
+
+	Check callCheckConfig `config:"check"`
+}
+
+type callCheckConfig struct {
+	JSON []*jsonResponseCheck `config:"json"`
+}
+
+type jsonResponseCheck struct {
+	Description string             `config:"description"`
+	Condition   *conditions.Config `config:"condition"`
+}
+
+func defaultConfig() config {
+	return config{
+		Timeout: 16 * time.Second,
+		Mode:    monitors.DefaultIPSettings,
+		Health: healthConfig{
+			Enabled:  true,
+			Services: []string{""},
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Socks5.URL != "" {
+		if c.Mode.Mode != monitors.PingAny && !c.Socks5.LocalResolve {
+			return errors.New("ping all ips only supported if proxy_use_local_resolver is enabled`")
+		}
+	}
+
+	if !c.Health.Enabled && c.Call == nil {
+		return errors.New("either health checks or a call must be configured")
+	}
+
+	return nil
+}
+
+func (c *callConfig) Validate() error {
+	if _, _, err := splitMethod(c.Method); err != nil {
+		return err
+	}
+	for _, check := range c.Check.JSON {
+		if check.Condition == nil {
+			return fmt.Errorf("json check '%s' is missing a condition", check.Description)
+		}
+	}
+	return nil
+}
+
+// splitMethod splits a method in the form package.Service/Method into the
+// service and method names.
+func splitMethod(method string) (service, name string, err error) {
+	method = strings.TrimPrefix(method, "/")
+	idx := strings.LastIndex(method, "/")
+	if idx <= 0 || idx == len(method)-1 {
+		return "", "", fmt.Errorf("invalid method '%s', expected package.Service/Method", method)
+	}
+	return method[:idx], method[idx+1:], nil
+}
@@ -0,0 +1,250 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/url"
+	"sync"
+
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/endpoint"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	plugin.Register("grpc", create, "synthetics/grpc")
+}
+
+var debugf = logp.MakeDebug("grpc")
+
+var errRedial = errors.New("connection lost, not reconnecting within a single check")
+
+func create(
+	name string,
+	cfg *common.Config,
+) (p plugin.Plugin, err error) {
+	return createWithResolver(cfg, monitors.NewStdResolver())
+}
+
+// Custom resolver is useful for tests against hostnames locally where we don't want to depend on any
+// hostnames existing in test environments
+func createWithResolver(
+	cfg *common.Config,
+	resolver monitors.Resolver,
+) (p plugin.Plugin, err error) {
+	jf, err := newJobFactory(cfg, resolver)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	js, err := jf.makeJobs()
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(jf.endpoints)}, nil
+}
+
+// jobFactory provides a common context around the execution of gRPC checks.
+type jobFactory struct {
+	config    config
+	tlsConfig *tlscommon.TLSConfig
+	endpoints []endpoint.Endpoint
+	call      *unaryCall
+	resolver  monitors.Resolver
+}
+
+func newJobFactory(commonCfg *common.Config, resolver monitors.Resolver) (*jobFactory, error) {
+	jf := &jobFactory{config: defaultConfig(), resolver: resolver}
+	err := jf.loadConfig(commonCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return jf, nil
+}
+
+// loadConfig parses the YAML config and populates the jobFactory fields.
+func (jf *jobFactory) loadConfig(commonCfg *common.Config) error {
+	var err error
+	if err = commonCfg.Unpack(&jf.config); err != nil {
+		return err
+	}
+
+	jf.tlsConfig, err = tlscommon.LoadTLSConfig(jf.config.TLS)
+	if err != nil {
+		return err
+	}
+
+	defaultScheme := "grpc"
+	if jf.tlsConfig != nil {
+		defaultScheme = "grpcs"
+	}
+
+	jf.endpoints, err = endpoint.MakeEndpoints(jf.config.Hosts, jf.config.Ports, defaultScheme, "grpc", "grpcs")
+	if err != nil {
+		return err
+	}
+
+	if jf.config.Call != nil {
+		jf.call, err = newUnaryCall(jf.config.Call)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// makeJobs returns the actual schedulable jobs for this monitor.
+func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
+	var js []jobs.Job
+	for _, ep := range jf.endpoints {
+		for _, u := range ep.PerPortURLs() {
+			job, err := jf.makeEndpointJob(u)
+			if err != nil {
+				return nil, err
+			}
+			js = append(js, wrappers.WithURLField(u, job))
+		}
+	}
+
+	return js, nil
+}
+
+// makeEndpointJob makes a job checking a single scheme/host/port combo.
+func (jf *jobFactory) makeEndpointJob(endpointURL *url.URL) (jobs.Job, error) {
+	// With a SOCKS5 proxy resolving the hostname there's no IP to look up.
+	if jf.config.Socks5.URL != "" && !jf.config.Socks5.LocalResolve {
+		return jobs.MakeSimpleJob(func(event *beat.Event) error {
+			return jf.check(event, endpointURL.Host, endpointURL)
+		}), nil
+	}
+
+	return monitors.MakeByHostJob(
+		endpointURL.Hostname(),
+		jf.config.Mode,
+		jf.resolver,
+		monitors.MakePingIPFactory(
+			func(event *beat.Event, ip *net.IPAddr) error {
+				return jf.check(event, net.JoinHostPort(ip.String(), endpointURL.Port()), endpointURL)
+			}))
+}
+
+// check connects to dialAddr and performs the configured health checks and
+// call. canonicalURL determines whether TLS is used and which server name is
+// passed to the TLS implementation and sent as the gRPC authority.
+func (jf *jobFactory) check(event *beat.Event, dialAddr string, canonicalURL *url.URL) error {
+	dialer, err := jf.buildDialer(event, dialAddr, canonicalURL)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jf.config.Timeout)
+	defer cancel()
+
+	conn, err := gogrpc.DialContext(ctx, canonicalURL.Host,
+		gogrpc.WithInsecure(), // TLS is handled by the dialer chain
+		gogrpc.WithBlock(),
+		gogrpc.WithReturnConnectionError(),
+		gogrpc.WithContextDialer(dialOnce(dialer, dialAddr)),
+	)
+	if err != nil {
+		debugf("dial failed with: %v", err)
+		var certErr x509.CertificateInvalidError
+		if errors.As(err, &certErr) {
+			tlsmeta.AddCertMetadata(event.Fields, []*x509.Certificate{certErr.Cert})
+		}
+		return reason.IOFailed(err)
+	}
+	defer conn.Close()
+
+	if len(jf.config.Metadata) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(jf.config.Metadata))
+	}
+
+	fields := common.MapStr{}
+	defer eventext.MergeEventFields(event, common.MapStr{"grpc": fields})
+
+	if jf.config.Health.Enabled {
+		if r := checkHealth(ctx, conn, jf.config.Health, fields); r != nil {
+			return r
+		}
+	}
+
+	if jf.call != nil {
+		return jf.call.exec(ctx, conn, fields)
+	}
+	return nil
+}
+
+// buildDialer creates the dialer chain used to connect to dialAddr, adding
+// the connection and TLS metadata to the event.
+func (jf *jobFactory) buildDialer(event *beat.Event, dialAddr string, canonicalURL *url.URL) (transport.Dialer, error) {
+	dc := &dialchain.DialerChain{
+		Net: dialchain.CreateNetDialer(jf.config.Timeout),
+	}
+
+	if jf.config.Socks5.URL != "" {
+		dc.AddLayer(dialchain.SOCKS5Layer(&jf.config.Socks5))
+	}
+
+	dc.AddLayer(dialchain.ConstAddrLayer(dialAddr))
+
+	// Fix the canonical host for the TLS layer, so the server certificate is
+	// validated against the hostname rather than the resolved IP.
+	if canonicalURL.Scheme == "grpcs" {
+		dc.AddLayer(dialchain.TLSLayer(jf.tlsConfig, jf.config.Timeout))
+		dc.AddLayer(dialchain.ConstAddrLayer(canonicalURL.Host))
+	}
+
+	return dc.Build(event)
+}
+
+// dialOnce adapts dialer for use by a gRPC connection. Only the first dial
+// succeeds, as the dialer chain writes its metadata to the event being built
+// and reconnects in the background must not race with the check.
+func dialOnce(dialer transport.Dialer, addr string) func(context.Context, string) (net.Conn, error) {
+	var once sync.Once
+	return func(ctx context.Context, _ string) (net.Conn, error) {
+		err := errRedial
+		var conn net.Conn
+		once.Do(func() {
+			conn, err = dialer.Dial("tcp", addr)
+		})
+		return conn, err
+	}
+}
@@ -0,0 +1,314 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+type testServer struct {
+	port   uint16
+	health *health.Server
+
+	mtx      sync.Mutex
+	metadata metadata.MD
+}
+
+// startServer starts a gRPC server exposing the health and reflection
+// services, recording the metadata of the last call received.
+func startServer(t *testing.T, opts ...gogrpc.ServerOption) *testServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ts := &testServer{
+		port:   uint16(l.Addr().(*net.TCPAddr).Port),
+		health: health.NewServer(),
+	}
+	opts = append(opts,
+		gogrpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *gogrpc.UnaryServerInfo, handler gogrpc.UnaryHandler) (interface{}, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			ts.mtx.Lock()
+			ts.metadata = md
+			ts.mtx.Unlock()
+			return handler(ctx, req)
+		}),
+	)
+
+	srv := gogrpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(srv, ts.health)
+	reflection.Register(srv)
+	go srv.Serve(l)
+	t.Cleanup(srv.Stop)
+
+	return ts
+}
+
+func (ts *testServer) lastMetadata() metadata.MD {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+	return ts.metadata
+}
+
+func runCheck(t *testing.T, scheme string, port uint16, configMap common.MapStr) *beat.Event {
+	configMap = configMap.Clone()
+	configMap["hosts"] = scheme + "://127.0.0.1"
+	configMap["ports"] = port
+	if _, err := configMap.GetValue("timeout"); err != nil {
+		configMap["timeout"] = "1s"
+	}
+	config, err := common.NewConfigFrom(configMap)
+	require.NoError(t, err)
+
+	p, err := create("grpc", config)
+	require.NoError(t, err)
+	require.Equal(t, 1, p.Endpoints)
+
+	sched := schedule.MustParse("@every 1s")
+	js := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "grpc", Schedule: sched, Timeout: 1})
+
+	events, err := jobs.ExecJobsAndConts(t, js)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	return events[0]
+}
+
+func grpcChecks(t *testing.T, scheme string, port uint16, status string) validator.Validator {
+	var summary validator.Validator
+	if status == "up" {
+		summary = hbtest.SummaryChecks(1, 0)
+	} else {
+		summary = hbtest.SummaryChecks(0, 1)
+	}
+	return lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", status, "grpc"),
+		hbtest.SimpleURLChecks(t, scheme, "127.0.0.1", port),
+		hbtest.RespondingTCPChecks(),
+		summary,
+	)
+}
+
+func healthChecks(service, status string) validator.Validator {
+	return lookslike.MustCompile(map[string]interface{}{
+		"grpc.health.service": service,
+		"grpc.health.status":  status,
+		"grpc.rtt.health.us":  isdef.IsDuration,
+	})
+}
+
+func TestHealthCheck(t *testing.T) {
+	ts := startServer(t)
+	ts.health.SetServingStatus("backend", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	t.Run("server", func(t *testing.T) {
+		event := runCheck(t, "grpc", ts.port, common.MapStr{})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "up"),
+			healthChecks("", "SERVING"),
+		)), event.Fields)
+	})
+
+	t.Run("not serving", func(t *testing.T) {
+		event := runCheck(t, "grpc", ts.port, common.MapStr{"health.services": []string{"", "backend"}})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "down"),
+			healthChecks("backend", "NOT_SERVING"),
+			hbtest.ErrorChecks("service 'backend' is NOT_SERVING", "validate"),
+		)), event.Fields)
+	})
+
+	t.Run("unknown service", func(t *testing.T) {
+		event := runCheck(t, "grpc", ts.port, common.MapStr{"health.services": []string{"missing"}})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "down"),
+			lookslike.MustCompile(map[string]interface{}{
+				"grpc.health.service": "missing",
+				"grpc.rtt.health.us":  isdef.IsDuration,
+			}),
+			hbtest.ErrorChecks("unknown service", "io"),
+		)), event.Fields)
+	})
+}
+
+func TestHealthWatch(t *testing.T) {
+	ts := startServer(t)
+	ts.health.SetServingStatus("backend", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	t.Run("becomes serving", func(t *testing.T) {
+		timer := time.AfterFunc(100*time.Millisecond, func() {
+			ts.health.SetServingStatus("backend", healthpb.HealthCheckResponse_SERVING)
+		})
+		defer timer.Stop()
+
+		event := runCheck(t, "grpc", ts.port, common.MapStr{"health.services": "backend", "health.watch": true})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "up"),
+			healthChecks("backend", "SERVING"),
+		)), event.Fields)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ts.health.SetServingStatus("backend", healthpb.HealthCheckResponse_NOT_SERVING)
+
+		event := runCheck(t, "grpc", ts.port, common.MapStr{
+			"health.services": "backend",
+			"health.watch":    true,
+			"timeout":         "200ms",
+		})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "down"),
+			healthChecks("backend", "NOT_SERVING"),
+			hbtest.ErrorChecks("service 'backend' is NOT_SERVING", "validate"),
+		)), event.Fields)
+	})
+}
+
+func TestMetadata(t *testing.T) {
+	ts := startServer(t)
+
+	event := runCheck(t, "grpc", ts.port, common.MapStr{"metadata": common.MapStr{"authorization": "Bearer abc"}})
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		grpcChecks(t, "grpc", ts.port, "up"),
+		healthChecks("", "SERVING"),
+	)), event.Fields)
+
+	require.Equal(t, []string{"Bearer abc"}, ts.lastMetadata().Get("authorization"))
+}
+
+func TestUnaryCall(t *testing.T) {
+	ts := startServer(t)
+	ts.health.SetServingStatus("backend", healthpb.HealthCheckResponse_SERVING)
+
+	call := func(condition common.MapStr) common.MapStr {
+		return common.MapStr{
+			"health.enabled": false,
+			"call": common.MapStr{
+				"method":  "grpc.health.v1.Health/Check",
+				"request": `{"service": "backend"}`,
+				"check.json": []common.MapStr{{
+					"description": "backend serving",
+					"condition":   condition,
+				}},
+			},
+		}
+	}
+	callChecks := lookslike.MustCompile(map[string]interface{}{
+		"grpc.call.method":      "grpc.health.v1.Health/Check",
+		"grpc.call.status_code": "OK",
+		"grpc.rtt.call.us":      isdef.IsDuration,
+	})
+
+	t.Run("match", func(t *testing.T) {
+		event := runCheck(t, "grpc", ts.port, call(common.MapStr{"equals.status": "SERVING"}))
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "up"),
+			callChecks,
+		)), event.Fields)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		event := runCheck(t, "grpc", ts.port, call(common.MapStr{"equals.status": "NOT_SERVING"}))
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "down"),
+			callChecks,
+			hbtest.ErrorChecks("JSON response did not match 1 conditions 'backend serving'", "validate"),
+		)), event.Fields)
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		cfg := call(common.MapStr{"equals.status": "SERVING"})
+		cfg.Put("call.method", "grpc.health.v1.Health/Missing")
+		event := runCheck(t, "grpc", ts.port, cfg)
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			grpcChecks(t, "grpc", ts.port, "down"),
+			lookslike.MustCompile(map[string]interface{}{"grpc.call.method": "grpc.health.v1.Health/Missing"}),
+			hbtest.ErrorChecks("method 'Missing' not found in service 'grpc.health.v1.Health'", "io"),
+		)), event.Fields)
+	})
+}
+
+func TestTLS(t *testing.T) {
+	// Borrow the certificate of an httptest server, valid for 127.0.0.1.
+	tlsSrv := httptest.NewTLSServer(nil)
+	cert := tlsSrv.Certificate()
+	serverCert := tlsSrv.TLS.Certificates[0]
+	tlsSrv.Close()
+
+	ts := startServer(t, gogrpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{serverCert}})))
+
+	certFile := hbtest.CertToTempFile(t, cert)
+	defer certFile.Close()
+
+	event := runCheck(t, "grpcs", ts.port, common.MapStr{"ssl.certificate_authorities": certFile.Name()})
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		grpcChecks(t, "grpcs", ts.port, "up"),
+		healthChecks("", "SERVING"),
+		hbtest.TLSChecks(0, 0, cert),
+	)), event.Fields)
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config common.MapStr
+		errMsg string
+	}{
+		{"nothing to check", common.MapStr{"health.enabled": false}, "either health checks or a call must be configured"},
+		{"invalid method", common.MapStr{"call.method": "Check"}, "invalid method 'Check'"},
+		{"invalid request", common.MapStr{"call.method": "a.B/C", "call.request": "{"}, "is not valid JSON"},
+		{"invalid scheme", common.MapStr{"hosts": "http://localhost:50051"}, "'http' is not a supported connection scheme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configMap := common.MapStr{"hosts": "localhost:50051"}
+			configMap.DeepUpdate(tt.config)
+			config, err := common.NewConfigFrom(configMap)
+			require.NoError(t, err)
+
+			_, err = create("grpc", config)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
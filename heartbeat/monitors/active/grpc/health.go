@@ -0,0 +1,114 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gogrpc "google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// checkHealth queries the grpc.health.v1.Health service for each configured
+// service, failing on the first one that isn't SERVING.
+func checkHealth(ctx context.Context, conn *gogrpc.ClientConn, config healthConfig, fields common.MapStr) reason.Reason {
+	client := healthpb.NewHealthClient(conn)
+
+	health := common.MapStr{}
+	fields["health"] = health
+
+	start := time.Now()
+	defer func() {
+		fields.Put("rtt.health", look.RTT(time.Since(start)))
+	}()
+
+	for _, service := range config.Services {
+		var status healthpb.HealthCheckResponse_ServingStatus
+		var err error
+		if config.Watch {
+			status, err = watchHealth(ctx, client, service)
+		} else {
+			status, err = checkServiceHealth(ctx, client, service)
+		}
+
+		health["service"] = service
+		if status != healthpb.HealthCheckResponse_UNKNOWN || err == nil {
+			health["status"] = status.String()
+		}
+		if err != nil {
+			return reason.IOFailed(err)
+		}
+		if status != healthpb.HealthCheckResponse_SERVING {
+			return reason.ValidateFailed(fmt.Errorf("service '%s' is %s", service, status))
+		}
+	}
+
+	return nil
+}
+
+func checkServiceHealth(
+	ctx context.Context,
+	client healthpb.HealthClient,
+	service string,
+) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+	return resp.Status, nil
+}
+
+// watchHealth waits for the service to become SERVING, returning the last
+// status received when the context expires first.
+func watchHealth(
+	ctx context.Context,
+	client healthpb.HealthClient,
+	service string,
+) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.Watch(watchCtx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+
+	status := healthpb.HealthCheckResponse_UNKNOWN
+	received := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			// Deadline expiry after a status has been received is reported as
+			// that status rather than as an IO error.
+			if received && ctx.Err() != nil {
+				return status, nil
+			}
+			return status, err
+		}
+		status, received = resp.Status, true
+		if status == healthpb.HealthCheckResponse_SERVING {
+			return status, nil
+		}
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	pkgerrors "github.com/pkg/errors"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/heartbeat/reason"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
@@ -38,6 +39,7 @@ import (
 type multiValidator struct {
 	respValidators []respValidator
 	bodyValidators []bodyValidator
+	tlsChecker     *tlscheck.Checker
 }
 
 func (rv multiValidator) wantsBody() bool {
@@ -57,7 +59,18 @@ func (rv multiValidator) validate(resp *http.Response, body string) reason.Reaso
 		}
 	}
 
-	return nil
+	// The TLS checks run last, as they may only be warnings
+	return rv.tlsChecker.Check(resp.TLS)
+}
+
+// requireVersion returns a copy of the validator checking first that responses
+// have the given HTTP version, if any.
+func (rv multiValidator) requireVersion(version string) multiValidator {
+	if version == "" {
+		return rv
+	}
+	rv.respValidators = append([]respValidator{checkVersion(version)}, rv.respValidators...)
+	return rv
 }
 
 // respValidator is used for validating using only the non-body fields of the *http.Response.
@@ -77,7 +90,7 @@ var (
 	errBodyIllegalBody       = errors.New("unsupported content under check.body")
 )
 
-func makeValidateResponse(config *responseParameters) (multiValidator, error) {
+func makeValidateResponse(config *responseParameters, tlsConfig tlscheck.Config) (multiValidator, error) {
 	var respValidators []respValidator
 	var bodyValidators []bodyValidator
 
@@ -109,7 +122,12 @@ func makeValidateResponse(config *responseParameters) (multiValidator, error) {
 		bodyValidators = append(bodyValidators, jsonChecks)
 	}
 
-	return multiValidator{respValidators, bodyValidators}, nil
+	tlsChecker, err := tlscheck.NewChecker(tlsConfig)
+	if err != nil {
+		return multiValidator{}, err
+	}
+
+	return multiValidator{respValidators, bodyValidators, tlsChecker}, nil
 }
 
 func checkStatus(status []uint16) respValidator {
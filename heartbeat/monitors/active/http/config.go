@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/transport/httpcommon"
 	"github.com/elastic/beats/v7/libbeat/conditions"
 )
@@ -34,6 +36,9 @@ type Config struct {
 	MaxRedirects int            `config:"max_redirects"`
 	Response     responseConfig `config:"response"`
 
+	// HTTP version to use and require from responses, negotiated if empty
+	HTTPVersion string `config:"http_version"`
+
 	Mode monitors.IPSettings `config:",inline"`
 
 	// authentication
@@ -43,6 +48,9 @@ type Config struct {
 	// http(s) ping validation
 	Check checkConfig `config:"check"`
 
+	// multi-step journey, replaces hosts if configured
+	Steps []*common.Config `config:"steps"`
+
 	Transport httpcommon.HTTPTransportSettings `config:",inline"`
 }
 
@@ -55,6 +63,7 @@ type responseConfig struct {
 type checkConfig struct {
 	Request  requestParameters  `config:"request"`
 	Response responseParameters `config:"response"`
+	TLS      tlscheck.Config    `config:"tls"`
 }
 
 type requestParameters struct {
@@ -66,7 +75,6 @@ type requestParameters struct {
 
 	// TODO:
 	//  - add support for cookies
-	//  - select HTTP version. golang lib will either use 1.1 or 2.0 if HTTPS is used, otherwise HTTP 1.1 . => implement/use specific http.RoundTripper implementation to change wire protocol/version being used
 }
 
 type responseParameters struct {
@@ -82,6 +90,22 @@ type jsonResponseCheck struct {
 	Condition   *conditions.Config `config:"condition"`
 }
 
+// stepConfig configures a single request of a journey.
+type stepConfig struct {
+	Name    string           `config:"name" validate:"required"`
+	URL     string           `config:"url" validate:"required"`
+	Check   checkConfig      `config:"check"`
+	Extract []*extractConfig `config:"extract"`
+}
+
+// extractConfig configures a variable to extract from the response of a journey step.
+type extractConfig struct {
+	Name   string `config:"name" validate:"required"`
+	JSON   string `config:"json"`
+	Header string `config:"header"`
+	Cookie string `config:"cookie"`
+}
+
 type compressionConfig struct {
 	Type  string `config:"type"`
 	Level int    `config:"level"`
@@ -115,6 +139,16 @@ func defaultConfig() Config {
 	return cfg
 }
 
+func defaultStepConfig() stepConfig {
+	return stepConfig{
+		Check: checkConfig{
+			Request: requestParameters{
+				Method: "GET",
+			},
+		},
+	}
+}
+
 // Validate validates of the responseConfig object is valid or not
 func (r *responseConfig) Validate() error {
 	switch strings.ToLower(r.IncludeBody) {
@@ -141,6 +175,21 @@ func (r *requestParameters) Validate() error {
 	return nil
 }
 
+// Validate validates of the extractConfig object is valid or not
+func (e *extractConfig) Validate() error {
+	sources := 0
+	for _, source := range []string{e.JSON, e.Header, e.Cookie} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("variable '%v' must be extracted from exactly one of 'json', 'header' or 'cookie'", e.Name)
+	}
+
+	return nil
+}
+
 // Validate validates of the compressionConfig object is valid or not
 func (c *compressionConfig) Validate() error {
 	t := strings.ToLower(c.Type)
@@ -161,6 +210,23 @@ func (c *compressionConfig) Validate() error {
 
 // Validate validates of the Config object is valid or not
 func (c *Config) Validate() error {
+	switch c.HTTPVersion {
+	case "", httpVersion11, httpVersion2:
+	case httpVersion3:
+		if c.Transport.Proxy.URL != nil && !c.Transport.Proxy.Disable {
+			return fmt.Errorf("HTTP/3 can't be used with a proxy")
+		}
+	default:
+		return fmt.Errorf("unknown option for `http_version`: '%s', please use one of '%s', '%s' or '%s'", c.HTTPVersion, httpVersion11, httpVersion2, httpVersion3)
+	}
+
+	if len(c.Steps) > 0 {
+		if len(c.Hosts) != 0 || len(c.URLs) != 0 {
+			return fmt.Errorf("hosts and urls can't be used with steps, set the url of each step instead")
+		}
+		return nil
+	}
+
 	if len(c.Hosts) == 0 && len(c.URLs) == 0 {
 		return fmt.Errorf("hosts is a mandatory parameter")
 	}
@@ -188,6 +254,14 @@ func (c *Config) Validate() error {
 		} else if u.Scheme == "" || u.Host == "" {
 			c.Hosts[i] = updateScheme(host)
 		}
+
+		if c.HTTPVersion == httpVersion2 && c.Transport.Proxy.URL != nil && !c.Transport.Proxy.Disable &&
+			strings.HasPrefix(c.Hosts[i], urlSchemaHTTP+"://") {
+			return fmt.Errorf("HTTP/2 without TLS can't be used with a proxy, as required by '%s'", c.Hosts[i])
+		}
+		if c.HTTPVersion == httpVersion3 && !strings.HasPrefix(c.Hosts[i], urlSchemaHTTPS+"://") {
+			return fmt.Errorf("HTTP/3 requires TLS, which is not used by '%s'", c.Hosts[i])
+		}
 	}
 
 	return nil
@@ -21,6 +21,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
 )
 
 var tests = []struct {
@@ -97,3 +100,82 @@ func TestConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidateHTTPVersion(t *testing.T) {
+	for version, valid := range map[string]bool{
+		"":    true,
+		"1.1": true,
+		"2":   true,
+		"2.0": false,
+		"3":   true,
+		"3.0": false,
+	} {
+		t.Run(version, func(t *testing.T) {
+			config := Config{Hosts: []string{"https://localhost:8080"}, HTTPVersion: version}
+			err := config.Validate()
+			if valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigValidateHTTP3(t *testing.T) {
+	for name, test := range map[string]struct {
+		host  string
+		proxy string
+		valid bool
+	}{
+		"https":          {host: "https://localhost:8443", valid: true},
+		"http":           {host: "http://localhost:8080"},
+		"without scheme": {host: "localhost:8080"},
+		"proxy":          {host: "https://localhost:8443", proxy: "http://localhost:3128"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			settings := map[string]interface{}{
+				"hosts":        test.host,
+				"http_version": "3",
+			}
+			if test.proxy != "" {
+				settings["proxy_url"] = test.proxy
+			}
+			cfg, err := common.NewConfigFrom(settings)
+			require.NoError(t, err)
+
+			config := defaultConfig()
+			err = cfg.Unpack(&config)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigValidateHTTP2Proxy(t *testing.T) {
+	for host, valid := range map[string]bool{
+		"http://localhost:8080":  false,
+		"localhost:8080":         false,
+		"https://localhost:8080": true,
+	} {
+		t.Run(host, func(t *testing.T) {
+			cfg, err := common.NewConfigFrom(map[string]interface{}{
+				"hosts":        host,
+				"http_version": "2",
+				"proxy_url":    "http://localhost:3128",
+			})
+			require.NoError(t, err)
+
+			config := defaultConfig()
+			err = cfg.Unpack(&config)
+			if valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// hopTracer is a round tripper recording the timings of every request sent
+// through it, one per hop when following redirects.
+type hopTracer struct {
+	next http.RoundTripper
+
+	// The trace callbacks can still be invoked after the round trip returns.
+	mutex sync.Mutex
+	hops  []*hop
+}
+
+type hop struct {
+	url        string
+	statusCode int
+	version    string
+
+	start, end               time.Time
+	dnsStart, dnsDone        time.Time
+	connectStart, connectEnd time.Time
+	tlsStart, tlsDone        time.Time
+	gotConn, firstByte       time.Time
+}
+
+func newHopTracer(next http.RoundTripper) *hopTracer {
+	return &hopTracer{next: next}
+}
+
+func (t *hopTracer) RoundTrip(req *http.Request) (*http.Response, error) {
+	h := &hop{url: req.URL.Redacted(), start: time.Now()}
+	t.mutex.Lock()
+	t.hops = append(t.hops, h)
+	t.mutex.Unlock()
+
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), t.clientTrace(h)))
+	resp, err := t.next.RoundTrip(req)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h.end = time.Now()
+	if resp != nil {
+		h.statusCode = resp.StatusCode
+		h.version = responseVersion(resp)
+	}
+	return resp, err
+}
+
+func (t *hopTracer) clientTrace(h *hop) *httptrace.ClientTrace {
+	record := func(ts *time.Time) {
+		t.mutex.Lock()
+		*ts = time.Now()
+		t.mutex.Unlock()
+	}
+
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { record(&h.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { record(&h.dnsDone) },
+		ConnectStart:         func(_, _ string) { record(&h.connectStart) },
+		ConnectDone:          func(_, _ string, _ error) { record(&h.connectEnd) },
+		TLSHandshakeStart:    func() { record(&h.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { record(&h.tlsDone) },
+		GotConn:              func(httptrace.GotConnInfo) { record(&h.gotConn) },
+		GotFirstResponseByte: func() { record(&h.firstByte) },
+	}
+}
+
+// fields returns the url, status code, version and timings of every hop.
+// The timings of the phases that were not traced, like the DNS resolution of
+// IP addresses, are omitted.
+func (t *hopTracer) fields() []common.MapStr {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	hops := make([]common.MapStr, 0, len(t.hops))
+	for _, h := range t.hops {
+		rtt := common.MapStr{}
+		addRTT := func(name string, start, end time.Time) {
+			if !start.IsZero() && !end.IsZero() {
+				rtt[name] = look.RTT(end.Sub(start))
+			}
+		}
+		addRTT("dns", h.dnsStart, h.dnsDone)
+		addRTT("connect", h.connectStart, h.connectEnd)
+		addRTT("tls", h.tlsStart, h.tlsDone)
+		addRTT("response_header", h.gotConn, h.firstByte)
+		addRTT("total", h.start, h.end)
+
+		fields := common.MapStr{
+			"url": h.url,
+			"rtt": rtt,
+		}
+		if h.statusCode != 0 {
+			fields["status_code"] = h.statusCode
+			fields["version"] = h.version
+		}
+		hops = append(hops, fields)
+	}
+	return hops
+}
@@ -19,6 +19,7 @@ package http
 
 import (
 	"bytes"
+	"net"
 	"net/http"
 	"net/url"
 
@@ -51,6 +52,10 @@ func create(
 		return plugin.Plugin{}, err
 	}
 
+	if len(config.Steps) > 0 {
+		return createJourney(&config)
+	}
+
 	var body []byte
 	var enc contentEncoder
 
@@ -71,18 +76,20 @@ func create(
 		body = buf.Bytes()
 	}
 
-	validator, err := makeValidateResponse(&config.Check.Response)
+	validator, err := makeValidateResponse(&config.Check.Response, config.Check.TLS)
 	if err != nil {
 		return plugin.Plugin{}, err
 	}
+	validator = validator.requireVersion(config.HTTPVersion)
 
 	// Determine whether we're using a proxy or not and then use that to figure out how to
 	// run the job
 	var makeJob func(string) (jobs.Job, error)
-	// In the event that a ProxyURL is present, or redirect support is enabled
+	// In the event that a ProxyURL is present, redirect support is enabled or HTTP/2 or HTTP/3 is required
 	// we execute DNS resolution requests inline with the request, not running them as a separate job, and not returning
 	// separate DNS rtt data.
-	if (config.Transport.Proxy.URL != nil && !config.Transport.Proxy.Disable) || config.MaxRedirects > 0 {
+	if (config.Transport.Proxy.URL != nil && !config.Transport.Proxy.Disable) || config.MaxRedirects > 0 ||
+		config.HTTPVersion == httpVersion2 || config.HTTPVersion == httpVersion3 {
 		transport, err := newRoundTripper(&config)
 		if err != nil {
 			return plugin.Plugin{}, err
@@ -124,13 +131,62 @@ func create(
 	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(config.Hosts)}, nil
 }
 
+// createJourney makes a monitor running the configured steps in order, as a
+// single job reporting an event per step.
+func createJourney(config *Config) (plugin.Plugin, error) {
+	steps, err := newJourneySteps(config.Steps)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+	for _, step := range steps {
+		step.validator = step.validator.requireVersion(config.HTTPVersion)
+	}
+
+	transport, err := newRoundTripper(config)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	js := []jobs.Job{newHTTPJourneyJob(config, steps, transport)}
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: 1}, nil
+}
+
 func newRoundTripper(config *Config) (http.RoundTripper, error) {
-	return config.Transport.RoundTripper(
+	timeout := config.Transport.Timeout
+	opts := []httpcommon.TransportOption{
+		// Let the transport resolve, connect and handshake itself, so that the
+		// timings of every request can be traced.
+		httpcommon.WithTransportFunc(func(t *http.Transport) {
+			dialer := &net.Dialer{Timeout: timeout}
+			t.Dial, t.DialTLS = nil, nil
+			t.DialContext = dialer.DialContext
+		}),
+	}
+	if config.HTTPVersion == httpVersion2 {
+		opts = append(opts,
+			httpcommon.WithForceAttemptHTTP2(true),
+			httpcommon.WithModRoundtripper(func(rt http.RoundTripper) http.RoundTripper {
+				return newCleartextHTTP2RoundTripper(rt, timeout)
+			}),
+		)
+	}
+	if config.HTTPVersion == httpVersion3 {
+		tls, err := tlscommon.LoadTLSConfig(config.Transport.TLS)
+		if err != nil {
+			return nil, err
+		}
+		// Requests are only sent over QUIC, replacing the TCP based transport
+		opts = append(opts, httpcommon.WithModRoundtripper(func(http.RoundTripper) http.RoundTripper {
+			return newHTTP3RoundTripper(tls.ToConfig(), timeout)
+		}))
+	}
+
+	return config.Transport.RoundTripper(append(opts,
 		httpcommon.WithAPMHTTPInstrumentation(),
 		httpcommon.WithoutProxyEnvironmentVariables(),
 		httpcommon.WithKeepaliveSettings{
 			Disable: true,
 		},
 		httpcommon.WithHeaderRoundTripper(map[string]string{"User-Agent": userAgent}),
-	)
+	)...)
 }
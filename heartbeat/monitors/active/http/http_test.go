@@ -18,6 +18,8 @@
 package http
 
 import (
+	"compress/gzip"
+	"compress/zlib"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -36,8 +38,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/andybalholm/brotli"
+	"github.com/quic-go/quic-go/http3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 
 	"github.com/elastic/beats/v7/heartbeat/hbtest"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
@@ -138,6 +144,7 @@ func minimalRespondingHTTPChecks(url, mimeType string, statusCode int) validator
 		httpBodyChecks(),
 		lookslike.MustCompile(map[string]interface{}{
 			"http": map[string]interface{}{
+				"version":              "1.1",
 				"response.mime_type":   mimeType,
 				"response.status_code": statusCode,
 				"rtt.total.us":         isdef.IsDuration,
@@ -537,6 +544,67 @@ func TestExpiredHTTPSServer(t *testing.T) {
 	)
 }
 
+func TestHTTPSTLSChecks(t *testing.T) {
+	server := httptest.NewTLSServer(hbtest.HelloWorldHandler(http.StatusOK))
+	defer server.Close()
+
+	cert, err := x509.ParseCertificate(server.TLS.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+	certFile := hbtest.CertToTempFile(t, cert)
+	require.NoError(t, certFile.Close())
+	defer os.Remove(certFile.Name())
+
+	// The certificate of the test server expires in 2084
+	daysLeft := int(time.Until(cert.NotAfter).Hours() / 24)
+
+	// max_redirects makes the monitor use the standard library transport, instead
+	// of dialing each IP
+	for _, transport := range []string{"ip", "host"} {
+		t.Run(transport, func(t *testing.T) {
+			config := func(tlsCheck map[string]interface{}) map[string]interface{} {
+				config := map[string]interface{}{
+					"ssl.certificate_authorities": certFile.Name(),
+					"check.tls":                   tlsCheck,
+				}
+				if transport == "host" {
+					config["max_redirects"] = 1
+				}
+				return config
+			}
+
+			event := sendTLSRequest(t, server.URL, false, config(map[string]interface{}{
+				"expiry.warn":          daysLeft - 1,
+				"protocol.min_version": "TLSv1.2",
+			}))
+			testslike.Test(t, lookslike.Compose(
+				lookslike.MustCompile(map[string]interface{}{"monitor.status": "up"}),
+				hbtest.SummaryChecks(1, 0),
+				lookslike.MustCompile(map[string]interface{}{"tls.established": true}),
+			), event.Fields)
+
+			event = sendTLSRequest(t, server.URL, false, config(map[string]interface{}{
+				"expiry.warn": daysLeft + 1,
+			}))
+			testslike.Test(t, lookslike.Compose(
+				lookslike.MustCompile(map[string]interface{}{"monitor.status": "warn"}),
+				hbtest.SummaryWarnChecks(1, 0, 1),
+				hbtest.ErrorChecks("certificate 'O=Acme Co' expires in", "validate"),
+				lookslike.MustCompile(map[string]interface{}{"http.response.status_code": http.StatusOK}),
+			), event.Fields)
+
+			event = sendTLSRequest(t, server.URL, false, config(map[string]interface{}{
+				"pinned.spki_sha256": []string{"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+				"expiry.warn":        daysLeft + 1,
+			}))
+			testslike.Test(t, lookslike.Compose(
+				lookslike.MustCompile(map[string]interface{}{"monitor.status": "down"}),
+				hbtest.SummaryChecks(0, 1),
+				hbtest.ErrorChecks("no certificate of the chain matches the pinned public keys; certificate 'O=Acme Co' expires in", "validate"),
+			), event.Fields)
+		})
+	}
+}
+
 func TestHTTPSx509Auth(t *testing.T) {
 	if runtime.GOOS == "windows" && bits.UintSize == 32 {
 		t.Skip("flaky test: https://github.com/elastic/beats/issues/25857")
@@ -669,6 +737,11 @@ func TestRedirect(t *testing.T) {
 						server.URL + redirectingPaths["/redirect_one"],
 						server.URL + redirectingPaths["/redirect_two"],
 					},
+					"http.hops": []map[string]interface{}{
+						hopChecks(testURL, http.StatusFound),
+						hopChecks(server.URL+redirectingPaths["/redirect_one"], http.StatusFound),
+						hopChecks(server.URL+redirectingPaths["/redirect_two"], http.StatusOK),
+					},
 				}),
 			)),
 			event.Fields,
@@ -676,6 +749,19 @@ func TestRedirect(t *testing.T) {
 	}
 }
 
+// hopChecks validates a hop to an IP address over plain HTTP, without DNS
+// resolution nor TLS handshake.
+func hopChecks(url string, statusCode int) map[string]interface{} {
+	return map[string]interface{}{
+		"url":                    url,
+		"status_code":            statusCode,
+		"version":                "1.1",
+		"rtt.connect.us":         isdef.IsDuration,
+		"rtt.response_header.us": isdef.IsDuration,
+		"rtt.total.us":           isdef.IsDuration,
+	}
+}
+
 func TestNoHeaders(t *testing.T) {
 	server := httptest.NewServer(hbtest.HelloWorldHandler(200))
 	defer server.Close()
@@ -806,3 +892,176 @@ func TestUserAgentInject(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, ua, "Heartbeat")
 }
+
+// serverCAConfig writes the certificate of a TLS test server to a temporary
+// file, returning the config trusting it.
+func serverCAConfig(t *testing.T, server *httptest.Server) map[string]interface{} {
+	cert, err := x509.ParseCertificate(server.TLS.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+
+	certFile := hbtest.CertToTempFile(t, cert)
+	require.NoError(t, certFile.Close())
+	t.Cleanup(func() { os.Remove(certFile.Name()) })
+
+	return map[string]interface{}{"ssl.certificate_authorities": certFile.Name()}
+}
+
+func TestRedirectHopsTLS(t *testing.T) {
+	server := httptest.NewTLSServer(hbtest.RedirectHandler(map[string]string{"/redirect": "/"}, "TargetBody"))
+	defer server.Close()
+
+	config := serverCAConfig(t, server)
+	config["max_redirects"] = 10
+	event := sendTLSRequest(t, server.URL+"/redirect", true, config)
+
+	tlsHopChecks := func(url string, statusCode int) map[string]interface{} {
+		checks := hopChecks(url, statusCode)
+		checks["rtt.tls.us"] = isdef.IsDuration
+		return checks
+	}
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("", "up", "http"),
+			lookslike.MustCompile(map[string]interface{}{
+				"http.hops": []map[string]interface{}{
+					tlsHopChecks(server.URL+"/redirect", http.StatusFound),
+					tlsHopChecks(server.URL+"/", http.StatusOK),
+				},
+			}),
+		),
+		event.Fields,
+	)
+}
+
+func TestHTTPVersion(t *testing.T) {
+	newServer := func(http2 bool) *httptest.Server {
+		server := httptest.NewUnstartedServer(hbtest.HelloWorldHandler(http.StatusOK))
+		server.EnableHTTP2 = http2
+		server.StartTLS()
+		return server
+	}
+	h2cServer := httptest.NewServer(h2c.NewHandler(hbtest.HelloWorldHandler(http.StatusOK), &http2.Server{}))
+	defer h2cServer.Close()
+
+	tests := []struct {
+		name        string
+		server      *httptest.Server
+		httpVersion string
+		// HTTP/2 requests resolve the host with the request, as with redirects
+		ip      string
+		status  string
+		version string
+	}{
+		{"negotiated", newServer(true), "", "127.0.0.1", "up", "1.1"},
+		{"HTTP/1.1 from HTTP/2 server", newServer(true), "1.1", "127.0.0.1", "up", "1.1"},
+		{"HTTP/2", newServer(true), "2", "", "up", "2"},
+		{"HTTP/2 from HTTP/1.1 server", newServer(false), "2", "", "down", "1.1"},
+		{"HTTP/2 without TLS", h2cServer, "2", "", "up", "2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := map[string]interface{}{}
+			if test.server.TLS != nil {
+				defer test.server.Close()
+				config = serverCAConfig(t, test.server)
+			}
+			if test.httpVersion != "" {
+				config["http_version"] = test.httpVersion
+			}
+			event := sendTLSRequest(t, test.server.URL, true, config)
+
+			checks := []validator.Validator{
+				hbtest.BaseChecks(test.ip, test.status, "http"),
+				lookslike.MustCompile(map[string]interface{}{"http.version": test.version}),
+			}
+			if test.status == "down" {
+				checks = append(checks, hbtest.ErrorChecks("received HTTP/1.1 response, expected HTTP/2", "validate"))
+			}
+			testslike.Test(t, lookslike.Compose(checks...), event.Fields)
+		})
+	}
+}
+
+func TestHTTP3(t *testing.T) {
+	// The TLS test server provides the certificate, it's served over QUIC too
+	tlsServer := httptest.NewTLSServer(hbtest.HelloWorldHandler(http.StatusOK))
+	defer tlsServer.Close()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	h3Server := &http3.Server{
+		Handler:   hbtest.HelloWorldHandler(http.StatusOK),
+		TLSConfig: http3.ConfigureTLSConfig(&tls.Config{Certificates: tlsServer.TLS.Certificates}),
+	}
+	go h3Server.Serve(conn)
+	defer h3Server.Close()
+
+	for name, test := range map[string]struct {
+		url    string
+		status string
+	}{
+		"HTTP/3":              {fmt.Sprintf("https://%s", conn.LocalAddr()), "up"},
+		"HTTP/3 without QUIC": {tlsServer.URL, "down"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := serverCAConfig(t, tlsServer)
+			config["http_version"] = "3"
+			config["timeout"] = "1s"
+			event := sendTLSRequest(t, test.url, true, config)
+
+			checks := []validator.Validator{hbtest.BaseChecks("", test.status, "http")}
+			if test.status == "up" {
+				checks = append(checks, lookslike.MustCompile(map[string]interface{}{"http.version": "3"}))
+			}
+			testslike.Test(t, lookslike.Compose(checks...), event.Fields)
+		})
+	}
+}
+
+func TestCompressedBody(t *testing.T) {
+	body := "compressed body"
+	encoders := map[string]func(io.Writer) io.WriteCloser{
+		"gzip":    func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+		"deflate": func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) },
+		"br":      func(w io.Writer) io.WriteCloser { return brotli.NewWriter(w) },
+	}
+
+	for encoding, newEncoder := range encoders {
+		encoding, newEncoder := encoding, newEncoder
+		t.Run(encoding, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Accept-Encoding") != encoding {
+					w.WriteHeader(http.StatusNotAcceptable)
+					return
+				}
+				w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+				w.Header().Set("Content-Encoding", encoding)
+				enc := newEncoder(w)
+				io.WriteString(enc, body)
+				enc.Close()
+			}))
+			defer server.Close()
+
+			event := sendTLSRequest(t, server.URL, true, map[string]interface{}{
+				"check.request.headers.Accept-Encoding": encoding,
+				"check.response.body":                   body,
+				"response.include_body":                 "always",
+			})
+
+			testslike.Test(
+				t,
+				lookslike.Compose(
+					hbtest.BaseChecks("127.0.0.1", "up", "http"),
+					respondingHTTPBodyChecks(body),
+					lookslike.MustCompile(map[string]interface{}{
+						"http.response.mime_type":                "text/plain; charset=utf-8",
+						"http.response.headers.Content-Encoding": encoding,
+					}),
+				),
+				event.Fields,
+			)
+		})
+	}
+}
@@ -0,0 +1,325 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+)
+
+// journeyStep is a compiled step of a multi-step journey. Its URL, header
+// values and body are templates rendered with the variables extracted by the
+// previous steps of the same run.
+type journeyStep struct {
+	name      string
+	method    string
+	url       *template.Template
+	headers   map[string]*template.Template
+	body      *template.Template
+	encoding  compressionConfig
+	validator multiValidator
+	extract   []*extractConfig
+}
+
+// journeyRun holds the state shared by the steps of a single journey run.
+type journeyRun struct {
+	config *Config
+	steps  []*journeyStep
+	jar    http.CookieJar
+	vars   map[string]string
+}
+
+func newJourneySteps(stepConfigs []*common.Config) ([]*journeyStep, error) {
+	steps := make([]*journeyStep, len(stepConfigs))
+	for i, cfg := range stepConfigs {
+		stepConfig := defaultStepConfig()
+		if err := cfg.Unpack(&stepConfig); err != nil {
+			return nil, errors.Wrapf(err, "invalid config for step %d", i+1)
+		}
+
+		step, err := newJourneyStep(&stepConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid config for step %d '%s'", i+1, stepConfig.Name)
+		}
+		steps[i] = step
+	}
+
+	return steps, nil
+}
+
+func newJourneyStep(config *stepConfig) (*journeyStep, error) {
+	request := config.Check.Request
+
+	step := &journeyStep{
+		name:    config.Name,
+		method:  strings.ToUpper(request.Method),
+		headers: map[string]*template.Template{},
+		extract: config.Extract,
+	}
+
+	var err error
+	if step.url, err = parseStepTemplate("url", config.URL); err != nil {
+		return nil, err
+	}
+	for k, v := range request.SendHeaders {
+		if step.headers[k], err = parseStepTemplate("header "+k, v); err != nil {
+			return nil, err
+		}
+	}
+	if request.SendBody != "" {
+		if step.body, err = parseStepTemplate("body", request.SendBody); err != nil {
+			return nil, err
+		}
+		step.encoding = request.Compression
+	}
+
+	if step.validator, err = makeValidateResponse(&config.Check.Response, config.Check.TLS); err != nil {
+		return nil, err
+	}
+
+	return step, nil
+}
+
+// parseStepTemplate parses a step setting as a template. The delimiters are
+// `[[` and `]]` as `${...}` is already expanded while loading the config.
+func parseStepTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Delims("[[", "]]").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s template", name)
+	}
+	return tmpl, nil
+}
+
+func renderStepTemplate(tmpl *template.Template, vars map[string]string) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// newHTTPJourneyJob creates a job running the first step of the journey, every
+// step returns the next one as a continuation, so each step reports its own event.
+// Steps after a failed one are not run.
+func newHTTPJourneyJob(config *Config, steps []*journeyStep, transport http.RoundTripper) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		run := &journeyRun{
+			config: config,
+			steps:  steps,
+			jar:    jar,
+			vars:   map[string]string{},
+		}
+		return run.stepJob(transport, 0)(event)
+	}
+}
+
+func (r *journeyRun) stepJob(transport http.RoundTripper, idx int) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		step := r.steps[idx]
+		eventext.MergeEventFields(event, common.MapStr{
+			"http": common.MapStr{
+				"step": common.MapStr{
+					"name":  step.name,
+					"index": idx + 1,
+					"total": len(r.steps),
+				},
+			},
+		})
+
+		request, body, err := step.buildRequest(r.config, r.vars)
+		if err != nil {
+			return nil, reason.ValidateFailed(errors.Wrapf(err, "could not build request of step '%s'", step.name))
+		}
+		eventext.MergeEventFields(event, common.MapStr{"url": wrappers.URLFields(request.URL)})
+
+		var redirects []string
+		rt, hops := traceHops(r.config, transport)
+		client := &http.Client{
+			CheckRedirect: makeCheckRedirect(r.config.MaxRedirects, &redirects),
+			Transport:     rt,
+			Jar:           r.jar,
+			Timeout:       r.config.Transport.Timeout,
+		}
+		_, _, errReason := execPing(event, client, request, body, r.config.Transport.Timeout, step.runValidator(r.vars), r.config.Response)
+		if len(redirects) > 0 {
+			event.PutValue("http.response.redirects", redirects)
+		}
+		if hops != nil {
+			event.PutValue("http.hops", hops.fields())
+		}
+		if errReason != nil {
+			return nil, errReason
+		}
+
+		if idx+1 < len(r.steps) {
+			return []jobs.Job{r.stepJob(transport, idx+1)}, nil
+		}
+		return nil, nil
+	}
+}
+
+func (s *journeyStep) buildRequest(config *Config, vars map[string]string) (*http.Request, []byte, error) {
+	addr, err := renderStepTemplate(s.url, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+	u, err := url.ParseRequestURI(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u.Scheme != urlSchemaHTTP && u.Scheme != urlSchemaHTTPS {
+		return nil, nil, fmt.Errorf("unsupported scheme in url '%s'", u.Redacted())
+	}
+
+	request, err := http.NewRequest(s.method, u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	request.Close = true
+
+	if config.Username != "" {
+		request.SetBasicAuth(config.Username, config.Password)
+	}
+	for k, tmpl := range s.headers {
+		v, err := renderStepTemplate(tmpl, vars)
+		if err != nil {
+			return nil, nil, err
+		}
+		// defining the Host header isn't enough. See https://github.com/golang/go/issues/7682
+		if k == "Host" {
+			request.Host = v
+		}
+		request.Header.Add(k, v)
+	}
+
+	if s.body == nil {
+		return request, nil, nil
+	}
+
+	payload, err := renderStepTemplate(s.body, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+	// encoders are not shared, as runs of the journey may overlap
+	enc, err := getContentEncoder(s.encoding.Type, s.encoding.Level)
+	if err != nil {
+		return nil, nil, err
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := enc.Encode(buf, bytes.NewBufferString(payload)); err != nil {
+		return nil, nil, err
+	}
+	enc.AddHeaders(&request.Header)
+
+	return request, buf.Bytes(), nil
+}
+
+// runValidator appends the extraction of the step variables to its response
+// checks, so variables are only extracted from valid responses.
+func (s *journeyStep) runValidator(vars map[string]string) multiValidator {
+	validator := multiValidator{
+		respValidators: append([]respValidator{}, s.validator.respValidators...),
+		bodyValidators: append([]bodyValidator{}, s.validator.bodyValidators...),
+		tlsChecker:     s.validator.tlsChecker,
+	}
+
+	for _, extract := range s.extract {
+		extract := extract
+		if extract.JSON != "" {
+			validator.bodyValidators = append(validator.bodyValidators, func(_ *http.Response, body string) error {
+				return extractJSON(extract, body, vars)
+			})
+		} else {
+			validator.respValidators = append(validator.respValidators, func(r *http.Response) error {
+				return extractResponse(extract, r, vars)
+			})
+		}
+	}
+
+	return validator
+}
+
+func extractJSON(extract *extractConfig, body string, vars map[string]string) error {
+	decoded := common.MapStr{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return errors.Wrapf(err, "could not parse JSON body to extract '%s'", extract.Name)
+	}
+	jsontransform.TransformNumbers(decoded)
+
+	value, err := decoded.GetValue(extract.JSON)
+	if err != nil {
+		return fmt.Errorf("could not extract '%s': key '%s' not found in JSON body", extract.Name, extract.JSON)
+	}
+
+	switch v := value.(type) {
+	case string:
+		vars[extract.Name] = v
+	case common.MapStr, []interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "could not extract '%s'", extract.Name)
+		}
+		vars[extract.Name] = string(encoded)
+	default:
+		vars[extract.Name] = fmt.Sprint(v)
+	}
+
+	return nil
+}
+
+func extractResponse(extract *extractConfig, r *http.Response, vars map[string]string) error {
+	if extract.Header != "" {
+		value := r.Header.Get(extract.Header)
+		if value == "" {
+			return fmt.Errorf("could not extract '%s': header '%s' not found in response", extract.Name, extract.Header)
+		}
+		vars[extract.Name] = value
+		return nil
+	}
+
+	for _, cookie := range r.Cookies() {
+		if cookie.Name == extract.Cookie {
+			vars[extract.Name] = cookie.Value
+			return nil
+		}
+	}
+	return fmt.Errorf("could not extract '%s': cookie '%s' not found in response", extract.Name, extract.Cookie)
+}
@@ -0,0 +1,324 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+// journeyServer serves a login flow, the token returned by /login must be sent
+// in the Authorization header of the other requests, along with the session cookie.
+func journeyServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		var credentials struct{ User, Password string }
+		if r.Method != "POST" || json.NewDecoder(r.Body).Decode(&credentials) != nil ||
+			credentials.User != "heartbeat" || credentials.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1", Path: "/"})
+		w.Header().Set("X-Account", "42")
+		fmt.Fprint(w, `{"auth": {"token": "abc", "expires": 3600}}`)
+	})
+	authorized := func(r *http.Request) bool {
+		cookie, err := r.Cookie("session")
+		return err == nil && cookie.Value == "s1" && r.Header.Get("Authorization") == "Bearer abc"
+	}
+	mux.HandleFunc("/accounts/42", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `{"name": "heartbeat", "plan": "gold"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func journeySteps(serverURL string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name": "login",
+			"url":  serverURL + "/login",
+			"check.request": map[string]interface{}{
+				"method": "POST",
+				"body":   `{"user": "heartbeat", "password": "secret"}`,
+			},
+			"extract": []interface{}{
+				map[string]interface{}{"name": "token", "json": "auth.token"},
+				map[string]interface{}{"name": "account", "header": "X-Account"},
+				map[string]interface{}{"name": "session", "cookie": "session"},
+			},
+		},
+		map[string]interface{}{
+			"name": "account",
+			"url":  serverURL + "/accounts/[[.account]]",
+			"check.request.headers": map[string]interface{}{
+				"Authorization": "Bearer [[.token]]",
+			},
+			"check.response.json": []interface{}{
+				map[string]interface{}{
+					"description": "gold plan",
+					"condition":   map[string]interface{}{"equals": map[string]interface{}{"plan": "gold"}},
+				},
+			},
+		},
+	}
+}
+
+func execJourney(t *testing.T, steps []interface{}) []*beat.Event {
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"timeout": "1s",
+		"steps":   steps,
+	})
+	require.NoError(t, err)
+
+	p, err := create("journey", config)
+	require.NoError(t, err)
+	require.Len(t, p.Jobs, 1)
+	require.Equal(t, 1, p.Endpoints)
+
+	sched := schedule.MustParse("@every 1s")
+	js := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "journey", Type: "http", Schedule: sched, Timeout: 1})
+
+	events, err := jobs.ExecJobsAndConts(t, js)
+	require.NoError(t, err)
+	return events
+}
+
+func stepChecks(name string, index, total int, url string, status string) validator.Validator {
+	return lookslike.Compose(
+		urlChecks(url),
+		lookslike.MustCompile(map[string]interface{}{
+			"monitor": map[string]interface{}{
+				"id":          "journey",
+				"status":      status,
+				"check_group": isdef.IsString,
+			},
+			"http.step": map[string]interface{}{
+				"name":  name,
+				"index": index,
+				"total": total,
+			},
+		}),
+	)
+}
+
+func TestJourney(t *testing.T) {
+	server := journeyServer(t)
+	events := execJourney(t, journeySteps(server.URL))
+	require.Len(t, events, 2)
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			stepChecks("login", 1, 2, server.URL+"/login", "up"),
+			lookslike.MustCompile(map[string]interface{}{"http.response.status_code": 200}),
+		),
+		events[0].Fields,
+	)
+	_, err := events[0].GetValue("summary")
+	assert.Error(t, err, "only the last step has a summary")
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			stepChecks("account", 2, 2, server.URL+"/accounts/42", "up"),
+			hbtest.SummaryChecks(2, 0),
+			lookslike.MustCompile(map[string]interface{}{"http.response.status_code": 200}),
+		),
+		events[1].Fields,
+	)
+
+	group0, _ := events[0].GetValue("monitor.check_group")
+	group1, _ := events[1].GetValue("monitor.check_group")
+	assert.Equal(t, group0, group1)
+}
+
+func TestJourneyFailedStep(t *testing.T) {
+	server := journeyServer(t)
+	steps := journeySteps(server.URL)
+	steps[0].(map[string]interface{})["check.request"] = map[string]interface{}{
+		"method": "POST",
+		"body":   `{"user": "heartbeat", "password": "wrong"}`,
+	}
+
+	events := execJourney(t, steps)
+	require.Len(t, events, 1, "steps after a failed one must not run")
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			stepChecks("login", 1, 2, server.URL+"/login", "down"),
+			hbtest.SummaryChecks(0, 1),
+			hbtest.ErrorChecks("401 Unauthorized", "validate"),
+		),
+		events[0].Fields,
+	)
+}
+
+func TestJourneyStepChecks(t *testing.T) {
+	server := journeyServer(t)
+	steps := journeySteps(server.URL)
+	steps[1].(map[string]interface{})["check.response.json"] = []interface{}{
+		map[string]interface{}{
+			"description": "platinum plan",
+			"condition":   map[string]interface{}{"equals": map[string]interface{}{"plan": "platinum"}},
+		},
+	}
+
+	events := execJourney(t, steps)
+	require.Len(t, events, 2)
+
+	testslike.Test(t, stepChecks("login", 1, 2, server.URL+"/login", "up"), events[0].Fields)
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			stepChecks("account", 2, 2, server.URL+"/accounts/42", "down"),
+			hbtest.SummaryChecks(1, 1),
+		),
+		events[1].Fields,
+	)
+	msg, _ := events[1].GetValue("error.message")
+	assert.Contains(t, msg, "platinum plan")
+}
+
+func TestJourneyExtractionFailure(t *testing.T) {
+	server := journeyServer(t)
+
+	tests := map[string]map[string]interface{}{
+		"json":   {"name": "token", "json": "auth.missing"},
+		"header": {"name": "account", "header": "X-Missing"},
+		"cookie": {"name": "session", "cookie": "missing"},
+	}
+
+	for name, extract := range tests {
+		t.Run(name, func(t *testing.T) {
+			steps := journeySteps(server.URL)
+			steps[0].(map[string]interface{})["extract"] = []interface{}{extract}
+
+			events := execJourney(t, steps)
+			require.Len(t, events, 1)
+
+			testslike.Test(
+				t,
+				lookslike.Compose(
+					stepChecks("login", 1, 2, server.URL+"/login", "down"),
+					hbtest.SummaryChecks(0, 1),
+				),
+				events[0].Fields,
+			)
+			msg, _ := events[0].GetValue("error.message")
+			assert.Contains(t, msg, fmt.Sprintf("could not extract '%s'", extract["name"]))
+		})
+	}
+}
+
+func TestJourneyUndefinedVariable(t *testing.T) {
+	server := journeyServer(t)
+	steps := journeySteps(server.URL)
+	delete(steps[0].(map[string]interface{}), "extract")
+
+	events := execJourney(t, steps)
+	require.Len(t, events, 2)
+
+	testslike.Test(t, stepChecks("login", 1, 2, server.URL+"/login", "up"), events[0].Fields)
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			lookslike.MustCompile(map[string]interface{}{
+				"monitor.status": "down",
+				"http.step.name": "account",
+			}),
+			hbtest.SummaryChecks(1, 1),
+		),
+		events[1].Fields,
+	)
+	msg, _ := events[1].GetValue("error.message")
+	assert.Contains(t, msg, "could not build request of step 'account'")
+}
+
+func TestJourneyConfig(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"hosts and steps": {
+			config: map[string]interface{}{
+				"hosts": "http://localhost",
+				"steps": []interface{}{map[string]interface{}{"name": "a", "url": "http://localhost"}},
+			},
+			err: "hosts and urls can't be used with steps",
+		},
+		"step without url": {
+			config: map[string]interface{}{
+				"steps": []interface{}{map[string]interface{}{"name": "a"}},
+			},
+			err: "invalid config for step 1",
+		},
+		"extract from several sources": {
+			config: map[string]interface{}{
+				"steps": []interface{}{map[string]interface{}{
+					"name":    "a",
+					"url":     "http://localhost",
+					"extract": []interface{}{map[string]interface{}{"name": "v", "json": "a", "header": "b"}},
+				}},
+			},
+			err: "variable 'v' must be extracted from exactly one of",
+		},
+		"invalid template": {
+			config: map[string]interface{}{
+				"steps": []interface{}{map[string]interface{}{"name": "a", "url": "http://localhost/[[.v"}},
+			},
+			err: "could not parse url template",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config, err := common.NewConfigFrom(test.config)
+			require.NoError(t, err)
+
+			_, err = create("journey", config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
@@ -18,6 +18,8 @@
 package http
 
 import (
+	"compress/gzip"
+	"compress/zlib"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -25,6 +27,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/andybalholm/brotli"
 	"github.com/docker/go-units"
 
 	"github.com/elastic/beats/v7/heartbeat/reason"
@@ -56,6 +59,11 @@ func processBody(resp *http.Response, config responseConfig, validator multiVali
 		bufferBodyBytes = minBufferBodyBytes
 	}
 
+	if err := decodeBody(resp); err != nil {
+		resp.Body.Close()
+		return nil, "", reason.IOFailed(fmt.Errorf("failed decoding HTTP response body: %w", err))
+	}
+
 	respBody, bodyLenBytes, bodyHash, respErr := readBody(resp, bufferBodyBytes)
 	// If we encounter an error while reading the body just fail early
 	if respErr != nil {
@@ -88,6 +96,52 @@ func processBody(resp *http.Response, config responseConfig, validator multiVali
 	return bodyFields, mime.Detect(respBody), errReason
 }
 
+// decodeBody replaces the body of responses compressed with gzip, deflate or
+// brotli by its decompressed content, so that the checks, hash and size apply to
+// the content. Bodies with other encodings are left as is.
+func decodeBody(resp *http.Response) error {
+	encodings := strings.Split(resp.Header.Get("Content-Encoding"), ",")
+	readers := make([]func(io.Reader) (io.Reader, error), 0, len(encodings))
+	for _, encoding := range encodings {
+		switch strings.ToLower(strings.TrimSpace(encoding)) {
+		case "", "identity":
+		case "gzip", "x-gzip":
+			readers = append(readers, func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) })
+		case "deflate":
+			readers = append(readers, func(r io.Reader) (io.Reader, error) { return zlib.NewReader(r) })
+		case "br":
+			readers = append(readers, func(r io.Reader) (io.Reader, error) { return brotli.NewReader(r), nil })
+		default:
+			return nil
+		}
+	}
+	if len(readers) == 0 {
+		return nil
+	}
+
+	// Encodings are listed in the order they were applied.
+	var body io.Reader = resp.Body
+	for i := len(readers) - 1; i >= 0; i-- {
+		decoded, err := readers[i](body)
+		if err == io.EOF {
+			// empty body, as in responses to HEAD requests
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		body = decoded
+	}
+
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{body, resp.Body}
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
 // readBody reads the first sampleSize bytes from the httpResponse,
 // then closes the body (which closes the connection). It doesn't return any errors
 // but does log them. During an error case the return values will be (nil, -1).
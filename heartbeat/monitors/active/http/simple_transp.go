@@ -20,6 +20,7 @@ package http
 import (
 	"bufio"
 	"compress/gzip"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -189,6 +190,12 @@ func (t *SimpleTransport) readResponse(
 	}
 	resp.Body = comboConnReadCloser{conn, resp.Body}
 
+	// Report the TLS connection state like the standard library transports do
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		state := tlsConn.ConnectionState()
+		resp.TLS = &state
+	}
+
 	t.sigStartRead()
 
 	if requestedGzip && resp.Header.Get("Content-Encoding") == gzipEncoding {
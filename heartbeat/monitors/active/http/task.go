@@ -61,16 +61,20 @@ func newHTTPMonitorHostJob(
 
 	return jobs.MakeSimpleJob(func(event *beat.Event) error {
 		var redirects []string
+		rt, hops := traceHops(config, transport)
 		client := &http.Client{
 			// Trace visited URLs when redirects occur
 			CheckRedirect: makeCheckRedirect(config.MaxRedirects, &redirects),
-			Transport:     transport,
+			Transport:     rt,
 			Timeout:       config.Transport.Timeout,
 		}
 		_, _, err := execPing(event, client, request, body, config.Transport.Timeout, validator, config.Response)
 		if len(redirects) > 0 {
 			event.PutValue("http.response.redirects", redirects)
 		}
+		if hops != nil {
+			event.PutValue("http.hops", hops.fields())
+		}
 		return err
 	}), nil
 }
@@ -222,7 +226,7 @@ func execPing(
 	validator multiValidator,
 	responseConfig responseConfig,
 ) (start, end time.Time, err reason.Reason) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
 	defer cancel()
 
 	req = attachRequestBody(&ctx, req, reqBody)
@@ -266,7 +270,10 @@ func execPing(
 		responseFields["headers"] = headerFields
 	}
 
-	httpFields := common.MapStr{"response": responseFields}
+	httpFields := common.MapStr{
+		"version":  responseVersion(resp),
+		"response": responseFields,
+	}
 
 	eventext.MergeEventFields(event, common.MapStr{"http": httpFields})
 
@@ -274,8 +281,8 @@ func execPing(
 	end = time.Now()
 
 	// Enrich event with TLS information when available. This is useful when connecting to an HTTPS server through
-	// a proxy.
-	if resp.TLS != nil {
+	// a proxy. The dialer chain already added it otherwise.
+	if established, _ := event.GetValue("tls.established"); resp.TLS != nil && established == nil {
 		tlsFields := common.MapStr{}
 		tlsmeta.AddTLSMetadata(tlsFields, *resp.TLS, tlsmeta.UnknownTLSHandshakeDuration)
 		eventext.MergeEventFields(event, tlsFields)
@@ -335,6 +342,16 @@ func splitHostnamePort(requ *http.Request) (string, uint16, error) {
 	return host, uint16(p), nil
 }
 
+// traceHops wraps the transport of a single run to record the timings of every
+// request when following redirects. The tracer is nil otherwise.
+func traceHops(config *Config, transport http.RoundTripper) (http.RoundTripper, *hopTracer) {
+	if config.MaxRedirects == 0 {
+		return transport, nil
+	}
+	hops := newHopTracer(transport)
+	return hops, hops
+}
+
 // makeCheckRedirect checks if max redirects are exceeded, also append to the redirects list if we're tracking those.
 // It's kind of ugly to return a result via a pointer argument, but it's the interface the
 // golang HTTP client gives us.
@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	"golang.org/x/net/http2"
+)
+
+const (
+	httpVersion11 = "1.1"
+	httpVersion2  = "2"
+	httpVersion3  = "3"
+)
+
+// responseVersion formats the HTTP version of a response as in the
+// http_version option, "1.1", "2" or "3".
+func responseVersion(resp *http.Response) string {
+	if resp.ProtoMajor == 1 {
+		return fmt.Sprintf("1.%d", resp.ProtoMinor)
+	}
+	return fmt.Sprintf("%d", resp.ProtoMajor)
+}
+
+// checkVersion is a respValidator requiring responses of the given HTTP version.
+func checkVersion(version string) respValidator {
+	return func(r *http.Response) error {
+		if actual := responseVersion(r); actual != version {
+			return fmt.Errorf("received HTTP/%s response, expected HTTP/%s", actual, version)
+		}
+		return nil
+	}
+}
+
+// cleartextHTTP2RoundTripper sends plain http requests with HTTP/2 prior
+// knowledge (h2c), and https requests with the next round tripper, which
+// negotiates HTTP/2 during the TLS handshake.
+type cleartextHTTP2RoundTripper struct {
+	h2c  *http2.Transport
+	next http.RoundTripper
+}
+
+func newCleartextHTTP2RoundTripper(next http.RoundTripper, timeout time.Duration) *cleartextHTTP2RoundTripper {
+	dialer := &net.Dialer{Timeout: timeout}
+	return &cleartextHTTP2RoundTripper{
+		h2c: &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				return dialer.Dial(network, addr)
+			},
+		},
+		next: next,
+	}
+}
+
+func (rt *cleartextHTTP2RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != urlSchemaHTTP {
+		return rt.next.RoundTrip(req)
+	}
+
+	// Single use connections never get a stream with this version of the
+	// HTTP/2 transport, instead of asking for one the idle connections are
+	// closed with the body, as keep-alives are disabled for the other transports.
+	keepAlive := *req
+	keepAlive.Close = false
+	resp, err := rt.h2c.RoundTrip(&keepAlive)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = closeIdleConnsBody{resp.Body, rt.h2c}
+	return resp, nil
+}
+
+// http3RoundTripper sends https requests over QUIC with HTTP/3.
+type http3RoundTripper struct {
+	h3 *http3.RoundTripper
+}
+
+func newHTTP3RoundTripper(tlsConfig *tls.Config, timeout time.Duration) *http3RoundTripper {
+	return &http3RoundTripper{
+		h3: &http3.RoundTripper{
+			TLSClientConfig: tlsConfig,
+			QuicConfig:      &quic.Config{HandshakeIdleTimeout: timeout},
+		},
+	}
+}
+
+func (rt *http3RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != urlSchemaHTTPS {
+		return nil, fmt.Errorf("HTTP/3 requires TLS, can't request '%s'", req.URL)
+	}
+
+	// As for HTTP/2 without TLS, connections are closed with the body, as
+	// keep-alives are disabled for the other transports.
+	resp, err := rt.h3.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = closeIdleConnsBody{resp.Body, rt.h3}
+	return resp, nil
+}
+
+// idleConnsCloser is a transport whose idle connections can be closed.
+type idleConnsCloser interface {
+	CloseIdleConnections()
+}
+
+// closeIdleConnsBody closes the idle connections of the transport once the
+// response body it reads from is closed.
+type closeIdleConnsBody struct {
+	io.ReadCloser
+	transport idleConnsCloser
+}
+
+func (b closeIdleConnsBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.transport.CloseIdleConnections()
+	return err
+}
@@ -18,6 +18,7 @@
 package icmp
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors"
@@ -29,11 +30,78 @@ type Config struct {
 
 	Timeout time.Duration `config:"timeout"`
 	Wait    time.Duration `config:"wait"`
+
+	// number of echo requests sent per check, 1 sending requests until the first reply
+	Count int `config:"count" validate:"min=1"`
+	// packet loss percentage above which the host is reported down
+	MaxPacketLoss float64 `config:"max_packet_loss" validate:"min=0,max=100"`
+
+	Traceroute TracerouteConfig `config:"traceroute"`
+}
+
+// TracerouteConfig configures the route tracing done when a host is down.
+type TracerouteConfig struct {
+	Enabled  bool          `config:"enabled"`
+	Protocol string        `config:"protocol"`
+	Port     uint16        `config:"port"`
+	MaxHops  int           `config:"max_hops" validate:"min=1,max=255"`
+	Timeout  time.Duration `config:"timeout" validate:"positive"`
+	// TotalTimeout bounds the whole trace, whatever the number of hops probed
+	TotalTimeout time.Duration `config:"total_timeout" validate:"positive"`
 }
 
+const (
+	traceICMP = "icmp"
+	traceUDP  = "udp"
+	traceTCP  = "tcp"
+)
+
 var DefaultConfig = Config{
 	Mode: monitors.DefaultIPSettings,
 
 	Timeout: 16 * time.Second,
 	Wait:    1 * time.Second,
+
+	Count:         1,
+	MaxPacketLoss: 100,
+
+	Traceroute: TracerouteConfig{
+		Protocol: traceICMP,
+		MaxHops:  30,
+		Timeout:  time.Second,
+
+		TotalTimeout: 5 * time.Second,
+	},
+}
+
+func (c *Config) Validate() error {
+	if c.Count > 1 && time.Duration(c.Count-1)*c.Wait >= c.Timeout {
+		return fmt.Errorf("sending %d requests %v apart takes longer than the timeout of %v", c.Count, c.Wait, c.Timeout)
+	}
+	return nil
+}
+
+func (c *TracerouteConfig) Validate() error {
+	switch c.Protocol {
+	case traceICMP, traceUDP, traceTCP:
+		return nil
+	default:
+		return fmt.Errorf("invalid traceroute protocol '%s', expected one of %s, %s or %s", c.Protocol, traceICMP, traceUDP, traceTCP)
+	}
+}
+
+// port returns the destination port of the probes, the base port incremented with
+// each hop for udp.
+func (c *TracerouteConfig) port() int {
+	if c.Port != 0 {
+		return int(c.Port)
+	}
+	switch c.Protocol {
+	case traceUDP:
+		return 33434
+	case traceTCP:
+		return 80
+	default:
+		return 0
+	}
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/monitors"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
@@ -59,6 +60,11 @@ func create(
 	if err != nil {
 		return plugin.Plugin{}, err
 	}
+	if config.Traceroute.Enabled {
+		if jf.tracer, err = newStdTracer(); err != nil {
+			return plugin.Plugin{}, err
+		}
+	}
 	return jf.makePlugin()
 
 }
@@ -67,6 +73,7 @@ type jobFactory struct {
 	config    Config
 	resolver  monitors.Resolver
 	loop      ICMPLoop
+	tracer    tracer
 	ipVersion string
 }
 
@@ -118,17 +125,64 @@ func (jf *jobFactory) makePlugin() (plugin2 plugin.Plugin, err error) {
 
 func (jf *jobFactory) pingIPFactory(config *Config) func(*net.IPAddr) jobs.Job {
 	return monitors.MakePingIPFactory(func(event *beat.Event, ip *net.IPAddr) error {
-		rtt, n, err := jf.loop.ping(ip, config.Timeout, config.Wait)
-		if err != nil {
-			return err
+		var err error
+		if config.Count > 1 {
+			err = jf.probe(event, ip, config)
+		} else {
+			err = jf.ping(event, ip, config)
 		}
 
-		icmpFields := common.MapStr{"requests": n}
-		if err == nil {
-			icmpFields["rtt"] = look.RTT(rtt)
-			eventext.MergeEventFields(event, common.MapStr{"icmp": icmpFields})
+		if err != nil && jf.tracer != nil {
+			jf.traceroute(event, ip, config.Traceroute)
 		}
-
-		return nil
+		return err
 	})
 }
+
+// ping sends requests until the first one is answered.
+func (jf *jobFactory) ping(event *beat.Event, ip *net.IPAddr, config *Config) error {
+	rtt, n, err := jf.loop.ping(ip, config.Timeout, config.Wait)
+	if err != nil {
+		return err
+	}
+
+	icmpFields := common.MapStr{"requests": n}
+	if err == nil {
+		icmpFields["rtt"] = look.RTT(rtt)
+		eventext.MergeEventFields(event, common.MapStr{"icmp": icmpFields})
+	}
+
+	return nil
+}
+
+// probe sends config.Count requests, reporting the packet loss and RTT stats.
+func (jf *jobFactory) probe(event *beat.Event, ip *net.IPAddr, config *Config) error {
+	rtts, err := jf.loop.probe(ip, config.Count, config.Timeout, config.Wait)
+	if err != nil {
+		return err
+	}
+
+	stats := computeStats(rtts)
+	eventext.MergeEventFields(event, common.MapStr{"icmp": stats.fields()})
+
+	if stats.received == 0 {
+		return timeoutError{}
+	}
+	if loss := stats.packetLoss(); loss > config.MaxPacketLoss {
+		return reason.ValidateFailed(fmt.Errorf("packet loss of %.1f%% exceeds %.1f%%", loss, config.MaxPacketLoss))
+	}
+	return nil
+}
+
+// traceroute adds the route to a host that is down to the event.
+func (jf *jobFactory) traceroute(event *beat.Event, ip *net.IPAddr, config TracerouteConfig) {
+	res, err := jf.tracer.trace(ip, config)
+	if err != nil {
+		logp.Warn("Traceroute to %s failed: %v", ip, err)
+	}
+	if res != nil {
+		eventext.MergeEventFields(event, common.MapStr{
+			"icmp": common.MapStr{"traceroute": res.fields()},
+		})
+	}
+}
@@ -32,8 +32,10 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/go-lookslike"
 	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
 )
 
 func TestICMPFields(t *testing.T) {
@@ -61,10 +63,145 @@ func TestICMPFields(t *testing.T) {
 	testslike.Test(t, validator, e.Fields)
 }
 
+func TestICMPProbeFields(t *testing.T) {
+	cfg := probeConfig(4)
+	cfg.MaxPacketLoss = 50
+	tl := mockLoop{probeRtts: []time.Duration{time.Millisecond, 0, 3 * time.Millisecond, 2 * time.Millisecond}}
+	e := execTestICMPCheckWithLoop(t, cfg, tl, nil)
+
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		probeChecks("up"),
+		hbtest.SummaryChecks(1, 0),
+		lookslike.MustCompile(map[string]interface{}{
+			"icmp.requests":    4,
+			"icmp.replies":     3,
+			"icmp.packet_loss": 25.0,
+			"icmp.rtt": map[string]interface{}{
+				"us":     look.RTT(2 * time.Millisecond)["us"],
+				"min":    look.RTT(time.Millisecond),
+				"max":    look.RTT(3 * time.Millisecond),
+				"stddev": look.RTT(816496),
+			},
+			"icmp.jitter": look.RTT(1500 * time.Microsecond),
+		}),
+	)), e.Fields)
+}
+
+func TestICMPProbePacketLoss(t *testing.T) {
+	cfg := probeConfig(4)
+	cfg.MaxPacketLoss = 20
+	tl := mockLoop{probeRtts: []time.Duration{time.Millisecond, 0, time.Millisecond, time.Millisecond}}
+	e := execTestICMPCheckWithLoop(t, cfg, tl, nil)
+
+	testslike.Test(t, lookslike.Compose(
+		probeChecks("down"),
+		hbtest.ErrorChecks("packet loss of 25.0% exceeds 20.0%", "validate"),
+		lookslike.MustCompile(map[string]interface{}{"icmp.packet_loss": 25.0}),
+	), e.Fields)
+}
+
+func TestICMPTracerouteWhenDown(t *testing.T) {
+	trace := &traceResult{
+		protocol: traceUDP,
+		hops: []traceHop{
+			{ttl: 1, ip: net.ParseIP("10.0.0.1"), rtt: time.Millisecond},
+			{ttl: 2},
+		},
+	}
+
+	t.Run("down", func(t *testing.T) {
+		tr := &mockTracer{result: trace}
+		e := execTestICMPCheckWithLoop(t, probeConfig(2), mockLoop{probeRtts: []time.Duration{0, 0}}, tr)
+		require.Equal(t, 1, tr.calls)
+
+		testslike.Test(t, lookslike.Compose(
+			probeChecks("down"),
+			hbtest.ErrorChecks("ping timeout", "io"),
+			lookslike.MustCompile(map[string]interface{}{
+				"icmp.packet_loss":         100.0,
+				"icmp.traceroute.protocol": "udp",
+				"icmp.traceroute.reached":  false,
+				"icmp.traceroute.hops": []common.MapStr{
+					{"ttl": 1, "ip": "10.0.0.1", "rtt": look.RTT(time.Millisecond)},
+					{"ttl": 2},
+				},
+			}),
+		), e.Fields)
+	})
+
+	t.Run("up", func(t *testing.T) {
+		tr := &mockTracer{result: trace}
+		e := execTestICMPCheckWithLoop(t, probeConfig(2), mockLoop{probeRtts: []time.Duration{time.Millisecond, time.Millisecond}}, tr)
+		require.Equal(t, 0, tr.calls)
+		_, err := e.GetValue("icmp.traceroute")
+		require.Error(t, err)
+	})
+}
+
+func TestComputeStats(t *testing.T) {
+	stats := computeStats([]time.Duration{0, 0})
+	require.Equal(t, 2, stats.sent)
+	require.Equal(t, 0, stats.received)
+	require.Equal(t, 100.0, stats.packetLoss())
+
+	stats = computeStats([]time.Duration{10 * time.Millisecond})
+	require.Equal(t, probeStats{
+		sent: 1, received: 1,
+		min: 10 * time.Millisecond, max: 10 * time.Millisecond, avg: 10 * time.Millisecond,
+	}, stats)
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := map[string]common.MapStr{
+		"count below 1":         {"count": 0},
+		"requests over timeout": {"count": 10, "wait": "1s", "timeout": "5s"},
+		"packet loss over 100":  {"max_packet_loss": 101},
+		"traceroute protocol":   {"traceroute.protocol": "sctp"},
+		"traceroute max hops":   {"traceroute.max_hops": 0},
+		"traceroute total":      {"traceroute.total_timeout": "-1s"},
+	}
+
+	for name, overrides := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := common.MapStr{"hosts": "localhost"}
+			m.DeepUpdate(overrides)
+			c, err := common.NewConfigFrom(m)
+			require.NoError(t, err)
+
+			config := DefaultConfig
+			require.Error(t, c.Unpack(&config))
+		})
+	}
+}
+
+func probeConfig(count int) Config {
+	cfg := DefaultConfig
+	cfg.Hosts = []string{"127.0.0.1"}
+	cfg.Mode = monitors.IPSettings{IPv4: true, IPv6: false, Mode: monitors.PingAny}
+	cfg.Count = count
+	return cfg
+}
+
+func probeChecks(status string) validator.Validator {
+	return lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", status, "icmp"),
+		lookslike.MustCompile(map[string]interface{}{
+			"url.full":   "icmp://127.0.0.1",
+			"url.domain": "127.0.0.1",
+			"url.scheme": "icmp",
+		}),
+	)
+}
+
 func execTestICMPCheck(t *testing.T, cfg Config) (mockLoop, *beat.Event) {
 	tl := mockLoop{pingRtt: time.Microsecond * 1000, pingRequests: 1}
+	return tl, execTestICMPCheckWithLoop(t, cfg, tl, nil)
+}
+
+func execTestICMPCheckWithLoop(t *testing.T, cfg Config, tl mockLoop, tr tracer) *beat.Event {
 	jf, err := newJobFactory(cfg, monitors.NewStdResolver(), tl)
 	require.NoError(t, err)
+	jf.tracer = tr
 	p, err := jf.makePlugin()
 	require.Len(t, p.Jobs, 1)
 	require.Equal(t, 1, p.Endpoints)
@@ -72,13 +209,14 @@ func execTestICMPCheck(t *testing.T, cfg Config) (mockLoop, *beat.Event) {
 	sched, _ := schedule.Parse("@every 1s")
 	wrapped := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "icmp", Schedule: sched, Timeout: 1})
 	wrapped[0](e)
-	return tl, e
+	return e
 }
 
 type mockLoop struct {
 	pingRtt             time.Duration
 	pingRequests        int
 	pingErr             error
+	probeRtts           []time.Duration
 	checkNetworkModeErr error
 }
 
@@ -89,3 +227,17 @@ func (t mockLoop) checkNetworkMode(mode string) error {
 func (t mockLoop) ping(addr *net.IPAddr, timeout time.Duration, interval time.Duration) (time.Duration, int, error) {
 	return t.pingRtt, t.pingRequests, t.pingErr
 }
+
+func (t mockLoop) probe(addr *net.IPAddr, count int, timeout time.Duration, interval time.Duration) ([]time.Duration, error) {
+	return t.probeRtts, nil
+}
+
+type mockTracer struct {
+	result *traceResult
+	calls  int
+}
+
+func (t *mockTracer) trace(addr *net.IPAddr, config TracerouteConfig) (*traceResult, error) {
+	t.calls++
+	return t.result, nil
+}
@@ -29,4 +29,12 @@ type ICMPLoop interface {
 		timeout time.Duration,
 		interval time.Duration,
 	) (time.Duration, int, error)
+	// probe sends count echo requests, interval apart, returning the RTT of each
+	// request answered within timeout, indexed by request, zero if unanswered.
+	probe(
+		addr *net.IPAddr,
+		count int,
+		timeout time.Duration,
+		interval time.Duration,
+	) ([]time.Duration, error)
 }
@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package icmp
+
+import (
+	"math"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// probeStats summarizes the RTTs of a series of echo requests.
+type probeStats struct {
+	sent, received        int
+	min, max, avg, stddev time.Duration
+	jitter                time.Duration
+}
+
+// computeStats computes the stats of the given RTTs, indexed by request, zero
+// for requests that were not answered. Jitter is the mean difference between
+// the RTTs of consecutive replies.
+func computeStats(rtts []time.Duration) probeStats {
+	s := probeStats{sent: len(rtts)}
+
+	var sum, jitterSum float64
+	var prev time.Duration
+	for _, rtt := range rtts {
+		if rtt <= 0 {
+			continue
+		}
+		if s.received == 0 || rtt < s.min {
+			s.min = rtt
+		}
+		if rtt > s.max {
+			s.max = rtt
+		}
+		if s.received > 0 {
+			jitterSum += math.Abs(float64(rtt - prev))
+		}
+		prev = rtt
+		sum += float64(rtt)
+		s.received++
+	}
+	if s.received == 0 {
+		return s
+	}
+
+	avg := sum / float64(s.received)
+	var variance float64
+	for _, rtt := range rtts {
+		if rtt > 0 {
+			variance += math.Pow(float64(rtt)-avg, 2)
+		}
+	}
+	s.avg = time.Duration(avg)
+	s.stddev = time.Duration(math.Sqrt(variance / float64(s.received)))
+	if s.received > 1 {
+		s.jitter = time.Duration(jitterSum / float64(s.received-1))
+	}
+	return s
+}
+
+// packetLoss returns the percentage of requests that were not answered.
+func (s probeStats) packetLoss() float64 {
+	if s.sent == 0 {
+		return 0
+	}
+	return float64(s.sent-s.received) * 100 / float64(s.sent)
+}
+
+func (s probeStats) fields() common.MapStr {
+	fields := common.MapStr{
+		"requests":    s.sent,
+		"replies":     s.received,
+		"packet_loss": s.packetLoss(),
+	}
+	if s.received > 0 {
+		rtt := look.RTT(s.avg)
+		rtt["min"] = look.RTT(s.min)
+		rtt["max"] = look.RTT(s.max)
+		rtt["stddev"] = look.RTT(s.stddev)
+		fields["rtt"] = rtt
+		fields["jitter"] = look.RTT(s.jitter)
+	}
+	return fields
+}
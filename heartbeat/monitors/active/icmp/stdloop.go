@@ -324,6 +324,72 @@ func (l *stdICMPLoop) ping(
 	return rtt, requests, nil
 }
 
+func (l *stdICMPLoop) probe(
+	addr *net.IPAddr,
+	count int,
+	timeout time.Duration,
+	interval time.Duration,
+) ([]time.Duration, error) {
+	type reply struct {
+		idx int
+		rtt time.Duration
+	}
+
+	toTimer := time.NewTimer(timeout)
+	defer toTimer.Stop()
+
+	doneSignal := make(chan struct{})
+	defer close(doneSignal)
+
+	// buffered so that late replies never block
+	replies := make(chan reply, count)
+	awaitResponse := func(idx int, ctx *requestContext) {
+		select {
+		case <-doneSignal:
+			ctx.Stop()
+		case r := <-ctx.result:
+			replies <- reply{idx: idx, rtt: r.packet.ts.Sub(ctx.ts)}
+		}
+	}
+
+	rtts := make([]time.Duration, count)
+	sent, received := 0, 0
+	var sendTimer <-chan time.Time
+	sendNext := func() error {
+		ctx, err := l.sendEchoRequest(addr)
+		if err != nil {
+			return err
+		}
+		go awaitResponse(sent, ctx)
+		sent++
+		if sent < count {
+			sendTimer = time.After(interval)
+		} else {
+			sendTimer = nil
+		}
+		return nil
+	}
+
+	if err := sendNext(); err != nil {
+		return nil, err
+	}
+	for received < count {
+		select {
+		case <-toTimer.C:
+			// requests not answered yet are lost
+			return rtts, nil
+		case <-sendTimer:
+			if err := sendNext(); err != nil {
+				return nil, err
+			}
+		case r := <-replies:
+			rtts[r.idx] = r.rtt
+			received++
+		}
+	}
+	return rtts, nil
+}
+
 func (l *stdICMPLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error) {
 	var conn *icmp.PacketConn
 	var proto int
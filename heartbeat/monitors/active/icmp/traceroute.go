@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package icmp
+
+import (
+	"net"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// tracer traces the route to a host.
+type tracer interface {
+	trace(addr *net.IPAddr, config TracerouteConfig) (*traceResult, error)
+}
+
+// traceResult is the route traced to a host, ending either with the host or
+// after the maximum number of hops.
+type traceResult struct {
+	protocol string
+	hops     []traceHop
+	reached  bool
+}
+
+// traceHop is the response to a probe sent with a given TTL, the IP being nil if
+// no response was received in time.
+type traceHop struct {
+	ttl int
+	ip  net.IP
+	rtt time.Duration
+}
+
+func (r *traceResult) fields() common.MapStr {
+	hops := make([]common.MapStr, 0, len(r.hops))
+	for _, hop := range r.hops {
+		fields := common.MapStr{"ttl": hop.ttl}
+		if hop.ip != nil {
+			fields["ip"] = hop.ip.String()
+			fields["rtt"] = look.RTT(hop.rtt)
+		}
+		hops = append(hops, fields)
+	}
+	return common.MapStr{
+		"protocol": r.protocol,
+		"reached":  r.reached,
+		"hops":     hops,
+	}
+}
@@ -0,0 +1,358 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package icmp
+
+import (
+	"math/rand"
+	"net"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+)
+
+// stdTracer traces routes by sending probes with increasing TTLs, reading the ICMP
+// errors they trigger from the error queue of the probe sockets (IP_RECVERR). This
+// way intermediate hops are recorded without a raw socket, as long as ICMP probes
+// can use unprivileged ping sockets (see net.ipv4.ping_group_range) or UDP or TCP
+// probes are used.
+type stdTracer struct{}
+
+func newStdTracer() (tracer, error) {
+	return stdTracer{}, nil
+}
+
+// sockExtendedErrLen is the size of struct sock_extended_err, followed by the
+// address of the host that sent the ICMP error.
+const sockExtendedErrLen = 16
+
+// icmpError is an ICMP error received for a probe.
+type icmpError struct {
+	origin, typ, code uint8
+	offender          net.IP
+}
+
+func (e *icmpError) timeExceeded() bool {
+	if e.origin == unix.SO_EE_ORIGIN_ICMP6 {
+		return e.typ == uint8(ipv6.ICMPTypeTimeExceeded)
+	}
+	return e.typ == uint8(ipv4.ICMPTypeTimeExceeded)
+}
+
+func (stdTracer) trace(addr *net.IPAddr, config TracerouteConfig) (*traceResult, error) {
+	res := &traceResult{protocol: config.Protocol}
+	deadline := time.Now().Add(config.TotalTimeout)
+	for ttl := 1; ttl <= config.MaxHops && time.Now().Before(deadline); ttl++ {
+		hop, reached, stop, err := probeHop(addr, config, ttl, deadline)
+		if err != nil {
+			return res, err
+		}
+		res.hops = append(res.hops, hop)
+		if reached {
+			res.reached = true
+			return res, nil
+		}
+		if stop {
+			return res, nil
+		}
+	}
+	return res, nil
+}
+
+// probeHop sends a single probe with the given TTL. The host reporting the expired TTL
+// is the hop, unless the probe reached addr. Tracing stops on other ICMP errors, such
+// as routers reporting the host as unreachable. Waiting for the answer ends at the
+// deadline of the whole trace at the latest.
+func probeHop(addr *net.IPAddr, config TracerouteConfig, ttl int, traceDeadline time.Time) (hop traceHop, reached, stop bool, err error) {
+	hop.ttl = ttl
+	v4 := addr.IP.To4() != nil
+
+	fd, raw, err := openProbeSocket(config.Protocol, v4)
+	if err != nil {
+		return hop, false, false, err
+	}
+	defer unix.Close(fd)
+
+	if v4 {
+		err = unix.SetsockoptInt(fd, unix.IPPROTO_IP, unix.IP_TTL, ttl)
+		if err == nil {
+			err = unix.SetsockoptInt(fd, unix.IPPROTO_IP, unix.IP_RECVERR, 1)
+		}
+	} else {
+		err = unix.SetsockoptInt(fd, unix.IPPROTO_IPV6, unix.IPV6_UNICAST_HOPS, ttl)
+		if err == nil {
+			err = unix.SetsockoptInt(fd, unix.IPPROTO_IPV6, unix.IPV6_RECVERR, 1)
+		}
+	}
+	if err != nil {
+		return hop, false, false, err
+	}
+
+	port := config.port()
+	if config.Protocol == traceUDP {
+		// Each hop is probed on its own port, as traceroute does
+		port += ttl - 1
+	}
+	sa := sockaddr(addr.IP, port, v4)
+
+	echoID := rand.Intn(0xffff)
+	start := time.Now()
+	switch config.Protocol {
+	case traceICMP:
+		err = unix.Sendto(fd, echoRequest(echoID, ttl, v4), 0, sa)
+	case traceUDP:
+		err = unix.Sendto(fd, make([]byte, 32), 0, sa)
+	case traceTCP:
+		err = unix.Connect(fd, sa)
+		if err == unix.EINPROGRESS {
+			err = nil
+		}
+	}
+	if err != nil {
+		return hop, false, false, err
+	}
+
+	events := int16(unix.POLLIN)
+	if config.Protocol == traceTCP {
+		events = unix.POLLOUT
+	}
+	deadline := start.Add(config.Timeout)
+	if traceDeadline.Before(deadline) {
+		deadline = traceDeadline
+	}
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return hop, false, false, nil
+		}
+
+		fds := []unix.PollFd{{Fd: int32(fd), Events: events}}
+		n, err := unix.Poll(fds, int(remaining/time.Millisecond)+1)
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return hop, false, false, err
+		}
+		if n == 0 {
+			continue
+		}
+		revents := fds[0].Revents
+
+		if revents&unix.POLLERR != 0 {
+			icmpErr, err := readICMPError(fd)
+			if err != nil {
+				return hop, false, false, err
+			}
+			if icmpErr != nil {
+				hop.ip, hop.rtt = icmpErr.offender, time.Since(start)
+				if icmpErr.timeExceeded() {
+					return hop, false, false, nil
+				}
+				// Errors sent by the host itself, like port unreachable for
+				// udp probes, mean it was reached
+				return hop, icmpErr.offender.Equal(addr.IP), true, nil
+			}
+		}
+
+		switch config.Protocol {
+		case traceTCP:
+			if revents&(unix.POLLOUT|unix.POLLERR|unix.POLLHUP) == 0 {
+				continue
+			}
+			// Both accepted and refused connections come from the host
+			soErr, err := unix.GetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_ERROR)
+			if err != nil {
+				return hop, false, false, err
+			}
+			if soErr == 0 || unix.Errno(soErr) == unix.ECONNREFUSED {
+				hop.ip, hop.rtt = addr.IP, time.Since(start)
+				return hop, true, true, nil
+			}
+			return hop, false, true, nil
+
+		default:
+			if revents&unix.POLLIN == 0 {
+				continue
+			}
+			from, ok := readReply(fd, config.Protocol, raw, v4, echoID)
+			if ok && from.Equal(addr.IP) {
+				hop.ip, hop.rtt = from, time.Since(start)
+				return hop, true, true, nil
+			}
+		}
+	}
+}
+
+// openProbeSocket opens a non-blocking socket for the probes. ICMP probes use
+// unprivileged ping sockets when allowed, raw sockets otherwise.
+func openProbeSocket(protocol string, v4 bool) (fd int, raw bool, err error) {
+	family := unix.AF_INET6
+	if v4 {
+		family = unix.AF_INET
+	}
+	flags := unix.SOCK_NONBLOCK | unix.SOCK_CLOEXEC
+
+	switch protocol {
+	case traceUDP:
+		fd, err = unix.Socket(family, unix.SOCK_DGRAM|flags, unix.IPPROTO_UDP)
+	case traceTCP:
+		fd, err = unix.Socket(family, unix.SOCK_STREAM|flags, unix.IPPROTO_TCP)
+	default:
+		proto := unix.IPPROTO_ICMPV6
+		if v4 {
+			proto = unix.IPPROTO_ICMP
+		}
+		fd, err = unix.Socket(family, unix.SOCK_DGRAM|flags, proto)
+		if err != nil {
+			fd, err = unix.Socket(family, unix.SOCK_RAW|flags, proto)
+			if err != nil {
+				return -1, false, noPingCapabilityError("Traceroute requires unprivileged ping sockets or cap_net_raw.")
+			}
+			raw = true
+		}
+	}
+	return fd, raw, err
+}
+
+func sockaddr(ip net.IP, port int, v4 bool) unix.Sockaddr {
+	if v4 {
+		sa := &unix.SockaddrInet4{Port: port}
+		copy(sa.Addr[:], ip.To4())
+		return sa
+	}
+	sa := &unix.SockaddrInet6{Port: port}
+	copy(sa.Addr[:], ip.To16())
+	return sa
+}
+
+func echoRequest(id, seq int, v4 bool) []byte {
+	var typ icmp.Type = ipv6.ICMPTypeEchoRequest
+	if v4 {
+		typ = ipv4.ICMPTypeEcho
+	}
+	msg := &icmp.Message{
+		Type: typ,
+		Body: &icmp.Echo{ID: id, Seq: seq, Data: make([]byte, 32)},
+	}
+	// The checksum of ICMPv6 messages is computed by the kernel
+	encoded, _ := msg.Marshal(nil)
+	return encoded
+}
+
+// readICMPError reads an ICMP error from the error queue of the socket, returning nil
+// if the queue only held local errors.
+func readICMPError(fd int) (*icmpError, error) {
+	buf := make([]byte, 512)
+	oob := make([]byte, 512)
+	for {
+		_, oobn, _, _, err := unix.Recvmsg(fd, buf, oob, unix.MSG_ERRQUEUE)
+		if err == unix.EAGAIN {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		msgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range msgs {
+			if icmpErr := parseICMPError(msg); icmpErr != nil {
+				return icmpErr, nil
+			}
+		}
+	}
+}
+
+func parseICMPError(msg unix.SocketControlMessage) *icmpError {
+	isV4 := msg.Header.Level == unix.IPPROTO_IP && msg.Header.Type == unix.IP_RECVERR
+	isV6 := msg.Header.Level == unix.IPPROTO_IPV6 && msg.Header.Type == unix.IPV6_RECVERR
+	if !isV4 && !isV6 || len(msg.Data) < sockExtendedErrLen {
+		return nil
+	}
+
+	e := &icmpError{origin: msg.Data[4], typ: msg.Data[5], code: msg.Data[6]}
+	if e.origin != unix.SO_EE_ORIGIN_ICMP && e.origin != unix.SO_EE_ORIGIN_ICMP6 {
+		return nil
+	}
+
+	// The offender is a sockaddr_in or sockaddr_in6
+	offender := msg.Data[sockExtendedErrLen:]
+	switch {
+	case e.origin == unix.SO_EE_ORIGIN_ICMP && len(offender) >= 8:
+		e.offender = net.IP(append([]byte(nil), offender[4:8]...))
+	case e.origin == unix.SO_EE_ORIGIN_ICMP6 && len(offender) >= 24:
+		e.offender = net.IP(append([]byte(nil), offender[8:24]...))
+	default:
+		return nil
+	}
+	return e
+}
+
+// readReply reads a response to a probe, returning its sender if it is an echo reply
+// to the probe or any udp response.
+func readReply(fd int, protocol string, raw, v4 bool, echoID int) (net.IP, bool) {
+	buf := make([]byte, 1500)
+	n, from, err := unix.Recvfrom(fd, buf, 0)
+	if err != nil {
+		return nil, false
+	}
+
+	var ip net.IP
+	switch sa := from.(type) {
+	case *unix.SockaddrInet4:
+		ip = net.IP(sa.Addr[:]).To16()
+	case *unix.SockaddrInet6:
+		ip = net.IP(sa.Addr[:])
+	default:
+		return nil, false
+	}
+
+	if protocol == traceUDP {
+		return ip, true
+	}
+
+	data := buf[:n]
+	proto := protocolIPv6ICMP
+	if v4 {
+		proto = protocolICMP
+		// Raw IPv4 sockets receive the IP header too
+		if raw && len(data) > 0 {
+			hdrLen := int(data[0]&0x0f) * 4
+			if hdrLen > len(data) {
+				return nil, false
+			}
+			data = data[hdrLen:]
+		}
+	}
+	m, err := icmp.ParseMessage(proto, data)
+	if err != nil || (m.Type != ipv4.ICMPTypeEchoReply && m.Type != ipv6.ICMPTypeEchoReply) {
+		return nil, false
+	}
+	// Ping sockets only receive their own replies, with the ID set by the kernel
+	if echo, ok := m.Body.(*icmp.Echo); !ok || (raw && echo.ID != echoID) {
+		return nil, false
+	}
+	return ip, true
+}
@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package icmp
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracerouteLoopback(t *testing.T) {
+	// closed ports, so that udp probes are rejected and tcp probes are reset
+	udp, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	udpPort := udp.LocalAddr().(*net.UDPAddr).Port
+	udp.Close()
+
+	tcp, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	tcpPort := tcp.Addr().(*net.TCPAddr).Port
+	tcp.Close()
+
+	tests := []struct {
+		protocol string
+		port     int
+	}{
+		{traceICMP, 0},
+		{traceUDP, udpPort},
+		{traceTCP, tcpPort},
+	}
+
+	tr, err := newStdTracer()
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.protocol, func(t *testing.T) {
+			config := DefaultConfig.Traceroute
+			config.Enabled = true
+			config.Protocol = test.protocol
+			config.Port = uint16(test.port)
+			config.MaxHops = 3
+
+			res, err := tr.trace(&net.IPAddr{IP: net.ParseIP("127.0.0.1")}, config)
+			if err != nil && strings.Contains(err.Error(), "Insufficient privileges") {
+				t.Skip(err)
+			}
+			require.NoError(t, err)
+
+			require.True(t, res.reached)
+			require.Equal(t, test.protocol, res.protocol)
+			require.Len(t, res.hops, 1)
+			require.Equal(t, 1, res.hops[0].ttl)
+			require.True(t, res.hops[0].ip.Equal(net.ParseIP("127.0.0.1")))
+			require.True(t, res.hops[0].rtt > 0 && res.hops[0].rtt < time.Second)
+		})
+	}
+}
+
+func TestTracerouteTotalTimeout(t *testing.T) {
+	// an open port that never answers, so that every probe times out
+	udp, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer udp.Close()
+
+	tr, err := newStdTracer()
+	require.NoError(t, err)
+
+	config := DefaultConfig.Traceroute
+	config.Enabled = true
+	config.Protocol = traceUDP
+	config.Port = uint16(udp.LocalAddr().(*net.UDPAddr).Port)
+	config.MaxHops = 1
+	config.Timeout = 10 * time.Second
+	config.TotalTimeout = 50 * time.Millisecond
+
+	start := time.Now()
+	res, err := tr.trace(&net.IPAddr{IP: net.ParseIP("127.0.0.1")}, config)
+	require.NoError(t, err)
+	require.False(t, res.reached)
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/libbeat/common/transport"
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
 )
@@ -43,6 +44,9 @@ type config struct {
 	// validate connection
 	SendString    string `config:"check.send"`
 	ReceiveString string `config:"check.receive"`
+
+	// validate established TLS connections
+	TLSCheck tlscheck.Config `config:"check.tls"`
 }
 
 func defaultConfig() config {
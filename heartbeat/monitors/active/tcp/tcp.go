@@ -18,6 +18,7 @@
 package tcp
 
 import (
+	"crypto/tls"
 	"crypto/x509"
 	"net"
 	"net/url"
@@ -27,7 +28,9 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/look"
 	"github.com/elastic/beats/v7/heartbeat/monitors"
 	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/endpoint"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
@@ -77,8 +80,9 @@ type jobFactory struct {
 	config        config
 	tlsConfig     *tlscommon.TLSConfig
 	defaultScheme string
-	endpoints     []endpoint
+	endpoints     []endpoint.Endpoint
 	dataCheck     dataCheck
+	tlsChecker    *tlscheck.Checker
 	resolver      monitors.Resolver
 }
 
@@ -109,21 +113,26 @@ func (jf *jobFactory) loadConfig(commonCfg *common.Config) error {
 		jf.defaultScheme = "ssl"
 	}
 
-	jf.endpoints, err = makeEndpoints(jf.config.Hosts, jf.config.Ports, jf.defaultScheme)
+	jf.endpoints, err = endpoint.MakeEndpoints(jf.config.Hosts, jf.config.Ports, jf.defaultScheme, "tcp", "plain", "tls", "ssl")
 	if err != nil {
 		return err
 	}
 
 	jf.dataCheck = makeDataCheck(&jf.config)
 
+	jf.tlsChecker, err = tlscheck.NewChecker(jf.config.TLSCheck)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // makeJobs returns the actual schedulable jobs for this monitor.
 func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
 	var jobs []jobs.Job
-	for _, endpoint := range jf.endpoints {
-		for _, url := range endpoint.perPortURLs() {
+	for _, ep := range jf.endpoints {
+		for _, url := range ep.PerPortURLs() {
 			endpointJob, err := jf.makeEndpointJob(url)
 			if err != nil {
 				return nil, err
@@ -234,9 +243,17 @@ func (jf *jobFactory) execDialer(
 		return reason.IOFailed(err)
 	}
 	defer conn.Close()
+
+	// TLS check failures are only reported if the data check succeeds
+	var tlsReason reason.Reason
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		state := tlsConn.ConnectionState()
+		tlsReason = jf.tlsChecker.Check(&state)
+	}
+
 	if jf.dataCheck == nil {
 		// no additional validation step => ping success
-		return nil
+		return tlsReason
 	}
 
 	if err := conn.SetDeadline(deadline); err != nil {
@@ -263,5 +280,5 @@ func (jf *jobFactory) execDialer(
 		return reason.MakeValidateError(err)
 	}
 
-	return nil
+	return tlsReason
 }
@@ -29,6 +29,7 @@ import (
 	"runtime"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
@@ -148,6 +149,64 @@ func TestTLSExpiredCert(t *testing.T) {
 	)
 }
 
+func TestTLSChecks(t *testing.T) {
+	ip, port, cert, certFile, teardown := setupTLSTestServer(t)
+	defer teardown()
+
+	// The certificate of the test server expires in 2084
+	daysLeft := int(time.Until(cert.NotAfter).Hours() / 24)
+
+	t.Run("passing", func(t *testing.T) {
+		event := testTLSTCPCheckWithConfig(t, ip, port, certFile.Name(), monitors.NewStdResolver(), common.MapStr{
+			"check.tls": common.MapStr{
+				"expiry.warn":          daysLeft - 1,
+				"protocol.min_version": "TLSv1.2",
+			},
+		})
+		testslike.Test(
+			t,
+			lookslike.Compose(
+				hbtest.BaseChecks(ip, "up", "tcp"),
+				hbtest.SummaryChecks(1, 0),
+			),
+			event.Fields,
+		)
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		event := testTLSTCPCheckWithConfig(t, ip, port, certFile.Name(), monitors.NewStdResolver(), common.MapStr{
+			"check.tls.expiry.warn": daysLeft + 1,
+		})
+		testslike.Test(
+			t,
+			lookslike.Strict(lookslike.Compose(
+				hbtest.TLSChecks(0, 0, cert),
+				hbtest.RespondingTCPChecks(),
+				hbtest.BaseChecks(ip, "warn", "tcp"),
+				hbtest.SummaryWarnChecks(1, 0, 1),
+				hbtest.SimpleURLChecks(t, "ssl", ip, port),
+				hbtest.ErrorChecks("certificate 'O=Acme Co' expires in", "validate"),
+			)),
+			event.Fields,
+		)
+	})
+
+	t.Run("down", func(t *testing.T) {
+		event := testTLSTCPCheckWithConfig(t, ip, port, certFile.Name(), monitors.NewStdResolver(), common.MapStr{
+			"check.tls.pinned.spki_sha256": []string{"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		})
+		testslike.Test(
+			t,
+			lookslike.Compose(
+				hbtest.BaseChecks(ip, "down", "tcp"),
+				hbtest.SummaryChecks(0, 1),
+				hbtest.ErrorChecks("no certificate of the chain matches the pinned public keys", "validate"),
+			),
+			event.Fields,
+		)
+	})
+}
+
 func setupTLSTestServer(t *testing.T) (ip string, port uint16, cert *x509.Certificate, certFile *os.File, teardown func()) {
 	// Start up a TLS Server
 	server, port, err := setupServer(t, func(handler http.Handler) (*httptest.Server, error) {
@@ -181,12 +240,19 @@ func setupTLSTestServer(t *testing.T) (ip string, port uint16, cert *x509.Certif
 }
 
 func testTLSTCPCheck(t *testing.T, host string, port uint16, certFileName string, resolver monitors.Resolver) *beat.Event {
-	config, err := common.NewConfigFrom(common.MapStr{
+	return testTLSTCPCheckWithConfig(t, host, port, certFileName, resolver, nil)
+}
+
+func testTLSTCPCheckWithConfig(t *testing.T, host string, port uint16, certFileName string, resolver monitors.Resolver, extraConfig common.MapStr) *beat.Event {
+	configSrc := common.MapStr{
 		"hosts":   host,
 		"ports":   int64(port),
 		"ssl":     common.MapStr{"certificate_authorities": certFileName},
 		"timeout": "1s",
-	})
+	}
+	configSrc.DeepUpdate(extraConfig)
+
+	config, err := common.NewConfigFrom(configSrc)
 	require.NoError(t, err)
 
 	p, err := createWithResolver(config, resolver)
@@ -0,0 +1,75 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+)
+
+// responseCheck validates a response received from the monitored service.
+type responseCheck func(response []byte) error
+
+// makeResponseCheck returns a check validating responses against all configured
+// receive options, a nil check accepts any response.
+func makeResponseCheck(config receiveConfig) responseCheck {
+	var checks []responseCheck
+
+	if config.String != "" {
+		expected := []byte(config.String)
+		checks = append(checks, func(response []byte) error {
+			if !bytes.Contains(response, expected) {
+				return fmt.Errorf("response doesn't contain '%s'", config.String)
+			}
+			return nil
+		})
+	}
+
+	if config.Regex != nil {
+		regex := config.Regex
+		checks = append(checks, func(response []byte) error {
+			if !regex.Match(response) {
+				return fmt.Errorf("response doesn't match regex '%s'", regex.String())
+			}
+			return nil
+		})
+	}
+
+	if config.HexPrefix != "" {
+		prefix, _ := hex.DecodeString(config.HexPrefix)
+		checks = append(checks, func(response []byte) error {
+			if !bytes.HasPrefix(response, prefix) {
+				return fmt.Errorf("response doesn't start with hex '%s'", config.HexPrefix)
+			}
+			return nil
+		})
+	}
+
+	if len(checks) == 0 {
+		return nil
+	}
+	return func(response []byte) error {
+		for _, check := range checks {
+			if err := check(response); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
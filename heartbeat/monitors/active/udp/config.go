@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/libbeat/common/match"
+)
+
+type config struct {
+	// check all ports if host does not contain port
+	Hosts []string `config:"hosts" validate:"required"`
+	Ports []uint16 `config:"ports"`
+
+	Mode monitors.IPSettings `config:",inline"`
+
+	Timeout time.Duration `config:"timeout"`
+
+	// validate service
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	// built-in probe to send instead of a payload, one of ntp or snmp
+	Probe string     `config:"probe"`
+	SNMP  snmpConfig `config:"snmp"`
+
+	// payload to send, as a string or hex encoded
+	Send    string `config:"send"`
+	SendHex string `config:"send_hex"`
+
+	Receive receiveConfig `config:"receive"`
+
+	// wait for a response, if disabled the monitor is only down when the
+	// host reports the port as unreachable
+	ExpectResponse bool `config:"expect_response"`
+}
+
+type receiveConfig struct {
+	String    string         `config:"string"`
+	Regex     *match.Matcher `config:"regex"`
+	HexPrefix string         `config:"hex_prefix"`
+}
+
+type snmpConfig struct {
+	Community string `config:"community"`
+}
+
+const (
+	probeNTP  = "ntp"
+	probeSNMP = "snmp"
+)
+
+func defaultConfig() config {
+	return config{
+		Timeout: 16 * time.Second,
+		Mode:    monitors.DefaultIPSettings,
+		Check: checkConfig{
+			SNMP:           snmpConfig{Community: "public"},
+			ExpectResponse: true,
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	check := &c.Check
+	if check.Send != "" && check.SendHex != "" {
+		return errors.New("check.send and check.send_hex can't be used together")
+	}
+	if _, err := hex.DecodeString(check.SendHex); err != nil {
+		return fmt.Errorf("invalid check.send_hex: %w", err)
+	}
+	if _, err := hex.DecodeString(check.Receive.HexPrefix); err != nil {
+		return fmt.Errorf("invalid check.receive.hex_prefix: %w", err)
+	}
+
+	hasPayload := check.Send != "" || check.SendHex != ""
+	hasReceive := check.Receive.String != "" || check.Receive.Regex != nil || check.Receive.HexPrefix != ""
+	switch strings.ToLower(check.Probe) {
+	case "":
+		if !hasPayload {
+			return errors.New("one of check.send, check.send_hex or check.probe is required")
+		}
+	case probeNTP, probeSNMP:
+		if hasPayload || hasReceive {
+			return fmt.Errorf("check.probe '%s' can't be combined with check.send or check.receive options", check.Probe)
+		}
+		if !check.ExpectResponse {
+			return fmt.Errorf("check.probe '%s' requires a response", check.Probe)
+		}
+	default:
+		return fmt.Errorf("unsupported check.probe '%s', supported probes are ntp and snmp", check.Probe)
+	}
+
+	if hasReceive && !check.ExpectResponse {
+		return errors.New("check.receive options require check.expect_response")
+	}
+
+	return nil
+}
+
+// payload returns the payload to send.
+func (c *checkConfig) payload() []byte {
+	if c.SendHex != "" {
+		b, _ := hex.DecodeString(c.SendHex)
+		return b
+	}
+	return []byte(c.Send)
+}
@@ -0,0 +1,258 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// probe implements the requests and responses of a built-in probe. A new probe
+// is created for every check.
+type probe interface {
+	// Request returns the request to send at the given time.
+	Request(sent time.Time) ([]byte, error)
+	// Parse validates the response received at the given time, returning the
+	// fields describing it.
+	Parse(response []byte, received time.Time) (common.MapStr, error)
+}
+
+func makeProbeFactory(config checkConfig) func() probe {
+	switch strings.ToLower(config.Probe) {
+	case probeNTP:
+		return func() probe { return &ntpProbe{} }
+	case probeSNMP:
+		return func() probe { return &snmpProbe{community: config.SNMP.Community} }
+	default:
+		return nil
+	}
+}
+
+const (
+	ntpPacketSize = 48
+	// seconds between the NTP epoch, 1900, and the unix epoch
+	ntpEpochOffset = 2208988800
+
+	ntpModeClient = 3
+	ntpModeServer = 4
+	ntpVersion    = 4
+	ntpLeapAlarm  = 3
+)
+
+// ntpProbe queries the time of an NTP server, as an SNTP client (RFC 4330).
+type ntpProbe struct {
+	sent     time.Time
+	transmit []byte
+}
+
+func (p *ntpProbe) Request(sent time.Time) ([]byte, error) {
+	p.sent = sent
+	req := make([]byte, ntpPacketSize)
+	req[0] = ntpVersion<<3 | ntpModeClient
+	binary.BigEndian.PutUint64(req[40:], toNTPTime(sent))
+	p.transmit = req[40:48]
+	return req, nil
+}
+
+func (p *ntpProbe) Parse(response []byte, received time.Time) (common.MapStr, error) {
+	if len(response) < ntpPacketSize {
+		return nil, fmt.Errorf("invalid NTP response of %d bytes", len(response))
+	}
+	if mode := response[0] & 0x7; mode != ntpModeServer {
+		return nil, fmt.Errorf("invalid NTP response mode %d", mode)
+	}
+	if !bytes.Equal(response[24:32], p.transmit) {
+		return nil, errors.New("NTP response doesn't match the request")
+	}
+
+	stratum := response[1]
+	refID := response[12:16]
+	if stratum == 0 {
+		return nil, fmt.Errorf("NTP server sent kiss code '%s'", strings.TrimRight(string(refID), "\x00"))
+	}
+
+	// Offset between the clocks of the server and heartbeat, see RFC 4330 section 5
+	t2 := fromNTPTime(binary.BigEndian.Uint64(response[32:]))
+	t3 := fromNTPTime(binary.BigEndian.Uint64(response[40:]))
+	offset := (t2.Sub(p.sent) + t3.Sub(received)) / 2
+
+	fields := common.MapStr{
+		"ntp": common.MapStr{
+			"stratum":      stratum,
+			"reference_id": ntpReferenceID(stratum, refID),
+			"offset":       common.MapStr{"us": offset.Microseconds()},
+		},
+	}
+
+	if response[0]>>6 == ntpLeapAlarm {
+		return fields, errors.New("NTP server clock is not synchronized")
+	}
+	return fields, nil
+}
+
+// ntpReferenceID returns the reference ID of the server, which is an ASCII
+// code of the reference clock for primary servers, or the address of the
+// upstream server otherwise.
+func ntpReferenceID(stratum uint8, refID []byte) string {
+	if stratum == 1 {
+		return strings.TrimRight(string(refID), "\x00")
+	}
+	return net.IP(refID).String()
+}
+
+func toNTPTime(t time.Time) uint64 {
+	secs := uint64(t.Unix() + ntpEpochOffset)
+	frac := (uint64(t.Nanosecond()) << 32) / uint64(time.Second)
+	return secs<<32 | frac
+}
+
+func fromNTPTime(ts uint64) time.Time {
+	secs := int64(ts>>32) - ntpEpochOffset
+	nanos := ((ts & 0xffffffff) * uint64(time.Second)) >> 32
+	return time.Unix(secs, int64(nanos))
+}
+
+const (
+	snmpVersion2c   = 1
+	snmpGetRequest  = 0
+	snmpGetResponse = 2
+)
+
+// sysDescrOID is the OID of the textual description of an SNMP agent.
+var sysDescrOID = asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}
+
+type snmpMessage struct {
+	Version   int
+	Community []byte
+	PDU       asn1.RawValue
+}
+
+type snmpPDU struct {
+	RequestID   int32
+	ErrorStatus int
+	ErrorIndex  int
+	VarBinds    []snmpVarBind
+}
+
+type snmpVarBind struct {
+	Name  asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+// snmpProbe gets the sysDescr of an SNMP agent, using SNMPv2c.
+type snmpProbe struct {
+	community string
+	requestID int32
+}
+
+func (p *snmpProbe) Request(_ time.Time) ([]byte, error) {
+	p.requestID = rand.Int31()
+	pdu, err := marshalSNMPPDU(snmpGetRequest, snmpPDU{
+		RequestID: p.requestID,
+		VarBinds:  []snmpVarBind{{Name: sysDescrOID, Value: asn1.NullRawValue}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(snmpMessage{
+		Version:   snmpVersion2c,
+		Community: []byte(p.community),
+		PDU:       pdu,
+	})
+}
+
+func (p *snmpProbe) Parse(response []byte, _ time.Time) (common.MapStr, error) {
+	var msg snmpMessage
+	if _, err := asn1.Unmarshal(response, &msg); err != nil {
+		return nil, fmt.Errorf("invalid SNMP response: %w", err)
+	}
+	if msg.PDU.Class != asn1.ClassContextSpecific || msg.PDU.Tag != snmpGetResponse {
+		return nil, fmt.Errorf("invalid SNMP response PDU type %d", msg.PDU.Tag)
+	}
+
+	pdu, err := unmarshalSNMPPDU(msg.PDU)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SNMP response: %w", err)
+	}
+	if pdu.RequestID != p.requestID {
+		return nil, errors.New("SNMP response doesn't match the request")
+	}
+	if pdu.ErrorStatus != 0 {
+		return nil, fmt.Errorf("SNMP agent returned error status %d", pdu.ErrorStatus)
+	}
+	if len(pdu.VarBinds) != 1 || !pdu.VarBinds[0].Name.Equal(sysDescrOID) {
+		return nil, errors.New("SNMP response doesn't contain sysDescr")
+	}
+
+	value := pdu.VarBinds[0].Value
+	if value.Class != asn1.ClassUniversal || value.Tag != asn1.TagOctetString {
+		return nil, errors.New("SNMP agent has no sysDescr")
+	}
+
+	return common.MapStr{
+		"snmp": common.MapStr{
+			"sys_descr": string(value.Bytes),
+		},
+	}, nil
+}
+
+// marshalSNMPPDU encodes the PDU as a context specific value, the tag being the PDU type.
+func marshalSNMPPDU(pduType int, pdu snmpPDU) (asn1.RawValue, error) {
+	b, err := asn1.Marshal(pdu)
+	if err != nil {
+		return asn1.RawValue{}, err
+	}
+
+	// Reuse the content of the marshalled sequence
+	var seq asn1.RawValue
+	if _, err := asn1.Unmarshal(b, &seq); err != nil {
+		return asn1.RawValue{}, err
+	}
+	return asn1.RawValue{
+		Class:      asn1.ClassContextSpecific,
+		Tag:        pduType,
+		IsCompound: true,
+		Bytes:      seq.Bytes,
+	}, nil
+}
+
+// unmarshalSNMPPDU decodes a PDU encoded as a context specific value.
+func unmarshalSNMPPDU(raw asn1.RawValue) (snmpPDU, error) {
+	var pdu snmpPDU
+	b, err := asn1.Marshal(asn1.RawValue{
+		Class:      asn1.ClassUniversal,
+		Tag:        asn1.TagSequence,
+		IsCompound: true,
+		Bytes:      raw.Bytes,
+	})
+	if err != nil {
+		return pdu, err
+	}
+	_, err = asn1.Unmarshal(b, &pdu)
+	return pdu, err
+}
@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/endpoint"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	plugin.Register("udp", create, "synthetics/udp")
+}
+
+var debugf = logp.MakeDebug("udp")
+
+// maxDatagramSize is the largest response that can be received.
+const maxDatagramSize = 65535
+
+func create(
+	name string,
+	cfg *common.Config,
+) (p plugin.Plugin, err error) {
+	return createWithResolver(cfg, monitors.NewStdResolver())
+}
+
+// Custom resolver is useful for tests against hostnames locally where we don't want to depend on any
+// hostnames existing in test environments
+func createWithResolver(
+	cfg *common.Config,
+	resolver monitors.Resolver,
+) (p plugin.Plugin, err error) {
+	jf, err := newJobFactory(cfg, resolver)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	js, err := jf.makeJobs()
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(js)}, nil
+}
+
+// jobFactory creates the jobs sending the configured payload or probe to every endpoint.
+type jobFactory struct {
+	config        config
+	endpoints     []endpoint.Endpoint
+	payload       []byte
+	newProbe      func() probe
+	responseCheck responseCheck
+	resolver      monitors.Resolver
+}
+
+func newJobFactory(commonCfg *common.Config, resolver monitors.Resolver) (*jobFactory, error) {
+	jf := &jobFactory{config: defaultConfig(), resolver: resolver}
+	if err := jf.loadConfig(commonCfg); err != nil {
+		return nil, err
+	}
+	return jf, nil
+}
+
+// loadConfig parses the YAML config and populates the jobFactory fields.
+func (jf *jobFactory) loadConfig(commonCfg *common.Config) error {
+	var err error
+	if err = commonCfg.Unpack(&jf.config); err != nil {
+		return err
+	}
+
+	jf.endpoints, err = endpoint.MakeEndpoints(jf.config.Hosts, jf.config.Ports, "udp", "udp")
+	if err != nil {
+		return err
+	}
+
+	jf.payload = jf.config.Check.payload()
+	jf.newProbe = makeProbeFactory(jf.config.Check)
+	jf.responseCheck = makeResponseCheck(jf.config.Check.Receive)
+
+	return nil
+}
+
+// makeJobs returns the actual schedulable jobs for this monitor.
+func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
+	var js []jobs.Job
+	for _, ep := range jf.endpoints {
+		for _, u := range ep.PerPortURLs() {
+			endpointJob, err := jf.makeEndpointJob(u)
+			if err != nil {
+				return nil, err
+			}
+			js = append(js, wrappers.WithURLField(u, endpointJob))
+		}
+	}
+	return js, nil
+}
+
+// makeEndpointJob makes a job that resolves the IPs of the endpoint, creating
+// one continuation per IP checked.
+func (jf *jobFactory) makeEndpointJob(endpointURL *url.URL) (jobs.Job, error) {
+	return monitors.MakeByHostJob(
+		endpointURL.Hostname(),
+		jf.config.Mode,
+		jf.resolver,
+		monitors.MakePingIPFactory(
+			func(event *beat.Event, ip *net.IPAddr) error {
+				return jf.check(event, net.JoinHostPort(ip.String(), endpointURL.Port()))
+			}))
+}
+
+// check sends the request to the given address and validates the response.
+func (jf *jobFactory) check(event *beat.Event, addr string) error {
+	conn, err := net.DialTimeout("udp", addr, jf.config.Timeout)
+	if err != nil {
+		debugf("dial failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+	defer conn.Close()
+
+	start := time.Now()
+	if err := conn.SetDeadline(start.Add(jf.config.Timeout)); err != nil {
+		debugf("setting connection deadline failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+
+	request := jf.payload
+	var p probe
+	if jf.newProbe != nil {
+		p = jf.newProbe()
+		if request, err = p.Request(start); err != nil {
+			return reason.IOFailed(err)
+		}
+	}
+
+	if _, err := conn.Write(request); err != nil {
+		debugf("send failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+
+	buf := make([]byte, maxDatagramSize)
+	n, err := conn.Read(buf)
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		if !jf.config.Check.ExpectResponse {
+			// The port wasn't reported unreachable
+			return nil
+		}
+		return reason.IOFailed(fmt.Errorf("no response received within %v", jf.config.Timeout))
+	}
+	if err != nil {
+		debugf("receive failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+
+	received := time.Now()
+	eventext.MergeEventFields(event, common.MapStr{
+		"udp": common.MapStr{
+			"rtt": look.RTT(received.Sub(start)),
+		},
+	})
+
+	response := buf[:n]
+	if p != nil {
+		fields, err := p.Parse(response, received)
+		if fields != nil {
+			eventext.MergeEventFields(event, common.MapStr{"udp": fields})
+		}
+		return reason.ValidateFailed(err)
+	}
+	if jf.responseCheck != nil {
+		return reason.ValidateFailed(jf.responseCheck(response))
+	}
+	return nil
+}
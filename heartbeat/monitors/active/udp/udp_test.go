@@ -0,0 +1,334 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"encoding/asn1"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+// startServer starts a UDP server answering requests with the given handler,
+// a nil response being ignored.
+func startServer(t *testing.T, handler func(request []byte) []byte) uint16 {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if response := handler(buf[:n]); response != nil {
+				conn.WriteTo(response, addr)
+			}
+		}
+	}()
+
+	return uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+}
+
+// closedPort returns a port nothing listens on.
+func closedPort(t *testing.T) uint16 {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+	require.NoError(t, conn.Close())
+	return port
+}
+
+func runCheck(t *testing.T, port uint16, configMap common.MapStr) *beat.Event {
+	configMap = configMap.Clone()
+	configMap["hosts"] = "127.0.0.1"
+	configMap["ports"] = port
+	if _, err := configMap.GetValue("timeout"); err != nil {
+		configMap["timeout"] = "1s"
+	}
+	config, err := common.NewConfigFrom(configMap)
+	require.NoError(t, err)
+
+	p, err := create("udp", config)
+	require.NoError(t, err)
+	require.Equal(t, 1, p.Endpoints)
+
+	sched := schedule.MustParse("@every 1s")
+	js := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "udp", Schedule: sched, Timeout: 1})
+
+	events, err := jobs.ExecJobsAndConts(t, js)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	return events[0]
+}
+
+func udpChecks(t *testing.T, port uint16, status string) validator.Validator {
+	return lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", status, "udp"),
+		hbtest.SimpleURLChecks(t, "udp", "127.0.0.1", port),
+	)
+}
+
+func echo(request []byte) []byte { return request }
+
+func TestPayloadChecks(t *testing.T) {
+	port := startServer(t, echo)
+
+	tests := []struct {
+		name   string
+		check  common.MapStr
+		errMsg string
+	}{
+		{"string", common.MapStr{"send": "ping"}, ""},
+		{"hex", common.MapStr{"send_hex": "cafe01"}, ""},
+		{"receive string", common.MapStr{"send": "hello world", "receive.string": "world"}, ""},
+		{"receive string mismatch", common.MapStr{"send": "hello", "receive.string": "world"}, "response doesn't contain 'world'"},
+		{"receive regex", common.MapStr{"send": "version 1.2.3", "receive.regex": `^version \d+\.\d+`}, ""},
+		{"receive regex mismatch", common.MapStr{"send": "hello", "receive.regex": `^version`}, "response doesn't match regex"},
+		{"receive hex prefix", common.MapStr{"send_hex": "cafe01", "receive.hex_prefix": "CAFE"}, ""},
+		{"receive hex prefix mismatch", common.MapStr{"send_hex": "cafe01", "receive.hex_prefix": "beef"}, "response doesn't start with hex 'beef'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := runCheck(t, port, common.MapStr{"check": tt.check})
+
+			status := "up"
+			var extra validator.Validator = lookslike.MustCompile(map[string]interface{}{})
+			if tt.errMsg != "" {
+				status = "down"
+				extra = hbtest.ErrorChecks(tt.errMsg, "validate")
+			}
+
+			testslike.Test(t, lookslike.Strict(lookslike.Compose(
+				udpChecks(t, port, status),
+				extra,
+				hbtest.SummaryChecks(boolToInt(tt.errMsg == ""), boolToInt(tt.errMsg != "")),
+				lookslike.MustCompile(map[string]interface{}{"udp.rtt.us": isdef.IsDuration}),
+			)), event.Fields)
+		})
+	}
+}
+
+func TestNoResponse(t *testing.T) {
+	silentPort := startServer(t, func([]byte) []byte { return nil })
+
+	t.Run("expected", func(t *testing.T) {
+		event := runCheck(t, silentPort, common.MapStr{"check.send": "ping", "timeout": "100ms"})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			udpChecks(t, silentPort, "down"),
+			hbtest.ErrorChecks("no response received within 100ms", "io"),
+			hbtest.SummaryChecks(0, 1),
+		)), event.Fields)
+	})
+
+	t.Run("not expected", func(t *testing.T) {
+		event := runCheck(t, silentPort, common.MapStr{"check.send": "ping", "check.expect_response": false, "timeout": "100ms"})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			udpChecks(t, silentPort, "up"),
+			hbtest.SummaryChecks(1, 0),
+		)), event.Fields)
+	})
+
+	t.Run("unreachable port", func(t *testing.T) {
+		port := closedPort(t)
+		event := runCheck(t, port, common.MapStr{"check.send": "ping", "check.expect_response": false, "timeout": "1s"})
+		testslike.Test(t, lookslike.Strict(lookslike.Compose(
+			udpChecks(t, port, "down"),
+			hbtest.ErrorChecks("connection refused", "io"),
+			hbtest.SummaryChecks(0, 1),
+		)), event.Fields)
+	})
+}
+
+// ntpResponse answers an NTP request as a stratum 2 server whose clock is
+// ahead by the given offset.
+func ntpResponse(offset time.Duration, leap byte) func([]byte) []byte {
+	return func(request []byte) []byte {
+		now := time.Now().Add(offset)
+		resp := make([]byte, ntpPacketSize)
+		resp[0] = leap<<6 | ntpVersion<<3 | ntpModeServer
+		resp[1] = 2
+		copy(resp[12:16], net.IPv4(10, 0, 0, 1).To4())
+		copy(resp[24:32], request[40:48])
+		binary.BigEndian.PutUint64(resp[32:], toNTPTime(now))
+		binary.BigEndian.PutUint64(resp[40:], toNTPTime(now))
+		return resp
+	}
+}
+
+func TestNTPProbe(t *testing.T) {
+	port := startServer(t, ntpResponse(time.Hour, 0))
+
+	event := runCheck(t, port, common.MapStr{"check.probe": "ntp"})
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		udpChecks(t, port, "up"),
+		hbtest.SummaryChecks(1, 0),
+		lookslike.MustCompile(map[string]interface{}{
+			"udp.rtt.us":           isdef.IsDuration,
+			"udp.ntp.stratum":      uint8(2),
+			"udp.ntp.reference_id": "10.0.0.1",
+			"udp.ntp.offset.us":    hbtestllext.IsInt64,
+		}),
+	)), event.Fields)
+
+	offset, _ := event.GetValue("udp.ntp.offset.us")
+	require.InDelta(t, time.Hour.Microseconds(), offset, float64(time.Second.Microseconds()))
+
+	t.Run("unsynchronized", func(t *testing.T) {
+		port := startServer(t, ntpResponse(0, ntpLeapAlarm))
+		event := runCheck(t, port, common.MapStr{"check.probe": "ntp"})
+		testslike.Test(t, hbtest.ErrorChecks("NTP server clock is not synchronized", "validate"), event.Fields)
+	})
+
+	t.Run("kiss of death", func(t *testing.T) {
+		port := startServer(t, func(request []byte) []byte {
+			resp := ntpResponse(0, 0)(request)
+			resp[1] = 0
+			copy(resp[12:16], "RATE")
+			return resp
+		})
+		event := runCheck(t, port, common.MapStr{"check.probe": "ntp"})
+		testslike.Test(t, hbtest.ErrorChecks("NTP server sent kiss code 'RATE'", "validate"), event.Fields)
+	})
+}
+
+// snmpAgent answers sysDescr requests of the given community.
+func snmpAgent(t *testing.T, community, sysDescr string) func([]byte) []byte {
+	return func(request []byte) []byte {
+		var msg snmpMessage
+		_, err := asn1.Unmarshal(request, &msg)
+		require.NoError(t, err)
+		if string(msg.Community) != community {
+			return nil
+		}
+		pdu, err := unmarshalSNMPPDU(msg.PDU)
+		require.NoError(t, err)
+
+		value, err := asn1.Marshal([]byte(sysDescr))
+		require.NoError(t, err)
+		var raw asn1.RawValue
+		_, err = asn1.Unmarshal(value, &raw)
+		require.NoError(t, err)
+		pdu.VarBinds[0].Value = raw
+
+		respPDU, err := marshalSNMPPDU(snmpGetResponse, pdu)
+		require.NoError(t, err)
+		msg.PDU = respPDU
+		resp, err := asn1.Marshal(msg)
+		require.NoError(t, err)
+		return resp
+	}
+}
+
+func TestSNMPProbe(t *testing.T) {
+	port := startServer(t, snmpAgent(t, "secret", "Linux router 5.10"))
+
+	event := runCheck(t, port, common.MapStr{"check.probe": "snmp", "check.snmp.community": "secret"})
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		udpChecks(t, port, "up"),
+		hbtest.SummaryChecks(1, 0),
+		lookslike.MustCompile(map[string]interface{}{
+			"udp.rtt.us":         isdef.IsDuration,
+			"udp.snmp.sys_descr": "Linux router 5.10",
+		}),
+	)), event.Fields)
+
+	t.Run("wrong community", func(t *testing.T) {
+		event := runCheck(t, port, common.MapStr{"check.probe": "snmp", "timeout": "100ms"})
+		testslike.Test(t, hbtest.ErrorChecks("no response received", "io"), event.Fields)
+	})
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		check common.MapStr
+		valid bool
+	}{
+		{"send", common.MapStr{"send": "ping"}, true},
+		{"probe", common.MapStr{"probe": "ntp"}, true},
+		{"no payload", common.MapStr{}, false},
+		{"send and send_hex", common.MapStr{"send": "ping", "send_hex": "00"}, false},
+		{"invalid hex", common.MapStr{"send_hex": "zz"}, false},
+		{"invalid hex prefix", common.MapStr{"send": "ping", "receive.hex_prefix": "0"}, false},
+		{"unknown probe", common.MapStr{"probe": "dhcp"}, false},
+		{"probe and send", common.MapStr{"probe": "snmp", "send": "ping"}, false},
+		{"probe and receive", common.MapStr{"probe": "ntp", "receive.string": "x"}, false},
+		{"receive without response", common.MapStr{"send": "ping", "receive.string": "x", "expect_response": false}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := common.NewConfigFrom(common.MapStr{
+				"hosts": "example.net:" + strconv.Itoa(123),
+				"check": tt.check,
+			})
+			require.NoError(t, err)
+			_, err = create("udp", config)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestEndpointSchemes(t *testing.T) {
+	config, err := common.NewConfigFrom(common.MapStr{
+		"hosts":      []string{"udp://example.net:161", "example.org"},
+		"ports":      []uint16{123, 124},
+		"check.send": "ping",
+	})
+	require.NoError(t, err)
+	p, err := create("udp", config)
+	require.NoError(t, err)
+	require.Equal(t, 3, p.Endpoints)
+
+	config, err = common.NewConfigFrom(common.MapStr{"hosts": "tcp://example.net:161", "check.send": "ping"})
+	require.NoError(t, err)
+	_, err = create("udp", config)
+	require.Error(t, err)
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
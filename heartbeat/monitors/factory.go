@@ -20,6 +20,7 @@ package monitors
 import (
 	"fmt"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/monitorstate"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
@@ -37,8 +38,9 @@ import (
 // RunnerFactory that can be used to create cfg.Runner cast versions of Monitor
 // suitable for config reloading.
 type RunnerFactory struct {
-	info  beat.Info
-	sched *scheduler.Scheduler
+	info         beat.Info
+	sched        *scheduler.Scheduler
+	stateTracker *monitorstate.Tracker
 }
 
 type publishSettings struct {
@@ -61,8 +63,9 @@ type publishSettings struct {
 }
 
 // NewFactory takes a scheduler and creates a RunnerFactory that can create cfgfile.Runner(Monitor) objects.
-func NewFactory(info beat.Info, sched *scheduler.Scheduler) *RunnerFactory {
-	return &RunnerFactory{info, sched}
+// The state of the created monitors is tracked by the given tracker, which may be nil.
+func NewFactory(info beat.Info, sched *scheduler.Scheduler, stateTracker *monitorstate.Tracker) *RunnerFactory {
+	return &RunnerFactory{info, sched, stateTracker}
 }
 
 // Create makes a new Runner for a new monitor with the given Config.
@@ -78,7 +81,7 @@ func (f *RunnerFactory) Create(p beat.Pipeline, c *common.Config) (cfgfile.Runne
 	}
 
 	p = pipetool.WithClientConfigEdit(p, configEditor)
-	monitor, err := newMonitor(c, plugin.GlobalPluginsReg, p, f.sched)
+	monitor, err := newMonitor(c, plugin.GlobalPluginsReg, p, f.sched, f.stateTracker)
 	return monitor, err
 }
 
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitors
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule/cron"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const (
+	// maintenanceMark runs checks during maintenance windows, reporting failures
+	// with the maintenance status.
+	maintenanceMark = "mark"
+	// maintenanceSuppress skips checks during maintenance windows.
+	maintenanceSuppress = "suppress"
+)
+
+// maintenanceWindowConfig configures a recurring period during which the checks of a
+// monitor are suppressed or marked. Windows start according to either a cron expression
+// or an iCalendar recurrence rule.
+type maintenanceWindowConfig struct {
+	Cron     string        `config:"cron"`
+	RRule    string        `config:"rrule"`
+	Duration time.Duration `config:"duration" validate:"required,positive"`
+	TimeZone string        `config:"timezone"`
+	Mode     string        `config:"mode"`
+}
+
+// Validate validates the window by compiling it.
+func (c *maintenanceWindowConfig) Validate() error {
+	_, err := c.compile()
+	return err
+}
+
+// maintenanceWindow is a compiled maintenanceWindowConfig.
+type maintenanceWindow struct {
+	start    *cron.Schedule
+	duration time.Duration
+	mode     string
+}
+
+func (c *maintenanceWindowConfig) compile() (*maintenanceWindow, error) {
+	w := &maintenanceWindow{duration: c.Duration, mode: c.Mode}
+	if w.mode == "" {
+		w.mode = maintenanceMark
+	}
+	if w.mode != maintenanceMark && w.mode != maintenanceSuppress {
+		return nil, fmt.Errorf("invalid maintenance window mode '%s', expected '%s' or '%s'", c.Mode, maintenanceMark, maintenanceSuppress)
+	}
+
+	var err error
+	switch {
+	case c.Cron != "" && c.RRule != "":
+		return nil, fmt.Errorf("maintenance windows can't set both cron and rrule")
+	case c.Cron != "":
+		w.start, err = cron.Parse(c.Cron)
+	case c.RRule != "":
+		w.start, err = cron.ParseRRule(c.RRule)
+	default:
+		return nil, fmt.Errorf("maintenance windows require either cron or rrule")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.TimeZone != "" {
+		location, err := time.LoadLocation(c.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window time zone: %w", err)
+		}
+		w.start = w.start.In(location)
+	}
+	return w, nil
+}
+
+// active returns true if t is within a window, that is if the window started
+// within the window duration before t.
+func (w *maintenanceWindow) active(t time.Time) bool {
+	start := w.start.Next(t.Add(-w.duration))
+	return !start.IsZero() && !start.After(t)
+}
+
+// compileMaintenanceWindows compiles the window configs, returning nil if there are none.
+func compileMaintenanceWindows(configs []maintenanceWindowConfig) ([]*maintenanceWindow, error) {
+	var windows []*maintenanceWindow
+	for i := range configs {
+		w, err := configs[i].compile()
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, w)
+	}
+	return windows, nil
+}
+
+// activeMaintenanceMode returns the mode of the windows active at t, suppression taking
+// precedence over marking, or the empty string if no window is active.
+func activeMaintenanceMode(windows []*maintenanceWindow, t time.Time) string {
+	mode := ""
+	for _, w := range windows {
+		if !w.active(t) {
+			continue
+		}
+		if w.mode == maintenanceSuppress {
+			return maintenanceSuppress
+		}
+		mode = w.mode
+	}
+	return mode
+}
+
+// withMaintenanceWindows wraps a job so that checks starting within a maintenance window
+// are either skipped or have their events marked, failed checks being reported with the
+// maintenance status.
+func withMaintenanceWindows(job jobs.Job, monitorID string, windows []*maintenanceWindow) jobs.Job {
+	if len(windows) == 0 {
+		return job
+	}
+
+	markedJob := jobs.Wrap(job, markMaintenance)
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		switch activeMaintenanceMode(windows, time.Now()) {
+		case maintenanceSuppress:
+			logp.Debug("scheduler", "Skipping check of monitor '%s' during maintenance window", monitorID)
+			eventext.CancelEvent(event)
+			return nil, nil
+		case maintenanceMark:
+			return markedJob(event)
+		default:
+			return job(event)
+		}
+	}
+}
+
+func markMaintenance(job jobs.Job) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		cont, err := job(event)
+		if eventext.IsEventCancelled(event) {
+			return cont, err
+		}
+
+		fields := common.MapStr{"maintenance": true}
+		if status, _ := event.GetValue("monitor.status"); status == "down" {
+			fields["status"] = "maintenance"
+		}
+		eventext.MergeEventFields(event, common.MapStr{"monitor": fields})
+		return cont, err
+	}
+}
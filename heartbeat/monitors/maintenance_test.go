@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func mustCompileWindow(t *testing.T, config maintenanceWindowConfig) *maintenanceWindow {
+	w, err := config.compile()
+	require.NoError(t, err)
+	return w
+}
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		config maintenanceWindowConfig
+		active []time.Time
+		idle   []time.Time
+	}{
+		{
+			"cron",
+			maintenanceWindowConfig{Cron: "0 2 * * SUN", Duration: 2 * time.Hour},
+			[]time.Time{
+				time.Date(2021, 6, 6, 2, 0, 0, 0, time.UTC),
+				time.Date(2021, 6, 6, 3, 59, 59, 0, time.UTC),
+			},
+			[]time.Time{
+				time.Date(2021, 6, 6, 1, 59, 59, 0, time.UTC),
+				time.Date(2021, 6, 6, 4, 0, 0, 0, time.UTC),
+				time.Date(2021, 6, 7, 2, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			"rrule with time zone",
+			maintenanceWindowConfig{RRule: "FREQ=WEEKLY;BYDAY=SU;BYHOUR=2", Duration: time.Hour, TimeZone: "Europe/Paris"},
+			[]time.Time{
+				time.Date(2021, 6, 6, 2, 30, 0, 0, paris),
+				time.Date(2021, 6, 6, 0, 30, 0, 0, time.UTC),
+			},
+			[]time.Time{
+				time.Date(2021, 6, 6, 2, 30, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := mustCompileWindow(t, tt.config)
+			for _, at := range tt.active {
+				require.True(t, w.active(at), "expected window to be active at %v", at)
+			}
+			for _, at := range tt.idle {
+				require.False(t, w.active(at), "expected window to be inactive at %v", at)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config maintenanceWindowConfig
+		errMsg string
+	}{
+		{"no start", maintenanceWindowConfig{Duration: time.Hour}, "require either cron or rrule"},
+		{"both starts", maintenanceWindowConfig{Cron: "* * * * *", RRule: "FREQ=DAILY", Duration: time.Hour}, "both cron and rrule"},
+		{"invalid mode", maintenanceWindowConfig{Cron: "* * * * *", Duration: time.Hour, Mode: "ignore"}, "invalid maintenance window mode"},
+		{"invalid time zone", maintenanceWindowConfig{Cron: "* * * * *", Duration: time.Hour, TimeZone: "Mars/Olympus"}, "time zone"},
+		{"invalid rrule", maintenanceWindowConfig{RRule: "FREQ=DAILY;COUNT=2", Duration: time.Hour}, "COUNT is not supported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+
+	cfg, err := common.NewConfigFrom(map[string]interface{}{
+		"schedule":            "@every 1s",
+		"maintenance_windows": []map[string]interface{}{{"cron": "* * * * *"}},
+	})
+	require.NoError(t, err)
+	require.Error(t, cfg.Unpack(&jobConfig{}), "duration is required")
+}
+
+func TestWithMaintenanceWindows(t *testing.T) {
+	// A window lasting longer than the interval between its starts is always active
+	always := func(mode string) []*maintenanceWindow {
+		return []*maintenanceWindow{mustCompileWindow(t, maintenanceWindowConfig{Cron: "* * * * *", Duration: time.Hour, Mode: mode})}
+	}
+	never := []*maintenanceWindow{mustCompileWindow(t, maintenanceWindowConfig{Cron: "0 0 1 1 * 2000", Duration: time.Second})}
+
+	t.Run("outside windows", func(t *testing.T) {
+		job, runs := flakyJob(1)
+		events, err := jobs.ExecJobAndConts(t, withMaintenanceWindows(job, "flaky", never))
+		require.NoError(t, err)
+		require.Equal(t, 1, *runs)
+		require.Len(t, events, 2)
+		for _, e := range events {
+			_, err := e.GetValue("monitor.maintenance")
+			require.Error(t, err)
+		}
+		status, _ := events[1].GetValue("monitor.status")
+		require.Equal(t, "down", status)
+	})
+
+	t.Run("mark", func(t *testing.T) {
+		job, runs := flakyJob(1)
+		events, err := jobs.ExecJobAndConts(t, withMaintenanceWindows(job, "flaky", always(maintenanceMark)))
+		require.NoError(t, err)
+		require.Equal(t, 1, *runs)
+		require.Len(t, events, 2)
+
+		var statuses []interface{}
+		for _, e := range events {
+			maintenance, _ := e.GetValue("monitor.maintenance")
+			require.Equal(t, true, maintenance)
+			status, _ := e.GetValue("monitor.status")
+			statuses = append(statuses, status)
+		}
+		require.Equal(t, []interface{}{"up", "maintenance"}, statuses)
+	})
+
+	t.Run("suppress", func(t *testing.T) {
+		windows := append(always(maintenanceMark), always(maintenanceSuppress)...)
+		job, runs := flakyJob(1)
+		events, err := jobs.ExecJobAndConts(t, withMaintenanceWindows(job, "flaky", windows))
+		require.NoError(t, err)
+		require.Equal(t, 0, *runs)
+		require.Len(t, events, 1)
+		require.True(t, eventext.IsEventCancelled(events[0]))
+	})
+}
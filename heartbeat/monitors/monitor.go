@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/monitorstate"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
@@ -54,9 +55,13 @@ type Monitor struct {
 	// internal datastructures
 	internalsMtx sync.Mutex
 	close        func() error
+	started      bool
 
 	pipelineConnector beat.PipelineConnector
 
+	// stateTracker tracks the state of the monitor across checks, it may be nil
+	stateTracker *monitorstate.Tracker
+
 	// stats is the countersRecorder used to record lifecycle events
 	// for global metrics + telemetry
 	stats plugin.RegistryRecorder
@@ -69,7 +74,7 @@ func (m *Monitor) String() string {
 }
 
 func checkMonitorConfig(config *common.Config, registrar *plugin.PluginsReg) error {
-	m, err := newMonitor(config, registrar, nil, nil)
+	m, err := newMonitor(config, registrar, nil, nil, nil)
 	if m != nil {
 		m.Stop() // Stop the monitor to free up the ID from uniqueness checks
 	}
@@ -93,8 +98,9 @@ func newMonitor(
 	registrar *plugin.PluginsReg,
 	pipelineConnector beat.PipelineConnector,
 	scheduler *scheduler.Scheduler,
+	stateTracker *monitorstate.Tracker,
 ) (*Monitor, error) {
-	m, err := newMonitorUnsafe(config, registrar, pipelineConnector, scheduler)
+	m, err := newMonitorUnsafe(config, registrar, pipelineConnector, scheduler, stateTracker)
 	if m != nil && err != nil {
 		m.Stop()
 	}
@@ -108,6 +114,7 @@ func newMonitorUnsafe(
 	registrar *plugin.PluginsReg,
 	pipelineConnector beat.PipelineConnector,
 	scheduler *scheduler.Scheduler,
+	stateTracker *monitorstate.Tracker,
 ) (*Monitor, error) {
 	// Extract just the Id, Type, and Enabled fields from the config
 	// We'll parse things more precisely later once we know what exact type of
@@ -135,6 +142,7 @@ func newMonitorUnsafe(
 		internalsMtx:      sync.Mutex{},
 		config:            config,
 		stats:             pluginFactory.Stats,
+		stateTracker:      stateTracker,
 	}
 
 	if m.stdFields.ID != "" {
@@ -183,7 +191,7 @@ func (m *Monitor) configHash() (uint64, error) {
 }
 
 func (m *Monitor) makeTasks(config *common.Config, jobs []jobs.Job) ([]*configuredJob, error) {
-	mtConf := jobConfig{}
+	mtConf := jobConfig{RetestOnFailure: defaultRetestConfig}
 	if err := config.Unpack(&mtConf); err != nil {
 		return nil, errors.Wrap(err, "invalid config, could not unpack monitor config")
 	}
@@ -215,6 +223,7 @@ func (m *Monitor) Start() {
 	for _, t := range m.configuredJobs {
 		t.Start()
 	}
+	m.started = true
 
 	m.stats.StartMonitor(int64(m.endpoints))
 }
@@ -230,6 +239,13 @@ func (m *Monitor) Stop() {
 		t.Stop()
 	}
 
+	// Monitors that failed to start, for instance because of a duplicated ID,
+	// must not drop the state of the monitor they conflict with.
+	if m.started && m.stateTracker != nil {
+		m.stateTracker.Remove(m.stdFields.ID)
+		m.started = false
+	}
+
 	if m.close != nil {
 		err := m.close()
 		if err != nil {
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/monitorstate"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/go-lookslike/testslike"
@@ -39,7 +40,7 @@ func TestMonitor(t *testing.T) {
 	require.NoError(t, err)
 	defer sched.Stop()
 
-	mon, err := newMonitor(serverMonConf, reg, pipelineConnector, sched)
+	mon, err := newMonitor(serverMonConf, reg, pipelineConnector, sched, nil)
 	require.NoError(t, err)
 
 	mon.Start()
@@ -90,11 +91,11 @@ func TestDuplicateMonitorIDs(t *testing.T) {
 	defer sched.Stop()
 
 	makeTestMon := func() (*Monitor, error) {
-		return newMonitor(serverMonConf, reg, pipelineConnector, sched)
+		return newMonitor(serverMonConf, reg, pipelineConnector, sched, nil)
 	}
 
 	// Ensure that an error is returned on a bad config
-	_, m0Err := newMonitor(badConf, reg, pipelineConnector, sched)
+	_, m0Err := newMonitor(badConf, reg, pipelineConnector, sched, nil)
 	require.Error(t, m0Err)
 
 	// Would fail if the previous newMonitor didn't free the monitor.id
@@ -115,6 +116,32 @@ func TestDuplicateMonitorIDs(t *testing.T) {
 	require.NoError(t, m3Err)
 }
 
+func TestMonitorStateRemoved(t *testing.T) {
+	serverMonConf := mockPluginConf(t, "custom", "@every 1h", "http://example.net")
+	reg, _, _ := mockPluginsReg()
+	pipelineConnector := &MockPipelineConnector{}
+
+	sched := scheduler.New(1, monitoring.NewRegistry())
+	require.NoError(t, sched.Start())
+	defer sched.Stop()
+
+	tracker := monitorstate.NewTracker(nil, monitorstate.DefaultFlappingConfig)
+	mon, err := newMonitor(serverMonConf, reg, pipelineConnector, sched, tracker)
+	require.NoError(t, err)
+	mon.Start()
+	tracker.Record("custom", monitorstate.StatusUp, 1, 0, time.Now())
+
+	// A monitor with a duplicated ID doesn't remove the state of the running one
+	_, err = newMonitor(serverMonConf, reg, pipelineConnector, sched, tracker)
+	require.Error(t, err)
+	_, changed := tracker.Record("custom", monitorstate.StatusUp, 1, 0, time.Now())
+	require.False(t, changed)
+
+	mon.Stop()
+	_, changed = tracker.Record("custom", monitorstate.StatusUp, 1, 0, time.Now())
+	require.True(t, changed, "the state should be removed with the monitor")
+}
+
 func TestCheckInvalidConfig(t *testing.T) {
 	serverMonConf := mockInvalidPluginConf(t)
 	reg, built, closed := mockPluginsReg()
@@ -125,7 +152,7 @@ func TestCheckInvalidConfig(t *testing.T) {
 	require.NoError(t, err)
 	defer sched.Stop()
 
-	m, err := newMonitor(serverMonConf, reg, pipelineConnector, sched)
+	m, err := newMonitor(serverMonConf, reg, pipelineConnector, sched, nil)
 	// This could change if we decide the contract for newMonitor should always return a monitor
 	require.Nil(t, m, "For this test to work we need a nil value for the monitor.")
 
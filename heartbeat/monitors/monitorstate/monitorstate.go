@@ -0,0 +1,241 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package monitorstate keeps track of the state of monitors across checks. A state
+// spans all consecutive checks of a monitor sharing the same status, so that it can
+// tell since when a monitor has been down, or whether it is flapping between
+// statuses. States are persisted in the libbeat statestore, surviving restarts.
+package monitorstate
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+)
+
+const (
+	// StatusUp is the status of monitors whose last check had no down endpoints.
+	StatusUp = "up"
+	// StatusDown is the status of monitors whose last check had down endpoints.
+	StatusDown = "down"
+
+	keyPrefix = "heartbeat::monitor_state::"
+)
+
+// FlappingConfig defines when a monitor is considered to be flapping: whenever its status
+// changed at least Threshold times during the last Window checks.
+type FlappingConfig struct {
+	Window    int `config:"window" validate:"min=2"`
+	Threshold int `config:"threshold" validate:"min=1"`
+}
+
+// Validate ensures flapping can be detected within the configured window.
+func (c *FlappingConfig) Validate() error {
+	if c.Threshold >= c.Window {
+		return fmt.Errorf("flapping threshold %d must be lower than the window of %d checks", c.Threshold, c.Window)
+	}
+	return nil
+}
+
+// DefaultFlappingConfig is the canonical instantiation of FlappingConfig.
+var DefaultFlappingConfig = FlappingConfig{
+	Window:    10,
+	Threshold: 3,
+}
+
+// State is the state of a single monitor.
+type State struct {
+	// ID uniquely identifies this state, a new ID is generated on each status change.
+	ID        string    `struct:"id" json:"id"`
+	Status    string    `struct:"status" json:"status"`
+	StartedAt time.Time `struct:"started_at" json:"started_at"`
+	// Checks is the number of consecutive checks with this status.
+	Checks int `struct:"checks" json:"checks"`
+	// Up and Down count the endpoints found up and down during this state.
+	Up   int `struct:"up" json:"up"`
+	Down int `struct:"down" json:"down"`
+	// History contains the statuses of the most recent checks, used for flapping detection.
+	History       []string  `struct:"history" json:"history"`
+	LastPublished time.Time `struct:"last_published" json:"last_published"`
+}
+
+// FlapCount returns the number of status changes in the recent checks history.
+func (s *State) FlapCount() int {
+	count := 0
+	for i := 1; i < len(s.History); i++ {
+		if s.History[i] != s.History[i-1] {
+			count++
+		}
+	}
+	return count
+}
+
+// Fields returns the `state.*` fields describing this state at the given time.
+func (s *State) Fields(threshold int, now time.Time) common.MapStr {
+	flapCount := s.FlapCount()
+	return common.MapStr{
+		"id":          s.ID,
+		"status":      s.Status,
+		"started_at":  s.StartedAt,
+		"duration_ms": now.Sub(s.StartedAt).Milliseconds(),
+		"checks":      s.Checks,
+		"up":          s.Up,
+		"down":        s.Down,
+		"flap_count":  flapCount,
+		"flapping":    flapCount >= threshold,
+	}
+}
+
+// Tracker tracks the states of multiple monitors by monitor ID. States are
+// persisted to the given store, if any.
+type Tracker struct {
+	mtx      sync.Mutex
+	store    *statestore.Store
+	states   map[string]*State
+	flapping FlappingConfig
+	log      *logp.Logger
+	// shutdown is set once heartbeat is shutting down, the persisted states of
+	// the monitors stopped after that are kept.
+	shutdown bool
+}
+
+// NewTracker creates a new Tracker. The store may be nil, in which case states are
+// only kept in memory.
+func NewTracker(store *statestore.Store, flapping FlappingConfig) *Tracker {
+	return &Tracker{
+		store:    store,
+		states:   map[string]*State{},
+		flapping: flapping,
+		log:      logp.NewLogger("monitorstate"),
+	}
+}
+
+// Flapping returns the flapping detection settings of this tracker.
+func (t *Tracker) Flapping() FlappingConfig {
+	return t.flapping
+}
+
+// Record updates the state of the given monitor with the outcome of a check, returning
+// a copy of the updated state. The returned bool is true when the check started a new
+// state, either because the status changed or because the monitor had no state yet.
+func (t *Tracker) Record(monitorID string, status string, up, down int, at time.Time) (State, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	st := t.get(monitorID)
+	changed := st == nil || st.Status != status
+	if changed {
+		var history []string
+		if st != nil {
+			history = st.History
+		}
+		st = &State{
+			ID:        fmt.Sprintf("%s-%x", monitorID, at.UnixNano()),
+			Status:    status,
+			StartedAt: at,
+			History:   history,
+		}
+		t.states[monitorID] = st
+	}
+
+	st.Checks++
+	st.Up += up
+	st.Down += down
+	st.History = append(st.History, status)
+	if extra := len(st.History) - t.flapping.Window; extra > 0 {
+		st.History = append([]string(nil), st.History[extra:]...)
+	}
+
+	t.persist(monitorID, st)
+	return *st, changed
+}
+
+// Published records the time an event of the given monitor was last published.
+func (t *Tracker) Published(monitorID string, at time.Time) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if st := t.get(monitorID); st != nil {
+		st.LastPublished = at
+		t.persist(monitorID, st)
+	}
+}
+
+// Remove drops the state of the given monitor, which has been removed. The persisted
+// state is also deleted, unless heartbeat is shutting down.
+func (t *Tracker) Remove(monitorID string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.states, monitorID)
+	if t.store == nil || t.shutdown {
+		return
+	}
+	if err := t.store.Remove(keyPrefix + monitorID); err != nil {
+		t.log.Errorf("Failed to remove state of monitor %s: %v", monitorID, err)
+	}
+}
+
+// Shutdown signals that heartbeat is shutting down. The persisted states of the
+// monitors removed afterwards are kept, so that they are resumed on restart.
+func (t *Tracker) Shutdown() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.shutdown = true
+}
+
+// get returns the state of the given monitor, loading it from the store if needed.
+// Must be called with the lock held.
+func (t *Tracker) get(monitorID string) *State {
+	if st, ok := t.states[monitorID]; ok {
+		return st
+	}
+	if t.store == nil {
+		return nil
+	}
+
+	key := keyPrefix + monitorID
+	if has, err := t.store.Has(key); err != nil || !has {
+		if err != nil {
+			t.log.Errorf("Failed to read state of monitor %s: %v", monitorID, err)
+		}
+		return nil
+	}
+
+	st := &State{}
+	if err := t.store.Get(key, st); err != nil {
+		t.log.Errorf("Failed to read state of monitor %s: %v", monitorID, err)
+		return nil
+	}
+	t.states[monitorID] = st
+	return st
+}
+
+// persist writes the state of the given monitor to the store. Must be called with
+// the lock held.
+func (t *Tracker) persist(monitorID string, st *State) {
+	if t.store == nil {
+		return
+	}
+	if err := t.store.Set(keyPrefix+monitorID, st); err != nil {
+		t.log.Errorf("Failed to persist state of monitor %s: %v", monitorID, err)
+	}
+}
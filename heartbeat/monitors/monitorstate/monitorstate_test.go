@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitorstate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/storetest"
+)
+
+func TestRecordStatusChanges(t *testing.T) {
+	tracker := NewTracker(nil, DefaultFlappingConfig)
+	start := time.Now()
+
+	st, changed := tracker.Record("mon", StatusUp, 1, 0, start)
+	require.True(t, changed)
+	require.Equal(t, StatusUp, st.Status)
+	require.Equal(t, 1, st.Checks)
+
+	st, changed = tracker.Record("mon", StatusUp, 2, 0, start.Add(time.Second))
+	require.False(t, changed)
+	require.Equal(t, 2, st.Checks)
+	require.Equal(t, 3, st.Up)
+	require.Equal(t, start, st.StartedAt)
+
+	downAt := start.Add(2 * time.Second)
+	down, changed := tracker.Record("mon", StatusDown, 1, 1, downAt)
+	require.True(t, changed)
+	require.NotEqual(t, st.ID, down.ID)
+	require.Equal(t, 1, down.Checks)
+	require.Equal(t, 1, down.Up)
+	require.Equal(t, 1, down.Down)
+	require.Equal(t, downAt, down.StartedAt)
+
+	fields := down.Fields(DefaultFlappingConfig.Threshold, downAt.Add(time.Minute))
+	require.Equal(t, int64(60000), fields["duration_ms"])
+	require.Equal(t, 1, fields["flap_count"])
+	require.Equal(t, false, fields["flapping"])
+
+	// Other monitors have their own state
+	_, changed = tracker.Record("other", StatusDown, 0, 1, downAt)
+	require.True(t, changed)
+}
+
+func TestFlapping(t *testing.T) {
+	tracker := NewTracker(nil, FlappingConfig{Window: 4, Threshold: 2})
+	now := time.Now()
+
+	var st State
+	for _, status := range []string{StatusUp, StatusDown, StatusUp} {
+		st, _ = tracker.Record("mon", status, 0, 0, now)
+	}
+	require.Equal(t, 2, st.FlapCount())
+	require.Equal(t, true, st.Fields(2, now)["flapping"])
+
+	// Once the monitor stabilizes, old changes fall out of the window
+	for i := 0; i < 3; i++ {
+		st, _ = tracker.Record("mon", StatusUp, 0, 0, now)
+	}
+	require.Len(t, st.History, 4)
+	require.Equal(t, 0, st.FlapCount())
+	require.Equal(t, false, st.Fields(2, now)["flapping"])
+}
+
+func TestPersistence(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	store, err := registry.Get("heartbeat")
+	require.NoError(t, err)
+	defer store.Close()
+
+	start := time.Now().Truncate(time.Second)
+	tracker := NewTracker(store, DefaultFlappingConfig)
+	st, _ := tracker.Record("mon", StatusDown, 0, 1, start)
+	tracker.Published("mon", start)
+
+	// A new tracker, as created on restart, picks up the persisted state
+	restarted := NewTracker(store, DefaultFlappingConfig)
+	resumed, changed := restarted.Record("mon", StatusDown, 0, 1, start.Add(time.Second))
+	require.False(t, changed)
+	require.Equal(t, st.ID, resumed.ID)
+	require.Equal(t, 2, resumed.Checks)
+	require.True(t, start.Equal(resumed.StartedAt))
+	require.True(t, start.Equal(resumed.LastPublished))
+}
+
+func TestRemove(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	store, err := registry.Get("heartbeat")
+	require.NoError(t, err)
+	defer store.Close()
+
+	now := time.Now()
+	tracker := NewTracker(store, DefaultFlappingConfig)
+	tracker.Record("removed", StatusUp, 1, 0, now)
+	tracker.Record("stopped", StatusUp, 1, 0, now)
+
+	tracker.Remove("removed")
+	require.NotContains(t, tracker.states, "removed")
+	has, err := store.Has(keyPrefix + "removed")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Monitors stopped on shutdown keep their persisted state
+	tracker.Shutdown()
+	tracker.Remove("stopped")
+	require.NotContains(t, tracker.states, "stopped")
+	has, err = store.Has(keyPrefix + "stopped")
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestFlappingConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultFlappingConfig.Validate())
+	invalid := FlappingConfig{Window: 3, Threshold: 3}
+	require.Error(t, invalid.Validate())
+}
@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitors
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/scheduler"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// retestConfig configures how failed checks are retested before being reported.
+type retestConfig struct {
+	// Retries is the number of times a failed check is run again, 0 disables retests.
+	Retries int `config:"retries" validate:"min=0"`
+	// Backoff is the time waited before the first retest, doubled on each subsequent one.
+	Backoff time.Duration `config:"backoff" validate:"min=0"`
+}
+
+var defaultRetestConfig = retestConfig{
+	Retries: 0,
+	Backoff: time.Second,
+}
+
+// retestOnFailure wraps a job so that failed checks are run again, after a backoff, until
+// they succeed or the retries are exhausted. Only the events of the last attempt are emitted,
+// so that transient failures don't report the monitor as down. Since the outcome of a check
+// is only known once all its continuations ran, the whole check runs within the job, executing
+// the continuations sequentially, and its events are replayed as continuations afterwards.
+// Waiting for the backoff ends early once the stop channel is closed. Retests never run past
+// the next scheduled check, so that they don't hold a scheduler slot for longer than the
+// schedule interval.
+func retestOnFailure(job jobs.Job, monitorID string, config retestConfig, sched scheduler.Schedule, stop <-chan struct{}) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		maxAttempts := config.Retries + 1
+		backoff := config.Backoff
+		nextCheck := sched.Next(time.Now())
+
+		events, err := execCheck(job)
+		attempt := 1
+	retest:
+		for ; attempt < maxAttempts && checkFailed(events); attempt++ {
+			if time.Now().Add(backoff).After(nextCheck) {
+				logp.Info("Check of monitor '%s' failed, not retesting past the next scheduled check (attempt %d of %d)", monitorID, attempt, maxAttempts)
+				break
+			}
+			logp.Info("Check of monitor '%s' failed, retesting in %v (attempt %d of %d)", monitorID, backoff, attempt+1, maxAttempts)
+			select {
+			case <-time.After(backoff):
+			case <-stop:
+				// The monitor is stopping, report the last attempt as is
+				break retest
+			}
+			backoff *= 2
+			events, err = execCheck(job)
+		}
+
+		for _, e := range events {
+			if summary, _ := e.GetValue("summary"); summary != nil {
+				eventext.MergeEventFields(e, common.MapStr{
+					"summary": common.MapStr{
+						"attempt":      attempt,
+						"max_attempts": maxAttempts,
+					},
+				})
+			}
+		}
+
+		// Replay the events of the last attempt
+		*event = *events[0]
+		var conts []jobs.Job
+		for _, e := range events[1:] {
+			e := e
+			conts = append(conts, func(contEvent *beat.Event) ([]jobs.Job, error) {
+				*contEvent = *e
+				return nil, nil
+			})
+		}
+		return conts, err
+	}
+}
+
+// execCheck runs the job and all its continuations, returning all the events of the check.
+func execCheck(job jobs.Job) ([]*beat.Event, error) {
+	event := &beat.Event{Fields: common.MapStr{}}
+	conts, err := job(event)
+	events := []*beat.Event{event}
+
+	for _, cont := range conts {
+		contEvents, _ := execCheck(cont)
+		events = append(events, contEvents...)
+	}
+	return events, err
+}
+
+// checkFailed returns true if any endpoint was down during the check.
+func checkFailed(events []*beat.Event) bool {
+	for _, e := range events {
+		if eventext.IsEventCancelled(e) {
+			continue
+		}
+		if status, _ := e.GetValue("monitor.status"); status == "down" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitors
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// flakyJob returns a job checking two endpoints, the second one being down
+// for the given number of checks.
+func flakyJob(failures int) (jobs.Job, *int) {
+	runs := 0
+	endpoint := func(u string, fail bool) jobs.Job {
+		parsed, _ := url.Parse(u)
+		return wrappers.WithURLField(parsed, jobs.MakeSimpleJob(func(event *beat.Event) error {
+			if fail {
+				return errors.New("down")
+			}
+			return nil
+		}))
+	}
+	job := func(event *beat.Event) ([]jobs.Job, error) {
+		runs++
+		eventext.MergeEventFields(event, common.MapStr{"run": runs})
+		return []jobs.Job{endpoint("http://b.example.com", runs <= failures)}, nil
+	}
+
+	sf := stdfields.StdMonitorFields{ID: "flaky", Type: "http", Schedule: schedule.MustParse("@every 1s"), Timeout: 1}
+	return wrappers.WrapCommon([]jobs.Job{job}, sf)[0], &runs
+}
+
+func execRetest(t *testing.T, failures int, config retestConfig, stop chan struct{}) ([]*beat.Event, int) {
+	return execRetestScheduled(t, failures, config, schedule.MustParse("@every 1m"), stop)
+}
+
+func execRetestScheduled(t *testing.T, failures int, config retestConfig, sched *schedule.Schedule, stop chan struct{}) ([]*beat.Event, int) {
+	job, runs := flakyJob(failures)
+	events, err := jobs.ExecJobAndConts(t, retestOnFailure(job, "flaky", config, sched, stop))
+	require.NoError(t, err)
+	return events, *runs
+}
+
+func summaryOf(t *testing.T, events []*beat.Event) common.MapStr {
+	for _, e := range events {
+		if summary, err := e.GetValue("summary"); err == nil {
+			return summary.(common.MapStr)
+		}
+	}
+	require.Fail(t, "no summary found")
+	return nil
+}
+
+func TestRetestOnFailure(t *testing.T) {
+	config := retestConfig{Retries: 2, Backoff: time.Millisecond}
+
+	t.Run("up", func(t *testing.T) {
+		events, runs := execRetest(t, 0, config, make(chan struct{}))
+		require.Equal(t, 1, runs)
+		require.Len(t, events, 2)
+		summary := summaryOf(t, events)
+		require.Equal(t, uint16(0), summary["down"])
+		require.Equal(t, 1, summary["attempt"])
+		require.Equal(t, 3, summary["max_attempts"])
+	})
+
+	t.Run("transient failure", func(t *testing.T) {
+		events, runs := execRetest(t, 2, config, make(chan struct{}))
+		require.Equal(t, 3, runs)
+		// Only the events of the last attempt are emitted
+		require.Len(t, events, 2)
+		run, _ := events[0].GetValue("run")
+		require.Equal(t, 3, run)
+		for _, e := range events {
+			status, _ := e.GetValue("monitor.status")
+			require.Equal(t, "up", status)
+		}
+		// All events belong to the same check
+		group0, _ := events[0].GetValue("monitor.check_group")
+		group1, _ := events[1].GetValue("monitor.check_group")
+		require.Equal(t, group0, group1)
+		require.Equal(t, 3, summaryOf(t, events)["attempt"])
+	})
+
+	t.Run("persistent failure", func(t *testing.T) {
+		events, runs := execRetest(t, 5, config, make(chan struct{}))
+		require.Equal(t, 3, runs)
+		summary := summaryOf(t, events)
+		require.Equal(t, uint16(1), summary["down"])
+		require.Equal(t, 3, summary["attempt"])
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		stop := make(chan struct{})
+		close(stop)
+		events, runs := execRetest(t, 5, retestConfig{Retries: 2, Backoff: time.Hour}, stop)
+		require.Equal(t, 1, runs)
+		require.Equal(t, 1, summaryOf(t, events)["attempt"])
+	})
+}
+
+func TestRetestBackoff(t *testing.T) {
+	start := time.Now()
+	execRetest(t, 5, retestConfig{Retries: 2, Backoff: 20 * time.Millisecond}, make(chan struct{}))
+	// 20ms before the first retest, 40ms before the second
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(60*time.Millisecond))
+}
+
+func TestRetestBackoffCappedAtSchedule(t *testing.T) {
+	config := retestConfig{Retries: 5, Backoff: 20 * time.Millisecond}
+	events, runs := execRetestScheduled(t, 10, config, schedule.MustParse("@every 100ms"), make(chan struct{}))
+	// 20ms and 40ms retests fit within the interval, waiting 80ms more would not
+	require.Equal(t, 3, runs)
+	require.Equal(t, 3, summaryOf(t, events)["attempt"])
+}
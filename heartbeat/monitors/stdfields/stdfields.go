@@ -18,6 +18,7 @@
 package stdfields
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,6 +31,35 @@ type ServiceFields struct {
 	Name string `config:"name"`
 }
 
+const (
+	// PublishAll publishes the events of every check.
+	PublishAll = "all"
+	// PublishStatusChange only publishes the summary events of checks changing the
+	// monitor status, plus a periodic heartbeat event.
+	PublishStatusChange = "status_change"
+)
+
+// PublishConfig controls which events of a monitor get published.
+type PublishConfig struct {
+	Mode      string        `config:"mode"`
+	Heartbeat time.Duration `config:"heartbeat" validate:"min=0"`
+}
+
+// Validate validates the publish mode.
+func (c *PublishConfig) Validate() error {
+	switch c.Mode {
+	case PublishAll, PublishStatusChange:
+		return nil
+	default:
+		return fmt.Errorf("invalid publish mode '%s', expected '%s' or '%s'", c.Mode, PublishAll, PublishStatusChange)
+	}
+}
+
+// OnStatusChange returns true if only status changes and heartbeats should be published.
+func (c PublishConfig) OnStatusChange() bool {
+	return c.Mode == PublishStatusChange
+}
+
 // StdMonitorFields represents the generic configuration options around a monitor plugin.
 type StdMonitorFields struct {
 	ID                string             `config:"id"`
@@ -40,10 +70,17 @@ type StdMonitorFields struct {
 	Service           ServiceFields      `config:"service"`
 	LegacyServiceName string             `config:"service_name"`
 	Enabled           bool               `config:"enabled"`
+	Publish           PublishConfig      `config:"publish"`
 }
 
 func ConfigToStdMonitorFields(config *common.Config) (StdMonitorFields, error) {
-	mpi := StdMonitorFields{Enabled: true}
+	mpi := StdMonitorFields{
+		Enabled: true,
+		Publish: PublishConfig{
+			Mode:      PublishAll,
+			Heartbeat: 10 * time.Minute,
+		},
+	}
 
 	if err := config.Unpack(&mpi); err != nil {
 		return mpi, errors.Wrap(err, "error unpacking monitor plugin config")
@@ -20,6 +20,7 @@ package stdfields
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -63,3 +64,35 @@ func TestLegacyServiceNameConfig(t *testing.T) {
 	}
 
 }
+
+func TestPublishConfig(t *testing.T) {
+	base := common.MapStr{
+		"type":     "http",
+		"id":       "myId",
+		"schedule": "@every 1s",
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		f, err := ConfigToStdMonitorFields(common.MustNewConfigFrom(base))
+		require.NoError(t, err)
+		require.Equal(t, PublishAll, f.Publish.Mode)
+		require.False(t, f.Publish.OnStatusChange())
+		require.Equal(t, 10*time.Minute, f.Publish.Heartbeat)
+	})
+
+	t.Run("status change", func(t *testing.T) {
+		cm := base.Clone()
+		cm["publish"] = common.MapStr{"mode": "status_change", "heartbeat": "1h"}
+		f, err := ConfigToStdMonitorFields(common.MustNewConfigFrom(cm))
+		require.NoError(t, err)
+		require.True(t, f.Publish.OnStatusChange())
+		require.Equal(t, time.Hour, f.Publish.Heartbeat)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		cm := base.Clone()
+		cm["publish"] = common.MapStr{"mode": "sometimes"}
+		_, err := ConfigToStdMonitorFields(common.MustNewConfigFrom(cm))
+		require.Error(t, err)
+	})
+}
@@ -20,9 +20,11 @@ package monitors
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/elastic/beats/v7/heartbeat/eventext"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -38,21 +40,45 @@ type configuredJob struct {
 	monitor  *Monitor
 	cancelFn context.CancelFunc
 	client   beat.Client
+	// schedule is the configured schedule, offset by the monitor jitter
+	schedule *schedule.Schedule
+	// stop is closed when the job is stopped, interrupting retests
+	stop chan struct{}
 }
 
 func newConfiguredJob(job jobs.Job, config jobConfig, monitor *Monitor) (*configuredJob, error) {
-	return &configuredJob{
-		job:     job,
-		config:  config,
-		monitor: monitor,
-	}, nil
+	t := &configuredJob{
+		config:   config,
+		monitor:  monitor,
+		schedule: config.Schedule.WithJitter(monitor.stdFields.ID, config.ScheduleJitter),
+		stop:     make(chan struct{}),
+	}
+
+	if config.RetestOnFailure.Retries > 0 {
+		job = retestOnFailure(job, monitor.stdFields.ID, config.RetestOnFailure, t.schedule, t.stop)
+	}
+	// The state is tracked last, so that it only sees the outcome of retested checks
+	job = wrappers.WrapState([]jobs.Job{job}, monitor.stdFields, monitor.stateTracker)[0]
+
+	windows, err := compileMaintenanceWindows(config.MaintenanceWindows)
+	if err != nil {
+		return nil, err
+	}
+	t.job = withMaintenanceWindows(job, monitor.stdFields.ID, windows)
+
+	return t, nil
 }
 
 // jobConfig represents fields needed to execute a single job.
 type jobConfig struct {
-	Name     string             `config:"pluginName"`
-	Type     string             `config:"type"`
-	Schedule *schedule.Schedule `config:"schedule" validate:"required"`
+	Name            string             `config:"pluginName"`
+	Type            string             `config:"type"`
+	Schedule        *schedule.Schedule `config:"schedule" validate:"required"`
+	RetestOnFailure retestConfig       `config:"retest_on_failure"`
+	// ScheduleJitter caps the deterministic offset spreading monitors sharing a schedule,
+	// nil applying the default and zero disabling it.
+	ScheduleJitter     *time.Duration            `config:"schedule_jitter" validate:"min=0"`
+	MaintenanceWindows []maintenanceWindowConfig `config:"maintenance_windows"`
 }
 
 // ProcessorsError is used to indicate situations when processors could not be loaded.
@@ -84,7 +110,7 @@ func (t *configuredJob) Start() {
 	}
 
 	tf := t.makeSchedulerTaskFunc()
-	t.cancelFn, err = t.monitor.scheduler.Add(t.config.Schedule, t.monitor.stdFields.ID, tf, t.config.Type)
+	t.cancelFn, err = t.monitor.scheduler.Add(t.schedule, t.monitor.stdFields.ID, tf, t.config.Type)
 	if err != nil {
 		logp.Err("could not start monitor: %v", err)
 	}
@@ -92,6 +118,11 @@ func (t *configuredJob) Start() {
 
 // Stop unschedules this configuredJob from execution.
 func (t *configuredJob) Stop() {
+	select {
+	case <-t.stop:
+	default:
+		close(t.stop)
+	}
 	if t.cancelFn != nil {
 		t.cancelFn()
 	}
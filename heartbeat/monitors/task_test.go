@@ -20,6 +20,7 @@ package monitors
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/elastic/go-lookslike/validator"
 
@@ -30,6 +31,8 @@ import (
 
 	"github.com/elastic/beats/v7/heartbeat/eventext"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 )
@@ -117,3 +120,18 @@ func Test_runPublishJob(t *testing.T) {
 		})
 	}
 }
+
+func TestConfiguredJobScheduleJitter(t *testing.T) {
+	config := jobConfig{
+		Schedule:        schedule.MustParse("@every 1h"),
+		RetestOnFailure: retestConfig{Retries: 1},
+	}
+	monitor := &Monitor{stdFields: stdfields.StdMonitorFields{ID: "mon"}}
+	job, err := newConfiguredJob(nil, config, monitor)
+	require.NoError(t, err)
+
+	// Retests are capped by the same jittered schedule the monitor is run with
+	now := time.Now()
+	expected := config.Schedule.WithJitter("mon", nil).Next(now)
+	require.Equal(t, expected, job.schedule.Next(now))
+}
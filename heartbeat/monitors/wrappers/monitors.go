@@ -192,6 +192,7 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 		remaining  uint16
 		up         uint16
 		down       uint16
+		warn       uint16
 		checkGroup string
 		generation uint64
 	}{
@@ -202,6 +203,7 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 		state.remaining = 1
 		state.up = 0
 		state.down = 0
+		state.warn = 0
 		state.generation++
 		u, err := uuid.NewV1()
 		if err != nil {
@@ -223,9 +225,14 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 			if !eventext.IsEventCancelled(event) {
 				// After each job
 				eventStatus, _ = event.GetValue("monitor.status")
-				if eventStatus == "up" {
+				// Warnings are reported separately, but the endpoints are up
+				switch eventStatus {
+				case "up":
 					state.up++
-				} else {
+				case "warn":
+					state.up++
+					state.warn++
+				default:
 					state.down++
 				}
 			}
@@ -239,15 +246,15 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 
 			// After last job
 			if state.remaining == 0 {
-				up := state.up
-				down := state.down
-
-				eventext.MergeEventFields(event, common.MapStr{
-					"summary": common.MapStr{
-						"up":   up,
-						"down": down,
-					},
-				})
+				summary := common.MapStr{
+					"up":   state.up,
+					"down": state.down,
+				}
+				if state.warn > 0 {
+					summary["warn"] = state.warn
+				}
+
+				eventext.MergeEventFields(event, common.MapStr{"summary": summary})
 				resetState()
 			}
 
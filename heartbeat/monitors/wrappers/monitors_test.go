@@ -31,6 +31,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/reason"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
@@ -329,6 +330,50 @@ func TestMultiJobContsCancelledEvents(t *testing.T) {
 	})
 }
 
+func TestWarnJob(t *testing.T) {
+	warnJob := func(event *beat.Event) ([]jobs.Job, error) {
+		return []jobs.Job{makeURLJob(t, "http://foo.com")}, reason.Warn(reason.ValidateFailed(fmt.Errorf("mywarning")))
+	}
+
+	monitorValidator := func(status string) validator.Validator {
+		return lookslike.MustCompile(map[string]interface{}{
+			"monitor": map[string]interface{}{
+				"duration.us": isdef.IsDuration,
+				"id":          testMonFields.ID,
+				"name":        testMonFields.Name,
+				"type":        testMonFields.Type,
+				"status":      status,
+				"check_group": isdef.IsString,
+			},
+		})
+	}
+
+	testCommonWrap(t, testDef{
+		"job warning",
+		testMonFields,
+		[]jobs.Job{warnJob},
+		[]validator.Validator{
+			lookslike.Compose(
+				lookslike.MustCompile(map[string]interface{}{"error": map[string]interface{}{"message": "mywarning", "type": "validate"}}),
+				monitorValidator("warn"),
+				hbtestllext.MonitorTimespanValidator,
+			),
+			lookslike.Compose(
+				urlValidator(t, "http://foo.com"),
+				monitorValidator("up"),
+				hbtestllext.MonitorTimespanValidator,
+				lookslike.MustCompile(map[string]interface{}{
+					"summary": map[string]interface{}{
+						"up":   uint16(2),
+						"down": uint16(0),
+						"warn": uint16(1),
+					},
+				}),
+			)},
+		nil,
+	})
+}
+
 func makeURLJob(t *testing.T, u string) jobs.Job {
 	parsed, err := url.Parse(u)
 	require.NoError(t, err)
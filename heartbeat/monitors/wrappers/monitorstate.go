@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wrappers
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/monitorstate"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// WrapState tracks the state of the monitor across checks, adding the `state` field
+// to the summary event of each check. Depending on the publish mode of the monitor,
+// events not changing the monitor status are cancelled. This must wrap jobs already
+// wrapped with WrapCommon, since it relies on the `summary` field. A nil tracker
+// leaves the jobs as is.
+func WrapState(js []jobs.Job, stdMonFields stdfields.StdMonitorFields, tracker *monitorstate.Tracker) []jobs.Job {
+	if tracker == nil {
+		if stdMonFields.Publish.OnStatusChange() {
+			logp.Warn("monitor %s publishes on status change, but heartbeat.state is not enabled. All events are published.", stdMonFields.ID)
+		}
+		return js
+	}
+	return jobs.WrapAll(js, addMonitorState(stdMonFields.Publish, tracker))
+}
+
+func addMonitorState(publish stdfields.PublishConfig, tracker *monitorstate.Tracker) jobs.JobWrapper {
+	return func(job jobs.Job) jobs.Job {
+		return func(event *beat.Event) ([]jobs.Job, error) {
+			cont, err := job(event)
+
+			summary, _ := event.GetValue("summary")
+			summaryFields, ok := summary.(common.MapStr)
+			if !ok {
+				// Only the summary knows the outcome of the whole check, intermediate
+				// events carry no status change on their own.
+				if publish.OnStatusChange() {
+					eventext.CancelEvent(event)
+				}
+				return cont, err
+			}
+
+			monitorID, _ := event.GetValue("monitor.id")
+			id, _ := monitorID.(string)
+			up, down := summaryCount(summaryFields["up"]), summaryCount(summaryFields["down"])
+			status := monitorstate.StatusUp
+			if down > 0 {
+				status = monitorstate.StatusDown
+			}
+
+			now := time.Now()
+			st, changed := tracker.Record(id, status, up, down, now)
+			eventext.MergeEventFields(event, common.MapStr{
+				"state": st.Fields(tracker.Flapping().Threshold, now),
+			})
+
+			if eventext.IsEventCancelled(event) {
+				return cont, err
+			}
+			if publish.OnStatusChange() && !changed &&
+				(publish.Heartbeat <= 0 || now.Sub(st.LastPublished) < publish.Heartbeat) {
+				eventext.CancelEvent(event)
+				return cont, err
+			}
+			if publish.OnStatusChange() {
+				// The last publication is only needed to publish heartbeats
+				tracker.Published(id, now)
+			}
+
+			return cont, err
+		}
+	}
+}
+
+// summaryCount converts the up and down counts of a summary, which monitors
+// report with different integer types.
+func summaryCount(v interface{}) int {
+	switch n := v.(type) {
+	case uint16:
+		return int(n)
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case uint64:
+		return int(n)
+	default:
+		return 0
+	}
+}
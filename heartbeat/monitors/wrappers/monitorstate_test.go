@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wrappers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/monitorstate"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/storetest"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+// runStateChecks runs one check per given status through the common and state
+// wrappers, returning the events of all checks.
+func runStateChecks(t *testing.T, sf stdfields.StdMonitorFields, tracker *monitorstate.Tracker, statuses ...bool) [][]*beat.Event {
+	var up bool
+	job := func(event *beat.Event) ([]jobs.Job, error) {
+		// Each check has two events, so that we can test intermediate events
+		return []jobs.Job{func(event *beat.Event) ([]jobs.Job, error) {
+			if !up {
+				return nil, errors.New("down")
+			}
+			return nil, nil
+		}}, nil
+	}
+	wrapped := WrapState(WrapCommon([]jobs.Job{job}, sf), sf, tracker)
+
+	var checks [][]*beat.Event
+	for _, status := range statuses {
+		up = status
+		events, err := jobs.ExecJobsAndConts(t, wrapped)
+		require.NoError(t, err)
+		checks = append(checks, events)
+	}
+	return checks
+}
+
+func TestStateFields(t *testing.T) {
+	tracker := monitorstate.NewTracker(nil, monitorstate.DefaultFlappingConfig)
+	checks := runStateChecks(t, testMonFields, tracker, true, true, false)
+
+	stateValidator := func(status string, checks, up, down, flapCount int) validator.Validator {
+		return lookslike.MustCompile(map[string]interface{}{
+			"state": map[string]interface{}{
+				"id":          isdef.IsString,
+				"status":      status,
+				"started_at":  hbtestllext.IsTime,
+				"duration_ms": hbtestllext.IsInt64,
+				"checks":      checks,
+				"up":          up,
+				"down":        down,
+				"flap_count":  flapCount,
+				"flapping":    false,
+			},
+		})
+	}
+
+	for _, check := range checks {
+		require.Len(t, check, 2)
+		_, err := check[0].GetValue("state")
+		require.Error(t, err, "intermediate events should have no state")
+	}
+	testslike.Test(t, stateValidator("up", 1, 2, 0, 0), checks[0][1].Fields)
+	testslike.Test(t, stateValidator("up", 2, 4, 0, 0), checks[1][1].Fields)
+	testslike.Test(t, stateValidator("down", 1, 1, 1, 1), checks[2][1].Fields)
+
+	upID, _ := checks[1][1].GetValue("state.id")
+	downID, _ := checks[2][1].GetValue("state.id")
+	require.NotEqual(t, upID, downID)
+}
+
+func TestStatePublishStatusChange(t *testing.T) {
+	sf := testMonFields
+	sf.Publish = stdfields.PublishConfig{Mode: stdfields.PublishStatusChange}
+	tracker := monitorstate.NewTracker(nil, monitorstate.DefaultFlappingConfig)
+	checks := runStateChecks(t, sf, tracker, true, true, false, false, true)
+
+	var published []bool
+	for _, check := range checks {
+		require.True(t, eventext.IsEventCancelled(check[0]), "intermediate events should be cancelled")
+		published = append(published, !eventext.IsEventCancelled(check[1]))
+	}
+	require.Equal(t, []bool{true, false, true, false, true}, published)
+}
+
+func TestStatePublishHeartbeat(t *testing.T) {
+	sf := testMonFields
+	sf.Publish = stdfields.PublishConfig{Mode: stdfields.PublishStatusChange, Heartbeat: time.Nanosecond}
+	tracker := monitorstate.NewTracker(nil, monitorstate.DefaultFlappingConfig)
+	checks := runStateChecks(t, sf, tracker, true, true)
+
+	// The heartbeat interval elapsed since the first check, so the second is published
+	require.False(t, eventext.IsEventCancelled(checks[1][1]))
+}
+
+func TestStatePublishAll(t *testing.T) {
+	tracker := monitorstate.NewTracker(nil, monitorstate.DefaultFlappingConfig)
+	checks := runStateChecks(t, testMonFields, tracker, true, true)
+
+	for _, check := range checks {
+		for _, event := range check {
+			require.False(t, eventext.IsEventCancelled(event))
+		}
+	}
+}
+
+func TestStatePublishAllNotPersisted(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	store, err := registry.Get("heartbeat")
+	require.NoError(t, err)
+	defer store.Close()
+
+	tracker := monitorstate.NewTracker(store, monitorstate.DefaultFlappingConfig)
+	runStateChecks(t, testMonFields, tracker, true)
+
+	// The time of the last publication is only tracked on status_change mode
+	restarted := monitorstate.NewTracker(store, monitorstate.DefaultFlappingConfig)
+	st, changed := restarted.Record(testMonFields.ID, monitorstate.StatusUp, 1, 0, time.Now())
+	require.False(t, changed)
+	require.True(t, st.LastPublished.IsZero())
+}
+
+func TestStateNilTracker(t *testing.T) {
+	checks := runStateChecks(t, testMonFields, nil, true)
+
+	_, err := checks[0][1].GetValue("state")
+	require.Error(t, err)
+}
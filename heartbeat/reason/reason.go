@@ -17,7 +17,11 @@
 
 package reason
 
-import "github.com/elastic/beats/v7/libbeat/common"
+import (
+	"errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
 
 type Reason interface {
 	error
@@ -33,6 +37,11 @@ type IOError struct {
 	err error
 }
 
+// WarnError wraps the Reason of a failed check that does not make the monitor down.
+type WarnError struct {
+	Reason
+}
+
 func ValidateFailed(err error) Reason {
 	if err == nil {
 		return nil
@@ -47,6 +56,20 @@ func IOFailed(err error) Reason {
 	return IOError{err}
 }
 
+// Warn marks the given Reason as a warning.
+func Warn(r Reason) Reason {
+	if r == nil {
+		return nil
+	}
+	return WarnError{r}
+}
+
+// IsWarning reports whether the error is a warning, rather than a failure.
+func IsWarning(err error) bool {
+	var warn WarnError
+	return errors.As(err, &warn)
+}
+
 func (e ValidateError) Error() string { return e.err.Error() }
 func (e ValidateError) Unwrap() error { return e.err }
 func (ValidateError) Type() string    { return "validate" }
@@ -55,6 +78,8 @@ func (e IOError) Error() string { return e.err.Error() }
 func (e IOError) Unwrap() error { return e.err }
 func (IOError) Type() string    { return "io" }
 
+func (e WarnError) Unwrap() error { return e.Reason }
+
 func FailError(typ string, err error) common.MapStr {
 	return common.MapStr{
 		"type":    typ,
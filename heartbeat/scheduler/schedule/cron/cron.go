@@ -18,12 +18,24 @@
 package cron
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gorhill/cronexpr"
 )
 
-type Schedule cronexpr.Expression
+// Schedule is a cron expression, evaluated in an optional time zone.
+type Schedule struct {
+	expr     *cronexpr.Expression
+	location *time.Location
+	// until is the last time the schedule can fire, if not zero.
+	until time.Time
+}
+
+// timeZonePrefixes are the prefixes setting the time zone of an expression,
+// as in `CRON_TZ=Europe/Paris 0 9 * * *`.
+var timeZonePrefixes = []string{"CRON_TZ=", "TZ="}
 
 func MustParse(in string) *Schedule {
 	s, err := Parse(in)
@@ -33,14 +45,60 @@ func MustParse(in string) *Schedule {
 	return s
 }
 
+// Parse parses a cron expression, optionally prefixed by the time zone it is
+// evaluated in. Without time zone, the expression is evaluated in the time zone
+// of the times passed to Next.
 func Parse(in string) (*Schedule, error) {
+	var location *time.Location
+	in = strings.TrimSpace(in)
+	for _, prefix := range timeZonePrefixes {
+		if !strings.HasPrefix(in, prefix) {
+			continue
+		}
+		fields := strings.SplitN(in[len(prefix):], " ", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("missing cron expression after time zone in '%s'", in)
+		}
+		var err error
+		location, err = time.LoadLocation(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone in '%s': %w", in, err)
+		}
+		in = fields[1]
+		break
+	}
+
 	expr, err := cronexpr.Parse(in)
-	return (*Schedule)(expr), err
+	if err != nil {
+		return nil, err
+	}
+	return &Schedule{expr: expr, location: location}, nil
 }
 
+// In returns a copy of the schedule evaluated in the given time zone.
+func (s *Schedule) In(location *time.Location) *Schedule {
+	c := *s
+	c.location = location
+	return &c
+}
+
+// Location returns the time zone the schedule is evaluated in, nil if it
+// follows the time zone of the times passed to Next.
+func (s *Schedule) Location() *time.Location {
+	return s.location
+}
+
+// Next returns the first time the schedule fires after t, or the zero time
+// if it never fires again.
 func (s *Schedule) Next(t time.Time) time.Time {
-	expr := (*cronexpr.Expression)(s)
-	return expr.Next(t)
+	if s.location != nil {
+		t = t.In(s.location)
+	}
+	next := s.expr.Next(t)
+	if !s.until.IsZero() && next.After(s.until) {
+		return time.Time{}
+	}
+	return next
 }
 
 func (s *Schedule) Unpack(str string) error {
@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestTimeZone(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	from := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		t.Run(prefix, func(t *testing.T) {
+			s, err := Parse(prefix + "Europe/Paris 0 9 * * *")
+			require.NoError(t, err)
+			require.Equal(t, paris, s.Location())
+
+			next := s.Next(from)
+			assert.True(t, time.Date(2021, 6, 2, 9, 0, 0, 0, paris).Equal(next), "got %v", next)
+		})
+	}
+
+	t.Run("In", func(t *testing.T) {
+		next := MustParse("0 9 * * *").In(paris).Next(from)
+		assert.True(t, time.Date(2021, 6, 2, 9, 0, 0, 0, paris).Equal(next), "got %v", next)
+	})
+
+	t.Run("without time zone", func(t *testing.T) {
+		next := MustParse("0 9 * * *").Next(from)
+		assert.True(t, time.Date(2021, 6, 2, 9, 0, 0, 0, time.UTC).Equal(next), "got %v", next)
+	})
+
+	for _, in := range []string{"TZ=Nowhere/Special 0 9 * * *", "CRON_TZ=UTC"} {
+		_, err := Parse(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	from := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC) // a Tuesday
+
+	tests := []struct {
+		rule string
+		want []time.Time
+	}{
+		{
+			"FREQ=HOURLY;BYMINUTE=30",
+			[]time.Time{time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC), time.Date(2021, 6, 1, 13, 30, 0, 0, time.UTC)},
+		},
+		{
+			"RRULE:FREQ=DAILY;BYHOUR=2,14",
+			[]time.Time{time.Date(2021, 6, 1, 14, 0, 0, 0, time.UTC), time.Date(2021, 6, 2, 2, 0, 0, 0, time.UTC)},
+		},
+		{
+			"FREQ=WEEKLY;BYDAY=SU;BYHOUR=3;BYMINUTE=15",
+			[]time.Time{time.Date(2021, 6, 6, 3, 15, 0, 0, time.UTC), time.Date(2021, 6, 13, 3, 15, 0, 0, time.UTC)},
+		},
+		{
+			"FREQ=MONTHLY;INTERVAL=1",
+			[]time.Time{time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25",
+			[]time.Time{time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC), time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"FREQ=DAILY;BYHOUR=1;UNTIL=20210602T120000Z",
+			[]time.Time{time.Date(2021, 6, 2, 1, 0, 0, 0, time.UTC), {}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			s, err := ParseRRule(tt.rule)
+			require.NoError(t, err)
+
+			next := from
+			for _, want := range tt.want {
+				next = s.Next(next)
+				assert.True(t, want.Equal(next), "want %v, got %v", want, next)
+			}
+		})
+	}
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	tests := map[string]string{
+		"":                                    "invalid rule part",
+		"BYHOUR=1":                            "FREQ is required",
+		"FREQ=MINUTELY":                       "FREQ MINUTELY is not supported",
+		"FREQ=DAILY;INTERVAL=2":               "only an INTERVAL of 1 is supported",
+		"FREQ=DAILY;COUNT=3":                  "COUNT is not supported",
+		"FREQ=WEEKLY":                         "WEEKLY requires BYDAY",
+		"FREQ=MONTHLY;BYDAY=1MO":              "ordinal weekdays are not supported",
+		"FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=13": "can't be combined",
+		"FREQ=DAILY;BYHOUR=24":                "not a number between 0 and 23",
+		"FREQ=DAILY;UNTIL=tomorrow":           "invalid UNTIL",
+	}
+
+	for rule, errMsg := range tests {
+		_, err := ParseRRule(rule)
+		if assert.Error(t, err, rule) {
+			assert.Contains(t, err.Error(), errMsg, rule)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorhill/cronexpr"
+)
+
+// rruleWeekdays maps the RRULE weekdays to cron day of week numbers.
+var rruleWeekdays = map[string]string{
+	"SU": "0", "MO": "1", "TU": "2", "WE": "3", "TH": "4", "FR": "5", "SA": "6",
+}
+
+// ParseRRule parses the subset of iCalendar recurrence rules (RFC 5545) that
+// can be expressed as cron expressions: HOURLY, DAILY, WEEKLY, MONTHLY and
+// YEARLY frequencies without INTERVAL or COUNT, restricted by BYMONTH,
+// BYMONTHDAY, BYDAY (without ordinals), BYHOUR, BYMINUTE and BYSECOND, and
+// ending at an optional UNTIL. Unrestricted time parts default to zero, as
+// there's no DTSTART to derive them from. The `RRULE:` prefix is optional.
+func ParseRRule(in string) (*Schedule, error) {
+	rule := strings.TrimPrefix(strings.TrimSpace(in), "RRULE:")
+
+	parts := map[string]string{}
+	for _, part := range strings.Split(rule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid rule part '%s' in '%s'", part, in)
+		}
+		parts[strings.ToUpper(kv[0])] = strings.ToUpper(kv[1])
+	}
+
+	s := &Schedule{}
+	fields := struct{ sec, min, hour, dom, month, dow string }{"0", "0", "0", "*", "*", "*"}
+	for name, value := range parts {
+		var err error
+		switch name {
+		case "FREQ":
+		case "INTERVAL":
+			if value != "1" {
+				err = fmt.Errorf("only an INTERVAL of 1 is supported")
+			}
+		case "UNTIL":
+			s.until, err = parseRRuleTime(value)
+		case "BYSECOND":
+			fields.sec, err = rruleNumbers(value, 0, 59)
+		case "BYMINUTE":
+			fields.min, err = rruleNumbers(value, 0, 59)
+		case "BYHOUR":
+			fields.hour, err = rruleNumbers(value, 0, 23)
+		case "BYMONTHDAY":
+			fields.dom, err = rruleNumbers(value, 1, 31)
+		case "BYMONTH":
+			fields.month, err = rruleNumbers(value, 1, 12)
+		case "BYDAY":
+			fields.dow, err = rruleWeekdayList(value)
+		default:
+			err = fmt.Errorf("%s is not supported", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule '%s': %w", in, err)
+		}
+	}
+
+	// Cron matches either the day of month or the day of week when both are
+	// restricted, while recurrence rules require both to match.
+	if fields.dom != "*" && fields.dow != "*" {
+		return nil, fmt.Errorf("invalid rule '%s': BYMONTHDAY and BYDAY can't be combined", in)
+	}
+
+	switch freq := parts["FREQ"]; freq {
+	case "HOURLY":
+		if _, ok := parts["BYHOUR"]; !ok {
+			fields.hour = "*"
+		}
+	case "DAILY":
+	case "WEEKLY":
+		if fields.dow == "*" {
+			return nil, fmt.Errorf("invalid rule '%s': WEEKLY requires BYDAY", in)
+		}
+	case "MONTHLY":
+		if fields.dom == "*" && fields.dow == "*" {
+			fields.dom = "1"
+		}
+	case "YEARLY":
+		if fields.month == "*" {
+			fields.month = "1"
+		}
+		if fields.dom == "*" && fields.dow == "*" {
+			fields.dom = "1"
+		}
+	case "":
+		return nil, fmt.Errorf("invalid rule '%s': FREQ is required", in)
+	default:
+		return nil, fmt.Errorf("invalid rule '%s': FREQ %s is not supported", in, freq)
+	}
+
+	expr, err := cronexpr.Parse(strings.Join([]string{
+		fields.sec, fields.min, fields.hour, fields.dom, fields.month, fields.dow, "*",
+	}, " "))
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule '%s': %w", in, err)
+	}
+	s.expr = expr
+	return s, nil
+}
+
+// rruleNumbers validates a comma separated list of numbers within [min, max].
+func rruleNumbers(value string, min, max int) (string, error) {
+	for _, v := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < min || n > max {
+			return "", fmt.Errorf("'%s' is not a number between %d and %d", v, min, max)
+		}
+	}
+	return value, nil
+}
+
+func rruleWeekdayList(value string) (string, error) {
+	var days []string
+	for _, v := range strings.Split(value, ",") {
+		day, ok := rruleWeekdays[v]
+		if !ok {
+			return "", fmt.Errorf("'%s' is not a weekday, ordinal weekdays are not supported", v)
+		}
+		days = append(days, day)
+	}
+	return strings.Join(days, ","), nil
+}
+
+// parseRRuleTime parses an UNTIL date or date-time, interpreted in UTC.
+func parseRRuleTime(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL '%s'", value)
+}
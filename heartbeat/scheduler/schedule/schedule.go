@@ -19,6 +19,7 @@ package schedule
 
 import (
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 
@@ -30,6 +31,15 @@ type Schedule struct {
 	scheduler.Schedule
 }
 
+// NextAfterInit returns the run following the run on init, which is Next unless
+// the underlying schedule is a scheduler.InitSchedule.
+func (s *Schedule) NextAfterInit(t time.Time) time.Time {
+	if initSched, ok := s.Schedule.(scheduler.InitSchedule); ok {
+		return initSched.NextAfterInit(t)
+	}
+	return s.Next(t)
+}
+
 // intervalScheduler defines a schedule that runs at fixed intervals.
 type intervalScheduler struct {
 	interval time.Duration
@@ -81,3 +91,81 @@ func (s *Schedule) Unpack(str string) error {
 	}
 	return err
 }
+
+// WithJitter returns the schedule shifted by a deterministic offset derived
+// from the monitor ID, spreading the monitors sharing a schedule over time.
+// Interval schedules run at fixed offsets from the epoch, within the interval
+// or the given maximum, whichever is lower. Cron schedules are only delayed
+// if a maximum is given. A nil maximum applies the defaults, while a zero
+// maximum disables jitter.
+func (s *Schedule) WithJitter(id string, max *time.Duration) *Schedule {
+	if max != nil && *max <= 0 {
+		return s
+	}
+
+	switch sched := s.Schedule.(type) {
+	case intervalScheduler:
+		if sched.interval <= 0 {
+			return s
+		}
+		window := sched.interval
+		if max != nil && *max < window {
+			window = *max
+		}
+		return &Schedule{phasedIntervalScheduler{
+			interval: sched.interval,
+			offset:   jitterOffset(id, window),
+		}}
+	default:
+		if max == nil {
+			return s
+		}
+		return &Schedule{offsetScheduler{Schedule: sched, offset: jitterOffset(id, *max)}}
+	}
+}
+
+// jitterOffset hashes the ID into an offset within [0, window).
+func jitterOffset(id string, window time.Duration) time.Duration {
+	h := fnv.New64a()
+	h.Write([]byte(id))
+	return time.Duration(h.Sum64() % uint64(window))
+}
+
+// phasedIntervalScheduler runs at fixed intervals, at a given offset from the
+// epoch, so that the run times don't depend on when the monitor started.
+type phasedIntervalScheduler struct {
+	interval time.Duration
+	offset   time.Duration
+}
+
+// RunOnInit returns true for interval schedulers.
+func (s phasedIntervalScheduler) RunOnInit() bool {
+	return true
+}
+
+// Next returns the first phased run time after t.
+func (s phasedIntervalScheduler) Next(t time.Time) time.Time {
+	n := t.UnixNano() - int64(s.offset)
+	next := n - n%int64(s.interval) + int64(s.interval) + int64(s.offset)
+	return time.Unix(0, next).In(t.Location())
+}
+
+// NextAfterInit returns the first phased run time more than half an interval
+// after the run on init, so that it isn't directly followed by another run.
+func (s phasedIntervalScheduler) NextAfterInit(t time.Time) time.Time {
+	return s.Next(t.Add(s.interval / 2))
+}
+
+// offsetScheduler delays all the run times of a schedule by an offset.
+type offsetScheduler struct {
+	scheduler.Schedule
+	offset time.Duration
+}
+
+func (s offsetScheduler) Next(t time.Time) time.Time {
+	next := s.Schedule.Next(t.Add(-s.offset))
+	if next.IsZero() {
+		return next
+	}
+	return next.Add(s.offset)
+}
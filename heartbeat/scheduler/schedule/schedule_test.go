@@ -18,10 +18,13 @@
 package schedule
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule/cron"
 )
@@ -161,3 +164,68 @@ func TestSchedule_Unpack(t *testing.T) {
 		})
 	}
 }
+
+func TestWithJitter(t *testing.T) {
+	durationPtr := func(d time.Duration) *time.Duration { return &d }
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("interval", func(t *testing.T) {
+		s := MustParse("@every 1m").WithJitter("my-monitor", nil)
+		require.True(t, s.RunOnInit())
+
+		phased, ok := s.Schedule.(phasedIntervalScheduler)
+		require.True(t, ok)
+		require.Equal(t, time.Minute, phased.interval)
+		require.True(t, phased.offset >= 0 && phased.offset < time.Minute)
+
+		// Runs are aligned on the offset, independently of the start time
+		next := s.Next(now)
+		require.Equal(t, phased.offset, time.Duration(next.UnixNano()%int64(time.Minute)))
+		require.True(t, next.After(now) && next.Sub(now) <= time.Minute, "next run in %v", next.Sub(now))
+		require.Equal(t, next.Add(time.Minute), s.Next(next))
+		require.Equal(t, next.Add(time.Minute), s.Next(next.Add(time.Millisecond)))
+		// Runs starting late don't skip the following slot
+		require.Equal(t, next.Add(time.Minute), s.Next(next.Add(45*time.Second)))
+
+		// The run on init is followed by a run more than half an interval later
+		afterInit := s.NextAfterInit(now)
+		require.Equal(t, phased.offset, time.Duration(afterInit.UnixNano()%int64(time.Minute)))
+		require.True(t, afterInit.Sub(now) > 30*time.Second && afterInit.Sub(now) <= 90*time.Second, "next run in %v", afterInit.Sub(now))
+
+		// The offset is deterministic
+		require.Equal(t, s, MustParse("@every 1m").WithJitter("my-monitor", nil))
+	})
+
+	t.Run("spreads monitors", func(t *testing.T) {
+		offsets := map[time.Duration]bool{}
+		for i := 0; i < 100; i++ {
+			s := MustParse("@every 1h").WithJitter(fmt.Sprintf("monitor-%d", i), nil)
+			offsets[s.Schedule.(phasedIntervalScheduler).offset/time.Minute] = true
+		}
+		require.Greater(t, len(offsets), 30)
+	})
+
+	t.Run("interval with max", func(t *testing.T) {
+		s := MustParse("@every 1h").WithJitter("my-monitor", durationPtr(time.Second))
+		require.Less(t, int64(s.Schedule.(phasedIntervalScheduler).offset), int64(time.Second))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := MustParse("@every 1m")
+		require.Equal(t, s, s.WithJitter("my-monitor", durationPtr(0)))
+	})
+
+	t.Run("cron", func(t *testing.T) {
+		s := MustParse("0 * * * *")
+		require.Equal(t, s, s.WithJitter("my-monitor", nil))
+
+		jittered := s.WithJitter("my-monitor", durationPtr(time.Minute))
+		require.False(t, jittered.RunOnInit())
+		offset := jittered.Schedule.(offsetScheduler).offset
+		require.True(t, offset >= 0 && offset < time.Minute)
+
+		next := jittered.Next(now)
+		require.Equal(t, now.Add(offset), next)
+		require.Equal(t, next.Add(time.Hour), jittered.Next(next))
+	})
+}
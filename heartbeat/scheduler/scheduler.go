@@ -78,6 +78,13 @@ type Schedule interface {
 	RunOnInit() bool
 }
 
+// InitSchedule is implemented by schedules running on init whose first run after the run
+// on init differs from Next, e.g. to avoid running twice in a short period.
+type InitSchedule interface {
+	// NextAfterInit returns the runAt following the run on init, started at the given runAt.
+	NextAfterInit(initRanAt time.Time) time.Time
+}
+
 func getJobLimitSem(jobLimitByType map[string]config.JobLimit) map[string]*semaphore.Weighted {
 	jobLimitSem := map[string]*semaphore.Weighted{}
 	for jobType, jobLimit := range jobLimitByType {
@@ -198,7 +205,8 @@ func (s *Scheduler) Add(sched Schedule, id string, entrypoint TaskFunc, jobType
 
 	var taskFn timerqueue.TimerTaskFn
 
-	taskFn = func(_ time.Time) {
+	// runJob runs the job and schedules its next run, as returned by next
+	runJob := func(next func(time.Time) time.Time) {
 		select {
 		case <-jobCtx.Done():
 			debugf("Job '%v' canceled", id)
@@ -209,16 +217,24 @@ func (s *Scheduler) Add(sched Schedule, id string, entrypoint TaskFunc, jobType
 		debugf("Job '%s' started", id)
 		lastRanAt := newSchedJob(jobCtx, s, id, jobType, entrypoint).run()
 		s.stats.activeJobs.Dec()
-		s.runOnce(sched.Next(lastRanAt), taskFn)
+		s.runOnce(next(lastRanAt), taskFn)
 		debugf("Job '%v' returned at %v", id, time.Now())
 	}
 
+	taskFn = func(_ time.Time) {
+		runJob(sched.Next)
+	}
+
 	// We skip using the scheduler to execute the initial tasks for jobs that have RunOnInit returning true.
 	// You might think it'd be simpler to just invoke runOnce in either case with 0 as a lastRanAt value,
 	// however, that would caused the missed deadline stats to be incremented. Given that, it's easier
 	// and slightly more efficient to simply run these tasks immediately in a goroutine.
 	if sched.RunOnInit() {
-		go taskFn(time.Now())
+		nextAfterInit := sched.Next
+		if initSched, ok := sched.(InitSchedule); ok {
+			nextAfterInit = initSched.NextAfterInit
+		}
+		go runJob(nextAfterInit)
 	} else {
 		s.runOnce(sched.Next(lastRanAt), taskFn)
 	}
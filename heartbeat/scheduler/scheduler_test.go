@@ -160,6 +160,47 @@ func TestScheduler_Start(t *testing.T) {
 	assert.Equal(t, int(removedEvents), int(counts["removed"]))
 }
 
+// initTestSchedule runs again right after the run on init, then never again.
+type initTestSchedule struct{}
+
+func (initTestSchedule) RunOnInit() bool {
+	return true
+}
+
+func (initTestSchedule) Next(now time.Time) time.Time {
+	return now.Add(time.Hour)
+}
+
+func (initTestSchedule) NextAfterInit(initRanAt time.Time) time.Time {
+	return initRanAt
+}
+
+func TestScheduler_NextAfterInit(t *testing.T) {
+	s := NewWithLocation(10, monitoring.NewRegistry(), tarawaTime(), nil)
+	defer s.Stop()
+	require.NoError(t, s.Start())
+
+	executed := make(chan struct{}, 3)
+	_, err := s.Add(initTestSchedule{}, "init", func(_ context.Context) []TaskFunc {
+		executed <- struct{}{}
+		return nil
+	}, "http")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-executed:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timed out waiting for the run after the run on init")
+		}
+	}
+	select {
+	case <-executed:
+		require.Fail(t, "the run after the run on init was followed by an unscheduled run")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestScheduler_Stop(t *testing.T) {
 	s := NewWithLocation(10, monitoring.NewRegistry(), tarawaTime(), nil)
 
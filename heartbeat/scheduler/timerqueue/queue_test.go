@@ -129,3 +129,30 @@ func TestQueueRunsTasksAddedAfterStart(t *testing.T) {
 		require.Equal(t, 1, r)
 	}
 }
+
+// BenchmarkSpreadTasks measures a queue holding thousands of monitors, spread over an
+// interval as with scheduling jitter, each rescheduled once run.
+func BenchmarkSpreadTasks(b *testing.B) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+	tq := NewTimerQueue(ctx)
+	tq.Start()
+
+	const numMonitors = 5000
+	interval := 10 * time.Millisecond
+	done := make(chan struct{}, numMonitors)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		start := time.Now()
+		for j := 0; j < numMonitors; j++ {
+			offset := time.Duration(j) * interval / numMonitors
+			tq.Push(start.Add(offset), func(now time.Time) {
+				tq.Push(now.Add(interval), func(_ time.Time) { done <- struct{}{} })
+			})
+		}
+		for j := 0; j < numMonitors; j++ {
+			<-done
+		}
+	}
+}
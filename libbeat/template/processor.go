@@ -420,7 +420,7 @@ func (p *Processor) object(f *mapping.Field) common.MapStr {
 		case "byte", "double", "float", "long", "short", "boolean":
 			dynProperties["type"] = otp.ObjectType
 			matchingType = matchType(otp.ObjectType, otp.ObjectTypeMappingType)
-		case "histogram":
+		case "histogram", "date":
 			dynProperties["type"] = otp.ObjectType
 			matchingType = matchType("*", otp.ObjectTypeMappingType)
 		default:
@@ -414,6 +414,21 @@ func TestDynamicTemplates(t *testing.T) {
 				},
 			},
 		},
+		{
+			field: mapping.Field{
+				Type: "object", ObjectType: "date",
+				Name: "series.*.created",
+			},
+			expected: []common.MapStr{
+				common.MapStr{
+					"series.*.created": common.MapStr{
+						"mapping":            common.MapStr{"type": "date"},
+						"match_mapping_type": "*",
+						"path_match":         "series.*.created",
+					},
+				},
+			},
+		},
 		{
 			field: mapping.Field{
 				Type: "object", ObjectType: "scaled_float",
@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+)
+
+var (
+	defaultScrapeInterval  = 10 * time.Second
+	defaultMetricsPath     = "/metrics"
+	defaultScheme          = "http"
+	defaultRefreshInterval = 5 * time.Minute
+)
+
+// Config for the prometheus autodiscover provider
+type Config struct {
+	// Prometheus compatible scrape configurations
+	ScrapeConfigs []ScrapeConfig `config:"scrape_configs" validate:"required"`
+
+	// Settings added to the prometheus module configuration of all the targets
+	Settings *common.Config `config:"settings"`
+}
+
+// ScrapeConfig is a subset of the scrape configuration of Prometheus
+type ScrapeConfig struct {
+	JobName        string              `config:"job_name" validate:"required"`
+	ScrapeInterval time.Duration       `config:"scrape_interval" validate:"positive,nonzero"`
+	ScrapeTimeout  time.Duration       `config:"scrape_timeout" validate:"positive"`
+	MetricsPath    string              `config:"metrics_path"`
+	Scheme         string              `config:"scheme"`
+	Params         map[string][]string `config:"params"`
+
+	StaticConfigs []TargetGroup  `config:"static_configs"`
+	FileSDConfigs []FileSDConfig `config:"file_sd_configs"`
+
+	// Relabeling rules applied to the labels of the targets
+	RelabelConfigs []p.RelabelConfig `config:"relabel_configs"`
+
+	// Relabeling rules applied to the scraped series, they are passed
+	// to the prometheus module
+	MetricRelabelConfigs []*common.Config `config:"metric_relabel_configs"`
+}
+
+// TargetGroup is a group of targets with a common set of labels
+type TargetGroup struct {
+	Targets []string          `config:"targets" json:"targets" yaml:"targets"`
+	Labels  map[string]string `config:"labels" json:"labels" yaml:"labels"`
+}
+
+// FileSDConfig configures the discovery of targets from files
+type FileSDConfig struct {
+	// Patterns of the JSON or YAML files with target groups
+	Files []string `config:"files" validate:"required"`
+
+	// Time between reads of the files, in case a change was missed
+	RefreshInterval time.Duration `config:"refresh_interval" validate:"positive,nonzero"`
+}
+
+// Unpack unpacks a scrape configuration, setting its defaults
+func (c *ScrapeConfig) Unpack(from *common.Config) error {
+	type tmpConfig ScrapeConfig
+	tmp := tmpConfig{
+		ScrapeInterval: defaultScrapeInterval,
+		MetricsPath:    defaultMetricsPath,
+		Scheme:         defaultScheme,
+	}
+	if err := from.Unpack(&tmp); err != nil {
+		return err
+	}
+
+	*c = ScrapeConfig(tmp)
+	return nil
+}
+
+// Validate validates the scrape configuration
+func (c *ScrapeConfig) Validate() error {
+	if c.Scheme != "http" && c.Scheme != "https" {
+		return fmt.Errorf("invalid scheme '%s' in scrape config '%s'", c.Scheme, c.JobName)
+	}
+	if c.ScrapeTimeout > c.ScrapeInterval {
+		return fmt.Errorf("scrape_timeout greater than scrape_interval in scrape config '%s'", c.JobName)
+	}
+	if len(c.StaticConfigs) == 0 && len(c.FileSDConfigs) == 0 {
+		return fmt.Errorf("no static_configs or file_sd_configs in scrape config '%s'", c.JobName)
+	}
+	for _, rule := range c.MetricRelabelConfigs {
+		var relabel p.RelabelConfig
+		if err := rule.Unpack(&relabel); err != nil {
+			return errors.Wrapf(err, "invalid metric_relabel_configs in scrape config '%s'", c.JobName)
+		}
+	}
+	return nil
+}
+
+// Unpack unpacks a file discovery configuration, setting its defaults
+func (c *FileSDConfig) Unpack(from *common.Config) error {
+	type tmpConfig FileSDConfig
+	tmp := tmpConfig{
+		RefreshInterval: defaultRefreshInterval,
+	}
+	if err := from.Unpack(&tmp); err != nil {
+		return err
+	}
+
+	*c = FileSDConfig(tmp)
+	return nil
+}
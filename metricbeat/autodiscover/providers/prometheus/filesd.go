@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// fileWatcher notifies when the files used for service discovery may have changed.
+// Directories containing the files are watched for changes, and a notification is
+// also sent periodically in case some change was missed.
+type fileWatcher struct {
+	patterns []string
+	refresh  time.Duration
+	logger   *logp.Logger
+
+	watcher *fsnotify.Watcher
+	changes chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newFileWatcher(patterns []string, refresh time.Duration, logger *logp.Logger) (*fileWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create file watcher")
+	}
+
+	dirs := map[string]bool{}
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			watcher.Close()
+			return nil, errors.Wrapf(err, "invalid file pattern '%s'", pattern)
+		}
+		dirs[filepath.Dir(pattern)] = true
+	}
+	for dir := range dirs {
+		// Directories can be created later, files will be read then on refresh
+		if err := watcher.Add(dir); err != nil {
+			logger.Warnf("Cannot watch directory '%s' for changes: %v", dir, err)
+		}
+	}
+
+	return &fileWatcher{
+		patterns: patterns,
+		refresh:  refresh,
+		logger:   logger,
+		watcher:  watcher,
+		changes:  make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// C returns a channel that receives a value when the files may have changed
+func (w *fileWatcher) C() <-chan struct{} {
+	return w.changes
+}
+
+// Start starts watching the files
+func (w *fileWatcher) Start() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.refresh)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.done:
+				return
+			case <-ticker.C:
+				w.notify()
+			case event := <-w.watcher.Events:
+				if w.matches(event.Name) {
+					w.logger.Debugf("File '%s' changed: %s", event.Name, event.Op)
+					w.notify()
+				}
+			case err := <-w.watcher.Errors:
+				w.logger.Errorf("Error watching files: %v", err)
+			}
+		}
+	}()
+}
+
+// Stop stops watching the files
+func (w *fileWatcher) Stop() {
+	close(w.done)
+	w.wg.Wait()
+	w.watcher.Close()
+}
+
+func (w *fileWatcher) matches(path string) bool {
+	for _, pattern := range w.patterns {
+		if matched, _ := filepath.Match(pattern, path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// notify sends a notification without blocking, pending notifications are
+// coalesced as all of them trigger the same full read of the files
+func (w *fileWatcher) notify() {
+	select {
+	case w.changes <- struct{}{}:
+	default:
+	}
+}
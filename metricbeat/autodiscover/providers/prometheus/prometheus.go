@@ -0,0 +1,192 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("prometheus", AutodiscoverBuilder)
+}
+
+// Provider is the prometheus autodiscover provider, it discovers targets from
+// Prometheus compatible scrape configurations and starts a prometheus module
+// to scrape each one of them
+type Provider struct {
+	config *Config
+	bus    bus.Bus
+	uuid   uuid.UUID
+	logger *logp.Logger
+
+	watcher *fileWatcher
+
+	mutex   sync.Mutex
+	targets map[string]*target
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// AutodiscoverBuilder builds a prometheus autodiscover provider, it fails if
+// there is some problem with the configuration
+func AutodiscoverBuilder(
+	beatName string,
+	bus bus.Bus,
+	uuid uuid.UUID,
+	c *common.Config,
+	keystore keystore.Keystore,
+) (autodiscover.Provider, error) {
+	cfgwarn.Beta("The prometheus autodiscover provider is beta")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up prometheus autodiscover provider")
+	}
+
+	var config Config
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	logger := logp.NewLogger("autodiscover.prometheus")
+	var patterns []string
+	var refresh time.Duration
+	for _, scrapeConfig := range config.ScrapeConfigs {
+		for _, fileSD := range scrapeConfig.FileSDConfigs {
+			patterns = append(patterns, fileSD.Files...)
+			if refresh == 0 || fileSD.RefreshInterval < refresh {
+				refresh = fileSD.RefreshInterval
+			}
+		}
+	}
+
+	var watcher *fileWatcher
+	if len(patterns) > 0 {
+		var err error
+		watcher, err = newFileWatcher(patterns, refresh, logger)
+		if err != nil {
+			return nil, errWrap(err)
+		}
+	}
+
+	return &Provider{
+		config:  &config,
+		bus:     bus,
+		uuid:    uuid,
+		logger:  logger,
+		watcher: watcher,
+		targets: map[string]*target{},
+		done:    make(chan struct{}),
+	}, nil
+}
+
+// Start starts autodiscover provider
+func (p *Provider) Start() {
+	p.sync()
+	if p.watcher == nil {
+		return
+	}
+
+	p.watcher.Start()
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-p.watcher.C():
+				p.sync()
+			}
+		}
+	}()
+}
+
+// Stop stops autodiscover provider
+func (p *Provider) Stop() {
+	close(p.done)
+	if p.watcher != nil {
+		p.watcher.Stop()
+	}
+	p.wg.Wait()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for id, t := range p.targets {
+		p.publish(t, false)
+		delete(p.targets, id)
+	}
+}
+
+// String returns the name of the provider
+func (p *Provider) String() string {
+	return "prometheus"
+}
+
+// sync discovers the current targets, and starts or stops the modules of the
+// targets that appeared or disappeared since the last time
+func (p *Provider) sync() {
+	current := map[string]*target{}
+	for i := range p.config.ScrapeConfigs {
+		for _, t := range p.config.ScrapeConfigs[i].targets(p.config.Settings, p.logger) {
+			current[t.id] = t
+		}
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for id, t := range p.targets {
+		if _, found := current[id]; !found {
+			p.logger.Debugf("Target '%s' removed", t.address)
+			p.publish(t, false)
+			delete(p.targets, id)
+		}
+	}
+	for id, t := range current {
+		if _, found := p.targets[id]; !found {
+			p.logger.Debugf("Target '%s' discovered", t.address)
+			p.publish(t, true)
+			p.targets[id] = t
+		}
+	}
+}
+
+func (p *Provider) publish(t *target, start bool) {
+	event := bus.Event{
+		"provider": p.uuid,
+		"id":       t.id,
+		"host":     t.address,
+		"config":   []*common.Config{t.config},
+	}
+	if start {
+		event["start"] = true
+	} else {
+		event["stop"] = true
+	}
+	p.bus.Publish(event)
+}
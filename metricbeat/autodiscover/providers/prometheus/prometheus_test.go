@@ -0,0 +1,224 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func scrapeConfig(t *testing.T, config map[string]interface{}) ScrapeConfig {
+	var c ScrapeConfig
+	require.NoError(t, common.MustNewConfigFrom(config).Unpack(&c))
+	return c
+}
+
+func TestTargets(t *testing.T) {
+	c := scrapeConfig(t, map[string]interface{}{
+		"job_name":        "node",
+		"scrape_interval": "30s",
+		"params": map[string]interface{}{
+			"module": []string{"cpu"},
+		},
+		"static_configs": []interface{}{
+			map[string]interface{}{
+				"targets": []string{"localhost:9100", "localhost:9101", "other:9100"},
+				"labels":  map[string]interface{}{"env": "prod", "__metrics_path__": "/node/metrics"},
+			},
+		},
+		"relabel_configs": []interface{}{
+			map[string]interface{}{
+				"source_labels": []string{"__address__"},
+				"regex":         "other:.*",
+				"action":        "drop",
+			},
+			map[string]interface{}{
+				"source_labels": []string{"__address__"},
+				"regex":         "([^:]+):(\\d+)",
+				"target_label":  "port",
+				"replacement":   "$2",
+			},
+		},
+		"metric_relabel_configs": []interface{}{
+			map[string]interface{}{
+				"source_labels": []string{"__name__"},
+				"regex":         "go_.*",
+				"action":        "drop",
+			},
+		},
+	})
+
+	settings := common.MustNewConfigFrom(map[string]interface{}{"timeout": "5s", "period": "1m"})
+	targets := c.targets(settings, logp.NewLogger("test"))
+	require.Len(t, targets, 2)
+	sort.Slice(targets, func(i, j int) bool { return targets[i].address < targets[j].address })
+
+	assert.Equal(t, "localhost:9100", targets[0].address)
+	assert.Equal(t, map[string]string{
+		"env":      "prod",
+		"instance": "localhost:9100",
+		"job":      "node",
+		"port":     "9100",
+	}, targets[0].labels)
+	assert.NotEqual(t, targets[0].id, targets[1].id)
+
+	var module struct {
+		Module      string            `config:"module"`
+		Hosts       []string          `config:"hosts"`
+		MetricsPath string            `config:"metrics_path"`
+		Period      time.Duration     `config:"period"`
+		Timeout     time.Duration     `config:"timeout"`
+		Query       map[string]string `config:"query"`
+		Labels      map[string]string `config:"target_labels"`
+		Relabel     []*common.Config  `config:"metric_relabel_configs"`
+	}
+	require.NoError(t, targets[0].config.Unpack(&module))
+	assert.Equal(t, "prometheus", module.Module)
+	assert.Equal(t, []string{"http://localhost:9100"}, module.Hosts)
+	assert.Equal(t, "/node/metrics", module.MetricsPath)
+	assert.Equal(t, 30*time.Second, module.Period)
+	assert.Equal(t, 5*time.Second, module.Timeout)
+	assert.Equal(t, map[string]string{"module": "cpu"}, module.Query)
+	assert.Equal(t, targets[0].labels, module.Labels)
+	assert.Len(t, module.Relabel, 1)
+}
+
+func TestScrapeConfigValidation(t *testing.T) {
+	for name, config := range map[string]map[string]interface{}{
+		"no job name": {
+			"static_configs": []interface{}{map[string]interface{}{"targets": []string{"localhost:9100"}}},
+		},
+		"no targets": {
+			"job_name": "node",
+		},
+		"invalid scheme": {
+			"job_name":       "node",
+			"scheme":         "ftp",
+			"static_configs": []interface{}{map[string]interface{}{"targets": []string{"localhost:9100"}}},
+		},
+		"timeout greater than interval": {
+			"job_name":        "node",
+			"scrape_interval": "10s",
+			"scrape_timeout":  "20s",
+			"static_configs":  []interface{}{map[string]interface{}{"targets": []string{"localhost:9100"}}},
+		},
+		"invalid metric relabeling": {
+			"job_name":               "node",
+			"static_configs":         []interface{}{map[string]interface{}{"targets": []string{"localhost:9100"}}},
+			"metric_relabel_configs": []interface{}{map[string]interface{}{"action": "unknown"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var c ScrapeConfig
+			assert.Error(t, common.MustNewConfigFrom(config).Unpack(&c))
+		})
+	}
+}
+
+func TestReadTargetGroups(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus-sd")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	jsonFile := filepath.Join(dir, "targets.json")
+	require.NoError(t, ioutil.WriteFile(jsonFile, []byte(`[{"targets": ["a:9100"], "labels": {"env": "dev"}}]`), 0644))
+	yamlFile := filepath.Join(dir, "targets.yml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte("- targets: [\"b:9100\", \"c:9100\"]\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`{`), 0644))
+
+	groups := readTargetGroups([]string{filepath.Join(dir, "*.json"), filepath.Join(dir, "*.yml")}, logp.NewLogger("test"))
+	require.Len(t, groups, 2)
+	assert.Equal(t, []string{"a:9100"}, groups[0].Targets)
+	assert.Equal(t, map[string]string{"env": "dev", filepathLabel: jsonFile}, groups[0].Labels)
+	assert.Equal(t, []string{"b:9100", "c:9100"}, groups[1].Targets)
+	assert.Equal(t, map[string]string{filepathLabel: yamlFile}, groups[1].Labels)
+}
+
+func TestProviderFileSD(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus-sd")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "targets.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[{"targets": ["a:9100"]}]`), 0644))
+
+	id, err := uuid.NewV4()
+	require.NoError(t, err)
+	b := bus.New(logp.NewLogger("test"), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	config := common.MustNewConfigFrom(map[string]interface{}{
+		"scrape_configs": []interface{}{
+			map[string]interface{}{
+				"job_name": "files",
+				"file_sd_configs": []interface{}{
+					map[string]interface{}{
+						"files":            []string{filepath.Join(dir, "*.json")},
+						"refresh_interval": "100ms",
+					},
+				},
+			},
+		},
+	})
+	provider, err := AutodiscoverBuilder("mockBeat", b, id, config, nil)
+	require.NoError(t, err)
+	provider.Start()
+
+	event := nextEvent(t, listener)
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "a:9100", event["host"])
+	assert.Equal(t, id, event["provider"])
+	assert.Len(t, event["config"], 1)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[{"targets": ["b:9100"]}]`), 0644))
+	events := []bus.Event{nextEvent(t, listener), nextEvent(t, listener)}
+	sort.Slice(events, func(i, j int) bool { return events[i]["host"].(string) < events[j]["host"].(string) })
+	assert.Equal(t, true, events[0]["stop"])
+	assert.Equal(t, "a:9100", events[0]["host"])
+	assert.Equal(t, true, events[1]["start"])
+	assert.Equal(t, "b:9100", events[1]["host"])
+
+	provider.Stop()
+	event = nextEvent(t, listener)
+	assert.Equal(t, true, event["stop"])
+	assert.Equal(t, "b:9100", event["host"])
+}
+
+func nextEvent(t *testing.T, listener bus.Listener) bus.Event {
+	t.Helper()
+	select {
+	case event := <-listener.Events():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for autodiscover event")
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/helper/labelhash"
+)
+
+// Labels with special meaning in the targets, as in Prometheus
+const (
+	addressLabel     = "__address__"
+	metricsPathLabel = "__metrics_path__"
+	schemeLabel      = "__scheme__"
+	paramLabelPrefix = "__param_"
+	filepathLabel    = "__meta_filepath"
+	instanceLabel    = "instance"
+	jobLabel         = "job"
+)
+
+// target is a scrape target, with the prometheus module configuration to scrape it
+type target struct {
+	id      string
+	address string
+	labels  map[string]string
+	config  *common.Config
+}
+
+// targets returns the targets of all the target groups of the scrape configuration,
+// discovered from its static configs and its file service discovery configs
+func (c *ScrapeConfig) targets(settings *common.Config, logger *logp.Logger) []*target {
+	groups := c.StaticConfigs
+	for _, fileSD := range c.FileSDConfigs {
+		groups = append(groups, readTargetGroups(fileSD.Files, logger)...)
+	}
+
+	var targets []*target
+	for _, group := range groups {
+		for _, address := range group.Targets {
+			t, err := c.target(address, group.Labels, settings)
+			if err != nil {
+				logger.Errorf("Invalid target '%s' in scrape config '%s': %v", address, c.JobName, err)
+				continue
+			}
+			if t != nil {
+				targets = append(targets, t)
+			}
+		}
+	}
+	return targets
+}
+
+// target applies the relabeling rules to the labels of a discovered target, and
+// builds the target to scrape. It returns nil if the target is dropped.
+func (c *ScrapeConfig) target(address string, groupLabels map[string]string, settings *common.Config) (*target, error) {
+	labels := map[string]string{}
+	for name, value := range groupLabels {
+		labels[name] = value
+	}
+	labels[addressLabel] = address
+
+	// The labels of the target group have precedence over the scrape config
+	scrapeLabels := map[string]string{
+		jobLabel:         c.JobName,
+		metricsPathLabel: c.MetricsPath,
+		schemeLabel:      c.Scheme,
+	}
+	for name, values := range c.Params {
+		if len(values) > 0 {
+			scrapeLabels[paramLabelPrefix+name] = values[0]
+		}
+	}
+	for name, value := range scrapeLabels {
+		if _, found := labels[name]; !found {
+			labels[name] = value
+		}
+	}
+
+	labels, keep := p.Relabel(labels, c.RelabelConfigs)
+	if !keep {
+		return nil, nil
+	}
+	if labels[addressLabel] == "" {
+		return nil, errors.New("no address after relabeling")
+	}
+	if _, found := labels[instanceLabel]; !found {
+		labels[instanceLabel] = labels[addressLabel]
+	}
+
+	hashLabels := common.MapStr{}
+	targetLabels := map[string]string{}
+	query := common.MapStr{}
+	for name, value := range labels {
+		hashLabels[name] = value
+		switch {
+		case strings.HasPrefix(name, paramLabelPrefix):
+			query[strings.TrimPrefix(name, paramLabelPrefix)] = value
+		case strings.HasPrefix(name, "__"):
+		default:
+			targetLabels[name] = value
+		}
+	}
+
+	config, err := c.moduleConfig(labels, targetLabels, query, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &target{
+		id:      c.JobName + ":" + labelhash.LabelHash(hashLabels),
+		address: labels[addressLabel],
+		labels:  targetLabels,
+		config:  config,
+	}, nil
+}
+
+// moduleConfig builds the prometheus module configuration to scrape a target
+func (c *ScrapeConfig) moduleConfig(labels, targetLabels map[string]string, query common.MapStr, settings *common.Config) (*common.Config, error) {
+	fields := common.MapStr{
+		"module":        "prometheus",
+		"metricsets":    []string{"collector"},
+		"hosts":         []string{labels[schemeLabel] + "://" + labels[addressLabel]},
+		"metrics_path":  labels[metricsPathLabel],
+		"period":        c.ScrapeInterval.String(),
+		"target_labels": targetLabels,
+	}
+	if c.ScrapeTimeout > 0 {
+		fields["timeout"] = c.ScrapeTimeout.String()
+	}
+	if len(query) > 0 {
+		fields["query"] = query
+	}
+
+	config := common.NewConfig()
+	if settings != nil {
+		if err := config.Merge(settings); err != nil {
+			return nil, err
+		}
+	}
+	if err := config.Merge(fields); err != nil {
+		return nil, err
+	}
+	for i, rule := range c.MetricRelabelConfigs {
+		if err := config.SetChild("metric_relabel_configs", i, rule); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// readTargetGroups reads the target groups from the JSON and YAML files that match
+// the given patterns. Files that cannot be read are logged and ignored.
+func readTargetGroups(patterns []string, logger *logp.Logger) []TargetGroup {
+	var groups []TargetGroup
+	for _, pattern := range patterns {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			logger.Errorf("Invalid file_sd_configs pattern '%s': %v", pattern, err)
+			continue
+		}
+		for _, path := range paths {
+			fileGroups, err := readTargetGroupsFile(path)
+			if err != nil {
+				logger.Errorf("Error reading targets from '%s': %v", path, err)
+				continue
+			}
+			groups = append(groups, fileGroups...)
+		}
+	}
+	return groups
+}
+
+func readTargetGroupsFile(path string) ([]TargetGroup, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []TargetGroup
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(content, &groups)
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(content, &groups)
+	default:
+		return nil, fmt.Errorf("unsupported file extension, expected .json, .yml or .yaml")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		if groups[i].Labels == nil {
+			groups[i].Labels = map[string]string{}
+		}
+		groups[i].Labels[filepathLabel] = path
+	}
+	return groups, nil
+}
@@ -39,6 +39,9 @@ import (
 	// include all metricbeat specific appenders
 	_ "github.com/elastic/beats/v7/metricbeat/autodiscover/appender/kubernetes/token"
 
+	// include all metricbeat specific providers
+	_ "github.com/elastic/beats/v7/metricbeat/autodiscover/providers/prometheus"
+
 	// Add metricbeat default processors
 	_ "github.com/elastic/beats/v7/metricbeat/processor/add_kubernetes_metadata"
 )
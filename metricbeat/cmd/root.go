@@ -36,6 +36,7 @@ import (
 
 	// Import processors.
 	_ "github.com/elastic/beats/v7/libbeat/processors/script"
+	_ "github.com/elastic/beats/v7/metricbeat/processors/rate"
 )
 
 const (
@@ -0,0 +1,526 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const openMetricsContentType = "application/openmetrics-text"
+
+// FamilyMetadata contains the metadata of a metric family exposed in the
+// OpenMetrics format that cannot be stored in a dto.MetricFamily.
+type FamilyMetadata struct {
+	// Unit of the metrics of the family, as declared in its UNIT line.
+	Unit string
+
+	// Created contains the creation time of each series of the family, keyed
+	// by the labels of the series.
+	Created map[string]time.Time
+}
+
+// CreatedTime returns the creation time of the series of the given metric, if known.
+func (m *FamilyMetadata) CreatedTime(metric *dto.Metric) (time.Time, bool) {
+	if m == nil || len(m.Created) == 0 {
+		return time.Time{}, false
+	}
+	created, found := m.Created[seriesKey(metric.GetLabel())]
+	return created, found
+}
+
+// SetCreatedTime sets the creation time of the series of the given metric.
+func (m *FamilyMetadata) SetCreatedTime(metric *dto.Metric, created time.Time) {
+	if m.Created == nil {
+		m.Created = map[string]time.Time{}
+	}
+	m.Created[seriesKey(metric.GetLabel())] = created
+}
+
+// seriesKey returns a string that is unique for a set of labels.
+func seriesKey(labels []*dto.LabelPair) string {
+	pairs := make([]string, len(labels))
+	for i, label := range labels {
+		pairs[i] = label.GetName() + "\xff" + label.GetValue()
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\xff")
+}
+
+// openMetricsSuffixes contains the suffixes of the samples allowed for each
+// OpenMetrics type.
+var openMetricsSuffixes = map[string][]string{
+	"counter":        {"_total", "_created"},
+	"gauge":          {""},
+	"histogram":      {"_bucket", "_count", "_sum", "_created"},
+	"gaugehistogram": {"_bucket", "_gcount", "_gsum"},
+	"summary":        {"", "_count", "_sum", "_created"},
+	"info":           {"_info"},
+	"stateset":       {""},
+	"unknown":        {""},
+}
+
+type openMetricsFamily struct {
+	name    string
+	typ     string
+	help    *string
+	unit    string
+	series  []*dto.Metric
+	byKey   map[string]*dto.Metric
+	created map[string]time.Time
+}
+
+type openMetricsParser struct {
+	families []*openMetricsFamily
+	byName   map[string]*openMetricsFamily
+	current  *openMetricsFamily
+}
+
+// parseOpenMetrics parses metrics in the OpenMetrics text format. It returns the
+// metric families, and the metadata of the families that have any, by family name.
+//
+// Counter families are named after their `_total` samples and info families after
+// their `_info` samples, so they have the same names as in the Prometheus text format.
+func parseOpenMetrics(r io.Reader) ([]*dto.MetricFamily, map[string]*FamilyMetadata, error) {
+	p := &openMetricsParser{byName: map[string]*openMetricsFamily{}}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if line == "# EOF" {
+			break
+		}
+		if err := p.parseLine(line); err != nil {
+			return nil, nil, errors.Wrapf(err, "parsing line %d", lineNumber)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	families, metadata := p.result()
+	return families, metadata, nil
+}
+
+func (p *openMetricsParser) parseLine(line string) error {
+	switch {
+	case line == "":
+		return nil
+	case strings.HasPrefix(line, "#"):
+		return p.parseMetadata(line)
+	default:
+		return p.parseSample(line)
+	}
+}
+
+func (p *openMetricsParser) parseMetadata(line string) error {
+	parts := strings.SplitN(strings.TrimPrefix(line, "# "), " ", 3)
+	if len(parts) < 2 {
+		// Comments are ignored.
+		return nil
+	}
+
+	keyword, name, value := parts[0], parts[1], ""
+	if len(parts) == 3 {
+		value = parts[2]
+	}
+
+	switch keyword {
+	case "TYPE":
+		if _, found := openMetricsSuffixes[value]; !found {
+			return fmt.Errorf("unknown type '%s' for metric family '%s'", value, name)
+		}
+		p.family(name).typ = value
+	case "HELP":
+		help := unescapeOpenMetrics(value)
+		p.family(name).help = &help
+	case "UNIT":
+		p.family(name).unit = value
+	}
+	return nil
+}
+
+// family returns the family with the given name, creating it if it doesn't
+// exist, and makes it the current family.
+func (p *openMetricsParser) family(name string) *openMetricsFamily {
+	f, found := p.byName[name]
+	if !found {
+		f = &openMetricsFamily{
+			name:    name,
+			typ:     "unknown",
+			byKey:   map[string]*dto.Metric{},
+			created: map[string]time.Time{},
+		}
+		p.byName[name] = f
+		p.families = append(p.families, f)
+	}
+	p.current = f
+	return f
+}
+
+// sampleFamily returns the family of a sample, and the suffix of the sample name.
+func (p *openMetricsParser) sampleFamily(name string) (*openMetricsFamily, string) {
+	if p.current != nil && strings.HasPrefix(name, p.current.name) {
+		suffix := name[len(p.current.name):]
+		for _, allowed := range openMetricsSuffixes[p.current.typ] {
+			if suffix == allowed {
+				return p.current, suffix
+			}
+		}
+	}
+	// Samples without metadata belong to their own untyped family.
+	return p.family(name), ""
+}
+
+func (p *openMetricsParser) parseSample(line string) error {
+	l := &openMetricsLexer{line: line}
+
+	name := l.name()
+	if name == "" {
+		return errors.New("missing metric name")
+	}
+
+	var labels []*dto.LabelPair
+	if l.peek() == '{' {
+		var err error
+		if labels, err = l.labels(); err != nil {
+			return err
+		}
+	}
+
+	value, err := l.float()
+	if err != nil {
+		return errors.Wrapf(err, "invalid value for metric '%s'", name)
+	}
+
+	var timestampMs *int64
+	if next := l.peekToken(); next != "" && next != "#" {
+		ts, err := l.float()
+		if err != nil {
+			return errors.Wrapf(err, "invalid timestamp for metric '%s'", name)
+		}
+		ms := int64(ts * 1000)
+		timestampMs = &ms
+	}
+
+	var exemplar *dto.Exemplar
+	if l.peekToken() == "#" {
+		l.token()
+		if exemplar, err = l.exemplar(); err != nil {
+			return errors.Wrapf(err, "invalid exemplar for metric '%s'", name)
+		}
+	}
+
+	f, suffix := p.sampleFamily(name)
+	return f.addSample(suffix, labels, value, timestampMs, exemplar)
+}
+
+func (f *openMetricsFamily) addSample(suffix string, labels []*dto.LabelPair, value float64, timestampMs *int64, exemplar *dto.Exemplar) error {
+	var special *dto.LabelPair
+	var seriesLabels []*dto.LabelPair
+	for _, label := range labels {
+		switch {
+		case label.GetName() == "le" && suffix == "_bucket",
+			label.GetName() == "quantile" && f.typ == "summary":
+			special = label
+		default:
+			seriesLabels = append(seriesLabels, label)
+		}
+	}
+
+	key := seriesKey(seriesLabels)
+	if suffix == "_created" {
+		sec, frac := math.Modf(value)
+		f.created[key] = time.Unix(int64(sec), int64(frac*1e9)).UTC()
+		return nil
+	}
+
+	metric, found := f.byKey[key]
+	if !found {
+		metric = &dto.Metric{Label: seriesLabels}
+		f.byKey[key] = metric
+		f.series = append(f.series, metric)
+	}
+	if timestampMs != nil {
+		metric.TimestampMs = timestampMs
+	}
+
+	switch f.typ {
+	case "counter":
+		metric.Counter = &dto.Counter{Value: &value, Exemplar: exemplar}
+	case "gauge", "info", "stateset":
+		metric.Gauge = &dto.Gauge{Value: &value}
+	case "unknown":
+		metric.Untyped = &dto.Untyped{Value: &value}
+	case "summary":
+		if metric.Summary == nil {
+			metric.Summary = &dto.Summary{}
+		}
+		switch suffix {
+		case "_count":
+			count := uint64(value)
+			metric.Summary.SampleCount = &count
+		case "_sum":
+			metric.Summary.SampleSum = &value
+		default:
+			if special == nil {
+				return errors.New("missing quantile label in summary")
+			}
+			quantile, err := strconv.ParseFloat(special.GetValue(), 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid quantile")
+			}
+			metric.Summary.Quantile = append(metric.Summary.Quantile, &dto.Quantile{Quantile: &quantile, Value: &value})
+		}
+	case "histogram", "gaugehistogram":
+		if metric.Histogram == nil {
+			metric.Histogram = &dto.Histogram{}
+		}
+		switch suffix {
+		case "_count", "_gcount":
+			count := uint64(value)
+			metric.Histogram.SampleCount = &count
+		case "_sum", "_gsum":
+			metric.Histogram.SampleSum = &value
+		default:
+			if special == nil {
+				return errors.New("missing le label in histogram bucket")
+			}
+			upperBound, err := strconv.ParseFloat(special.GetValue(), 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid bucket bound")
+			}
+			count := uint64(value)
+			metric.Histogram.Bucket = append(metric.Histogram.Bucket, &dto.Bucket{
+				UpperBound:      &upperBound,
+				CumulativeCount: &count,
+				Exemplar:        exemplar,
+			})
+		}
+	}
+	return nil
+}
+
+// result returns the parsed families and their metadata.
+func (p *openMetricsParser) result() ([]*dto.MetricFamily, map[string]*FamilyMetadata) {
+	families := make([]*dto.MetricFamily, 0, len(p.families))
+	metadata := map[string]*FamilyMetadata{}
+	for _, f := range p.families {
+		if len(f.series) == 0 {
+			continue
+		}
+
+		name := f.name
+		var typ dto.MetricType
+		switch f.typ {
+		case "counter":
+			name += "_total"
+			typ = dto.MetricType_COUNTER
+		case "info":
+			name += "_info"
+			typ = dto.MetricType_GAUGE
+		case "gauge", "stateset":
+			typ = dto.MetricType_GAUGE
+		case "summary":
+			typ = dto.MetricType_SUMMARY
+		case "histogram":
+			typ = dto.MetricType_HISTOGRAM
+		case "gaugehistogram":
+			typ = dto.MetricType_GAUGE_HISTOGRAM
+		default:
+			typ = dto.MetricType_UNTYPED
+		}
+
+		families = append(families, &dto.MetricFamily{
+			Name:   &name,
+			Help:   f.help,
+			Type:   &typ,
+			Metric: f.series,
+		})
+		if f.unit != "" || len(f.created) > 0 {
+			metadata[name] = &FamilyMetadata{Unit: f.unit, Created: f.created}
+		}
+	}
+	return families, metadata
+}
+
+// openMetricsLexer reads the tokens of an OpenMetrics sample line.
+type openMetricsLexer struct {
+	line string
+	pos  int
+}
+
+func (l *openMetricsLexer) done() bool {
+	return l.pos >= len(l.line)
+}
+
+func (l *openMetricsLexer) peek() byte {
+	if l.done() {
+		return 0
+	}
+	return l.line[l.pos]
+}
+
+func (l *openMetricsLexer) skipSpaces() {
+	for !l.done() && l.line[l.pos] == ' ' {
+		l.pos++
+	}
+}
+
+// name reads a metric or label name.
+func (l *openMetricsLexer) name() string {
+	start := l.pos
+	for !l.done() {
+		c := l.line[l.pos]
+		if c == '{' || c == ' ' || c == '=' || c == ',' || c == '}' {
+			break
+		}
+		l.pos++
+	}
+	return l.line[start:l.pos]
+}
+
+// token reads the next space-separated token.
+func (l *openMetricsLexer) token() string {
+	l.skipSpaces()
+	start := l.pos
+	for !l.done() && l.line[l.pos] != ' ' {
+		l.pos++
+	}
+	return l.line[start:l.pos]
+}
+
+// peekToken returns the next space-separated token without consuming it.
+func (l *openMetricsLexer) peekToken() string {
+	pos := l.pos
+	token := l.token()
+	l.pos = pos
+	return token
+}
+
+func (l *openMetricsLexer) float() (float64, error) {
+	return strconv.ParseFloat(l.token(), 64)
+}
+
+// labels reads a set of labels between braces.
+func (l *openMetricsLexer) labels() ([]*dto.LabelPair, error) {
+	l.skipSpaces()
+	if l.peek() != '{' {
+		return nil, errors.New("expected '{'")
+	}
+	l.pos++
+
+	var labels []*dto.LabelPair
+	for {
+		if l.peek() == '}' {
+			l.pos++
+			return labels, nil
+		}
+
+		name := l.name()
+		if name == "" || l.peek() != '=' {
+			return nil, fmt.Errorf("invalid label at position %d", l.pos)
+		}
+		l.pos++
+		value, err := l.quoted()
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, &dto.LabelPair{Name: &name, Value: &value})
+
+		if l.peek() == ',' {
+			l.pos++
+		}
+	}
+}
+
+// quoted reads a quoted label value, unescaping it.
+func (l *openMetricsLexer) quoted() (string, error) {
+	if l.peek() != '"' {
+		return "", fmt.Errorf("expected '\"' at position %d", l.pos)
+	}
+	l.pos++
+
+	var value strings.Builder
+	for !l.done() {
+		c := l.line[l.pos]
+		l.pos++
+		switch c {
+		case '"':
+			return value.String(), nil
+		case '\\':
+			if l.done() {
+				break
+			}
+			escaped := l.line[l.pos]
+			l.pos++
+			if escaped == 'n' {
+				value.WriteByte('\n')
+			} else {
+				value.WriteByte(escaped)
+			}
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated label value")
+}
+
+// exemplar reads an exemplar, after its leading '#'.
+func (l *openMetricsLexer) exemplar() (*dto.Exemplar, error) {
+	labels, err := l.labels()
+	if err != nil {
+		return nil, err
+	}
+	value, err := l.float()
+	if err != nil {
+		return nil, err
+	}
+	exemplar := &dto.Exemplar{Label: labels, Value: &value}
+
+	if l.peekToken() != "" {
+		ts, err := l.float()
+		if err != nil {
+			return nil, err
+		}
+		sec, frac := math.Modf(ts)
+		exemplar.Timestamp = timestamppb.New(time.Unix(int64(sec), int64(frac*1e9)))
+	}
+	return exemplar, nil
+}
+
+// unescapeOpenMetrics unescapes the text of HELP lines.
+func unescapeOpenMetrics(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	return strings.NewReplacer(`\\`, `\`, `\n`, "\n", `\"`, `"`).Replace(s)
+}
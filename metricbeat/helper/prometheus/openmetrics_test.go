@@ -0,0 +1,185 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const openMetrics = `# TYPE http_requests counter
+# UNIT http_requests requests
+# HELP http_requests Total number of \"HTTP\" requests.
+http_requests_total{code="200"} 1027 # {trace_id="4bf92f3577b34da6"} 1 1637337600.5
+http_requests_created{code="200"} 1637337000.25
+http_requests_total{code="500"} 3
+# TYPE request_duration_seconds histogram
+# UNIT request_duration_seconds seconds
+request_duration_seconds_bucket{le="0.1"} 8
+request_duration_seconds_bucket{le="1"} 10 # {trace_id="00f067aa0ba902b7"} 0.7
+request_duration_seconds_bucket{le="+Inf"} 11
+request_duration_seconds_count 11
+request_duration_seconds_sum 4.5
+request_duration_seconds_created 1637337000
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.5"} 0.05
+rpc_duration_seconds{quantile="0.99"} 0.5
+rpc_duration_seconds_count 20
+rpc_duration_seconds_sum 2
+# TYPE build info
+build_info{version="1.2.3",path="C:\\beats"} 1
+# TYPE temperature gauge
+temperature{room="kitchen"} 21.5 1637337600
+undeclared_metric 7
+# EOF
+`
+
+func familyByName(families []*dto.MetricFamily, name string) *dto.MetricFamily {
+	for _, f := range families {
+		if f.GetName() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func TestParseOpenMetrics(t *testing.T) {
+	families, metadata, err := parseOpenMetrics(strings.NewReader(openMetrics))
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range families {
+		names = append(names, f.GetName())
+	}
+	assert.Equal(t, []string{
+		"http_requests_total",
+		"request_duration_seconds",
+		"rpc_duration_seconds",
+		"build_info",
+		"temperature",
+		"undeclared_metric",
+	}, names)
+
+	counter := familyByName(families, "http_requests_total")
+	assert.Equal(t, dto.MetricType_COUNTER, counter.GetType())
+	assert.Equal(t, `Total number of "HTTP" requests.`, counter.GetHelp())
+	require.Len(t, counter.Metric, 2)
+	assert.Equal(t, 1027.0, counter.Metric[0].GetCounter().GetValue())
+	exemplar := counter.Metric[0].GetCounter().GetExemplar()
+	require.NotNil(t, exemplar)
+	assert.Equal(t, "trace_id", exemplar.Label[0].GetName())
+	assert.Equal(t, "4bf92f3577b34da6", exemplar.Label[0].GetValue())
+	assert.Equal(t, 1.0, exemplar.GetValue())
+	assert.Equal(t, time.Unix(1637337600, 5e8).UTC(), exemplar.GetTimestamp().AsTime())
+	assert.Nil(t, counter.Metric[1].GetCounter().GetExemplar())
+
+	require.Contains(t, metadata, "http_requests_total")
+	assert.Equal(t, "requests", metadata["http_requests_total"].Unit)
+	created, found := metadata["http_requests_total"].CreatedTime(counter.Metric[0])
+	assert.True(t, found)
+	assert.Equal(t, time.Unix(1637337000, 25e7).UTC(), created)
+	_, found = metadata["http_requests_total"].CreatedTime(counter.Metric[1])
+	assert.False(t, found)
+
+	histogram := familyByName(families, "request_duration_seconds")
+	assert.Equal(t, dto.MetricType_HISTOGRAM, histogram.GetType())
+	require.Len(t, histogram.Metric, 1)
+	h := histogram.Metric[0].GetHistogram()
+	assert.Equal(t, uint64(11), h.GetSampleCount())
+	assert.Equal(t, 4.5, h.GetSampleSum())
+	require.Len(t, h.Bucket, 3)
+	assert.Equal(t, 0.1, h.Bucket[0].GetUpperBound())
+	assert.Equal(t, uint64(10), h.Bucket[1].GetCumulativeCount())
+	assert.Equal(t, 0.7, h.Bucket[1].GetExemplar().GetValue())
+	assert.Equal(t, "seconds", metadata["request_duration_seconds"].Unit)
+	_, found = metadata["request_duration_seconds"].CreatedTime(histogram.Metric[0])
+	assert.True(t, found)
+
+	summary := familyByName(families, "rpc_duration_seconds")
+	require.Len(t, summary.Metric, 1)
+	s := summary.Metric[0].GetSummary()
+	assert.Equal(t, uint64(20), s.GetSampleCount())
+	require.Len(t, s.Quantile, 2)
+	assert.Equal(t, 0.99, s.Quantile[1].GetQuantile())
+	assert.NotContains(t, metadata, "rpc_duration_seconds")
+
+	info := familyByName(families, "build_info")
+	assert.Equal(t, dto.MetricType_GAUGE, info.GetType())
+	assert.Equal(t, `C:\beats`, info.Metric[0].Label[1].GetValue())
+
+	gauge := familyByName(families, "temperature")
+	assert.Equal(t, 21.5, gauge.Metric[0].GetGauge().GetValue())
+	assert.Equal(t, int64(1637337600000), gauge.Metric[0].GetTimestampMs())
+
+	untyped := familyByName(families, "undeclared_metric")
+	assert.Equal(t, dto.MetricType_UNTYPED, untyped.GetType())
+	assert.Equal(t, 7.0, untyped.Metric[0].GetUntyped().GetValue())
+}
+
+func TestParseOpenMetricsErrors(t *testing.T) {
+	for name, text := range map[string]string{
+		"unknown type":         "# TYPE foo bar\n",
+		"invalid value":        "foo{a=\"b\"} abc\n",
+		"unterminated label":   "foo{a=\"b} 1\n",
+		"invalid label":        "foo{a} 1\n",
+		"invalid exemplar":     "foo_total 1 # {trace_id=\"a\"}\n",
+		"bucket without bound": "# TYPE foo histogram\nfoo_bucket 1\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := parseOpenMetrics(strings.NewReader(text))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGetFamiliesWithMetadata(t *testing.T) {
+	p := &prometheus{mockFetcher{response: openMetrics, contentType: "application/openmetrics-text; version=1.0.0; charset=utf-8"}, logp.NewLogger("test")}
+	families, metadata, err := p.GetFamiliesWithMetadata()
+	require.NoError(t, err)
+	assert.Len(t, families, 6)
+	assert.Len(t, metadata, 2)
+
+	// Responses in the Prometheus text format have no metadata.
+	p = &prometheus{mockFetcher{response: promMetrics, contentType: "text/plain; version=0.0.4"}, logp.NewLogger("test")}
+	families, metadata, err = p.GetFamiliesWithMetadata()
+	require.NoError(t, err)
+	assert.Len(t, families, 5)
+	assert.Empty(t, metadata)
+}
+
+func TestAcceptHeader(t *testing.T) {
+	header, err := acceptHeader()
+	require.NoError(t, err)
+	assert.Equal(t, `text/plain;version=0.0.4;q=0.5,*/*;q=0.1`, header)
+
+	header, err = acceptHeader(FormatProtobuf, FormatOpenMetrics)
+	require.NoError(t, err)
+	assert.Equal(t, "application/vnd.google.protobuf;proto=io.prometheus.client.MetricFamily;encoding=delimited;q=0.7,"+
+		"application/openmetrics-text;version=1.0.0;q=0.6,"+
+		"text/plain;version=0.0.4;q=0.5,*/*;q=0.1", header)
+
+	_, err = acceptHeader("json")
+	assert.Error(t, err)
+}
@@ -22,8 +22,11 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	dto "github.com/prometheus/client_model/go"
@@ -35,13 +38,59 @@ import (
 	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
-const acceptHeader = `text/plain;version=0.0.4;q=0.5,*/*;q=0.1`
+// Exposition formats that can be requested to Prometheus endpoints.
+const (
+	// FormatText is the Prometheus text format, supported by all endpoints.
+	FormatText = "text"
+
+	// FormatOpenMetrics is the OpenMetrics text format, it includes units,
+	// creation timestamps and exemplars.
+	FormatOpenMetrics = "openmetrics"
+
+	// FormatProtobuf is the Prometheus protobuf format, it is needed to collect
+	// native histograms.
+	FormatProtobuf = "protobuf"
+)
+
+var formatMediaTypes = map[string]string{
+	FormatText:        `text/plain;version=0.0.4`,
+	FormatOpenMetrics: openMetricsContentType + `;version=1.0.0`,
+	FormatProtobuf:    `application/vnd.google.protobuf;proto=io.prometheus.client.MetricFamily;encoding=delimited`,
+}
+
+// acceptHeader builds an Accept header that requests the given formats in order
+// of preference. The Prometheus text format is always accepted as fallback.
+func acceptHeader(formats ...string) (string, error) {
+	var accepted []string
+	seen := map[string]bool{}
+	for _, format := range append(formats, FormatText) {
+		if _, found := formatMediaTypes[format]; !found {
+			return "", fmt.Errorf("unknown exposition format '%s'", format)
+		}
+		if !seen[format] {
+			seen[format] = true
+			accepted = append(accepted, format)
+		}
+	}
+
+	var header []string
+	for i, format := range accepted {
+		q := 0.5 + 0.1*float64(len(accepted)-1-i)
+		header = append(header, formatMediaTypes[format]+";q="+strconv.FormatFloat(q, 'f', 1, 64))
+	}
+	header = append(header, "*/*;q=0.1")
+	return strings.Join(header, ","), nil
+}
 
 // Prometheus helper retrieves prometheus formatted metrics
 type Prometheus interface {
 	// GetFamilies requests metric families from prometheus endpoint and returns them
 	GetFamilies() ([]*dto.MetricFamily, error)
 
+	// GetFamiliesWithMetadata requests metric families from prometheus endpoint and
+	// returns them, with the OpenMetrics metadata of the families by family name
+	GetFamiliesWithMetadata() ([]*dto.MetricFamily, map[string]*FamilyMetadata, error)
+
 	GetProcessedMetrics(mapping *MetricsMapping) ([]common.MapStr, error)
 
 	ProcessMetrics(families []*dto.MetricFamily, mapping *MetricsMapping) ([]common.MapStr, error)
@@ -58,32 +107,51 @@ type httpfetcher interface {
 	FetchResponse() (*http.Response, error)
 }
 
-// NewPrometheusClient creates new prometheus helper
-func NewPrometheusClient(base mb.BaseMetricSet) (Prometheus, error) {
+// NewPrometheusClient creates new prometheus helper. Exposition formats other than
+// the Prometheus text format can be requested, in order of preference.
+func NewPrometheusClient(base mb.BaseMetricSet, formats ...string) (Prometheus, error) {
 	http, err := helper.NewHTTP(base)
 	if err != nil {
 		return nil, err
 	}
+	return NewPrometheusClientFromHTTP(http, base.Logger(), formats...)
+}
+
+// NewPrometheusClientFromHTTP creates a new prometheus helper that requests the
+// metrics with the given HTTP helper, for metricsets that need to request them
+// from a different URI than the one of their host
+func NewPrometheusClientFromHTTP(http *helper.HTTP, logger *logp.Logger, formats ...string) (Prometheus, error) {
+	accept, err := acceptHeader(formats...)
+	if err != nil {
+		return nil, err
+	}
 
-	http.SetHeaderDefault("Accept", acceptHeader)
+	http.SetHeaderDefault("Accept", accept)
 	http.SetHeaderDefault("Accept-Encoding", "gzip")
-	return &prometheus{http, base.Logger()}, nil
+	return &prometheus{http, logger}, nil
 }
 
 // GetFamilies requests metric families from prometheus endpoint and returns them
 func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
+	families, _, err := p.GetFamiliesWithMetadata()
+	return families, err
+}
+
+// GetFamiliesWithMetadata requests metric families from prometheus endpoint and
+// returns them, with the OpenMetrics metadata of the families by family name
+func (p *prometheus) GetFamiliesWithMetadata() ([]*dto.MetricFamily, map[string]*FamilyMetadata, error) {
 	var reader io.Reader
 
 	resp, err := p.FetchResponse()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.Header.Get("Content-Encoding") == "gzip" {
 		greader, err := gzip.NewReader(resp.Body)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		defer greader.Close()
 		reader = greader
@@ -96,17 +164,25 @@ func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
 		if err == nil {
 			p.logger.Debug("error received from prometheus endpoint: ", string(bodyBytes))
 		}
-		return nil, fmt.Errorf("unexpected status code %d from server", resp.StatusCode)
+		return nil, nil, fmt.Errorf("unexpected status code %d from server", resp.StatusCode)
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil && mediaType == openMetricsContentType {
+		families, metadata, err := parseOpenMetrics(reader)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "decoding of OpenMetrics response failed")
+		}
+		return families, metadata, nil
 	}
 
 	format := expfmt.ResponseFormat(resp.Header)
 	if format == "" {
-		return nil, fmt.Errorf("Invalid format for response of response")
+		return nil, nil, fmt.Errorf("Invalid format for response of response")
 	}
 
 	decoder := expfmt.NewDecoder(reader, format)
 	if decoder == nil {
-		return nil, fmt.Errorf("Unable to create decoder to decode response")
+		return nil, nil, fmt.Errorf("Unable to create decoder to decode response")
 	}
 
 	families := []*dto.MetricFamily{}
@@ -117,13 +193,13 @@ func (p *prometheus) GetFamilies() ([]*dto.MetricFamily, error) {
 			if err == io.EOF {
 				break
 			}
-			return nil, errors.Wrap(err, "decoding of metric family failed")
+			return nil, nil, errors.Wrap(err, "decoding of metric family failed")
 		} else {
 			families = append(families, mf)
 		}
 	}
 
-	return families, nil
+	return families, nil, nil
 }
 
 // MetricsMapping defines mapping settings for Prometheus metrics, to be used with `GetProcessedMetrics`
@@ -178,7 +178,8 @@ metrics_that_use_labels{label1="I am 1"} 20
 )
 
 type mockFetcher struct {
-	response string
+	response    string
+	contentType string
 }
 
 var _ = httpfetcher(&mockFetcher{})
@@ -191,12 +192,17 @@ func (m mockFetcher) FetchResponse() (*http.Response, error) {
 	writer.Write([]byte(m.response))
 	writer.Close()
 
+	header := http.Header{
+		"Content-Encoding": []string{"gzip"},
+	}
+	if m.contentType != "" {
+		header.Set("Content-Type", m.contentType)
+	}
+
 	return &http.Response{
 		StatusCode: 200,
-		Header: http.Header{
-			"Content-Encoding": []string{"gzip"},
-		},
-		Body: ioutil.NopCloser(body),
+		Header:     header,
+		Body:       ioutil.NopCloser(body),
 	}, nil
 }
 
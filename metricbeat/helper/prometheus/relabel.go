@@ -0,0 +1,246 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// Relabeling actions, as defined by Prometheus.
+const (
+	RelabelReplace   = "replace"
+	RelabelKeep      = "keep"
+	RelabelDrop      = "drop"
+	RelabelHashMod   = "hashmod"
+	RelabelLabelMap  = "labelmap"
+	RelabelLabelDrop = "labeldrop"
+	RelabelLabelKeep = "labelkeep"
+)
+
+// MetricNameLabel is the label that contains the name of a metric when relabeling series.
+const MetricNameLabel = "__name__"
+
+var labelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// RelabelConfig is a relabeling rule, compatible with the `relabel_configs` and
+// `metric_relabel_configs` of Prometheus.
+type RelabelConfig struct {
+	SourceLabels []string `config:"source_labels"`
+	Separator    string   `config:"separator"`
+	Regex        string   `config:"regex"`
+	Modulus      uint64   `config:"modulus"`
+	TargetLabel  string   `config:"target_label"`
+	Replacement  string   `config:"replacement"`
+	Action       string   `config:"action"`
+
+	regex *regexp.Regexp
+	// invalid is set for rules that fail to compile when they are applied,
+	// so they are ignored.
+	invalid bool
+}
+
+// Unpack unpacks a relabeling rule, setting the same defaults as Prometheus.
+func (c *RelabelConfig) Unpack(from *common.Config) error {
+	type tmpConfig RelabelConfig
+	tmp := tmpConfig{
+		Separator:   ";",
+		Regex:       "(.*)",
+		Replacement: "$1",
+		Action:      RelabelReplace,
+	}
+	if err := from.Unpack(&tmp); err != nil {
+		return err
+	}
+
+	*c = RelabelConfig(tmp)
+	return c.Compile()
+}
+
+// Compile validates the rule and compiles its regular expression. It's called
+// when the rule is unpacked from a configuration, rules created otherwise
+// should be compiled before being applied.
+func (c *RelabelConfig) Compile() error {
+	c.Action = strings.ToLower(c.Action)
+	switch c.Action {
+	case RelabelReplace, RelabelHashMod:
+		if c.TargetLabel == "" {
+			return fmt.Errorf("relabel action '%s' requires a target_label", c.Action)
+		}
+		if c.Action == RelabelHashMod && c.Modulus == 0 {
+			return errors.New("relabel action 'hashmod' requires a modulus")
+		}
+	case RelabelKeep, RelabelDrop, RelabelLabelMap, RelabelLabelDrop, RelabelLabelKeep:
+	default:
+		return fmt.Errorf("unknown relabel action '%s'", c.Action)
+	}
+
+	regex, err := regexp.Compile("^(?:" + c.Regex + ")$")
+	if err != nil {
+		return errors.Wrapf(err, "invalid relabel regex '%s'", c.Regex)
+	}
+	c.regex = regex
+	return nil
+}
+
+// Relabel applies the relabeling rules to a set of labels. It returns the resulting
+// labels, or false if the labels are dropped.
+func Relabel(labels map[string]string, configs []RelabelConfig) (map[string]string, bool) {
+	if len(configs) == 0 {
+		return labels, true
+	}
+
+	result := make(map[string]string, len(labels))
+	for name, value := range labels {
+		result[name] = value
+	}
+	for i := range configs {
+		if !configs[i].apply(result) {
+			return nil, false
+		}
+	}
+	return result, true
+}
+
+// apply applies the rule to the labels, it returns false if they are dropped.
+func (c *RelabelConfig) apply(labels map[string]string) bool {
+	if c.invalid {
+		return true
+	}
+	if c.regex == nil {
+		// Rules created without Unpack or Compile
+		if err := c.Compile(); err != nil {
+			logp.NewLogger("prometheus").Errorf("Ignoring invalid relabel rule: %v", err)
+			c.invalid = true
+			return true
+		}
+	}
+
+	values := make([]string, len(c.SourceLabels))
+	for i, name := range c.SourceLabels {
+		values[i] = labels[name]
+	}
+	value := strings.Join(values, c.Separator)
+
+	switch c.Action {
+	case RelabelKeep:
+		return c.regex.MatchString(value)
+	case RelabelDrop:
+		return !c.regex.MatchString(value)
+	case RelabelReplace:
+		indexes := c.regex.FindStringSubmatchIndex(value)
+		if indexes == nil {
+			break
+		}
+		target := string(c.regex.ExpandString(nil, c.TargetLabel, value, indexes))
+		if !labelNameRegexp.MatchString(target) {
+			break
+		}
+		replacement := string(c.regex.ExpandString(nil, c.Replacement, value, indexes))
+		if replacement == "" {
+			delete(labels, target)
+		} else {
+			labels[target] = replacement
+		}
+	case RelabelHashMod:
+		sum := md5.Sum([]byte(value))
+		labels[c.TargetLabel] = fmt.Sprintf("%d", binary.BigEndian.Uint64(sum[8:])%c.Modulus)
+	case RelabelLabelMap:
+		mapped := map[string]string{}
+		for name, v := range labels {
+			if c.regex.MatchString(name) {
+				mapped[c.regex.ReplaceAllString(name, c.Replacement)] = v
+			}
+		}
+		for name, v := range mapped {
+			labels[name] = v
+		}
+	case RelabelLabelDrop, RelabelLabelKeep:
+		for name := range labels {
+			if c.regex.MatchString(name) == (c.Action == RelabelLabelDrop) {
+				delete(labels, name)
+			}
+		}
+	}
+	return true
+}
+
+// RelabelFamilies applies the relabeling rules to the series of the metric families.
+// The labels of a series include the name of its family in the __name__ label, series
+// can be moved to other families if this label is changed.
+func RelabelFamilies(families []*dto.MetricFamily, configs []RelabelConfig) []*dto.MetricFamily {
+	if len(configs) == 0 {
+		return families
+	}
+
+	var result []*dto.MetricFamily
+	byName := map[string]*dto.MetricFamily{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{MetricNameLabel: family.GetName()}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			labels, keep := Relabel(labels, configs)
+			if !keep {
+				continue
+			}
+			name := labels[MetricNameLabel]
+			if name == "" {
+				continue
+			}
+
+			var labelNames []string
+			for labelName := range labels {
+				if !strings.HasPrefix(labelName, "__") {
+					labelNames = append(labelNames, labelName)
+				}
+			}
+			sort.Strings(labelNames)
+			metric.Label = metric.Label[:0]
+			for _, labelName := range labelNames {
+				labelName, value := labelName, labels[labelName]
+				metric.Label = append(metric.Label, &dto.LabelPair{Name: &labelName, Value: &value})
+			}
+
+			relabeled, found := byName[name]
+			if !found {
+				relabeled = &dto.MetricFamily{
+					Name: &name,
+					Help: family.Help,
+					Type: family.Type,
+				}
+				byName[name] = relabeled
+				result = append(result, relabeled)
+			}
+			relabeled.Metric = append(relabeled.Metric, metric)
+		}
+	}
+	return result
+}
@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func relabelConfigs(t *testing.T, configs ...map[string]interface{}) []RelabelConfig {
+	t.Helper()
+	var rules struct {
+		Rules []RelabelConfig `config:"rules"`
+	}
+	list := make([]interface{}, len(configs))
+	for i, c := range configs {
+		list[i] = c
+	}
+	err := common.MustNewConfigFrom(map[string]interface{}{"rules": list}).Unpack(&rules)
+	require.NoError(t, err)
+	require.Len(t, rules.Rules, len(configs))
+	return rules.Rules
+}
+
+func TestRelabel(t *testing.T) {
+	labels := map[string]string{
+		"__address__":       "10.0.0.1:9100",
+		"__meta_env":        "production",
+		"__meta_team":       "observability",
+		"__metrics_path__":  "/metrics",
+		"instance_hostname": "node-1",
+	}
+
+	cases := map[string]struct {
+		configs  []map[string]interface{}
+		expected map[string]string
+	}{
+		"replace with defaults": {
+			configs: []map[string]interface{}{
+				{"source_labels": []string{"__meta_env"}, "target_label": "env"},
+			},
+			expected: map[string]string{"env": "production"},
+		},
+		"replace with groups": {
+			configs: []map[string]interface{}{
+				{
+					"source_labels": []string{"__address__"},
+					"regex":         "([^:]+):\\d+",
+					"target_label":  "host",
+					"replacement":   "$1",
+				},
+			},
+			expected: map[string]string{"host": "10.0.0.1"},
+		},
+		"replace removes empty labels": {
+			configs: []map[string]interface{}{
+				{"source_labels": []string{"missing"}, "target_label": "instance_hostname"},
+			},
+			expected: map[string]string{"instance_hostname": ""},
+		},
+		"replace without match": {
+			configs: []map[string]interface{}{
+				{"source_labels": []string{"__meta_env"}, "regex": "staging", "target_label": "env"},
+			},
+			expected: map[string]string{"env": ""},
+		},
+		"labelmap": {
+			configs: []map[string]interface{}{
+				{"action": "labelmap", "regex": "__meta_(.+)"},
+			},
+			expected: map[string]string{"env": "production", "team": "observability"},
+		},
+		"labeldrop": {
+			configs: []map[string]interface{}{
+				{"action": "labeldrop", "regex": "instance_.*"},
+			},
+			expected: map[string]string{"instance_hostname": ""},
+		},
+		"labelkeep": {
+			configs: []map[string]interface{}{
+				{"action": "labelkeep", "regex": "__.*"},
+			},
+			expected: map[string]string{"instance_hostname": "", "__address__": "10.0.0.1:9100"},
+		},
+		"hashmod": {
+			configs: []map[string]interface{}{
+				{"action": "hashmod", "source_labels": []string{"__address__"}, "modulus": 1, "target_label": "shard"},
+			},
+			expected: map[string]string{"shard": "0"},
+		},
+	}
+
+	for title, c := range cases {
+		t.Run(title, func(t *testing.T) {
+			result, keep := Relabel(labels, relabelConfigs(t, c.configs...))
+			require.True(t, keep)
+			for name, value := range c.expected {
+				assert.Equal(t, value, result[name], name)
+			}
+		})
+	}
+
+	// The original labels are not modified.
+	assert.Len(t, labels, 5)
+}
+
+func TestRelabelKeepDrop(t *testing.T) {
+	labels := map[string]string{"job": "node", "env": "production"}
+
+	_, keep := Relabel(labels, relabelConfigs(t, map[string]interface{}{
+		"action": "keep", "source_labels": []string{"job", "env"}, "regex": "node;prod.*",
+	}))
+	assert.True(t, keep)
+
+	_, keep = Relabel(labels, relabelConfigs(t, map[string]interface{}{
+		"action": "keep", "source_labels": []string{"env"}, "regex": "prod",
+	}))
+	assert.False(t, keep, "regular expressions are anchored")
+
+	_, keep = Relabel(labels, relabelConfigs(t, map[string]interface{}{
+		"action": "drop", "source_labels": []string{"env"}, "regex": "production",
+	}))
+	assert.False(t, keep)
+}
+
+func TestRelabelConfigValidation(t *testing.T) {
+	for title, config := range map[string]map[string]interface{}{
+		"unknown action":               {"action": "rename"},
+		"replace without target label": {"source_labels": []string{"a"}},
+		"hashmod without modulus":      {"action": "hashmod", "target_label": "shard"},
+		"invalid regex":                {"action": "keep", "regex": "("},
+	} {
+		t.Run(title, func(t *testing.T) {
+			var c RelabelConfig
+			assert.Error(t, common.MustNewConfigFrom(config).Unpack(&c))
+		})
+	}
+}
+
+func TestRelabelInvalidRule(t *testing.T) {
+	labels := map[string]string{"env": "production"}
+
+	invalid := RelabelConfig{Action: RelabelKeep, SourceLabels: []string{"env"}, Regex: "("}
+	assert.Error(t, invalid.Compile())
+
+	// Invalid rules created without Unpack are ignored, the following rules
+	// are still applied.
+	configs := []RelabelConfig{
+		{Action: RelabelKeep, SourceLabels: []string{"env"}, Regex: "("},
+		{Action: RelabelDrop, SourceLabels: []string{"env"}, Regex: "production"},
+	}
+	_, keep := Relabel(labels, configs)
+	assert.False(t, keep)
+	assert.True(t, configs[0].invalid)
+	assert.Nil(t, configs[0].regex)
+}
+
+func TestRelabelFamilies(t *testing.T) {
+	families, err := (&prometheus{mockFetcher{response: promMetrics}, nil}).GetFamilies()
+	require.NoError(t, err)
+
+	families = RelabelFamilies(families, relabelConfigs(t,
+		map[string]interface{}{"action": "drop", "source_labels": []string{"__name__"}, "regex": "histogram_.*"},
+		map[string]interface{}{"source_labels": []string{"__name__"}, "regex": "(.*)_metric", "target_label": "__name__", "replacement": "renamed_$1"},
+		map[string]interface{}{"action": "labeldrop", "regex": "label[34]"},
+	))
+
+	var names []string
+	for _, family := range families {
+		names = append(names, family.GetName())
+	}
+	sort.Strings(names)
+	assert.Equal(t, "renamed_first,renamed_second,renamed_summary", strings.Join(names, ","))
+
+	first := familyByName(families, "renamed_first").GetMetric()[0]
+	require.Len(t, first.GetLabel(), 2)
+	assert.Equal(t, "label1", first.GetLabel()[0].GetName())
+	assert.Equal(t, "label2", first.GetLabel()[1].GetName())
+}
@@ -201,6 +201,8 @@ func switchDriverName(d string) string {
 		return "postgres"
 	case "postgresql":
 		return "postgres"
+	case "sqlite":
+		return "sqlite3"
 	}
 
 	return d
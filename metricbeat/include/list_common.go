@@ -95,12 +95,15 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/kvm/dommemstat"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kvm/status"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux"
+	_ "github.com/elastic/beats/v7/metricbeat/module/linux/cgroup"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/conntrack"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/iostat"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/ksm"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/memory"
+	_ "github.com/elastic/beats/v7/metricbeat/module/linux/netstat"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/pageinfo"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/pressure"
+	_ "github.com/elastic/beats/v7/metricbeat/module/linux/tcpinfo"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node_stats"
@@ -153,6 +156,7 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/system"
 	_ "github.com/elastic/beats/v7/metricbeat/module/system/core"
 	_ "github.com/elastic/beats/v7/metricbeat/module/system/cpu"
+	_ "github.com/elastic/beats/v7/metricbeat/module/system/disk_health"
 	_ "github.com/elastic/beats/v7/metricbeat/module/system/diskio"
 	_ "github.com/elastic/beats/v7/metricbeat/module/system/entropy"
 	_ "github.com/elastic/beats/v7/metricbeat/module/system/filesystem"
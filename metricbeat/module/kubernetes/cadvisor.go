@@ -0,0 +1,288 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// podUIDRe extracts the UID of a pod from the cgroup path in the id label of
+// cAdvisor metrics, the systemd cgroup driver replaces its dashes by underscores.
+var podUIDRe = regexp.MustCompile(`pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})`)
+
+// CadvisorConverter builds stats summaries from the metrics exposed by the
+// kubelet in /metrics/cadvisor, for clusters where the summary API is disabled.
+// cAdvisor only exposes the CPU usage as a counter, so the converter keeps the
+// previous samples to calculate the usage in nanocores, that is reported from
+// the second conversion on.
+type CadvisorConverter struct {
+	lock sync.Mutex
+	cpu  map[string]cpuSample
+}
+
+type cpuSample struct {
+	usage     float64
+	timestamp time.Time
+}
+
+// NewCadvisorConverter creates a new converter of cAdvisor metrics
+func NewCadvisorConverter() *CadvisorConverter {
+	return &CadvisorConverter{
+		cpu: make(map[string]cpuSample),
+	}
+}
+
+type cadvisorPod struct {
+	stats      PodStats
+	containers []*ContainerStats
+	network    map[string]*networkStats
+}
+
+func (p *cadvisorPod) container(name string) *ContainerStats {
+	for _, container := range p.containers {
+		if container.Name == name {
+			return container
+		}
+	}
+	container := &ContainerStats{Name: name}
+	p.containers = append(p.containers, container)
+	return container
+}
+
+type networkStats struct {
+	rxBytes, rxErrors, txBytes, txErrors uint64
+}
+
+// Summary converts the cAdvisor metric families of the kubelet of the given node
+// to a stats summary. Samples without timestamp are considered taken at now.
+func (c *CadvisorConverter) Summary(families []*dto.MetricFamily, nodeName string, now time.Time) *Summary {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var pods []*cadvisorPod
+	podsByRef := map[string]*cadvisorPod{}
+	cpu := make(map[string]cpuSample)
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := cadvisorLabels(metric)
+			namespace, podName := labels["namespace"], labels["pod"]
+			if namespace == "" || podName == "" {
+				// Not a pod, but the root, system or kubepods cgroups
+				continue
+			}
+
+			if family.GetName() == "container_cpu_usage_seconds_total" {
+				if cpu := labels["cpu"]; cpu != "" && cpu != "total" {
+					// Per CPU usage, reported by cAdvisor when per CPU metrics are enabled
+					continue
+				}
+			}
+
+			value := cadvisorValue(metric)
+			if math.IsNaN(value) || value < 0 {
+				continue
+			}
+
+			pod, ok := podsByRef[namespace+"/"+podName]
+			if !ok {
+				pod = &cadvisorPod{network: map[string]*networkStats{}}
+				pod.stats.PodRef.Namespace = namespace
+				pod.stats.PodRef.Name = podName
+				podsByRef[namespace+"/"+podName] = pod
+				pods = append(pods, pod)
+			}
+			if pod.stats.PodRef.UID == "" {
+				if m := podUIDRe.FindStringSubmatch(labels["id"]); m != nil {
+					pod.stats.PodRef.UID = strings.Replace(m[1], "_", "-", -1)
+				}
+			}
+
+			containerName := labels["container"]
+			if containerName == "" || containerName == "POD" {
+				// Series of the pod cgroup or of its sandbox, network stats are
+				// only reported here.
+				addPodMetric(pod, family.GetName(), labels, value)
+				continue
+			}
+
+			container := pod.container(containerName)
+			switch family.GetName() {
+			case "container_cpu_usage_seconds_total":
+				timestamp := now
+				if ms := metric.GetTimestampMs(); ms > 0 {
+					timestamp = time.Unix(0, ms*int64(time.Millisecond))
+				}
+				container.CPU.Time = timestamp.UTC().Format(time.RFC3339)
+				container.CPU.UsageCoreNanoSeconds = uint64(value * 1e9)
+
+				cuid := namespace + "/" + podName + "/" + containerName
+				if prev, found := c.cpu[cuid]; found && value >= prev.usage && timestamp.After(prev.timestamp) {
+					elapsed := timestamp.Sub(prev.timestamp).Seconds()
+					container.CPU.UsageNanoCores = uint64((value - prev.usage) / elapsed * 1e9)
+				}
+				cpu[cuid] = cpuSample{usage: value, timestamp: timestamp}
+			case "container_cpu_cfs_periods_total":
+				cpuThrottling(container).Periods = uint64(value)
+			case "container_cpu_cfs_throttled_periods_total":
+				cpuThrottling(container).ThrottledPeriods = uint64(value)
+			case "container_cpu_cfs_throttled_seconds_total":
+				cpuThrottling(container).ThrottledTimeNanoSeconds = uint64(value * 1e9)
+			case "container_memory_usage_bytes":
+				container.Memory.UsageBytes = uint64(value)
+			case "container_memory_working_set_bytes":
+				container.Memory.WorkingSetBytes = uint64(value)
+			case "container_memory_rss":
+				container.Memory.RssBytes = uint64(value)
+			case "container_spec_memory_limit_bytes":
+				// Replaced by the available memory once all metrics are read
+				container.Memory.AvailableBytes = uint64(value)
+			case "container_memory_failures_total":
+				if labels["scope"] != "container" {
+					continue
+				}
+				switch labels["failure_type"] {
+				case "pgfault":
+					container.Memory.PageFaults = uint64(value)
+				case "pgmajfault":
+					container.Memory.MajorPageFaults = uint64(value)
+				}
+			case "container_fs_usage_bytes":
+				container.Rootfs.UsedBytes += uint64(value)
+			case "container_fs_limit_bytes":
+				container.Rootfs.CapacityBytes += uint64(value)
+			case "container_start_time_seconds":
+				container.StartTime = time.Unix(int64(value), 0).UTC().Format(time.RFC3339)
+			}
+		}
+	}
+	// Samples of containers that are gone are dropped
+	c.cpu = cpu
+
+	// Order of families and metrics is not guaranteed, sort the stats so the
+	// summaries are stable between fetches
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].stats.PodRef.Namespace != pods[j].stats.PodRef.Namespace {
+			return pods[i].stats.PodRef.Namespace < pods[j].stats.PodRef.Namespace
+		}
+		return pods[i].stats.PodRef.Name < pods[j].stats.PodRef.Name
+	})
+
+	summary := &Summary{}
+	summary.Node.NodeName = nodeName
+	for _, pod := range pods {
+		sort.Slice(pod.containers, func(i, j int) bool {
+			return pod.containers[i].Name < pod.containers[j].Name
+		})
+		for _, container := range pod.containers {
+			// As in the summary API, available memory is the one left until the
+			// container is OOM killed, and it is only reported if there is a limit
+			if limit := container.Memory.AvailableBytes; limit > container.Memory.WorkingSetBytes {
+				container.Memory.AvailableBytes = limit - container.Memory.WorkingSetBytes
+			} else {
+				container.Memory.AvailableBytes = 0
+			}
+			if container.Rootfs.CapacityBytes > container.Rootfs.UsedBytes {
+				container.Rootfs.AvailableBytes = container.Rootfs.CapacityBytes - container.Rootfs.UsedBytes
+			}
+
+			// Logs are not accounted by cAdvisor, so the ephemeral storage
+			// of the pod is the one used by the writable layers of its containers
+			pod.stats.EphemeralStorage.UsedBytes += container.Rootfs.UsedBytes
+			pod.stats.Containers = append(pod.stats.Containers, *container)
+		}
+		for _, network := range pod.network {
+			pod.stats.Network.RxBytes += network.rxBytes
+			pod.stats.Network.RxErrors += network.rxErrors
+			pod.stats.Network.TxBytes += network.txBytes
+			pod.stats.Network.TxErrors += network.txErrors
+		}
+		summary.Pods = append(summary.Pods, pod.stats)
+	}
+	return summary
+}
+
+func addPodMetric(pod *cadvisorPod, name string, labels map[string]string, value float64) {
+	if name == "container_start_time_seconds" && labels["container"] == "" {
+		pod.stats.StartTime = time.Unix(int64(value), 0).UTC().Format(time.RFC3339)
+		return
+	}
+
+	iface := labels["interface"]
+	if iface == "" {
+		return
+	}
+	network, ok := pod.network[iface]
+	if !ok {
+		network = &networkStats{}
+		pod.network[iface] = network
+	}
+	switch name {
+	case "container_network_receive_bytes_total":
+		network.rxBytes = uint64(value)
+	case "container_network_receive_errors_total":
+		network.rxErrors = uint64(value)
+	case "container_network_transmit_bytes_total":
+		network.txBytes = uint64(value)
+	case "container_network_transmit_errors_total":
+		network.txErrors = uint64(value)
+	}
+}
+
+func cpuThrottling(container *ContainerStats) *CPUThrottling {
+	if container.CPUThrottling == nil {
+		container.CPUThrottling = &CPUThrottling{}
+	}
+	return container.CPUThrottling
+}
+
+// cadvisorLabels returns the labels of a metric, with the names used by
+// kubelets older than 1.16 renamed to the current ones
+func cadvisorLabels(metric *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(metric.GetLabel()))
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	for old, current := range map[string]string{"container_name": "container", "pod_name": "pod"} {
+		if v, ok := labels[old]; ok {
+			if _, found := labels[current]; !found {
+				labels[current] = v
+			}
+		}
+	}
+	return labels
+}
+
+func cadvisorValue(metric *dto.Metric) float64 {
+	switch {
+	case metric.Counter != nil:
+		return metric.GetCounter().GetValue()
+	case metric.Gauge != nil:
+		return metric.GetGauge().GetValue()
+	case metric.Untyped != nil:
+		return metric.GetUntyped().GetValue()
+	}
+	return math.NaN()
+}
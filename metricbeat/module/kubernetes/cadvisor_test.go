@@ -0,0 +1,143 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package kubernetes
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readCadvisorFamilies(t *testing.T, file string) []*dto.MetricFamily {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(f)
+	require.NoError(t, err)
+
+	var result []*dto.MetricFamily
+	for _, family := range families {
+		result = append(result, family)
+	}
+	return result
+}
+
+func TestCadvisorSummary(t *testing.T) {
+	converter := NewCadvisorConverter()
+	now := time.Now()
+
+	summary := converter.Summary(readCadvisorFamilies(t, "_meta/test/cadvisor_v1.21.txt"), "kind-worker", now)
+	assert.Equal(t, "kind-worker", summary.Node.NodeName)
+	require.Len(t, summary.Pods, 2)
+
+	pod := findPod(t, summary, "web-0")
+	assert.Equal(t, "default", pod.PodRef.Namespace)
+	assert.Equal(t, "8b3c4c7e-6c09-4d6c-9a68-5b1bb3fe4c01", pod.PodRef.UID)
+	assert.Equal(t, "2021-09-14T08:02:09Z", pod.StartTime)
+	assert.EqualValues(t, 4328742, pod.Network.RxBytes)
+	assert.EqualValues(t, 1854390, pod.Network.TxBytes)
+	assert.EqualValues(t, 1859584+368640, pod.EphemeralStorage.UsedBytes)
+	require.Len(t, pod.Containers, 2)
+
+	shipper := pod.Containers[0]
+	assert.Equal(t, "log-shipper", shipper.Name)
+	assert.EqualValues(t, 0, shipper.Memory.AvailableBytes, "no memory limit")
+	assert.EqualValues(t, 2, shipper.Memory.MajorPageFaults)
+	assert.Nil(t, shipper.CPUThrottling, "no CPU limit")
+
+	nginx := pod.Containers[1]
+	assert.Equal(t, "nginx", nginx.Name)
+	assert.Equal(t, "2021-09-14T08:02:11Z", nginx.StartTime)
+	assert.EqualValues(t, 41231768000, nginx.CPU.UsageCoreNanoSeconds)
+	assert.EqualValues(t, 0, nginx.CPU.UsageNanoCores, "usage in nanocores needs two samples")
+	assert.EqualValues(t, 14745600, nginx.Memory.UsageBytes)
+	assert.EqualValues(t, 12451840, nginx.Memory.WorkingSetBytes)
+	assert.EqualValues(t, 3305472, nginx.Memory.RssBytes)
+	assert.EqualValues(t, 268435456-12451840, nginx.Memory.AvailableBytes)
+	assert.EqualValues(t, 3641, nginx.Memory.PageFaults)
+	assert.EqualValues(t, 1859584, nginx.Rootfs.UsedBytes)
+	assert.EqualValues(t, 62725623808-1859584, nginx.Rootfs.AvailableBytes)
+	assert.Equal(t, &CPUThrottling{
+		Periods:                  8453,
+		ThrottledPeriods:         12,
+		ThrottledTimeNanoSeconds: 381230000,
+	}, nginx.CPUThrottling)
+
+	summary = converter.Summary(readCadvisorFamilies(t, "_meta/test/cadvisor_v1.21_next.txt"), "kind-worker", now)
+	pod = findPod(t, summary, "web-0")
+	assert.InDelta(t, 0.126498/15*1e9, pod.Containers[0].CPU.UsageNanoCores, 1)
+	assert.InDelta(t, 0.0323/15*1e9, pod.Containers[1].CPU.UsageNanoCores, 1)
+	pod = findPod(t, summary, "coredns-558bd4d5db-4fpzt")
+	assert.InDelta(t, 0.045272/15*1e9, pod.Containers[0].CPU.UsageNanoCores, 1)
+}
+
+func TestCadvisorSummaryLegacyLabels(t *testing.T) {
+	summary := NewCadvisorConverter().Summary(readCadvisorFamilies(t, "_meta/test/cadvisor_v1.15.txt"), "kind-worker", time.Now())
+	require.Len(t, summary.Pods, 2)
+
+	pod := findPod(t, summary, "coredns-558bd4d5db-4fpzt")
+	assert.Equal(t, "kube-system", pod.PodRef.Namespace)
+	assert.Equal(t, "e1d7a8b5-36e2-4a0a-b0a3-cf17d30a0c52", pod.PodRef.UID)
+	assert.EqualValues(t, 9882731, pod.Network.RxBytes)
+	require.Len(t, pod.Containers, 1)
+	assert.Equal(t, "coredns", pod.Containers[0].Name)
+	assert.EqualValues(t, 17494016, pod.Containers[0].Memory.UsageBytes)
+
+	// Per CPU series are ignored, only the total usage is reported
+	pod = findPod(t, summary, "web-0")
+	for _, container := range pod.Containers {
+		if container.Name == "nginx" {
+			assert.EqualValues(t, 41231768000, container.CPU.UsageCoreNanoSeconds)
+		}
+	}
+}
+
+func TestCadvisorURI(t *testing.T) {
+	cases := map[string]string{
+		"http://localhost:10255/stats/summary":                              "http://localhost:10255/metrics/cadvisor",
+		"https://node:10250/stats/summary?only_cpu_and_memory=false":        "https://node:10250/metrics/cadvisor?only_cpu_and_memory=false",
+		"https://apiserver/api/v1/nodes/kind-worker/proxy/stats/summary":    "https://apiserver/api/v1/nodes/kind-worker/proxy/metrics/cadvisor",
+		"https://apiserver/api/v1/nodes/kind-worker/proxy/metrics/cadvisor": "https://apiserver/api/v1/nodes/kind-worker/proxy/metrics/cadvisor",
+		"http://localhost:10255/":                                           "http://localhost:10255/metrics/cadvisor",
+	}
+	for summaryURI, expected := range cases {
+		uri, err := cadvisorURI(summaryURI)
+		if assert.NoError(t, err) {
+			assert.Equal(t, expected, uri)
+		}
+	}
+}
+
+func findPod(t *testing.T, summary *Summary, name string) PodStats {
+	for _, pod := range summary.Pods {
+		if pod.PodRef.Name == name {
+			return pod
+		}
+	}
+	t.Fatalf("pod %s not found", name)
+	return PodStats{}
+}
@@ -18,10 +18,7 @@
 package container
 
 import (
-	"fmt"
-
 	"github.com/elastic/beats/v7/libbeat/logp"
-	"github.com/elastic/beats/v7/metricbeat/helper"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/mb/parse"
 	k8smod "github.com/elastic/beats/v7/metricbeat/module/kubernetes"
@@ -57,28 +54,22 @@ func init() {
 // multiple fetch calls.
 type MetricSet struct {
 	mb.BaseMetricSet
-	http     *helper.HTTP
+	stats    *k8smod.KubeletStatsFetcher
 	enricher util.Enricher
-	mod      k8smod.Module
 }
 
 // New create a new instance of the MetricSet
 // Part of new is also setting up the configuration by processing additional
 // configuration entries if needed.
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
-	http, err := helper.NewHTTP(base)
+	stats, err := k8smod.NewKubeletStatsFetcher(base)
 	if err != nil {
 		return nil, err
 	}
-	mod, ok := base.Module().(k8smod.Module)
-	if !ok {
-		return nil, fmt.Errorf("must be child of kubernetes module")
-	}
 	return &MetricSet{
 		BaseMetricSet: base,
-		http:          http,
+		stats:         stats,
 		enricher:      util.NewContainerMetadataEnricher(base, true),
-		mod:           mod,
 	}, nil
 }
 
@@ -88,19 +79,14 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 func (m *MetricSet) Fetch(reporter mb.ReporterV2) {
 	m.enricher.Start()
 
-	body, err := m.mod.GetKubeletStats(m.http)
+	summary, err := m.stats.Fetch()
 	if err != nil {
 		m.Logger().Error(err)
 		reporter.Error(err)
 		return
 	}
 
-	events, err := eventMapping(body, util.PerfMetrics)
-	if err != nil {
-		m.Logger().Error(err)
-		reporter.Error(err)
-		return
-	}
+	events := eventMapping(summary, util.PerfMetrics)
 
 	m.enricher.Enrich(events)
 
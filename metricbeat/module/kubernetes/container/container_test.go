@@ -21,16 +21,25 @@ package container
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	"github.com/elastic/beats/v7/metricbeat/module/kubernetes"
 	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
 )
 
-const testFile = "../_meta/test/stats_summary.json"
+const (
+	testFile         = "../_meta/test/stats_summary.json"
+	testFileV121     = "../_meta/test/stats_summary_v1.21.json"
+	testFileCadvisor = "../_meta/test/cadvisor_v1.21.txt"
+)
 
 func TestEventMapping(t *testing.T) {
 	f, err := os.Open(testFile)
@@ -44,8 +53,10 @@ func TestEventMapping(t *testing.T) {
 	cache.NodeMemAllocatable.Set("gke-beats-default-pool-a5b33e2e-hdww", 146227200)
 	cache.ContainerMemLimit.Set(util.ContainerUID("default", "nginx-deployment-2303442956-pcqfc", "nginx"), 14622720)
 
-	events, err := eventMapping(body, cache)
-	assert.NoError(t, err, "error mapping "+testFile)
+	summary, err := kubernetes.ParseSummary(body)
+	assert.NoError(t, err, "error parsing "+testFile)
+
+	events := eventMapping(summary, cache)
 
 	assert.Len(t, events, 1, "got wrong number of events")
 
@@ -86,6 +97,98 @@ func TestEventMapping(t *testing.T) {
 	}
 }
 
+func TestEventMappingEphemeralStorage(t *testing.T) {
+	body, err := ioutil.ReadFile(testFileV121)
+	require.NoError(t, err, "cannot read test file "+testFileV121)
+
+	summary, err := kubernetes.ParseSummary(body)
+	require.NoError(t, err, "error parsing "+testFileV121)
+
+	cache := util.NewPerfMetricsCache()
+	cache.ContainerEphemeralStorageLimit.Set(util.ContainerUID("default", "web-0", "nginx"), 1073741824)
+
+	events := eventMapping(summary, cache)
+	require.Len(t, events, 3, "got wrong number of events")
+
+	testCases := map[string]interface{}{
+		"name": "nginx",
+
+		"rootfs.used.bytes":            1859584,
+		"logs.used.bytes":              24576,
+		"ephemeral_storage.used.bytes": 1859584 + 24576,
+
+		// calculated pct fields:
+		"ephemeral_storage.usage.limit.pct": float64(1859584+24576) / float64(1073741824),
+	}
+
+	for k, v := range testCases {
+		testValue(t, events[0], k, v)
+	}
+
+	// log-shipper has no ephemeral storage limit
+	testValue(t, events[1], "ephemeral_storage.used.bytes", 368640+8192)
+	_, err = events[1].GetValue("ephemeral_storage.usage.limit.pct")
+	assert.Error(t, err, "ephemeral storage usage is not limited")
+
+	// throttling is only available from cAdvisor
+	_, err = events[0].GetValue("cpu.throttling")
+	assert.Error(t, err, "throttling is not reported by the summary API")
+}
+
+func TestFetchCadvisor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics/cadvisor" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		http.ServeFile(w, r, testFileCadvisor)
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":       "kubernetes",
+		"metricsets":   []string{"container"},
+		"hosts":        []string{server.URL},
+		"stats_source": "cadvisor",
+		"host":         "kind-worker",
+		"add_metadata": false,
+	}
+	f := mbtest.NewReportingMetricSetV2(t, config)
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 3)
+
+	// Containers are sorted by pod and name
+	shipper, nginx := events[0], events[1]
+	testValue(t, shipper.MetricSetFields, "name", "log-shipper")
+	_, err := shipper.MetricSetFields.GetValue("cpu.throttling")
+	assert.Error(t, err, "log-shipper has no CPU limit")
+
+	testCases := map[string]interface{}{
+		"name":                             "nginx",
+		"start_time":                       "2021-09-14T08:02:11Z",
+		"cpu.usage.core.ns":                41231768000,
+		"cpu.throttling.periods":           8453,
+		"cpu.throttling.throttled.periods": 12,
+		"cpu.throttling.throttled.ns":      381230000,
+		"cpu.throttling.throttled.pct":     float64(12) / float64(8453),
+		"memory.usage.bytes":               14745600,
+		"memory.available.bytes":           268435456 - 12451840,
+		"rootfs.used.bytes":                1859584,
+		"ephemeral_storage.used.bytes":     1859584,
+	}
+	for k, v := range testCases {
+		testValue(t, nginx.MetricSetFields, k, v)
+	}
+	testValue(t, nginx.ModuleFields, "pod.name", "web-0")
+	testValue(t, nginx.ModuleFields, "node.name", "kind-worker")
+
+	// Logs are not accounted by cAdvisor
+	_, err = nginx.MetricSetFields.GetValue("logs")
+	assert.Error(t, err, "logs are not reported by cAdvisor")
+}
+
 func testValue(t *testing.T, event common.MapStr, field string, value interface{}) {
 	data, err := event.GetValue(field)
 	assert.NoError(t, err, "Could not read field "+field)
@@ -18,23 +18,14 @@
 package container
 
 import (
-	"encoding/json"
-	"fmt"
-
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/module/kubernetes"
 	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
 )
 
-func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.MapStr, error) {
+func eventMapping(summary *kubernetes.Summary, perfMetrics *util.PerfMetricsCache) []common.MapStr {
 	events := []common.MapStr{}
-	var summary kubernetes.Summary
-
-	err := json.Unmarshal(content, &summary)
-	if err != nil {
-		return nil, fmt.Errorf("Cannot unmarshal json response: %s", err)
-	}
 
 	node := summary.Node
 	nodeCores := perfMetrics.NodeCoresAllocatable.Get(node.NodeName)
@@ -95,7 +86,16 @@ func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.
 					},
 				},
 
-				"logs": common.MapStr{
+				"ephemeral_storage": common.MapStr{
+					"used": common.MapStr{
+						"bytes": container.Rootfs.UsedBytes + container.Logs.UsedBytes,
+					},
+				},
+			}
+
+			// Logs are not accounted when stats are collected from cAdvisor
+			if container.Logs.CapacityBytes > 0 {
+				containerEvent.Put("logs", common.MapStr{
 					"available": common.MapStr{
 						"bytes": container.Logs.AvailableBytes,
 					},
@@ -110,7 +110,20 @@ func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.
 						"free":  container.Logs.InodesFree,
 						"count": container.Logs.Inodes,
 					},
-				},
+				})
+			}
+
+			if throttling := container.CPUThrottling; throttling != nil {
+				containerEvent.Put("cpu.throttling", common.MapStr{
+					"periods": throttling.Periods,
+					"throttled": common.MapStr{
+						"periods": throttling.ThrottledPeriods,
+						"ns":      throttling.ThrottledTimeNanoSeconds,
+					},
+				})
+				if throttling.Periods > 0 {
+					containerEvent.Put("cpu.throttling.throttled.pct", float64(throttling.ThrottledPeriods)/float64(throttling.Periods))
+				}
 			}
 
 			if container.StartTime != "" {
@@ -137,10 +150,14 @@ func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.
 				containerEvent.Put("memory.usage.limit.pct", float64(container.Memory.UsageBytes)/memLimit)
 			}
 
+			if storageLimit := perfMetrics.ContainerEphemeralStorageLimit.Get(cuid); storageLimit > 0 {
+				containerEvent.Put("ephemeral_storage.usage.limit.pct", float64(container.Rootfs.UsedBytes+container.Logs.UsedBytes)/storageLimit)
+			}
+
 			events = append(events, containerEvent)
 		}
 
 	}
 
-	return events, nil
+	return events
 }
@@ -32,5 +32,5 @@ func init() {
 // AssetKubernetes returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/kubernetes.
 func AssetKubernetes() string {
-	return "eJzsXU9z47aSv8+nQPnkbDk6bG3tYWrrVSWel32zSSZeeyY5bG0pMNmSEFMAA4D26H36VwD4ByQBkBRB2bGlQypjW90/dDeA7kaj8S16gMN79FDcA6cgQbxDSBKZwXt08WP9w4t3CKUgEk5ySRh9j/72DiGEmj9Ae5CcJOrbHDLAAt6jLX6HkAApCd2K9+j/LoTILq7QxU7K/OL/1e92jMt1wuiGbN+jDc4EvENoQyBLxXvN4FtE8R468NRHHnLFgbMiL3/igKc+H+mG8T1WP0aYpkhILImQJBGIbVDOUoH2mOItpOj+YPFZlRRsNDYinBMB/BF4/RsXqACwjvy+u/mIDEFLlNWnLdLq04Vmw+PwZwFCrpKMAJWtP6lwPsDhifG087sAWvW51vQQfIWkUHqtGIkgCg6CFTyBeDhuDWVIkZN2F4Ao7pfE4CPfg5GwPD4ApMmiyyQrhAR+pZmKHCdwVUvnmyCuR+D38WD94/PnG9Qj2bNMlkYUhebZI9nnSSVQuVaM4quhxKBZoB6LLpaUH9a8oPFg/AZyBxzJHVQ8UCFAoJQfUJdRF8wDoV1uM5D8SGiqVteS+oBK9jmjcdeoiiTaYZpmapWyhBJE0127ZyJRi7omiTas0syIZeIRuCAsommUBGsU/WF2IWjJtTa3mRCqSeIi3GW+B7ljEe1RT0wH0d6gmYhohvWIu1QrtjlnCQjh5OgyRNd+b9NL8mIlIOn9vqKZsuI+6657vYFc33xBAhJG0y6yhtMe9owf1LZOUqBydX9oPLM+34zRreOXxi97j3xfbqH6Xv0RIhRVPEsMQxAfCZcFzk6JsGQ5BHCTihXLga4SVvRWv0FoLdafiv09cLXiKoJoQzKo/4BxvxqFxFxCGsFo7ozBIEFoAnqJKY274uGcACoQiGb99b5acO3trwqxyoEnQCXJYPVv3hGy+z8gcSnA/GI9RQ7VnK9AoD1JOCunE2rg+HXiGoYo9jP1E8aVFPsiw5I8AnKxCkGbb7wVNE1J71AV/UEggvwTzMyOqekpoBWCSWq1IIe0GmNBamGcqGIL5hIaVuQDGETOqIBnVa+BMEW/fdDLK9hGOVrDfaAxVFxCcZPqO/3xbaoamHOnMWmQVYi/l7dnq60SHwgL5MiydIYcz8mL6C04czc2swxLoMnhGEt2aUtUBK+UiSoE5t/EOE72njQIKZ4J1ZjodMHcF8kDyJNuOSVrtCNCsi3He2RA+MGOdSWmoKhoGk2OVd4ynkODhdqOsPnhODDPoMcG9XhNJgXnah2bL7uPdJOR7U6OMHVGt7yglNBt1FClWT8TvWmpb6OSUTirDDJJV0buUVbyJulfalMgLDUXJ3tcpESu4NGniKnsNT2k6bnHaxhyUNAgjcizItll3uw1VGJC551xWNKt6UU54tCR5VqSvTuVm2LZ/cVAwuZOEUQ9glZ6ZfQuPpShvPmCCoG34BCEb9g2FP1d7zx0AQpRbQ2ScRfhYeJDDGwmjkW5y8Yb1lafAQnbn+va7JTcrxmHUvgUU++W1cKLKVOC8cEeAXkkXGMYkA6wrIGxFFa5c19qcIkEZ5CuNxnDvj+swo4y0okxBiVfLBCuaKp/s41ODUkmcaaxI5xlLMES32egvhccbEb2RP71RpvChlBIDfw6A98shZfqJ16JILJBBdXfhdR9iJex7fgc8sCofmJb5Ypv2MQFCT9ikmG3+c9flHzRMBo394aCajRe21o+9WBRgnOcEHlQDrCber2uln/5FuRjrHm8bNSC9xbkohf28WIhaj3wn1nM2+XdfjyKupl91nbQzBbvgKxDEQ5h9yMeLsVqDCSPdS4BSRuIA1L7TCtaKumtLNpdOxw4mFvOuX5pIjGC8A74hfuZP1voJ7qaHgtAL97bHDPmGQ5naRB+n9OWEO+VLqBXNktu7+7Cc6SC/MT4A6FbAf7k2OuQyG9moEiAHCeZHG9hg4vMkWCcdoLtxtRktBQj5OFU75/4D8ZPhkhz8+KqZxFjchOxBuhtxBm3jEld5yIOQsJ+csjxVlwft5xsl/wcm7llVPrizxejnSTu+OKIOOzsP2dZBtxckJh1CnBdEyuvW8Q5A3iWMtVTVq6fuhT2xCWw6r/x2H3CexhXaf1PRiPy/Ug3HAvJi0QWHPrEzwW/Zjjngt9zwe+54HfEMM4Fv24g54Lf0RjPBb/ngt9zwe/8gl+Hlzm1BPiJ8Yc/CyjcHucxW58CDcrhNGV587fznwzBuv6u3MxDvkRBN4QSsYviTnypiY1hjdM0hg3/VulFERww5BRyuYvKU1McnD6SkyjzteFrVzlr6u7AjKWwSlTAnkjmjq+PMVx4JIn2JGL6wPoYo6IcMtgd4EzuYtSON8xrqsidClqibj/MyeDxHF2NZ3fTOljyD7JekwCnwFdErPdYSE9O5p6xDHDX0Ru62L5rbrZrXROBOjzeddHoitZ3XfYTElafd2C35zAVslXOCtQ+pOdG/Ru5wxJhDmgLFDiWpp9IVU9crqstDoSqwFYJ98dudxM0IRnmNzCProPSvjbbq+KCOCSMp8LIvTY+SfZgfpZjLklSZJgbIaAdFoglukg9dSDU35R4nztQ9heTUNpvQ7iQ65IV9fT0mF4A/LkCqMapeaCGh/pZ16rsCyGLA1IsBvA0uRDRO5UzGCR8leOt4WdDp7QESJsGAuQRqEMcCcsPa8lcCJo9DYtOqOdPvQXR3WpKY8HVVthtzHEk98+HvD5yD3N05CF9Rh/mqI/xq94WHHLGpWluQYRDF6EJtGjXjQ1ne/S0I8lOC8esDUQ0K6M7NxQ18/xJ7ROKMGJ0LBYr545TLPF8jf1cUkJYCJYQvSs8EbkLzqGQ3txL6HSPrLYDDj2FoNCCNeJkqbVoaQaE0fBMaQBVelnHPRn475JsaRKbxhjc3m/8Y4lRPHXTpriMNUlEqklgJsATHpqN1enJOnovml/LXjS2QMKHNQWJeAD2hZI/C0D6SIFsiHIrmQXEkVKql3HINuuM0IeIYG5/Uus4B6HQlH2KfNsIoY8se4R07cC41OpU8XTJJbRO4ZzEt5zvbj7WnYxK6wmoK25LK8X7oWxrNcA47uJhL1gBpsvN14ryBNHHnbBfPn4Y4G0nLebEfNZVRR1nnm8pnm8pej7xbylqj/WvfkHxfFPB/Tfnmwq9T7ybCueC9B7kc0G6D/q5IH2gIJ2CVNYTbe3mX1+5Cd5CAuRR5/t9tOpTCc5d55qjUY9F9NXHqc4jvXalfOaYij2R8iXp5bNTL/WhxvkWSPUZKc8fzhdAJovofPfD/vTE8zaufVjlC54r511Yp+kY0OB6Kb0CGkS+fgG1n1NQb5bnmDWc7JVfuFD/B//+MMxgiAkaOdPR+DTKmBmPpqVbPu61Hzx9B0EjdxH0tgU5Yp9BU5a9NylE925Uh7KtO1xzst05S/+Sye5zrFp9zrGq/flrKeUvGKu+iVOmF3Oq0gP2EtvxTGn7+KZaParNte6+I7rtd8oej4wCYhztGQf7j0vCigTmMNQJMvKp2/l4qQf8Rc68cyOseNPx6G5YbyWh2Jow/kF3DiLXr/8k0gjmqXce6Q82Xv1xtRFJ3cBByUTfXB0QTI63sF7wRNTAGn0+uz4NHv/prNU+5OthTsRv3WfStOY/OFwX7zva5Rx9q8PXgafJRadRbnC4Ou9YdwC6LXLmcOmR8956mCu1Nj3LdHqdZ6ZcvGpFfs77vsFpMLLfTNuPHeo2E7jKGF7Djukz04EW7jITGVmwv0x9GhLuLhOANKOzTCvv57kIPt4wpvSUqedPt6PMcVY9uZdMsAHFmD4yUbrIhOC7ekLEQhRsPRECNc84R3eO6UIY21hkvFbHgx3oKBKA6tfg3KVlfKeYALzYunT0iLFuN0zrEBNXkeN6w4TALqnK0T1hQgDnKjPQDaZrQhHtxuUcDjV+OeZW/MiWL/V2eKDJqE0pyPShuAfjppfO+oEmzhz5wNZWZCBG7gzD4r870ORGwblVZDvPALJN/YOhBx396OaZhxffiKcB/Zi8zwPGXGe80IfeB+ychuZc//Ge0G00tX8ypJFFe9ITkCMhzvRdgyAnGMAAypNYQ3gwfpPoZQ1EsoO0yOa177UyBzW9c9qgz+OVpQ16V1mPZDPUmNfyTIosysDuSitFWErY57JPuuJZrwYR2arJ6qJ7Tsec0zFDkM7pmHM6ZiKiczrmnI45p2PO6ZhzOsaJIdiZ0vB39aUMQpjSk7IXi3U7QR63ScK/w+nD0r/TFEmGgKbWYNzb0kjYc9ISE9AEJmAX0bwZ4cYUmok5S1c5BxWmKAS6ke1+UJ/DSG5Yihq6qKQ7DcQc7bj5BxThwTBPHx4UQwop48YY3CtSQZ61AZb2ekp/984xd4Y31h7ieS6uC8So/bOHY2YG2Tdp33UZ11WE77pcjruF0zzRGeMuztE9uHriua5xEXezQCGxLOLdXs93WPjLKN0D6A4iVMZdD0czQpdlc+Yr9ISJ1P8jge8JxeHnTwGn/gv27kbXI1E2CDUTt3xbDqQKyP3FaYRK2PY6ch8BxvAZbFrfa/Brg5mlv9+MhtBljepaNxhVSrvmWOx+Yiz/HicPbLO5Qn/nXF+suymy7ArV/1v+vq9a9WG81r5agS6v2T7PQEJ61UjiGlPK5G1BNQvGr9Avv/z8I8kySL8ph79yTpQp12YG34DQddm+yyKGrq8ce5Lar2++6IZrwrAM6L3y8U8CqWQHKXIzbMspdLEmsDMoXDmHRC0F79F/rv4jBvIay0iBhrAPwxsY3dFSP2kDOKPE5d90GxJBWfdubhQMtomoFPj8uBu1VZcafJeKE87oH+w+lktjqEVxaHqHUeNdGnRd4ujR6J6SzmXgpGM5jGX3fvfMGMOnIYFylpEOpfo2SqKc5hnPHjUpFkNKxUSieUy+ZySW3ynWohA50LR3oz/kGrW429mVyoSIihxddBvL1b3MHacegSCkHbrnLNkh0Tv3qCA8YeHsmF6vUljIdWUB0XAooeu3HyoYvKDuCQJfF2KvKA+yTwGnGaF+zkM296EkULPGGwm8nlIaScL0qylcOYEbTDJLE2P+J/xPf6iXYtgz2r7ENKeI4oOmd6cvCZ14ZWw2pzwjCR4ftg1sOM7RlUyOvNo9fKfueLfmc+tRm6ZbSSUWlANvBuKFmIIgPNAmax7Aknrrza5J8MJBaizpmSh1IrSCnk69Fq8hmI1i84wd9jNfkbJcoYZglDmfY0fLnNEbbHD6WkgNF1cC4gTLiIWjVhqhGzZxFRmaorOyIx8ajI25VdO2Rn0pckjm3B2OhbE/DWbNz1iwXPPTDyxPnW/WRAdl+PQBVUDGhEnuiT3OSXAHTkuFSI7Xx3bt93K08xd6qEXFCLHWgZumJVUddnRDjhEehA5eFthfnPGRF0bHU10ChmERhiGKJAHox2VxkWguQmyKrI+mQjKpU+L4HUNZaP1E1lRv0/cWVh04uV7DGu2MKFgIS+stslBE2cJVZaBPiszNtYPJUUMfS40NByRASkK3U/W5rGueMLoh24Lr/HMNtSmfquR4edfb+hsXjuMsg4yI7tFmLCFaHF68FG2s1oYTkB97op5nZudLTtPW3ibfu0oah8Tm2IlRnCOw7q5cv8+kX6BlT1SYByH7m2WDzvE2VkR01TtZAWToErYrdHHNGf0fdn/hd42JWCeMSs6y7j2RaJB/Mao25++GEbq8kLyAiyt0scGZUP/DOLr4L8oo/O3CbY0TD8GnmaMhPsMeq+V8GRHa+drW5uERZEEfKHuiAb0PeExR0Zau01io9RR/ma+NxazECKePZilBP3dllzegSyX+K6SFr0RfSt5vIwUtU8PBGHVWJYZG2eIzVIxhztfWOQchCucrZbGEZ1qg3ZSMjpZiSsTDKeB+IOJhNlhWyDXbrBXmBaH+UshfNgrv0Thzkp5CpjcfPxwl0iXqUazOecuVgNSP5Nl9+sL1H1VH9wFQMyLfClPdO/507+JZMn+uIoNPvraJQ9URtWKeFXmJNtz330pwLaI73YByKdO0dRNMD9UKWRSO7r/ZfX+iFjBwQYQEKh9ZVuxj+VcNWWToNqlFzvb6L79V6yd8+9yFKr8aeIqEp4QwNGvGnbOXPJyPgIRqcKcOwpTX4iRhPNWPWzNLJx73lXG8hXWS4V5zzNHc7wwRpInUuZiePaExhQA+u0wyTPaLGWeS4Rdsoje/Xgfs0wxh1vP93xOaQloJw8+qLGpbl1YzY0bcNrWk1fSKPyuU3DQBN22ss+brfbdLxQQO32kSSJFw81hwft38er3yTSf39jlrzkR6sIR0d+3yCPH4bI1C9vHGyWzHhFwvw1GR9rFdLDGlGJdRx3H1PQte9OjALG963FY3PW6Aqi1ptVode8EjJrp5aZCqPm6hbFlX4RU3F96rPtpunci8EjprCahKy0SkIroFC1lsqP6KmpdUD9cqkro1/3i+MrjjcT1b/dsIbOxet99eSmhboGUDopoTuj/ovboBhwKHK01V8z0sU73QluKmyLJDxW1QmtblN33I9GfBJI62tFg0oywuy9Wm35ZY/1djHapQ70ppCgLDwRxBQYoud5ineoMSkH4T6u4VJ+xoD9R7kUPRO5aFPUIzc9RXr9Dvaqi/q7H+rgb7u2f/cAz8iPGZw10lSmN+OM8zAgJJ1g9Pw//0h7NqOSBJrOxKSe3ZL/nclTgCyZOsEBK4zwkfweMjlcApztDHm9rky/G7WcJX84VZEXE1sooY+vDpzj8FapbHD7PH0BNbZAyn63ucYZrMEutPDKfo+5JObVAepnOmeDWwHo06IqRbruLwOSaiKfjQVwxUyDbHJio2/3DRCde0eavGnKLSNNRi2PqCHV3CpsjiOfYVxWiefUgIQ5khd1VsLZK62BBdgtqgzT54V46g6/2dINRoCa/2oY6KNhb2T61+vJV72vL5fEJEzxB29Ar6xwJ8tvhjyASb6GBpI7TikOPLlxa2xdoCLbAvwwYryxsBrJPk7eZ45y3Jdsr32f28Fhq/t5dz9kgEYb4q0QknWQ2lxuuzUfgOKPQ50dpxUXtSYKCplNe9TZ/NA8V7kmAVMJe7W3lc4j5XKw9l7onOes46Y/iZpeYmbgr6mbJGNoRuEaYpKrnE90daah/wSvQr6rGs3zzJbj0aGcUrcXSPmqQJx1PKdb8Hr0N46hfa38RD0QlzVnkNEx9iYDPp3bToswm+64jGbTrVR0n7mnEoRU4x9fR17KB8KW9mL1RxdX4TuQX69b9hent3N04U5cuvr/+h2996T9wOSCbHW1jw+dTm5uHoJ11Phmj4UdeodW7t4rZnc9D7UrHK2pzcNvHuLDjL2oaIhgijFzkLfyAZlI6peb48XMGKJh0jv1IRNU0GBmXkaK6BXrt4tDfllYw9iNzRddgegkhwBunaV+hvD6TsMzxvKDett//LAgsdLXoVTChLA1Xnc1TssR0U1f39YpTlGYS1snIIxyYxwPzAAcaAcTeOjY3G+K8lnH8FAAD//8aj9Yg="
+	return "eJzsfV1z2ziW9r1+BcpX6bfcunhray9SW1017XTvZKeT9tpJ52JrSw2TRxbGFMAGQDuaX78FECApEgBBEZKdWJOuqTiSz/OcD3wdAAc/ogfYvUUP1R1wChLEAiFJZAFv0cU/mn+8WCCUg8g4KSVh9C36aYEQQu0X0BYkJ5n6bQ4FYAFv0T1eICRASkLvxVv0PxdCFBeX6GIjZXnxv+qzDeNylTG6Jvdv0RoXAhYIrQkUuXirAX5EFG+hR099IHelQuCsKs2/OOip/97TNeNbrFgjTHMkJJZESJIJxNaoZLlAW0zxPeTobtfBWRoJXTZdRrgkAvgj8OYTF6kAsZ79/nb9HtUCO6a0f/ZNipCbWpceh78qEHKZFQSo3PuK5fkAuyfG895nAbbqvystD8FXyCrlVwskgiw4CFbxDNLxuKlhIUdO2X0Coro7Jgef+AGNjJXpCSAtFr3JikpI4JcaVJQ4g8vGOj8EeT0Cv0tH6++fPl2jgcg+ZsbyhKbQmAORQ0wqgcqVAkqHbd1gOGgINIDoc8n5bsUrmo7GF5Ab4EhuwGKgSoBAOd+hPlCfzAOheTom/yA0V72rkR5Ezti2ZDRtH2VFog2meaF6qY5Rgmz6ffdMJqpT1yLRmlnPRHQTj8AFYQlDwwhsWAzV7FPQlgOejoJtJC7BffAtyA3L02HrhukQOlCaCZkOtdG4L9XClpxlIIQT0RWIrvG+Ky8rq6WAbPC5lZmz6q7YjzyHIlfXn5GAjNFceJG2sGV8t+QgSA5ULu927cys+78at2D03vFhPS97i3y/vMfqZ/UlRCiymIbDGMVHwmWFi1MyNJBjBNe5WLIS6DJjFZVTqe1Bf6y2d8BVj6sEojUpoPkC48JLQUjMJeQJgua2DhgkCM1AdzEmuC3GwoWvFgLJot824rziera/rMSyBJ4BlaSA5f/zasju/gmZywH1B6spdrBt3pJAW5JxZpoTaumISWqIajvTP2FeWbWtCizJIyAXVIja/OC11LQkPUJZ+aNEBPkX1C07paenkFYMJrm1Qznk1RQd0h7HiS7u0DyGh5X4AAdRMirgWd1bU5ji3yHp4zu4yzLaw0OiKVxsqLhFDSf96WPKKrZwAddpkGUI34vtGWqNLIGwQI4sS0/lZMNcytmCVcELVmAJNNsdEskubwkr8FKFqGJQ/0zqiVN3TBqllC6EGk50umHuquwB5EmHHAONNkRIds/xFtUk/GS743UiTzYya0/GOq/LJJ0DWy6tB1kJ9T/GkXkGP7as4z2ZVZyr9dZ8272n64Lcb2REqDN6zytKCb1fHqPzQTjTg5ZijQyQm5VlBDLLl7XdnYy8bDxM2qS/8aZAWGoUJzyuciKX8Ag0DbyWh7Q8t776C0sOSmHIE2JakX1wC6zSpZjQvWyNe+QKpEI61m3kJdni0CvLlSRbWDgXRlhCyBjDhM2tEogGAhtrlFX0KD6CpHIslcD34DBEzCRA/+7g0xChkNQ9JRnvWy1O+BhAF4QK71dGl7WRFu7+uWrCTtn9inEwxqeYeoesPb6YsoxxEEHLBClH0lUEKwH5CGRDjOWwLDMZ5CUyXEC+WhcM+75olx1mpZNCBxXcWCBsZaqf2VqnhiSTuECU5YBwUbAMS3xXALq6/hxUtiBbIr89bXNYEwp5Tb/JwLdd4RvGAxZBZI0qqn8X8h8WPtvIDWdSqq2OxdSGG6Hn1a+3HQSlWENfoCciNwhrO2ial4jRYofwIyaF9uvTBoapHPVHWUdtxwuEOaCMFYUe4NCasy3K/pY/EsH4wuG/iH6sBE5YLoKhkqLBtkMqFLhULRfUgYMMtipHHWLRcx3ky9NzNohq8fW0IdmmF5pPWLT0IpWgx+f/SbcWNU6H+CqlYjv4lv5L616uB/1JIM6m+9EaoGD3YhHba4xQ/43dq+X8mi3iZgeWQ9NjLHzG93VhoXmHle7LqEWG5lhiboJLtX3a7jHDJc6I3CnnuaVbFew3X4N96jlCvG3UpOk12EXpOcEsRM2yxFEM41qGTjBMpNJ1X9+2Fq9CLbE1BzgRLwUVQ8kTncegpKBclCwV5660Pxp8kWDFNZ5Z+FSbE2OhVnPaxtePw5HN/eMt0F+aSWpDeDMSLfEXuVb90GE/cbnqiYCXv2KN0XnGotUERNy6lQ+OP31vreTm9jbcRizlJ8Yf1EF5kN+5Rb7UiqqbAXGWKfE9rHFVSOG1jJd7BKfOclj1Ah4kS2aL/8n4yRhpNC8vy4kzJtdiERsyvnCx4uz00qvb9xGHN4xJfVZO7ISErenP4ufWr2Xq47ZTOxV65WszvTZz28jMxUfM410/zDfQSdYdn0dWHFBuYAscFyshGR929H41Rwj8YgUjI1hHmrrGtTdZuVQuIFKgJ07qvHqBd8D1bTBH3stn165NR44Te606FnERFtfWhoHmEY0Q38MyNCONmo2Oz0QjdHA5LjAZ1aTtD41bF30N1SdcbSLw+kLfrF3rq0aYuR6Y5lres1yrOOVNq1Nf3TjxlQ31/+ngPuItxN0M+hejCXHf0zXHQvIqkxWHofDzBZXzBZXzBZXzBZXzBZXzBZXzBZXzBZXzBZVv54LKTwt/NjHyyorKRP9VQQXJhj5FGtSEsz5GPn84/60W2JwXN4N5aC5R0TWhRGySTCc+N8JioHGep4jhL9YvCOf5SCDnUMpNUkwtcbT5SE5AJMXt3srR0hcuZJVDW5ojhIwnC1x4JJkyftI5sOLaSA4F7AZwITe7pOCNVOROO1r01Ar7kWo+waRUDNz+STm/kha2AJwDXxKx2mJVNqcnv9bzjrECMF0EcIcd7pdNW4lF4SMiUA9j0Wejb2As+vD9kA0Af9pAt5yUltdU5QJpj9c2n8gNlvrc7T1QdVWqrn9l77+YfnUPgVBV7UEZt8U5IBnmDzCPr4PWvlJhWqMgDhnjuTrq2O011DnR+t9KzCXJqgLz2ghogwVimb5UlTsY6t+UeFsuYjoTV1diJa0JF3JloGgvx2NFTr+w8skSVHpqDKuOqsLA1oOoahkV+OiECjzKx7LZghjsANccJHyVi2gGH2o5JhIgb5Jr9+QRqMMcGSt3K8lcDCw3Dlj0lnr+1FuQ3Y2WFEvO4g8KSR2I/mlXNhn1MKIjD+kL+jCi3oWxtZg4lIzrknFyQ0w3FN2AGjF7n4ZsMaFKlL5v0J6b1sxUn930jE5KaTPPH9U4ocyPGI3lYplsQeIcS7wYcpnosQ9GEsJCsIwovPp+hz9own5zd6F+dm5prbyMw8AhwQ5r1PC9TksDqPxTsKW0hKxfVml3Bv7TiDUhYU7+e1kcYVvC6O/XvCkymBZYi2wvMmh8fbHD1QJaNnb3ZJW8dtofpnZa1yDhzZqK5OngP1PyVwVIbymQNVEF/liHiCOlZGkIKNargtCHhGRufkMcSg5CsaH3zhCx+IQ+suIR8pWD47F6J4tpJrKLsZ7FcsUlSR85qvqfEWqbsIOWpZC2BKPCVhIjgNN2Ht0OKwB6vPZqJU8wfdoG+/n9uxFsi0v3d9HdjSLuar0Sdb5Vf75Vf7pb9XrG+q1fqLeEnFvhftf4XPLajoaeb8UccivmfPnhfPnhfPkh1eUHCvKJ8YdFbMz44sXK41+9an0fIXgDGZBHyAOyLGngnPEZrGMZffUhWSLye3fKJ46p2BIpX5JfPjn9YkmcbxxNvXH06/my0dhlo4GJ2sllxBUHyF+beV7HFaN2TmD09agT2l0+Lq+XUpeiZeSrTWHp8Ip6szyugPAFg5VHtmpeeJwgC4wP4wBjIF2gUBONdFRsi5/gVPXfe2VetJ4+gsSOIq/ckBHjzKRu71Ua0T0aWXXKvTtcbjPFZbtLln+Tye7zWvW8Vj2vVZ9hrfoqdplezK7KgNi3Xqb4VZUmVoNrc8te9Es9mZrEjAJiHG0Z71zJF1awEqHOFnsqQB1p1+14LSvUaUa0KuvVkJh020td4ueia9980bVwczy48lpoKfjdNhi/0r2NyNX3vxNZG+ZpsB+58FH//rera5M0BRyUTdQl1DHDqB3K1RF3RGta0fuzq9PwidudfabiTyXLm7JPnc5zvwKUuBxIVfWgdGEo2JZy945w9MiKajsILF9cf+NFopr+8Rm5Dx3bsFIebS7Vtcmny3HFbJr0GfW6splatnYpUYevuhI08FuEft7th29kg8dXQ65P5iR7KL9GVvU+zR5T3dt66HwH1d3MS5BNoTe1iHTKHU6GzVMz9c0yFUBrVYnYSNuiDX4EhOvp9KJvsZKzr7vFWEMJqNu5bKtlIQH8cdbTZqEicf4D6UGP+MvDeYvDHYjkKgsXKAo3B2Ugznslb67V9uVZGFPxYRHTz7p72LGCaMHeI7IYWq/OmJ3VekbAwD378NjnLjHWzKGdU+dJJdASMwsWP4ssfRagtAfabsbHlD2LKXoWHxhTCp55y50dFtW2iE10obNgdaS92kgxmtvW6y4l1hIRE+i7ChalYhSsixQiNS84hwWKrOwRCp26TIm8Gk92pNxVgKrfg3O7lj1uk5zZoZfal44CZqECWyd0ZLckWIwnh2SP6couu0hfDgnOdaYh4RITV6jsoLhZDFFGq5J5gIKzq3ZUDNYjsxzEjmZRg1IQVL1CXE/TzWR9RzPnBu7I0FYVICJHhnHz3+5odq3o3CixTf/bvI5v/2HsdXw/u6HHkvCLeGfdz8n71nrKfsZLfeyx9d5RnZLrL29V2bpUbv9YnwJCHdmT3tOPpDjP92GSEwJghOVJoiGsjD8kFn1lRLaBvNpfSbv7o0Bv1MkcNPLOaYPvPm0wqLNwIMxY1XiLx0FURRLFbk2UIiyl2hgZiraYTW+QEFY1VpfcczrmnI45p2PO6ZhzOuacjjmnY87pmHM65hTpmGDZZH/R5CCFKQWTB2uxfpniwwZJ+P9w+mXpLzRX1fyA5h1l3MNSJO2JbfFQNoEG2Gc0DNL5nFwyLX7J8mXJQS1TlE10lfXtqD/HmVyzHLVykZE7jcQc77jxA47wcJjnDw+LMYeYdWMKdCsqGARNAJp4PeV8t7NqtTQiBlb71YbxvCmui0TU+DngMc9jHR77ghZ94OZUz6KP0u/KA0NJ54poI29GZq1ld3CByN5HCF01vEjuhBISy6ofJr5BzTesteLKDe49OTOuwIiV3epoIPTGvBxwiZ4wUSViL5EEviUUB28jcMD5zsvS/QpDJMuWoQZx27fLRJ+PEF4yhErYf1z0QDI1jrMfC1afT+a/L7WH0JuG1ZWufq2cdsWx2PzGWPkzzh7Yen2JfuFc3/q+roriEjV/NZ8PXav+MN54X/VAb67YtixAQn7ZWuIKU8rkTUU1BOOX6PffP/yDFAXkPxj1lwuXaabc6RxrJfqM4NJ3k7GW6zs0OcntKkGoYXrHEvuE2jn+SSgZOMiRG3DfTqGLpoGRQfEqOWSqK3iL/n35bymYN1wiDRriPk5vRLuDre5mZbk7k6Nzg929/h5RMbx4n2gCcylL0xk/4m4d+Py8W7cZDQbcLeeMM/pPdrcY81rklKaWlmRCM6fo95XhMZBhhZul2mwApxwLkjFqnpbZHYzTikAlK0i2cyLhTE2anWsXb9B5Nh5qUWp9KJotvGGQtNBErEQlSqA55E5o99RoD72bXTFYKsHiltt7aMOx6xFYhOzB/lKybIPEYN/DUlBvOrie87AM1PNBKxsByXgooyvJjSV4RZ3wFL4eCV5JHoXPAecFoX7ksZh7ZwQ00HgtgTdNUzPJmH7Si6ucyhqTouOJmL+Efxz+pdENw1aldeWcfrFziOKdlncL8vQ9o/0lDmVBMiycv9jXakQzr3YGxKGlTyfn3b7BNwIhFUHVvvTUHglq7+tZs6gESKuIl2IOguw/xZaSoJHeITqRXniRmsp6GmWq5Sp6OvdWNNrBll4OZcF2W6CzmnxnKtQKTNLmS1yJwwfYYPPtMK1RXAkIy8Oa8wjdSIdH4zRC12xiLzLWRGdlR961HNtws822Yf1GlJD5U0njDSEVx2Ez8JKq6Oloudqnn1iZD2ZgRyFV4wwJWSIxyyR3ww4Q6cS/e+GUbCIwag/dh3beRlJ8HIf3Wni1RkjVD1y39RKbZUd/yTHe9uvFy+Dj+eOLc33kpdGbqR6DRg0RpiGqLAPIj8xEowixroohG8vEW79y3oihIrR5v1FMjBXXym6ktGakbVRDUrQQlp2HMkMryj1eNgN9UmZu1B4nxxn6VG5sEZAAqZL+IvG4f3isK39mjK7JfaWG+Q7V9viUteOb28HQbwmWmOOigIKI7ZGM2EF48VbscmXrGPuxp/2915SW07L1bJNvXUcax8zmGInHRuMJZuu+WGhH5Pp5dPZE1cvhRPRmJ6MPNyZkZx9xDDBDb+B+iS5UWva/2N3FD16mRKzUtjhnRf+eSDLKv9euFqgFQm8uJK/g4hJdrHEh1F8YRxf/QRmFn3psLVPnJm2qcKyFz4hH00cdKSa7+dq9wcNjyIo+UPZEL344dMaUlK2ZOsVSfeFPYU4JwrGoCaePZjlBv8XYPd6A3ijzXyJtfBUlxvL+GKmoSQ0H16jubMgUlns4bhO3pOr9tZV6cFhUHI5ovA8a6doAHWzFnIiHU9B9R8TDbLKskiu2XinOR6T6eyV/Xyu+B/MsSX4Km16/f3eQSY9xHqVT1vV4R0CaF1y7RWTdaIM6emFSh89jG04WyUHoSMciujb3nRM49iGDj76avmOnIxrHPCtzw7bxnfNQh/s5kHS+0w/jTLXAIb4JpocahxyVjq6HZ5AWfQqleoRcSKCyLmK6GLN03PyqFWuKo7apRc62+ps/qq4efkwxCZuThf2jpqdELBdBJ7kC3+sgF4aV5IRxncGdqoSWgXCWMZ6r/XrJOj5xoprqi6uswEIcin5rSjhqIU0uZhBPe/v8vR8XfWL9uMwKTLZHC86swC84RK//uArEZ22f1RyAn4k6O4Qex5qCOdRmyzTPaBHmFhu0PdMyfatQdtMC3LKxzpqvtiw/GOFvWgRSIpanbl/Xf1wtfc3JPXy+hNe0SOm0AilDBghmaxSz99dOMFVkY3UcRCXaBzsxJzAN2Kw6hr7wWX6sOY2FYgRFB01z0+PG3vS4BqqGpOVy+cNzJD967OalQUxyAvKTcG3QXHwvh2xba9bb+iATdQFGoHkGYn5XYAkuXBac11C6VP0nasbbS3NIYvCNwEA3IXHfnmCxxtCHpG7qH0KHuY67S3Q4r3AbPuL5twhu7E6X3z6W0e6BmgJEDZJ9aqIl19nz8PIs8B0UJ/DtuiqKnUUbtaZlZzeZ/qqYxIuxdhvbtXRkJulczB7/Ec6m3xiu/630Hz2h3rfSFAY1Qr0FpbZLNpjnOsMo1LU3Ow+0tnPCz1kV7Cs6kGQhlDaHQnQ1rFuOknaJ/lSq/ql0/VN13n86gZ2KH6CfFqddq52FcFkWBASS7bnHnhjfj8O/WK6qOyAZJGouRlqShjInQm4ND/+KMSsqVaLDNwmPwHhPJXCKC/T+ugl5o78bEr7Wv7BKoZkVht59vPU3gQaSlMkAPWuLguF8dYcLTLNZZv2N4Rz9bOTY8PQtaOY0cavYQIYVTui92lGZFSJago+9BVBLtjkxYWH+7pLTG3fcPf7w1JjTVFqG6gz3fsEiqFUArKsi3cTeSkw2sw8ZwTF5CbC0k5bGJM1hQ/QG1ABdj4O3RoP+7M8SsnObnmy30aYtNfaM18yhDlptHHl+2k78mulpw7cEvxGfY9lhcKYTfLb1h4+cJdauDo4dhJ11yOHHl44ci00Edsi+jBi0kRdBrJfk7ed43Y6N7ZK7Kd9nn+ftsfHP9krOHokgzHdKdMJOViupnfV1WbgJ8HqfaOW4qD2Bw00txVz31vj5juItybBaMJvRzWyXCCcRsylzR3TWc9Yewwe1paw5gH4/tLWN2uJT71AaFCeRWfORPbePzEr0A4Wpol8L675onGRWYsU5jRHhiZ8W/noP3gmhruCz8l6/GPPBEPNWCRze5zjkhNGYds3b8EPz+4x9mrfW1Xkf5xfGhI8BdEEGNy0c3nPlcCZYuPtHFaq5Uk/la7Ppai8m+bQIsgzUqokkGklSEVTZGA/kkU5cHS+IXLvXE6w2dh7q0PfH3Qq/nge2b25v40xhniXfXwd/jxb5Mnh/fcQy6sHtI77t3d48jH5v/GSM/C+OWz5Jz7mZIzPPPUEfWsXMo73TkrVwYrkajK+xWFHOY21jQkOCX2Yr/LV9hlsf12z0Hj94O76N/KJNdLiJGsX9Nupq4n6VOvpl6q7DTTXdefpc7z1E3SpjVqILnx6e59C/a1crnb8HL5vDImEHex9+n+9iT+xEevj8lH7Sp/T/bwB+r4bj"
 }
@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/metricbeat/helper"
+	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+const (
+	// StatsSourceSummary collects kubelet stats from its summary API
+	StatsSourceSummary = "summary"
+	// StatsSourceCadvisor collects kubelet stats from its cAdvisor metrics
+	StatsSourceCadvisor = "cadvisor"
+
+	summaryPath  = "/stats/summary"
+	cadvisorPath = "/metrics/cadvisor"
+)
+
+type kubeletStatsConfig struct {
+	StatsSource string `config:"stats_source"`
+	// Node is the name of the node of the kubelet, also used for metadata enrichment
+	Node string `config:"host"`
+}
+
+func (c *kubeletStatsConfig) Validate() error {
+	switch c.StatsSource {
+	case StatsSourceSummary, StatsSourceCadvisor:
+		return nil
+	}
+	return fmt.Errorf("invalid stats_source '%s', valid sources are '%s' and '%s'", c.StatsSource, StatsSourceSummary, StatsSourceCadvisor)
+}
+
+// KubeletStatsFetcher fetches the stats summary of a kubelet, from its summary
+// API, or built from its cAdvisor metrics when `stats_source` is `cadvisor`.
+type KubeletStatsFetcher struct {
+	mod        Module
+	http       *helper.HTTP
+	prometheus p.Prometheus
+	converter  *CadvisorConverter
+	nodeName   string
+}
+
+// NewKubeletStatsFetcher creates a fetcher of kubelet stats for a metricset of
+// the kubernetes module
+func NewKubeletStatsFetcher(base mb.BaseMetricSet) (*KubeletStatsFetcher, error) {
+	mod, ok := base.Module().(Module)
+	if !ok {
+		return nil, fmt.Errorf("must be child of kubernetes module")
+	}
+
+	config := kubeletStatsConfig{
+		StatsSource: StatsSourceSummary,
+	}
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	http, err := helper.NewHTTP(base)
+	if err != nil {
+		return nil, err
+	}
+
+	fetcher := &KubeletStatsFetcher{
+		mod:  mod,
+		http: http,
+	}
+	if config.StatsSource == StatsSourceCadvisor {
+		uri, err := cadvisorURI(http.GetURI())
+		if err != nil {
+			return nil, err
+		}
+		http.SetURI(uri)
+
+		fetcher.prometheus, err = p.NewPrometheusClientFromHTTP(http, base.Logger())
+		if err != nil {
+			return nil, err
+		}
+		fetcher.converter = NewCadvisorConverter()
+
+		// cAdvisor metrics don't include the node name, that is needed to
+		// calculate the usage percentages of the node
+		fetcher.nodeName = config.Node
+		if fetcher.nodeName == "" {
+			fetcher.nodeName = os.Getenv("NODE_NAME")
+		}
+	}
+	return fetcher, nil
+}
+
+// Fetch fetches the stats summary of the kubelet. Fetches from different
+// metricsets of the same module are shared.
+func (f *KubeletStatsFetcher) Fetch() (*Summary, error) {
+	if f.converter != nil {
+		families, err := f.mod.GetCadvisorFamilies(f.prometheus)
+		if err != nil {
+			return nil, errors.Wrap(err, "error fetching cAdvisor metrics")
+		}
+		return f.converter.Summary(families, f.nodeName, time.Now()), nil
+	}
+
+	body, err := f.mod.GetKubeletStats(f.http)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSummary(body)
+}
+
+// ParseSummary parses the response of the summary API of the kubelet
+func ParseSummary(content []byte) (*Summary, error) {
+	var summary Summary
+	if err := json.Unmarshal(content, &summary); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal json response: %s", err)
+	}
+	return &summary, nil
+}
+
+// cadvisorURI returns the URI of the cAdvisor metrics of the kubelet with the
+// given summary API URI, that can be proxied through the API server.
+func cadvisorURI(summaryURI string) (string, error) {
+	u, err := url.Parse(summaryURI)
+	if err != nil {
+		return "", errors.Wrap(err, "error parsing kubelet URI")
+	}
+	if strings.HasSuffix(u.Path, summaryPath) {
+		u.Path = strings.TrimSuffix(u.Path, summaryPath) + cadvisorPath
+	} else if !strings.HasSuffix(u.Path, cadvisorPath) {
+		u.Path = cadvisorPath
+	}
+	return u.String(), nil
+}
@@ -41,6 +41,7 @@ type Module interface {
 	mb.Module
 	GetStateMetricsFamilies(prometheus p.Prometheus) ([]*dto.MetricFamily, error)
 	GetKubeletStats(http *helper.HTTP) ([]byte, error)
+	GetCadvisorFamilies(prometheus p.Prometheus) ([]*dto.MetricFamily, error)
 }
 
 type familiesCache struct {
@@ -84,10 +85,14 @@ type module struct {
 
 	kubeStateMetricsCache *kubeStateMetricsCache
 	kubeletStatsCache     *kubeletStatsCache
+	cadvisorCache         *kubeStateMetricsCache
 	cacheHash             uint64
 }
 
 func ModuleBuilder() func(base mb.BaseModule) (mb.Module, error) {
+	cadvisorCache := &kubeStateMetricsCache{
+		cacheMap: make(map[uint64]*familiesCache),
+	}
 	kubeStateMetricsCache := &kubeStateMetricsCache{
 		cacheMap: make(map[uint64]*familiesCache),
 	}
@@ -103,6 +108,7 @@ func ModuleBuilder() func(base mb.BaseModule) (mb.Module, error) {
 			BaseModule:            base,
 			kubeStateMetricsCache: kubeStateMetricsCache,
 			kubeletStatsCache:     kubeletStatsCache,
+			cadvisorCache:         cadvisorCache,
 			cacheHash:             hash,
 		}
 		return &m, nil
@@ -146,6 +152,25 @@ func (m *module) GetKubeletStats(http *helper.HTTP) ([]byte, error) {
 	return statsCache.sharedStats, statsCache.lastFetchErr
 }
 
+func (m *module) GetCadvisorFamilies(prometheus p.Prometheus) ([]*dto.MetricFamily, error) {
+	m.cadvisorCache.lock.Lock()
+	defer m.cadvisorCache.lock.Unlock()
+
+	now := time.Now()
+
+	// NOTE: These entries will be never removed, this can be a leak if
+	// metricbeat is used to monitor clusters dynamically created.
+	// (https://github.com/elastic/beats/pull/25640#discussion_r633395213)
+	familiesCache := m.cadvisorCache.getCacheMapEntry(m.cacheHash)
+
+	if familiesCache.lastFetchTimestamp.IsZero() || now.Sub(familiesCache.lastFetchTimestamp) > m.Config().Period {
+		familiesCache.sharedFamilies, familiesCache.lastFetchErr = prometheus.GetFamilies()
+		familiesCache.lastFetchTimestamp = now
+	}
+
+	return familiesCache.sharedFamilies, familiesCache.lastFetchErr
+}
+
 func generateCacheHash(host []string) (uint64, error) {
 	id, err := hashstructure.Hash(host, nil)
 	if err != nil {
@@ -18,30 +18,22 @@
 package pod
 
 import (
-	"encoding/json"
-	"fmt"
-
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/module/kubernetes"
 	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
 )
 
-func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.MapStr, error) {
+func eventMapping(summary *kubernetes.Summary, perfMetrics *util.PerfMetricsCache) []common.MapStr {
 	events := []common.MapStr{}
 
-	var summary kubernetes.Summary
-	err := json.Unmarshal(content, &summary)
-	if err != nil {
-		return nil, fmt.Errorf("cannot unmarshal json response: %s", err)
-	}
-
 	node := summary.Node
 	nodeCores := perfMetrics.NodeCoresAllocatable.Get(node.NodeName)
 	nodeMem := perfMetrics.NodeMemAllocatable.Get(node.NodeName)
 	for _, pod := range summary.Pods {
 		var usageNanoCores, usageMem, availMem, rss, workingSet, pageFaults, majorPageFaults uint64
-		var coresLimit, memLimit float64
+		var coresLimit, memLimit, storageLimit float64
+		storageLimited := len(pod.Containers) > 0
 
 		for _, cont := range pod.Containers {
 			cuid := util.ContainerUID(pod.PodRef.Namespace, pod.PodRef.Name, cont.Name)
@@ -55,6 +47,14 @@ func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.
 
 			coresLimit += perfMetrics.ContainerCoresLimit.GetWithDefault(cuid, nodeCores)
 			memLimit += perfMetrics.ContainerMemLimit.GetWithDefault(cuid, nodeMem)
+
+			// Pods without limit in some container can use all the
+			// ephemeral storage of the node
+			if limit := perfMetrics.ContainerEphemeralStorageLimit.Get(cuid); limit > 0 {
+				storageLimit += limit
+			} else {
+				storageLimited = false
+			}
 		}
 
 		podEvent := common.MapStr{
@@ -106,6 +106,24 @@ func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.
 			podEvent.Put("start_time", pod.StartTime)
 		}
 
+		if storage := pod.EphemeralStorage; storage.UsedBytes > 0 || storage.CapacityBytes > 0 {
+			podEvent.Put("ephemeral_storage.used.bytes", storage.UsedBytes)
+
+			// Capacity of the filesystem is not known when stats are collected from cAdvisor
+			if storage.CapacityBytes > 0 {
+				podEvent.Put("ephemeral_storage.available.bytes", storage.AvailableBytes)
+				podEvent.Put("ephemeral_storage.capacity.bytes", storage.CapacityBytes)
+				podEvent.Put("ephemeral_storage.inodes", common.MapStr{
+					"used":  storage.InodesUsed,
+					"free":  storage.InodesFree,
+					"count": storage.Inodes,
+				})
+			}
+			if storageLimited {
+				podEvent.Put("ephemeral_storage.usage.limit.pct", float64(storage.UsedBytes)/storageLimit)
+			}
+		}
+
 		if coresLimit > nodeCores {
 			coresLimit = nodeCores
 		}
@@ -142,5 +160,5 @@ func eventMapping(content []byte, perfMetrics *util.PerfMetricsCache) ([]common.
 
 		events = append(events, podEvent)
 	}
-	return events, nil
+	return events
 }
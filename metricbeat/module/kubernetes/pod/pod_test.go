@@ -21,16 +21,25 @@ package pod
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	"github.com/elastic/beats/v7/metricbeat/module/kubernetes"
 	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
 )
 
-const testFile = "../_meta/test/stats_summary.json"
+const (
+	testFile         = "../_meta/test/stats_summary.json"
+	testFileV121     = "../_meta/test/stats_summary_v1.21.json"
+	testFileCadvisor = "../_meta/test/cadvisor_v1.21.txt"
+)
 
 func TestEventMapping(t *testing.T) {
 	f, err := os.Open(testFile)
@@ -44,8 +53,10 @@ func TestEventMapping(t *testing.T) {
 	cache.NodeMemAllocatable.Set("gke-beats-default-pool-a5b33e2e-hdww", 146227200)
 	cache.ContainerMemLimit.Set(util.ContainerUID("default", "nginx-deployment-2303442956-pcqfc", "nginx"), 14622720)
 
-	events, err := eventMapping(body, cache)
-	assert.NoError(t, err, "error mapping "+testFile)
+	summary, err := kubernetes.ParseSummary(body)
+	assert.NoError(t, err, "error parsing "+testFile)
+
+	events := eventMapping(summary, cache)
 
 	assert.Len(t, events, 1, "got wrong number of events")
 
@@ -73,6 +84,87 @@ func TestEventMapping(t *testing.T) {
 	}
 }
 
+func TestEventMappingEphemeralStorage(t *testing.T) {
+	body, err := ioutil.ReadFile(testFileV121)
+	require.NoError(t, err, "cannot read test file "+testFileV121)
+
+	summary, err := kubernetes.ParseSummary(body)
+	require.NoError(t, err, "error parsing "+testFileV121)
+
+	cache := util.NewPerfMetricsCache()
+	cache.ContainerEphemeralStorageLimit.Set(util.ContainerUID("default", "web-0", "nginx"), 1073741824)
+	cache.ContainerEphemeralStorageLimit.Set(util.ContainerUID("default", "web-0", "log-shipper"), 536870912)
+	cache.ContainerEphemeralStorageLimit.Set(util.ContainerUID("kube-system", "coredns-558bd4d5db-4fpzt", "coredns"), 0)
+
+	events := eventMapping(summary, cache)
+	require.Len(t, events, 2, "got wrong number of events")
+
+	testCases := map[string]interface{}{
+		"name": "web-0",
+
+		"ephemeral_storage.used.bytes":      2260992,
+		"ephemeral_storage.available.bytes": int64(41229250560),
+		"ephemeral_storage.capacity.bytes":  int64(62725623808),
+		"ephemeral_storage.inodes.used":     72,
+		"ephemeral_storage.inodes.free":     3406392,
+		"ephemeral_storage.inodes.count":    3907584,
+
+		// calculated pct fields:
+		"ephemeral_storage.usage.limit.pct": float64(2260992) / float64(1073741824+536870912),
+	}
+
+	for k, v := range testCases {
+		testValue(t, events[0], k, v)
+	}
+
+	// coredns has no ephemeral storage limit
+	testValue(t, events[1], "ephemeral_storage.used.bytes", 90112)
+	_, err = events[1].GetValue("ephemeral_storage.usage.limit.pct")
+	assert.Error(t, err, "ephemeral storage usage is not limited")
+}
+
+func TestFetchCadvisor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics/cadvisor" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		http.ServeFile(w, r, testFileCadvisor)
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":       "kubernetes",
+		"metricsets":   []string{"pod"},
+		"hosts":        []string{server.URL},
+		"stats_source": "cadvisor",
+		"host":         "kind-worker",
+		"add_metadata": false,
+	}
+	f := mbtest.NewReportingMetricSetV2(t, config)
+	events, errs := mbtest.ReportingFetchV2(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 2)
+
+	event := events[0]
+	testCases := map[string]interface{}{
+		"name":                         "web-0",
+		"uid":                          "8b3c4c7e-6c09-4d6c-9a68-5b1bb3fe4c01",
+		"start_time":                   "2021-09-14T08:02:09Z",
+		"memory.usage.bytes":           14745600 + 39636992,
+		"memory.working_set.bytes":     12451840 + 38141952,
+		"network.rx.bytes":             4328742,
+		"network.tx.bytes":             1854390,
+		"ephemeral_storage.used.bytes": 1859584 + 368640,
+	}
+	for k, v := range testCases {
+		testValue(t, event.MetricSetFields, k, v)
+	}
+	testValue(t, event.ModuleFields, "node.name", "kind-worker")
+	testValue(t, event.ModuleFields, "namespace", "default")
+}
+
 func testValue(t *testing.T, event common.MapStr, field string, expected interface{}) {
 	data, err := event.GetValue(field)
 	assert.NoError(t, err, "Could not read field "+field)
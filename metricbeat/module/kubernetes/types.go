@@ -76,61 +76,96 @@ type Summary struct {
 			UserDefinedMetrics interface{} `json:"userDefinedMetrics"`
 		} `json:"systemContainers"`
 	} `json:"node"`
-	Pods []struct {
-		Containers []struct {
-			CPU struct {
-				Time                 string `json:"time"`
-				UsageCoreNanoSeconds uint64 `json:"usageCoreNanoSeconds"`
-				UsageNanoCores       uint64 `json:"usageNanoCores"`
-			} `json:"cpu"`
-			Logs struct {
-				AvailableBytes uint64 `json:"availableBytes"`
-				CapacityBytes  uint64 `json:"capacityBytes"`
-				Inodes         uint64 `json:"inodes"`
-				InodesFree     uint64 `json:"inodesFree"`
-				InodesUsed     uint64 `json:"inodesUsed"`
-				UsedBytes      uint64 `json:"usedBytes"`
-			} `json:"logs"`
-			Memory struct {
-				AvailableBytes  uint64 `json:"availableBytes"`
-				MajorPageFaults uint64 `json:"majorPageFaults"`
-				PageFaults      uint64 `json:"pageFaults"`
-				RssBytes        uint64 `json:"rssBytes"`
-				Time            string `json:"time"`
-				UsageBytes      uint64 `json:"usageBytes"`
-				WorkingSetBytes uint64 `json:"workingSetBytes"`
-			} `json:"memory"`
-			Name   string `json:"name"`
-			Rootfs struct {
-				AvailableBytes uint64 `json:"availableBytes"`
-				CapacityBytes  uint64 `json:"capacityBytes"`
-				InodesUsed     uint64 `json:"inodesUsed"`
-				UsedBytes      uint64 `json:"usedBytes"`
-			} `json:"rootfs"`
-			StartTime          string      `json:"startTime"`
-			UserDefinedMetrics interface{} `json:"userDefinedMetrics"`
-		} `json:"containers"`
-		Network struct {
-			RxBytes  uint64 `json:"rxBytes"`
-			RxErrors uint64 `json:"rxErrors"`
-			Time     string `json:"time"`
-			TxBytes  uint64 `json:"txBytes"`
-			TxErrors uint64 `json:"txErrors"`
-		} `json:"network"`
-		PodRef struct {
-			Name      string `json:"name"`
-			Namespace string `json:"namespace"`
-			UID       string `json:"uid"`
-		} `json:"podRef"`
-		StartTime string `json:"startTime"`
-		Volume    []struct {
-			AvailableBytes uint64 `json:"availableBytes"`
-			CapacityBytes  uint64 `json:"capacityBytes"`
-			Inodes         uint64 `json:"inodes"`
-			InodesFree     uint64 `json:"inodesFree"`
-			InodesUsed     uint64 `json:"inodesUsed"`
-			Name           string `json:"name"`
-			UsedBytes      uint64 `json:"usedBytes"`
-		} `json:"volume"`
-	} `json:"pods"`
+	Pods []PodStats `json:"pods"`
+}
+
+// PodStats holds the stats of a pod, as reported by the kubelet summary API
+type PodStats struct {
+	Containers       []ContainerStats `json:"containers"`
+	EphemeralStorage struct {
+		AvailableBytes uint64 `json:"availableBytes"`
+		CapacityBytes  uint64 `json:"capacityBytes"`
+		Inodes         uint64 `json:"inodes"`
+		InodesFree     uint64 `json:"inodesFree"`
+		InodesUsed     uint64 `json:"inodesUsed"`
+		UsedBytes      uint64 `json:"usedBytes"`
+	} `json:"ephemeral-storage"`
+	Network struct {
+		RxBytes  uint64 `json:"rxBytes"`
+		RxErrors uint64 `json:"rxErrors"`
+		Time     string `json:"time"`
+		TxBytes  uint64 `json:"txBytes"`
+		TxErrors uint64 `json:"txErrors"`
+	} `json:"network"`
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+		UID       string `json:"uid"`
+	} `json:"podRef"`
+	StartTime string        `json:"startTime"`
+	Volume    []VolumeStats `json:"volume"`
+}
+
+// ContainerStats holds the stats of a container, as reported by the kubelet summary API
+type ContainerStats struct {
+	CPU struct {
+		Time                 string `json:"time"`
+		UsageCoreNanoSeconds uint64 `json:"usageCoreNanoSeconds"`
+		UsageNanoCores       uint64 `json:"usageNanoCores"`
+	} `json:"cpu"`
+	Logs struct {
+		AvailableBytes uint64 `json:"availableBytes"`
+		CapacityBytes  uint64 `json:"capacityBytes"`
+		Inodes         uint64 `json:"inodes"`
+		InodesFree     uint64 `json:"inodesFree"`
+		InodesUsed     uint64 `json:"inodesUsed"`
+		UsedBytes      uint64 `json:"usedBytes"`
+	} `json:"logs"`
+	Memory struct {
+		AvailableBytes  uint64 `json:"availableBytes"`
+		MajorPageFaults uint64 `json:"majorPageFaults"`
+		PageFaults      uint64 `json:"pageFaults"`
+		RssBytes        uint64 `json:"rssBytes"`
+		Time            string `json:"time"`
+		UsageBytes      uint64 `json:"usageBytes"`
+		WorkingSetBytes uint64 `json:"workingSetBytes"`
+	} `json:"memory"`
+	Name   string `json:"name"`
+	Rootfs struct {
+		AvailableBytes uint64 `json:"availableBytes"`
+		CapacityBytes  uint64 `json:"capacityBytes"`
+		InodesUsed     uint64 `json:"inodesUsed"`
+		UsedBytes      uint64 `json:"usedBytes"`
+	} `json:"rootfs"`
+	StartTime          string      `json:"startTime"`
+	UserDefinedMetrics interface{} `json:"userDefinedMetrics"`
+
+	// CPUThrottling is not part of the summary API, it is only available when
+	// the stats are collected from the cAdvisor metrics of the kubelet.
+	CPUThrottling *CPUThrottling `json:"-"`
+}
+
+// CPUThrottling holds the CFS throttling stats of a container
+type CPUThrottling struct {
+	Periods                  uint64
+	ThrottledPeriods         uint64
+	ThrottledTimeNanoSeconds uint64
+}
+
+// VolumeStats holds the stats of a pod volume, as reported by the kubelet summary API
+type VolumeStats struct {
+	AvailableBytes uint64        `json:"availableBytes"`
+	CapacityBytes  uint64        `json:"capacityBytes"`
+	Inodes         uint64        `json:"inodes"`
+	InodesFree     uint64        `json:"inodesFree"`
+	InodesUsed     uint64        `json:"inodesUsed"`
+	Name           string        `json:"name"`
+	UsedBytes      uint64        `json:"usedBytes"`
+	PVCRef         *PVCReference `json:"pvcRef,omitempty"`
+}
+
+// PVCReference references the persistent volume claim of a volume
+type PVCReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
 }
@@ -149,6 +149,10 @@ func NewResourceMetadataEnricher(
 
 			switch r := r.(type) {
 			case *kubernetes.Pod:
+				// Report container limits to PerfMetrics cache, so pod usages
+				// can be compared with them
+				reportContainerLimits(r)
+
 				m[id] = podMetaGen.Generate(r)
 
 			case *kubernetes.Node:
@@ -235,21 +239,10 @@ func NewContainerMetadataEnricher(
 			pod := r.(*kubernetes.Pod)
 			meta := metaGen.Generate(pod)
 
-			for _, container := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
-				cuid := ContainerUID(pod.GetObjectMeta().GetNamespace(), pod.GetObjectMeta().GetName(), container.Name)
-
-				// Report container limits to PerfMetrics cache
-				if cpu, ok := container.Resources.Limits["cpu"]; ok {
-					if q, err := resource.ParseQuantity(cpu.String()); err == nil {
-						PerfMetrics.ContainerCoresLimit.Set(cuid, float64(q.MilliValue())/1000)
-					}
-				}
-				if memory, ok := container.Resources.Limits["memory"]; ok {
-					if q, err := resource.ParseQuantity(memory.String()); err == nil {
-						PerfMetrics.ContainerMemLimit.Set(cuid, float64(q.Value()))
-					}
-				}
+			// Report container limits to PerfMetrics cache
+			reportContainerLimits(pod)
 
+			for _, container := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
 				id := join(pod.GetObjectMeta().GetNamespace(), pod.GetObjectMeta().GetName(), container.Name)
 				m[id] = meta
 			}
@@ -271,6 +264,28 @@ func NewContainerMetadataEnricher(
 	return enricher
 }
 
+func reportContainerLimits(pod *kubernetes.Pod) {
+	for _, container := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
+		cuid := ContainerUID(pod.GetObjectMeta().GetNamespace(), pod.GetObjectMeta().GetName(), container.Name)
+
+		if cpu, ok := container.Resources.Limits["cpu"]; ok {
+			if q, err := resource.ParseQuantity(cpu.String()); err == nil {
+				PerfMetrics.ContainerCoresLimit.Set(cuid, float64(q.MilliValue())/1000)
+			}
+		}
+		if memory, ok := container.Resources.Limits["memory"]; ok {
+			if q, err := resource.ParseQuantity(memory.String()); err == nil {
+				PerfMetrics.ContainerMemLimit.Set(cuid, float64(q.Value()))
+			}
+		}
+		if storage, ok := container.Resources.Limits["ephemeral-storage"]; ok {
+			if q, err := resource.ParseQuantity(storage.String()); err == nil {
+				PerfMetrics.ContainerEphemeralStorageLimit.Set(cuid, float64(q.Value()))
+			}
+		}
+	}
+}
+
 func getString(m common.MapStr, key string) string {
 	val, err := m.GetValue(key)
 	if err != nil {
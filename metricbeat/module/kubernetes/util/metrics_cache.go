@@ -38,8 +38,9 @@ func NewPerfMetricsCache() *PerfMetricsCache {
 		NodeMemAllocatable:   newValueMap(defaultTimeout),
 		NodeCoresAllocatable: newValueMap(defaultTimeout),
 
-		ContainerMemLimit:   newValueMap(defaultTimeout),
-		ContainerCoresLimit: newValueMap(defaultTimeout),
+		ContainerMemLimit:              newValueMap(defaultTimeout),
+		ContainerCoresLimit:            newValueMap(defaultTimeout),
+		ContainerEphemeralStorageLimit: newValueMap(defaultTimeout),
 	}
 }
 
@@ -48,8 +49,9 @@ type PerfMetricsCache struct {
 	NodeMemAllocatable   *valueMap
 	NodeCoresAllocatable *valueMap
 
-	ContainerMemLimit   *valueMap
-	ContainerCoresLimit *valueMap
+	ContainerMemLimit              *valueMap
+	ContainerCoresLimit            *valueMap
+	ContainerEphemeralStorageLimit *valueMap
 }
 
 // Start cache workers
@@ -58,6 +60,7 @@ func (c *PerfMetricsCache) Start() {
 	c.NodeCoresAllocatable.Start()
 	c.ContainerMemLimit.Start()
 	c.ContainerCoresLimit.Start()
+	c.ContainerEphemeralStorageLimit.Start()
 }
 
 // Stop cache workers
@@ -66,6 +69,7 @@ func (c *PerfMetricsCache) Stop() {
 	c.NodeCoresAllocatable.Stop()
 	c.ContainerMemLimit.Stop()
 	c.ContainerCoresLimit.Stop()
+	c.ContainerEphemeralStorageLimit.Stop()
 }
 
 type valueMap struct {
@@ -69,6 +69,11 @@ func eventMapping(content []byte) ([]common.MapStr, error) {
 			}
 			if volume.CapacityBytes > 0 {
 				volumeEvent.Put("fs.used.pct", float64(volume.UsedBytes)/float64(volume.CapacityBytes))
+				volumeEvent.Put("fs.available.pct", float64(volume.AvailableBytes)/float64(volume.CapacityBytes))
+			}
+			if volume.PVCRef != nil {
+				// Same field as in state_persistentvolumeclaim events, to correlate them
+				volumeEvent.Put(mb.ModuleDataKey+".persistentvolumeclaim.name", volume.PVCRef.Name)
 			}
 			events = append(events, volumeEvent)
 		}
@@ -25,11 +25,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
-const testFile = "../_meta/test/stats_summary.json"
+const (
+	testFile     = "../_meta/test/stats_summary.json"
+	testFileV121 = "../_meta/test/stats_summary_v1.21.json"
+)
 
 func TestEventMapping(t *testing.T) {
 	f, err := os.Open(testFile)
@@ -60,6 +65,39 @@ func TestEventMapping(t *testing.T) {
 	}
 }
 
+func TestEventMappingPersistentVolumeClaim(t *testing.T) {
+	body, err := ioutil.ReadFile(testFileV121)
+	require.NoError(t, err, "cannot read test file "+testFileV121)
+
+	events, err := eventMapping(body)
+	require.NoError(t, err, "error mapping "+testFileV121)
+	require.Len(t, events, 4, "got wrong number of events")
+
+	testCases := map[string]interface{}{
+		"name": "data",
+
+		"fs.available.bytes": 309698560,
+		"fs.capacity.bytes":  1063256064,
+		"fs.used.bytes":      734003200,
+		"fs.inodes.used":     1520,
+		"fs.inodes.count":    65536,
+
+		// calculated pct fields:
+		"fs.used.pct":      float64(734003200) / float64(1063256064),
+		"fs.available.pct": float64(309698560) / float64(1063256064),
+
+		mb.ModuleDataKey + ".persistentvolumeclaim.name": "data-web-0",
+	}
+
+	for k, v := range testCases {
+		testValue(t, events[0], k, v)
+	}
+
+	// projected volumes are not backed by claims
+	_, err = events[1].GetValue(mb.ModuleDataKey + ".persistentvolumeclaim")
+	assert.Error(t, err)
+}
+
 func testValue(t *testing.T, event common.MapStr, field string, value interface{}) {
 	data, err := event.GetValue(field)
 	assert.NoError(t, err, "Could not read field "+field)
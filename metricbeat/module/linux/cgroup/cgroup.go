@@ -0,0 +1,164 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroup
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/match"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/linux"
+)
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "cgroup", New)
+}
+
+// MetricSet holds any configuration or state information. It must implement
+// the mb.MetricSet interface. And this is best achieved by embedding
+// mb.BaseMetricSet because it implements all of the required mb.MetricSet
+// interface methods except for Fetch.
+type MetricSet struct {
+	mb.BaseMetricSet
+	root         string
+	maxDepth     int
+	includePaths []match.Matcher
+	excludePaths []match.Matcher
+}
+
+// New creates a new instance of the MetricSet. New is responsible for unpacking
+// any MetricSet specific configuration options if there are any.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux cgroup metricset is beta.")
+
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("the linux/cgroup metricset is only supported on Linux")
+	}
+
+	linuxModule, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	config := struct {
+		MaxDepth     int             `config:"cgroup.max_depth" validate:"min=0"`
+		IncludePaths []match.Matcher `config:"cgroup.include_paths"`
+		ExcludePaths []match.Matcher `config:"cgroup.exclude_paths"`
+	}{MaxDepth: 3}
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		root:          filepath.Join(linuxModule.HostFS, "/sys/fs/cgroup"),
+		maxDepth:      config.MaxDepth,
+		includePaths:  config.IncludePaths,
+		excludePaths:  config.ExcludePaths,
+	}, nil
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(report mb.ReporterV2) error {
+	root, err := unifiedRoot(m.root)
+	if err != nil {
+		return err
+	}
+
+	m.walk(root, "/", 0, report)
+	return nil
+}
+
+// unifiedRoot returns the root of the cgroups v2 unified hierarchy, it can be
+// mounted in /sys/fs/cgroup, or in /sys/fs/cgroup/unified in hybrid setups.
+func unifiedRoot(root string) (string, error) {
+	for _, dir := range []string{root, filepath.Join(root, "unified")} {
+		if _, err := os.Stat(filepath.Join(dir, "cgroup.controllers")); err == nil {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("cgroup v2 unified hierarchy not found in %s", root)
+}
+
+// walk reports the metrics of a cgroup and its descendants, up to the maximum depth.
+// It returns false if the reporter is closed.
+func (m *MetricSet) walk(dir, cgroupPath string, depth int, report mb.ReporterV2) bool {
+	if anyMatch(m.excludePaths, cgroupPath) {
+		return true
+	}
+
+	if len(m.includePaths) == 0 || anyMatch(m.includePaths, cgroupPath) {
+		fields, err := fetchCgroupStats(dir)
+		switch {
+		case os.IsNotExist(errors.Cause(err)):
+			// The cgroup was removed while it was being read
+			return true
+		case err != nil:
+			report.Error(errors.Wrapf(err, "error fetching stats of cgroup %s", cgroupPath))
+		default:
+			fields["path"] = cgroupPath
+			if !report.Event(mb.Event{MetricSetFields: fields}) {
+				return false
+			}
+		}
+	}
+
+	if depth >= m.maxDepth {
+		return true
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			report.Error(errors.Wrapf(err, "error listing cgroup %s", cgroupPath))
+		}
+		return true
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if !m.walk(filepath.Join(dir, entry.Name()), path.Join(cgroupPath, entry.Name()), depth+1, report) {
+			return false
+		}
+	}
+	return true
+}
+
+func anyMatch(matchers []match.Matcher, value string) bool {
+	for _, matcher := range matchers {
+		if matcher.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
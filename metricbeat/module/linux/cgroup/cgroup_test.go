@@ -0,0 +1,159 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package cgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig(nil))
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	assert.Empty(t, errs)
+
+	byPath := eventsByPath(t, events)
+	assert.Len(t, byPath, 5)
+
+	service := byPath["/system.slice/nginx.service"]
+	require.NotNil(t, service)
+	expected := common.MapStr{
+		"cpu.usage.us":                   uint64(82311000),
+		"cpu.user.us":                    uint64(61200000),
+		"cpu.system.us":                  uint64(21111000),
+		"cpu.periods":                    uint64(1200),
+		"cpu.throttled.periods":          uint64(35),
+		"cpu.throttled.us":               uint64(4120000),
+		"cpu.pressure.some.10.pct":       2.5,
+		"cpu.pressure.full.10.pct":       1.1,
+		"memory.usage.bytes":             uint64(134217728),
+		"memory.limit.bytes":             uint64(536870912),
+		"memory.swap.usage.bytes":        uint64(0),
+		"memory.swap.limit.bytes":        uint64(0),
+		"memory.stat.anon":               uint64(67108864),
+		"memory.events.oom_kill":         uint64(1),
+		"io.read.bytes":                  uint64(53477376),
+		"io.write.bytes":                 uint64(104857600),
+		"io.read.ops":                    uint64(1220),
+		"io.write.ops":                   uint64(5400),
+		"io.pressure.some.total.time.us": uint64(1200),
+		"pids.current":                   uint64(9),
+		"pids.limit":                     uint64(4915),
+	}
+	for field, value := range expected {
+		actual, err := service.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.Equal(t, value, actual, field)
+		}
+	}
+
+	// Limits set to "max" and stats of disabled controllers are omitted
+	slice := byPath["/system.slice"]
+	require.NotNil(t, slice)
+	for _, field := range []string{"memory.limit.bytes", "pids.limit"} {
+		_, err := slice.GetValue(field)
+		assert.Error(t, err, field)
+	}
+	worker := byPath["/system.slice/nginx.service/worker"]
+	require.NotNil(t, worker)
+	for _, field := range []string{"io", "pids", "cpu.pressure"} {
+		_, err := worker.GetValue(field)
+		assert.Error(t, err, field)
+	}
+}
+
+func TestFetchFilters(t *testing.T) {
+	cases := map[string]struct {
+		config   map[string]interface{}
+		expected []string
+	}{
+		"max depth": {
+			config:   map[string]interface{}{"cgroup.max_depth": 1},
+			expected: []string{"/", "/system.slice", "/user.slice"},
+		},
+		"include paths": {
+			config:   map[string]interface{}{"cgroup.include_paths": []string{`\.service$`, `^/user\.slice`}},
+			expected: []string{"/system.slice/nginx.service", "/user.slice"},
+		},
+		"exclude paths": {
+			config:   map[string]interface{}{"cgroup.exclude_paths": []string{`^/system\.slice/`}},
+			expected: []string{"/", "/system.slice", "/user.slice"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := mbtest.NewReportingMetricSetV2Error(t, getConfig(c.config))
+			events, errs := mbtest.ReportingFetchV2Error(f)
+			assert.Empty(t, errs)
+
+			var paths []string
+			for path := range eventsByPath(t, events) {
+				paths = append(paths, path)
+			}
+			assert.ElementsMatch(t, c.expected, paths)
+		})
+	}
+}
+
+func TestFetchNoUnifiedHierarchy(t *testing.T) {
+	config := getConfig(nil)
+	config["hostfs"] = "./_meta/testdata/sys/fs/cgroup/system.slice"
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+	_, errs := mbtest.ReportingFetchV2Error(f)
+	assert.NotEmpty(t, errs)
+}
+
+func TestData(t *testing.T) {
+	config := getConfig(map[string]interface{}{"cgroup.include_paths": []string{`^/system\.slice/nginx\.service$`}})
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func eventsByPath(t *testing.T, events []mb.Event) map[string]common.MapStr {
+	byPath := map[string]common.MapStr{}
+	for _, event := range events {
+		path, err := event.MetricSetFields.GetValue("path")
+		require.NoError(t, err)
+		byPath[path.(string)] = event.MetricSetFields
+	}
+	return byPath
+}
+
+func getConfig(extra map[string]interface{}) map[string]interface{} {
+	config := map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"cgroup"},
+		"hostfs":     "./_meta/testdata",
+	}
+	for k, v := range extra {
+		config[k] = v
+	}
+	return config
+}
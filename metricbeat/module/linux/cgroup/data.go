@@ -0,0 +1,237 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cgroup
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/module/linux/pressure"
+)
+
+// cpuStatFields maps the keys of cpu.stat to event fields
+var cpuStatFields = map[string]string{
+	"usage_usec":     "usage.us",
+	"user_usec":      "user.us",
+	"system_usec":    "system.us",
+	"nr_periods":     "periods",
+	"nr_throttled":   "throttled.periods",
+	"throttled_usec": "throttled.us",
+}
+
+// ioStatFields maps the keys of io.stat to event fields
+var ioStatFields = map[string]string{
+	"rbytes": "read.bytes",
+	"wbytes": "write.bytes",
+	"rios":   "read.ops",
+	"wios":   "write.ops",
+	"dbytes": "discard.bytes",
+	"dios":   "discard.ops",
+}
+
+// fetchCgroupStats reads the stats of the cgroup in the given directory. Files of
+// controllers that are not enabled for the cgroup are ignored.
+func fetchCgroupStats(dir string) (common.MapStr, error) {
+	if _, err := os.Stat(filepath.Join(dir, "cgroup.controllers")); err != nil {
+		return nil, err
+	}
+
+	event := common.MapStr{}
+	readers := []func(string, common.MapStr) error{
+		readCPUStats,
+		readMemoryStats,
+		readIOStats,
+		readPidsStats,
+		readPressureStats,
+	}
+	for _, read := range readers {
+		if err := read(dir, event); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+func readCPUStats(dir string, event common.MapStr) error {
+	stats, err := readKeyValueFile(filepath.Join(dir, "cpu.stat"))
+	if err != nil || stats == nil {
+		return err
+	}
+	for key, value := range stats {
+		if field, found := cpuStatFields[key]; found {
+			event.Put("cpu."+field, value)
+		}
+	}
+	return nil
+}
+
+func readMemoryStats(dir string, event common.MapStr) error {
+	values := map[string]string{
+		"memory.current":      "memory.usage.bytes",
+		"memory.max":          "memory.limit.bytes",
+		"memory.swap.current": "memory.swap.usage.bytes",
+		"memory.swap.max":     "memory.swap.limit.bytes",
+	}
+	for file, field := range values {
+		if err := readSingleValue(filepath.Join(dir, file), field, event); err != nil {
+			return err
+		}
+	}
+
+	for file, field := range map[string]string{"memory.stat": "memory.stat", "memory.events": "memory.events"} {
+		stats, err := readKeyValueFile(filepath.Join(dir, file))
+		if err != nil {
+			return err
+		}
+		if stats != nil {
+			event.Put(field, stats)
+		}
+	}
+	return nil
+}
+
+// readIOStats reads io.stat, adding up the stats of all the devices
+func readIOStats(dir string, event common.MapStr) error {
+	content, err := readFile(filepath.Join(dir, "io.stat"))
+	if err != nil || content == nil {
+		return err
+	}
+
+	totals := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		// Each line contains the device number followed by key=value pairs
+		parts := strings.Fields(scanner.Text())
+		if len(parts) < 2 {
+			continue
+		}
+		for _, stat := range parts[1:] {
+			kv := strings.SplitN(stat, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			if _, found := ioStatFields[kv[0]]; !found {
+				continue
+			}
+			value, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid value in io.stat: %s", stat)
+			}
+			totals[kv[0]] += value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	for key, field := range ioStatFields {
+		if value, found := totals[key]; found {
+			event.Put("io."+field, value)
+		}
+	}
+	return nil
+}
+
+func readPidsStats(dir string, event common.MapStr) error {
+	if err := readSingleValue(filepath.Join(dir, "pids.current"), "pids.current", event); err != nil {
+		return err
+	}
+	return readSingleValue(filepath.Join(dir, "pids.max"), "pids.limit", event)
+}
+
+// readPressureStats reads the pressure stall information of the cgroup, available
+// in kernels with PSI support
+func readPressureStats(dir string, event common.MapStr) error {
+	for _, resource := range []string{"cpu", "memory", "io"} {
+		content, err := readFile(filepath.Join(dir, resource+".pressure"))
+		if err != nil {
+			return err
+		}
+		if content == nil {
+			continue
+		}
+		stats, err := pressure.ParsePSIStats(bytes.NewReader(content))
+		if err != nil {
+			return errors.Wrapf(err, "error parsing %s.pressure", resource)
+		}
+		event.Put(resource+".pressure", pressure.PSIFields(stats))
+	}
+	return nil
+}
+
+// readSingleValue reads a file with a single numeric value and stores it in the
+// given field. The value is omitted if the file doesn't exist or contains "max".
+func readSingleValue(path, field string, event common.MapStr) error {
+	content, err := readFile(path)
+	if err != nil || content == nil {
+		return err
+	}
+
+	raw := strings.TrimSpace(string(content))
+	if raw == "max" {
+		return nil
+	}
+	value, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid value in %s", filepath.Base(path))
+	}
+	event.Put(field, value)
+	return nil
+}
+
+// readKeyValueFile reads files with a key and a numeric value per line, as
+// cpu.stat or memory.stat. It returns nil if the file doesn't exist.
+func readKeyValueFile(path string) (common.MapStr, error) {
+	content, err := readFile(path)
+	if err != nil || content == nil {
+		return nil, err
+	}
+
+	stats := common.MapStr{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		parts := strings.Fields(scanner.Text())
+		if len(parts) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s in %s", parts[0], filepath.Base(path))
+		}
+		stats[parts[0]] = value
+	}
+	return stats, scanner.Err()
+}
+
+// readFile reads a file of a cgroup, it returns nil if the file doesn't exist,
+// what happens if the controller is not enabled for the cgroup.
+func readFile(path string) ([]byte, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return content, err
+}
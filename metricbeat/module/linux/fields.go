@@ -32,5 +32,5 @@ func init() {
 // AssetLinux returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/linux.
 func AssetLinux() string {
-	return "eJzUml+P28YRwN/vUwwOKBAHtnxnJ05yDwXcXFAErdtDHb+0aInV7lDcarlL7x/JyqcPdpeUKImUyJNIS3oJYkozv5mdv8t7BXNcPYDg0n25AbDcCnyA2/D/tzcAGgUSgw8wRUtuABgaqnlhuZIP8OcbAIi/hVwxJ/AGIOUomHkIj16BJDluxPuPXRX4ADOtXFH+S4PMjVyzMhZzyNFqTk35sK6jrocqKa0mdL5+0qTPf3btqj4tLP7TJHwXpA5jXJ4Tvdp61oZzRLX/lOJApSDTZA0DxhLLjeXUvAzfQQaEamUM/Pz0CajSaHZkNUHXwZlWu2wbcqHkrOHhEXj/KQidozVBfIEMmEOwauNWSAkX3GlsBUOixSoZCG/DgdJqjhtQqyAncwStVA6p0iBxCUru+bUGGiUMQFmxcQk2wxq0JVPR7rlUOckGwDGOUjQmdUKswCDRNEPWan5Fw2dSNRzz+ULMIEogQiNhq+AjpDYeJNk55930rEFKg9omPihxCNf9w+VT1D6dqzNdZqgRBDe2VF79JzLAAdQFEfxEyH3xex61GbFAiZTKwhQheLHBN+sCGOIh0Wgs0XYAF4aYB6HU3BXefZxmkJFwzlOEUu+m0sSvazT891pwrp2ofCUdonHsST7UNXzMTjR+dmjsJEc9Q5MUqBODtLGTpEKRXd8edd1vGYJcx59XCaVKA0EngwI1GKRKsnjsSx+bnx26mEe++DBccIqTRjOWmlsc2Y6g89yGbJ3HqAexoeXG7NHW7OpwAON6/jTy4PESeDJd2b1Gcjbsv3jh0eupVnkz42R3elI6J/YB9sm2DCBLwnfxTgQnC9RkhmB5jmAKlDZMI9tR0+jxWBAN6gWyQ/k6otdjyJzT7dGE8fy+E/TPdHyVoWQxS3xjGgbdS4ZvuIzue+GPwVN2zNhm8lBDB+YOOkCgnNnsLNBjpuVpgeGfcYqJFztQUEQNEdwHR86F4DH9zItgxK+v/3mav6fONG/Az6J/Qk1RWg+v0rDfBnbmNJezcgDcQm5vQt9MiWRLzmwGznLBfydebTB6860XE3iMXzfEOh2/oih1OkzrfiLmBhZEOK8FqFAmHO393d2fNv7YGzXnJh9iztwWe/BqwhLbXN+fcTHxt48fapcQPe8aCuIHQ5MRPciS9TEIjlr8zuwM7jaWJhbeqO9kGO4bXyk/ajsG4+Rgrvkk+WeHBzByssZYKEEsb7hfOB3jKRwNzYicea9YpSAlxlYFMljf7qXUCZEYSuQQdy2bDd1XmbhQhBUyhlNGFghTvxd7AHkI04TVM5GKYUIzwgfBjZ4MRTqgaSThNiYnXxJPXEV2N0zmimF9ylwhOCV+P/cVZCcOK6Qcc7V1SXO2avn3eG0d5AMjWz87VDujK59fQEuNRejAzy6bMx9zydwsScEmwXd9D6saqONgfuw0Y8iXgQ7TFUTVxwAZ10jt+IBRr2i/3StmqUYcD8xrC37zw0V8p3HIdxaJGPF0N8tZpNVIBeF515MOtOMddTvt0WOPX0gwTTnlKOlqUtD2m0lDiUCWNI2qdeoiTqXHsKPuihY2DJUAMsMJvAehlqhr/wZcslAoTS14/LhprHazmYhtcy031pf2Ih+P8+u4IOr+Ki6ozM/cDJtitL16FxpT/uUBbv8TvPDf20PV/Te/EAQpQJW0vtXXqrzvU6R8E+FBygB2Jmw0smbc3vXHkYZglSVi2LQ71tBrBpVvpwqlRHsgOoOs8aapM3fbjztgf4hd2DN4WiKEirPIxooj5Iey5gh3x2xpGfO2duCtKDo4KWvsPb6fNz7IgnAR5ue+kaIxXpR8Xf6KAqbOglS2MWi6GWScLoQbuE0es0ctUFOV57xr2DNMiRO26bqvM/oJKfsY1ce7zFTpRub6lM5lqobYHBpkH1oYpo6xVbLzg3agDt54JJbEq/PXhVb0ddDgFURhvhuGwhYjcroCpdlevBzrKI8f3rcechNzB+7A/uF93H0et1euY1h1tNu73R68jdcSgx0JA4VGBJo5OTc+Vd787+7bp/d//SX5+Ou/fzmMdj862n1XtDejo73pivZ2dLS3XdG+Gx3tu65o34+O9n1XtHejo73rivbD6Gg/dEX7cXS0H7ui/TQ62k+dS+747eC+rR9UUFIxNJNvGzu+mv4f95aHDiT/Istq5uRKQmj4tSnAd1WvYGvS2B+MNBqz/UeWJwxG8RK1kuk3XSECmJ/2PGT5d7thXqOFe1nu5i+BSAa86whFCzcxKsfJ/V3D3tX+QvHwwnXE5VuvSjOisXoT4Gf8+K6RWPBOsuDhwBIzL185Bkcg8zv9z0+fwqgNBCzK6k+RllwytWy+olhb++6qrTX8i131svft3VUbbDNfJjInmUbWy/BwezPxyid7W2FrDetgX5ALZGqUcLZKz9rrfqrV+nV/X6ubTSqv3q41WctroX75Wrf5ClN22+bOWVu3+hoTd+eo++Zu3fyrS99DF+Tls9QJcWkZvLFRCJBKvuJMHDYRDM+dsESickY8M7GDKy4ssc/mir75Hpxxafl+vsB4ZhkIXrmoMtDfJc0GcnW1zZyrnvle2Xphud7P1s4JXVl7acnc82j7Zmxl9kVlazebWw269mbN1WmNunLBhSXuWVzQJ5+vvjm3B8Iz0vy6m7JP9z8CAAD//wf37qw="
+	return "eJzcnV+P27aywN/9KQYBLtAWG2eTtGm7DxfIzQYXi9ttFk3ycg/OcWlxbPMsRaokZa/76Q+GlCzZlmTZlpW10wWKXdmc3wyHw+FfvYRHXN6AFCp9GgA44STewAv/+4sBgEGJzOINjNGxAQBHGxmROKHVDfz3AADCdyHWPJU4AJgIlNze+EcvQbEYi+LpP7dM8AamRqdJ9peKMoty7dI6jCFGZ0Rks4dlGWU5UbnYamEA20oBNILQzx9odWoihNSyKQJTHBKD1qYGwTomJQg10SZmZBnQE/jNWyUAWZi/KZW2SV/WIGFutvYg1+ERlwtt+MazBmL6eWBuRjBuhpltqEKZE3MEp/2fjdYu/0iqxEQgh5lAw0w0Ww4rGaMkHXozDNO8QvJ/gVVqNd0P9It2TMKHh6/gRIwQaWXTGDmMlx7SMftoQaiSIlf0aywioy1GWnHbxIqmO9RtSKGARECsOXZDHJz+xMx5y+qMOkEjNO+K+fc0HqMh10RqWRHGqBxkMnKPJcXGTPGF4G4GUsTCgZsxBzM2R0DJEou8ntjNjHZOIu+VXShYzEQ0K1kZFsxCQdMCuDPfCE3Pe0fhAnqyBocGC7qWHhFjrM0yCxTjpcPWwCGO3kDVl3Yoc++FUnNcxY5MB4rYwllfc6g4U66Z2/tS/9zUucyY4Zkvr9XDFSjtwGCijUMOwj8zCMKC0uELjSrZBUt6ro/PC5Z0URsevd8qydBPWiGOueEPG5KDLnr8b4zcxqPwx1Gttu2czDrmNlr4FTBb0kPBnyXCPxt1wDlS5fWnRRFbAwEEgpYKhQ/XqCT00CDjvbnY/5AcIJEbzWNidAyUVHKciwhr2obQw4URrr/WHHhJpkO1gex0K2BSdqiT1rStnYEKBp2g8Rm4BWFtivwwSNIQT0LpS+4KkwsbMdO3u2ZSt6CF2gv6FNbNyu7EvongdhilxqBynYMmRkdoLW7k2u27RU/nO5iO2O7Zk4jTGFQFYzd9HuXb+ZB5aHWMw9fXw2SrdwjGnUjNXI3nJmii7UrZod+XGQKbo6Hu3M6YQaoGn/SusnHmgKYFHBBcfR7sR/vIQSs/XtVzNMCAAmJIh2EhFNeLtkZ4d0FGsOLJLQ8zw9vrS3KGmUGEWaq4QX6YPRyNyobE1N0sALUBXy6wsdUydRl/mHP5bmNU9/2Rxmih6SSV8tlFASlBafVScNlLEPA2eHc5NjgsBngrvL2+HDMcFwK8OZ5XCDjAFo3jxgtLBbKx6H6BoNIU7y7LFK3jQaUx3l5fljX2DguVVjnz5CDTqZW+F5EidBIcLiJR6Cg2XEa60HFouICkIVOpUl2hLy1nuPu0Z0gQ+tJyhbtPe8cCoS8uR7j7dHAQEPqycoO7T7v1vIic4KjGfxG5wJFt/zJygI6a/gX0/eWWn6sYaaWcYdHjKbb0VRXetDXPpnHMzLLSsJs4LSybFUdWUZPRCoacwwnrRGSv/GeQA6OBl/XTS5E2W8t2VdBlcG70JlujS7SAp5+ERY9Ii1VGJwly4CmNIAuzwoQJKVKDtWDIjFyOToRXcKByRmAB6jTE7NFvfYwpIoDCBWiFth40lHACypwtXwdcQTs2lvWWm+hU8RPg2DSiRT8KJxSQaRMo8lr1cxoxVdrgCXByF7OItLpMa/xLbyOMaPGR9hyUTEaYy3pIZdG4ETklnsJ0xepuXqcLvzAqhXWZ8Px/gQEaUOdMiiMhYbdF/U7JiCnaxTRG8K0FeS1W8IeRQeuYccfRVXwAgs+D1PqR9kyHfmTGfD2PETK5RaQJHzdoxd8l51wZUVMkHeyK1Ad0HFslN/Ua5LNDg3+laN0wRjNFO0rQjCxGgyrrVWUtO01HmUmxck8iIRNpwcvklPHkSYWvdp+I/JUibZLJtoKH/RDVyYbfuNKzHl5m14qs1UevFVHQZptS1mhLelVyr1dAv5Y/jtxbPANu2KvUAXZpP53fQVfJOKwZEmyTrSnAFky4bsHzsYRPpW1CW8QpG1n3mkqLh4Bo0czrNosHd+nR6l5gp2b3JfZo9w2nP9Dw2feHbD4dUcd0GnQq2Y++vPm+z4dWLVtsNbmPoSfm9jJAopq6WSfQfTbLDPNAx6DWKiIcUWs/DXAmoTw0l1KshuakxN2rT8fZe5zaZXf0D2EahuD1pDilxFMj1DRLANeQa2nhu+IYUOqEFH/7fcB+eFd86vsh3IaPW+ZSkx3Wi6LUULrpM2JhYc5kSjaBSGrrx7Svr6//q7DHYNMojzYebNqjgzxzvdimJJPy0er4vonRolr+7/N9aRJi43EVRZkkYZQY+tmx7YHE8SOEz77gICU7dzdswSLU9BQwgtKNrPzAtAsmVSczzVcl/kqxASNmK4y5lswJiSfAeCABEM2YmpJVnNYwocWQLEB67eutRBMPIxsxZU+AVozQKUKGAYUfQnqbhSODY5ppIADVhGn90HOkNMdRNGPiJLjBksVpRoPMz8bE7GlExLlnt8PkaXIKyMKmPE2kiBjNy1AE2fDDHCms8A52hakDomU4cJ2tqXPmWMvYSaCjIwJoJpGKOSJsTsnnRo90zo0PqazNEnZWVp5Qh3mAig+sUXsRuaPT8YggehcgFwYj1z9gkCuXDXwTg9gfGElbHSsJJ6kb2KxDJnus3WJw5oWBwUgyEbetaU/bX1XX0+6s9vCBEU4mIhKoomXFamBBayMmkY+qUtXdi4Nb2EF2TgsFQ14A3ZAA70HqBZrS30Ao7gOlLTkPpZvWmXQ6laHbXJVbtSembILgVd/GBEH2NzFBrv4snWKVj9ZH78TgRDzdwIt/eEf454tBg4ZfZsKG3okWGhx19aUoT8uOLFuJIJDMgf0Za1rdLZQb7tkh+K2YtbXYSbPb1aGXFMpWpxKt5bAWmY55V840teau+3IL7NUBejqHTWtFUlMT4yUtdpA3tZod3JljH0a+PgYuGT3durqizEwd0KG83fgHmzMhKcHc21MM0lQF8m/Ln1PAOHX+UGOV07RTyKYmkan9tvrQXsJIx7Fo6/YcJyyVrmq6r48mexvEA4mn8iqZc1aF7lTLeb+jW2jzCJFOlUOz2qfyiEahDFPar+jE7iuF7lUG4o/qFn+1Kk4GzdE918RFyQif+rz34eOTQ8WRw5cPDystr4q5+i9R8vHJ0fJIfnnVlrrDSlXEN9PkrlKRuwP1iJP+K+U+lU7QbV91tXL/cES99KhIXVVUc1NDqYGO4l6xP9xXg0fxQeij2E57xP+QkZN1vSoxWso6rR+TLhPcUOneTvfVykV91kd9bNqXO+V9cn+9reT+yg/hHkla8uwV/uVvtOpZrYF/1EKLXAPqu+kaxFP00hVlN/Wy45Tz5WjjC/VALWx1yxwrJwNeAgkI2tGQlTL2LG0cL0EbvpXUVSGXsW/v3289a2JuwU0/t/fvPRfcrs+L7sIqo7243hwo5/9qvW8PQvqhIQ1Es1SFvcJv/nX9w8P7//04+nz3/x+b0V73jva6Ldqb3tHetEV72zva27ZoP/aO9mNbtJ96R/upLdq73tHetUX7uXe0n9ui/dI72i9t0X7tHe3Xtmiv++8OXtf1BzkUrcfudRVhC5I/2CKfTaQsyHf4pSyAelUSsJZpDDbB8nM6g02qgxKjsNKZl1lx/XR2WbafVImS9CqbQL/ykxZCD5o7/hyarqk5l4O2TTextDtet9L23Vlr2/oo3Urft9dnrfDex+ZWij/7k7IVWlerlK2PnWtjzdZu9muvZZ3fnbvOrVttWeu31+eu9t5tt6z+2TXfDL5Jsed4sr3pRG+1imBFnErHFOrUygMb9nM84t6ZKfZt78/yrHtn1jg0DDy/Q+/7m6RaQaHPNvMWes/2LvTZduJC792ghT7fzlvog1us0GfaaQtdq9C5d9ZCH9dRC332nbTQx3bQQp9/5yx0Vx2z0GfeKZebe66Ui5KNdcQj5u7W9Xo/nRqcMtqwlc/Z6Um2FK5UWHi1V6DHtPEUi1PNwgDtWNnAaprMo51tciiSysoQyX5V8RsVBncP+U6pAvYKtJLL4vUA/lDdan10vLq8Q2hV7UGBk16o05HbBFYqcJvWXrUiBW1Aroqp5jYYa4ddGfgPX1qlhfdgDkz5sdBG7g4NnrEfbfE29LQTb3Pr4hGvS/xMxZ3AqYvnXQXDYtdnqWxgRTjJtq361x1VQxnn6LB3dwH6fdYjZfazsdaOzqsZuqvopTMiCaF6y7625RvliDhmT90R5y89OQnqnJmT2neTdc6MWL199DDqaKE4MW+IDsBcp9s3UrVE9lt8C6wpWsLKUok6XotTeuVjnYXRGaZsLJwdVh2WONTK4XWIxZGknKIk0JVeKFQwgxWKLgKY4TKkFXRd0VgKO0O+W4VTvWlohe/PkVLCPEaKWuva0LogPfO74KNHpRcS+RQ5LLGmv5PanhCW3v8qONLgkgRVI6Sq6rqqDiksXf1ApmlvFouKj/wlFw13rlTANW2n3wEeLr3J4j3JBy/fVjarLU0yP8462wTRVCtmMJp/S8UMRkhvLd6hW/ldeiwJB5Jru2XPNNzLiY5WZ+VTO2rhit4muco4sufhREKTMpmZ+tMnF3ggr90j5nVje6EimfK1EGht1uccZvFSIO1NlTWpjeT/GQATu6ET"
 }
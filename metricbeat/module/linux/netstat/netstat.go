@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netstat
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/linux"
+)
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "netstat", New)
+}
+
+// sectionNames are the names used in events for the known sections of the
+// counter files, other sections are reported with their lowercased name.
+var sectionNames = map[string]string{
+	"TcpExt":   "tcp_ext",
+	"IpExt":    "ip_ext",
+	"MPTcpExt": "mptcp_ext",
+	"Ip":       "ip",
+	"Icmp":     "icmp",
+	"IcmpMsg":  "icmp_msg",
+	"Tcp":      "tcp",
+	"Udp":      "udp",
+	"UdpLite":  "udp_lite",
+}
+
+// MetricSet holds any configuration or state information. It must implement
+// the mb.MetricSet interface. And this is best achieved by embedding
+// mb.BaseMetricSet because it implements all of the required mb.MetricSet
+// interface methods except for Fetch.
+type MetricSet struct {
+	mb.BaseMetricSet
+	procNet string
+}
+
+// New creates a new instance of the MetricSet. New is responsible for unpacking
+// any MetricSet specific configuration options if there are any.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux netstat metricset is beta.")
+
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("the linux/netstat metricset is only supported on Linux")
+	}
+
+	linuxModule, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		procNet:       filepath.Join(linuxModule.HostFS, "/proc/net"),
+	}, nil
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(report mb.ReporterV2) error {
+	event := common.MapStr{}
+	for _, name := range []string{"netstat", "snmp"} {
+		path := filepath.Join(m.procNet, name)
+		if err := readCounters(path, event); err != nil {
+			return errors.Wrapf(err, "error reading counters from %s", path)
+		}
+	}
+
+	report.Event(mb.Event{MetricSetFields: event})
+	return nil
+}
+
+// readCounters reads the counters of a file of /proc/net into the event.
+func readCounters(path string, event common.MapStr) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return parseCounters(f, event)
+}
+
+// parseCounters parses files in the format of /proc/net/netstat and /proc/net/snmp,
+// where each section has a line with the names of its counters, followed by a
+// line with their values, both prefixed by the name of the section.
+func parseCounters(r io.Reader, event common.MapStr) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024)
+	for scanner.Scan() {
+		names := strings.Fields(scanner.Text())
+		if len(names) == 0 {
+			continue
+		}
+		if !scanner.Scan() {
+			return fmt.Errorf("missing values of section %s", names[0])
+		}
+		values := strings.Fields(scanner.Text())
+		if len(values) != len(names) || values[0] != names[0] {
+			return fmt.Errorf("values of section %s don't match its counters", names[0])
+		}
+
+		section := strings.TrimSuffix(names[0], ":")
+		counters := common.MapStr{}
+		for i := 1; i < len(names); i++ {
+			value, err := parseValue(values[i])
+			if err != nil {
+				return errors.Wrapf(err, "error parsing %s in section %s", names[i], section)
+			}
+			counters[names[i]] = value
+		}
+
+		name, found := sectionNames[section]
+		if !found {
+			name = strings.ToLower(section)
+		}
+		event[name] = counters
+	}
+	return scanner.Err()
+}
+
+// parseValue parses the value of a counter, most of them are unsigned, but
+// some are signed, as Tcp MaxConn, which is -1 when there is no limit.
+func parseValue(s string) (interface{}, error) {
+	if strings.HasPrefix(s, "-") {
+		return strconv.ParseInt(s, 10, 64)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
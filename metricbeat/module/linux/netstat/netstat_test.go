@@ -0,0 +1,90 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netstat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	assert.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	fields := events[0].MetricSetFields
+	for _, section := range []string{"tcp_ext", "ip_ext", "mptcp_ext", "ip", "icmp", "icmp_msg", "tcp", "udp", "udp_lite"} {
+		assert.Contains(t, fields, section)
+	}
+
+	expected := common.MapStr{
+		"tcp_ext.TW":               uint64(346),
+		"ip_ext.InOctets":          uint64(504883587),
+		"ip.InReceives":            uint64(41960),
+		"icmp_msg.OutType3":        uint64(940),
+		"tcp.MaxConn":              int64(-1),
+		"tcp.RetransSegs":          uint64(17),
+		"udp.OutDatagrams":         uint64(155),
+		"udp_lite.NoPorts":         uint64(0),
+		"mptcp_ext.MPCapableSYNRX": uint64(0),
+	}
+	for field, value := range expected {
+		actual, err := fields.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.Equal(t, value, actual, field)
+		}
+	}
+}
+
+func TestParseCountersErrors(t *testing.T) {
+	cases := map[string]string{
+		"missing values":  "Udp: InDatagrams NoPorts\n",
+		"values mismatch": "Udp: InDatagrams NoPorts\nUdp: 155\n",
+		"other section":   "Udp: InDatagrams NoPorts\nTcp: 155 0\n",
+		"invalid value":   "Udp: InDatagrams NoPorts\nUdp: 155 none\n",
+	}
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := parseCounters(strings.NewReader(content), common.MapStr{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"netstat"},
+		"hostfs":     "./_meta/testdata",
+	}
+}
@@ -108,33 +108,14 @@ func fetchLinuxPSIStats(m *MetricSet) ([]common.MapStr, error) {
 			return nil, errors.Wrap(err, "check that /proc/pressure is available, and/or enabled")
 		}
 
-		event := common.MapStr{
-			resource: common.MapStr{
-				"some": common.MapStr{
-					"10": common.MapStr{
-						"pct": psiMetric.Some.Avg10,
-					},
-					"60": common.MapStr{
-						"pct": psiMetric.Some.Avg60,
-					},
-					"300": common.MapStr{
-						"pct": psiMetric.Some.Avg300,
-					},
-					"total": common.MapStr{
-						"time": common.MapStr{
-							"us": psiMetric.Some.Total,
-						},
-					},
-				},
-			},
+		// 'full' metrics are not reported for /proc/pressure/cpu, they are not
+		// present or always zero at the system level
+		if resource == "cpu" {
+			psiMetric.Full = nil
 		}
 
-		// /proc/pressure/cpu does not contain 'full' metrics
-		if resource != "cpu" {
-			event.Put(resource+".full.10.pct", psiMetric.Full.Avg10)
-			event.Put(resource+".full.60.pct", psiMetric.Full.Avg60)
-			event.Put(resource+".full.300.pct", psiMetric.Full.Avg300)
-			event.Put(resource+".full.total.time.us", psiMetric.Full.Total)
+		event := common.MapStr{
+			resource: PSIFields(psiMetric),
 		}
 
 		events = append(events, event)
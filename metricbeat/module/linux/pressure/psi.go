@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pressure
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/prometheus/procfs"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const psiLineFormat = "avg10=%f avg60=%f avg300=%f total=%d"
+
+// ParsePSIStats parses pressure stall information in the format used by the
+// files in /proc/pressure, and by the *.pressure files of cgroups v2.
+func ParsePSIStats(r io.Reader) (procfs.PSIStats, error) {
+	stats := procfs.PSIStats{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		l := scanner.Text()
+		prefix := strings.Split(l, " ")[0]
+		if prefix != "some" && prefix != "full" {
+			// Ignore unknown measurements that could be added in the future
+			continue
+		}
+
+		psi := procfs.PSILine{}
+		_, err := fmt.Sscanf(l, prefix+" "+psiLineFormat, &psi.Avg10, &psi.Avg60, &psi.Avg300, &psi.Total)
+		if err != nil {
+			return procfs.PSIStats{}, fmt.Errorf("invalid pressure line '%s': %v", l, err)
+		}
+		if prefix == "some" {
+			stats.Some = &psi
+		} else {
+			stats.Full = &psi
+		}
+	}
+
+	return stats, scanner.Err()
+}
+
+// PSIFields returns the fields for the pressure stall information of a resource.
+// Full metrics are only included if they are available.
+func PSIFields(stats procfs.PSIStats) common.MapStr {
+	fields := common.MapStr{}
+	if stats.Some != nil {
+		fields["some"] = psiLineFields(stats.Some)
+	}
+	if stats.Full != nil {
+		fields["full"] = psiLineFields(stats.Full)
+	}
+	return fields
+}
+
+func psiLineFields(line *procfs.PSILine) common.MapStr {
+	return common.MapStr{
+		"10": common.MapStr{
+			"pct": line.Avg10,
+		},
+		"60": common.MapStr{
+			"pct": line.Avg60,
+		},
+		"300": common.MapStr{
+			"pct": line.Avg300,
+		},
+		"total": common.MapStr{
+			"time": common.MapStr{
+				"us": line.Total,
+			},
+		},
+	}
+}
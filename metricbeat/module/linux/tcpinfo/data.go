@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package tcpinfo
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// group aggregates the metrics of the connections of a group.
+type group struct {
+	fields common.MapStr
+
+	connections int
+	recvQueue   uint64
+	sendQueue   uint64
+
+	// withInfo is the number of connections with tcp_info, the rest of the
+	// metrics are aggregated only from them.
+	withInfo     int
+	rttSum       uint64
+	rttMax       uint32
+	rttvarSum    uint64
+	sndCwndSum   uint64
+	totalRetrans uint64
+	retrans      uint64
+	lost         uint64
+	unacked      uint64
+
+	withBytesReceived int
+	bytesAcked        uint64
+	bytesReceived     uint64
+
+	withBytesSent int
+	bytesSent     uint64
+	bytesRetrans  uint64
+}
+
+func (g *group) add(c connection) {
+	g.connections++
+	g.recvQueue += uint64(c.recvQueue)
+	g.sendQueue += uint64(c.sendQueue)
+
+	info := c.info
+	if info == nil {
+		return
+	}
+	g.withInfo++
+	g.rttSum += uint64(info.rtt)
+	if info.rtt > g.rttMax {
+		g.rttMax = info.rtt
+	}
+	g.rttvarSum += uint64(info.rttvar)
+	g.sndCwndSum += uint64(info.sndCwnd)
+	g.totalRetrans += uint64(info.totalRetrans)
+	g.retrans += uint64(info.retrans)
+	g.lost += uint64(info.lost)
+	g.unacked += uint64(info.unacked)
+
+	if info.hasBytesReceived {
+		g.withBytesReceived++
+		g.bytesAcked += info.bytesAcked
+		g.bytesReceived += info.bytesReceived
+	}
+	if info.hasBytesSent {
+		g.withBytesSent++
+		g.bytesSent += info.bytesSent
+		g.bytesRetrans += info.bytesRetrans
+	}
+}
+
+func (g *group) event() common.MapStr {
+	event := g.fields.Clone()
+	event.DeepUpdate(common.MapStr{
+		"connections": g.connections,
+		"recv_queue":  common.MapStr{"bytes": g.recvQueue},
+		"send_queue":  common.MapStr{"bytes": g.sendQueue},
+	})
+	if g.withInfo == 0 {
+		return event
+	}
+
+	event.DeepUpdate(common.MapStr{
+		"rtt": common.MapStr{
+			"avg": common.MapStr{"us": g.rttSum / uint64(g.withInfo)},
+			"max": common.MapStr{"us": g.rttMax},
+		},
+		"rttvar": common.MapStr{
+			"avg": common.MapStr{"us": g.rttvarSum / uint64(g.withInfo)},
+		},
+		"cwnd": common.MapStr{
+			"avg": float64(g.sndCwndSum) / float64(g.withInfo),
+		},
+		"retransmits": common.MapStr{
+			"total":   g.totalRetrans,
+			"current": g.retrans,
+		},
+		"lost":    g.lost,
+		"unacked": g.unacked,
+	})
+
+	bytes := common.MapStr{}
+	if g.withBytesReceived > 0 {
+		bytes["acked"] = g.bytesAcked
+		bytes["received"] = g.bytesReceived
+	}
+	if g.withBytesSent > 0 {
+		bytes["sent"] = g.bytesSent
+		bytes["retransmitted"] = g.bytesRetrans
+	}
+	if len(bytes) > 0 {
+		event["bytes"] = bytes
+	}
+	return event
+}
@@ -0,0 +1,186 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package tcpinfo
+
+import (
+	"bytes"
+	"net"
+	"syscall"
+
+	"github.com/elastic/gosigar/sys"
+	"github.com/elastic/gosigar/sys/linux"
+	"github.com/pkg/errors"
+)
+
+const (
+	// inetDiagInfo is the type of the attribute containing the tcp_info struct.
+	inetDiagInfo = 2
+
+	// sizeofInetDiagMsg is the size of the inet_diag_msg struct, attributes
+	// are placed after it.
+	sizeofInetDiagMsg = 72
+
+	// readBufferSize is the size of the buffer used to read the responses.
+	readBufferSize = 64 * 1024
+)
+
+var byteOrder = sys.GetEndian()
+
+// dumpFunc requests the TCP sockets of an address family in the given states,
+// and returns the raw netlink response.
+type dumpFunc func(family linux.AddressFamily, states uint32) ([]byte, error)
+
+// netlinkDump dumps the TCP sockets with their tcp_info using inet_diag.
+func netlinkDump(family linux.AddressFamily, states uint32) ([]byte, error) {
+	req := linux.NewInetDiagReqV2(family)
+
+	// Request the INET_DIAG_INFO extension and filter by state in the kernel,
+	// they are the ext and states fields of inet_diag_req_v2.
+	req.Data[2] = 1 << (inetDiagInfo - 1)
+	byteOrder.PutUint32(req.Data[4:8], states)
+
+	var resp bytes.Buffer
+	if _, err := linux.NetlinkInetDiagWithBuf(req, make([]byte, readBufferSize), &resp); err != nil {
+		return nil, errors.Wrapf(err, "failed requesting %v TCP sockets", family)
+	}
+	return resp.Bytes(), nil
+}
+
+// connection is a TCP connection as reported by inet_diag.
+type connection struct {
+	state      linux.TCPState
+	localIP    net.IP
+	localPort  int
+	remoteIP   net.IP
+	remotePort int
+	recvQueue  uint32
+	sendQueue  uint32
+
+	// info is nil if the kernel didn't report the tcp_info of the socket,
+	// as happens with sockets in TIME-WAIT state.
+	info *tcpInfo
+}
+
+// tcpInfo contains the fields of interest of the tcp_info struct. Fields added in
+// later versions of the kernel are only set if the struct is long enough.
+// https://github.com/torvalds/linux/blob/v5.10/include/uapi/linux/tcp.h#L214
+type tcpInfo struct {
+	unacked      uint32
+	lost         uint32
+	retrans      uint32
+	rtt          uint32
+	rttvar       uint32
+	sndCwnd      uint32
+	totalRetrans uint32
+
+	hasBytesReceived bool
+	bytesAcked       uint64
+	bytesReceived    uint64
+
+	hasBytesSent bool
+	bytesSent    uint64
+	bytesRetrans uint64
+}
+
+// parseDump parses the raw netlink response of a dump of TCP sockets.
+func parseDump(raw []byte) ([]connection, error) {
+	msgs, err := syscall.ParseNetlinkMessage(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing netlink messages")
+	}
+
+	var connections []connection
+	for _, m := range msgs {
+		switch m.Header.Type {
+		case syscall.NLMSG_DONE:
+			return connections, nil
+		case syscall.NLMSG_ERROR:
+			return nil, linux.ParseNetlinkError(m.Data)
+		}
+
+		msg, err := linux.ParseInetDiagMsg(m.Data)
+		if err != nil {
+			return nil, err
+		}
+		c := connection{
+			state:      linux.TCPState(msg.State),
+			localIP:    msg.SrcIP(),
+			localPort:  msg.SrcPort(),
+			remoteIP:   msg.DstIP(),
+			remotePort: msg.DstPort(),
+			recvQueue:  msg.RQueue,
+			sendQueue:  msg.WQueue,
+		}
+		if len(m.Data) > sizeofInetDiagMsg {
+			if info, found := parseAttributes(m.Data[sizeofInetDiagMsg:])[inetDiagInfo]; found {
+				c.info = parseTCPInfo(info)
+			}
+		}
+		connections = append(connections, c)
+	}
+	return connections, nil
+}
+
+// parseAttributes parses the netlink attributes of an inet_diag message.
+func parseAttributes(b []byte) map[uint16][]byte {
+	attrs := map[uint16][]byte{}
+	for len(b) >= syscall.SizeofRtAttr {
+		length := int(byteOrder.Uint16(b[0:2]))
+		if length < syscall.SizeofRtAttr || length > len(b) {
+			break
+		}
+		attrs[byteOrder.Uint16(b[2:4])] = b[syscall.SizeofRtAttr:length]
+
+		aligned := (length + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+		if aligned > len(b) {
+			break
+		}
+		b = b[aligned:]
+	}
+	return attrs
+}
+
+// parseTCPInfo parses a tcp_info struct, it returns nil if it is shorter than
+// the one of the oldest supported kernels.
+func parseTCPInfo(b []byte) *tcpInfo {
+	if len(b) < 104 {
+		return nil
+	}
+	info := &tcpInfo{
+		unacked:      byteOrder.Uint32(b[24:28]),
+		lost:         byteOrder.Uint32(b[32:36]),
+		retrans:      byteOrder.Uint32(b[36:40]),
+		rtt:          byteOrder.Uint32(b[68:72]),
+		rttvar:       byteOrder.Uint32(b[72:76]),
+		sndCwnd:      byteOrder.Uint32(b[80:84]),
+		totalRetrans: byteOrder.Uint32(b[100:104]),
+	}
+	if len(b) >= 136 {
+		info.hasBytesReceived = true
+		info.bytesAcked = byteOrder.Uint64(b[120:128])
+		info.bytesReceived = byteOrder.Uint64(b[128:136])
+	}
+	if len(b) >= 216 {
+		info.hasBytesSent = true
+		info.bytesSent = byteOrder.Uint64(b[200:208])
+		info.bytesRetrans = byteOrder.Uint64(b[208:216])
+	}
+	return info
+}
@@ -0,0 +1,239 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package tcpinfo
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/elastic/gosigar/sys/linux"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "tcpinfo", New)
+}
+
+const (
+	groupByConnection    = "connection"
+	groupByRemoteService = "remote_service"
+	groupByLocalPort     = "local_port"
+)
+
+// tcpStates are the names of the TCP states that can be used in the configuration,
+// and that are reported for each connection.
+var tcpStates = map[string]linux.TCPState{
+	"established": linux.TCP_ESTABLISHED,
+	"syn_sent":    linux.TCP_SYN_SENT,
+	"syn_recv":    linux.TCP_SYN_RECV,
+	"fin_wait1":   linux.TCP_FIN_WAIT1,
+	"fin_wait2":   linux.TCP_FIN_WAIT2,
+	"time_wait":   linux.TCP_TIME_WAIT,
+	"close":       linux.TCP_CLOSE,
+	"close_wait":  linux.TCP_CLOSE_WAIT,
+	"last_ack":    linux.TCP_LAST_ACK,
+	"listen":      linux.TCP_LISTEN,
+	"closing":     linux.TCP_CLOSING,
+}
+
+type config struct {
+	States      []string `config:"tcpinfo.states"`
+	LocalPorts  []int    `config:"tcpinfo.local_ports"`
+	RemotePorts []int    `config:"tcpinfo.remote_ports"`
+	RemoteCIDRs []string `config:"tcpinfo.remote_cidrs"`
+	GroupBy     string   `config:"tcpinfo.group_by"`
+}
+
+var defaultConfig = config{
+	States:  []string{"established"},
+	GroupBy: groupByRemoteService,
+}
+
+// MetricSet holds any configuration or state information. It must implement
+// the mb.MetricSet interface. And this is best achieved by embedding
+// mb.BaseMetricSet because it implements all of the required mb.MetricSet
+// interface methods except for Fetch.
+type MetricSet struct {
+	mb.BaseMetricSet
+	states      uint32
+	localPorts  map[int]bool
+	remotePorts map[int]bool
+	remoteNets  []*net.IPNet
+	groupBy     string
+	dump        dumpFunc
+}
+
+// New creates a new instance of the MetricSet. New is responsible for unpacking
+// any MetricSet specific configuration options if there are any.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux tcpinfo metricset is beta.")
+
+	config := defaultConfig
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		localPorts:    portSet(config.LocalPorts),
+		remotePorts:   portSet(config.RemotePorts),
+		groupBy:       config.GroupBy,
+		dump:          netlinkDump,
+	}
+
+	for _, name := range config.States {
+		state, found := tcpStates[name]
+		if !found {
+			return nil, fmt.Errorf("unknown TCP state '%s' in tcpinfo.states", name)
+		}
+		m.states |= 1 << state
+	}
+
+	for _, cidr := range config.RemoteCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid network in tcpinfo.remote_cidrs")
+		}
+		m.remoteNets = append(m.remoteNets, ipNet)
+	}
+
+	switch config.GroupBy {
+	case groupByConnection, groupByRemoteService, groupByLocalPort:
+	default:
+		return nil, fmt.Errorf("invalid tcpinfo.group_by '%s', it must be one of %s, %s or %s",
+			config.GroupBy, groupByConnection, groupByRemoteService, groupByLocalPort)
+	}
+
+	return m, nil
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(report mb.ReporterV2) error {
+	var connections []connection
+	for _, family := range []linux.AddressFamily{linux.AF_INET, linux.AF_INET6} {
+		raw, err := m.dump(family, m.states)
+		if err != nil {
+			report.Error(err)
+			continue
+		}
+		familyConnections, err := parseDump(raw)
+		if err != nil {
+			report.Error(errors.Wrapf(err, "error parsing %v TCP sockets", family))
+			continue
+		}
+		connections = append(connections, familyConnections...)
+	}
+
+	groups := map[string]*group{}
+	var keys []string
+	for _, c := range connections {
+		if !m.matches(c) {
+			continue
+		}
+		key, fields := m.groupKey(c)
+		g, found := groups[key]
+		if !found {
+			g = &group{fields: fields}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.add(c)
+	}
+
+	for _, key := range keys {
+		if !report.Event(mb.Event{MetricSetFields: groups[key].event()}) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// matches checks if a connection matches the configured filters.
+func (m *MetricSet) matches(c connection) bool {
+	if m.states&(1<<c.state) == 0 {
+		return false
+	}
+	if len(m.localPorts) > 0 && !m.localPorts[c.localPort] {
+		return false
+	}
+	if len(m.remotePorts) > 0 && !m.remotePorts[c.remotePort] {
+		return false
+	}
+	if len(m.remoteNets) == 0 {
+		return true
+	}
+	for _, ipNet := range m.remoteNets {
+		if ipNet.Contains(c.remoteIP) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupKey returns the key of the group of a connection, and the fields that
+// identify the group in its event.
+func (m *MetricSet) groupKey(c connection) (string, common.MapStr) {
+	switch m.groupBy {
+	case groupByLocalPort:
+		return strconv.Itoa(c.localPort), common.MapStr{
+			"local": common.MapStr{"port": c.localPort},
+		}
+	case groupByConnection:
+		local := net.JoinHostPort(c.localIP.String(), strconv.Itoa(c.localPort))
+		remote := net.JoinHostPort(c.remoteIP.String(), strconv.Itoa(c.remotePort))
+		return local + "-" + remote, common.MapStr{
+			"local":  common.MapStr{"ip": c.localIP.String(), "port": c.localPort},
+			"remote": common.MapStr{"ip": c.remoteIP.String(), "port": c.remotePort},
+			"state":  stateName(c.state),
+		}
+	default:
+		return net.JoinHostPort(c.remoteIP.String(), strconv.Itoa(c.remotePort)), common.MapStr{
+			"remote": common.MapStr{"ip": c.remoteIP.String(), "port": c.remotePort},
+		}
+	}
+}
+
+func portSet(ports []int) map[int]bool {
+	set := make(map[int]bool, len(ports))
+	for _, port := range ports {
+		set[port] = true
+	}
+	return set
+}
+
+func stateName(state linux.TCPState) string {
+	for name, s := range tcpStates {
+		if s == state {
+			return name
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,243 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package tcpinfo
+
+import (
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/elastic/gosigar/sys/linux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestParseDump(t *testing.T) {
+	raw, err := ioutil.ReadFile("./_meta/testdata/inet_diag_ipv4.bin")
+	require.NoError(t, err)
+
+	connections, err := parseDump(raw)
+	require.NoError(t, err)
+	require.Len(t, connections, 6)
+
+	c := connections[1]
+	assert.Equal(t, linux.TCP_ESTABLISHED, c.state)
+	assert.Equal(t, "10.0.0.5", c.localIP.String())
+	assert.Equal(t, 43212, c.localPort)
+	assert.Equal(t, "10.0.1.20", c.remoteIP.String())
+	assert.Equal(t, 9200, c.remotePort)
+	assert.EqualValues(t, 10, c.recvQueue)
+	require.NotNil(t, c.info)
+	assert.EqualValues(t, 2500, c.info.rtt)
+	assert.EqualValues(t, 500, c.info.rttvar)
+	assert.EqualValues(t, 20, c.info.sndCwnd)
+	assert.EqualValues(t, 1, c.info.retrans)
+	assert.EqualValues(t, 20100, c.info.bytesSent)
+
+	// TIME-WAIT sockets have no tcp_info
+	assert.Equal(t, linux.TCP_TIME_WAIT, connections[4].state)
+	assert.Nil(t, connections[4].info)
+
+	// tcp_info of old kernels doesn't contain byte counters
+	require.NotNil(t, connections[5].info)
+	assert.False(t, connections[5].info.hasBytesReceived)
+	assert.False(t, connections[5].info.hasBytesSent)
+}
+
+func TestFetch(t *testing.T) {
+	events := fetch(t, nil)
+	require.Len(t, events, 4)
+
+	expected := common.MapStr{
+		"remote.ip":           "10.0.1.20",
+		"remote.port":         9200,
+		"connections":         2,
+		"rtt.avg.us":          uint64(2000),
+		"rtt.max.us":          uint32(2500),
+		"rttvar.avg.us":       uint64(400),
+		"cwnd.avg":            float64(15),
+		"retransmits.total":   uint64(3),
+		"retransmits.current": uint64(1),
+		"lost":                uint64(1),
+		"unacked":             uint64(3),
+		"send_queue.bytes":    uint64(100),
+		"recv_queue.bytes":    uint64(10),
+		"bytes.acked":         uint64(30000),
+		"bytes.received":      uint64(12000),
+		"bytes.sent":          uint64(30600),
+		"bytes.retransmitted": uint64(600),
+	}
+	assertFields(t, expected, events["10.0.1.20:9200"])
+
+	ssh := events["172.16.0.3:60000"]
+	require.NotNil(t, ssh)
+	_, err := ssh.GetValue("bytes")
+	assert.Error(t, err)
+
+	assertFields(t, common.MapStr{
+		"remote.ip":   "2001:db8::20",
+		"remote.port": 443,
+		"connections": 1,
+		"rtt.avg.us":  uint64(20000),
+	}, events["[2001:db8::20]:443"])
+}
+
+func TestFetchFilters(t *testing.T) {
+	cases := map[string]struct {
+		config   map[string]interface{}
+		expected []string
+	}{
+		"states": {
+			config:   map[string]interface{}{"tcpinfo.states": []string{"established", "time_wait"}},
+			expected: []string{"10.0.1.20:9200", "192.168.1.10:5432", "172.16.0.3:60000", "[2001:db8::20]:443"},
+		},
+		"local ports": {
+			config:   map[string]interface{}{"tcpinfo.local_ports": []int{22}},
+			expected: []string{"172.16.0.3:60000"},
+		},
+		"remote ports": {
+			config:   map[string]interface{}{"tcpinfo.remote_ports": []int{9200, 443}},
+			expected: []string{"10.0.1.20:9200", "[2001:db8::20]:443"},
+		},
+		"remote cidrs": {
+			config:   map[string]interface{}{"tcpinfo.remote_cidrs": []string{"192.168.0.0/16", "2001:db8::/32"}},
+			expected: []string{"192.168.1.10:5432", "[2001:db8::20]:443"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var keys []string
+			for key := range fetch(t, c.config) {
+				keys = append(keys, key)
+			}
+			assert.ElementsMatch(t, c.expected, keys)
+		})
+	}
+}
+
+func TestFetchGroupBy(t *testing.T) {
+	events := fetch(t, map[string]interface{}{"tcpinfo.group_by": "connection", "tcpinfo.remote_ports": []int{9200}})
+	require.Len(t, events, 2)
+	for _, event := range events {
+		assert.Equal(t, "established", event["state"])
+		assert.Equal(t, 1, event["connections"])
+	}
+
+	events = fetch(t, map[string]interface{}{"tcpinfo.group_by": "local_port", "tcpinfo.states": []string{"listen"}})
+	require.Len(t, events, 1)
+	assertFields(t, common.MapStr{"local.port": 9200, "connections": 1}, events["9200"])
+}
+
+func TestConfigValidation(t *testing.T) {
+	for name, config := range map[string]map[string]interface{}{
+		"unknown state":  {"tcpinfo.states": []string{"running"}},
+		"invalid cidr":   {"tcpinfo.remote_cidrs": []string{"10.0.0.0"}},
+		"invalid groups": {"tcpinfo.group_by": "process"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := getConfig(config)
+			_, _, err := mb.NewModule(common.MustNewConfigFrom(c), mb.Registry)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestData(t *testing.T) {
+	f := newMetricSet(t, map[string]interface{}{"tcpinfo.remote_ports": []int{9200}})
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+// fetch returns the events reported with the fixtures, by the key of their group.
+func fetch(t *testing.T, config map[string]interface{}) map[string]common.MapStr {
+	f := newMetricSet(t, config)
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	assert.Empty(t, errs)
+
+	m := f.(*MetricSet)
+	byKey := map[string]common.MapStr{}
+	for _, event := range events {
+		key, _ := m.groupKey(connectionFromEvent(t, event))
+		byKey[key] = event.MetricSetFields
+	}
+	return byKey
+}
+
+// connectionFromEvent returns a connection with the fields that identify the
+// group of an event.
+func connectionFromEvent(t *testing.T, event mb.Event) connection {
+	var c connection
+	fields := event.MetricSetFields
+	if ip, err := fields.GetValue("remote.ip"); err == nil {
+		c.remoteIP = net.ParseIP(ip.(string))
+	}
+	if port, err := fields.GetValue("remote.port"); err == nil {
+		c.remotePort = port.(int)
+	}
+	if ip, err := fields.GetValue("local.ip"); err == nil {
+		c.localIP = net.ParseIP(ip.(string))
+	}
+	if port, err := fields.GetValue("local.port"); err == nil {
+		c.localPort = port.(int)
+	}
+	return c
+}
+
+func assertFields(t *testing.T, expected, fields common.MapStr) {
+	t.Helper()
+	require.NotNil(t, fields)
+	for field, value := range expected {
+		actual, err := fields.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.Equal(t, value, actual, field)
+		}
+	}
+}
+
+func newMetricSet(t *testing.T, config map[string]interface{}) mb.ReportingMetricSetV2Error {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig(config))
+	f.(*MetricSet).dump = fixtureDump
+	return f
+}
+
+// fixtureDump returns the dumps captured in the test data, filtering by
+// state is done by the kernel when dumping, and is ignored here.
+func fixtureDump(family linux.AddressFamily, _ uint32) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join("_meta", "testdata", "inet_diag_"+family.String()+".bin"))
+}
+
+func getConfig(extra map[string]interface{}) map[string]interface{} {
+	config := map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"tcpinfo"},
+	}
+	for k, v := range extra {
+		config[k] = v
+	}
+	return config
+}
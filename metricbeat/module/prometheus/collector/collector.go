@@ -19,11 +19,13 @@ package collector
 
 import (
 	"regexp"
+	"time"
 
 	"github.com/pkg/errors"
 	dto "github.com/prometheus/client_model/go"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/mb/parse"
@@ -62,8 +64,9 @@ type PromEventsGenerator interface {
 	// Start must be called before using the generator
 	Start()
 
-	// GeneratePromEvents converts a Prometheus metric family into a list of PromEvents
-	GeneratePromEvents(mf *dto.MetricFamily) []PromEvent
+	// GeneratePromEvents converts a Prometheus metric family into a list of PromEvents,
+	// metadata is nil when the family has no OpenMetrics metadata
+	GeneratePromEvents(mf *dto.MetricFamily, metadata *p.FamilyMetadata) []PromEvent
 
 	// Stop must be called when the generator won't be used anymore
 	Stop()
@@ -82,6 +85,8 @@ type MetricSet struct {
 	promEventsGen   PromEventsGenerator
 	host            string
 	eventGenStarted bool
+	targetLabels    map[string]string
+	relabelConfigs  []p.RelabelConfig
 }
 
 // MetricSetBuilder returns a builder function for a new Prometheus metricset using
@@ -92,7 +97,13 @@ func MetricSetBuilder(namespace string, genFactory PromEventsGeneratorFactory) f
 		if err := base.Module().UnpackConfig(&config); err != nil {
 			return nil, err
 		}
-		prometheus, err := p.NewPrometheusClient(base)
+		if config.UseOpenMetrics {
+			cfgwarn.Experimental("Prometheus 'use_openmetrics' setting is experimental")
+		}
+		if config.UseNativeHistograms {
+			cfgwarn.Experimental("Prometheus 'use_native_histograms' setting is experimental")
+		}
+		prometheus, err := p.NewPrometheusClient(base, config.formats()...)
 		if err != nil {
 			return nil, err
 		}
@@ -108,6 +119,8 @@ func MetricSetBuilder(namespace string, genFactory PromEventsGeneratorFactory) f
 			namespace:       namespace,
 			promEventsGen:   promEventsGen,
 			eventGenStarted: false,
+			targetLabels:    config.TargetLabels,
+			relabelConfigs:  config.MetricRelabelConfigs,
 		}
 		// store host here to use it as a pointer when building `up` metric
 		ms.host = ms.Host()
@@ -131,7 +144,7 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 		m.eventGenStarted = true
 	}
 
-	families, err := m.prometheus.GetFamilies()
+	families, metadata, err := m.prometheus.GetFamiliesWithMetadata()
 	eventList := map[string]common.MapStr{}
 	if err != nil {
 		// send up event only
@@ -140,6 +153,8 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 		// set the error to report it after sending the up event
 		err = errors.Wrap(err, "unable to decode response from prometheus endpoint")
 	} else {
+		families, metadata = m.relabel(families, metadata)
+
 		// add up event to the list
 		families = append(families, m.upMetricFamily(1.0))
 	}
@@ -148,7 +163,7 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 		if m.skipFamily(family) {
 			continue
 		}
-		promEvents := m.promEventsGen.GeneratePromEvents(family)
+		promEvents := m.promEventsGen.GeneratePromEvents(family, metadata[family.GetName()])
 
 		for _, promEvent := range promEvents {
 			labelsHash := promEvent.LabelsHash()
@@ -195,6 +210,88 @@ func (m *MetricSet) Close() error {
 	return nil
 }
 
+// seriesMetadata is the metadata of a series, resolved before it's relabeled.
+type seriesMetadata struct {
+	family     *p.FamilyMetadata
+	created    time.Time
+	hasCreated bool
+}
+
+// relabel adds the target labels and applies the relabeling rules to the series.
+// The metadata is keyed by the family names and labels of the series as exposed,
+// so it's resolved for each series before relabeling, and keyed again by the
+// resulting family names and labels.
+func (m *MetricSet) relabel(families []*dto.MetricFamily, metadata map[string]*p.FamilyMetadata) ([]*dto.MetricFamily, map[string]*p.FamilyMetadata) {
+	if len(m.targetLabels) == 0 && len(m.relabelConfigs) == 0 {
+		return families, metadata
+	}
+
+	series := map[*dto.Metric]seriesMetadata{}
+	for _, family := range families {
+		familyMetadata := metadata[family.GetName()]
+		if familyMetadata == nil {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			created, found := familyMetadata.CreatedTime(metric)
+			series[metric] = seriesMetadata{family: familyMetadata, created: created, hasCreated: found}
+		}
+	}
+
+	families = m.addTargetLabels(families)
+	families = p.RelabelFamilies(families, m.relabelConfigs)
+	if len(series) == 0 {
+		return families, metadata
+	}
+
+	relabeled := map[string]*p.FamilyMetadata{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			seriesMetadata, found := series[metric]
+			if !found {
+				continue
+			}
+			familyMetadata, found := relabeled[family.GetName()]
+			if !found {
+				familyMetadata = &p.FamilyMetadata{Unit: seriesMetadata.family.Unit}
+				relabeled[family.GetName()] = familyMetadata
+			}
+			if seriesMetadata.hasCreated {
+				familyMetadata.SetCreatedTime(metric, seriesMetadata.created)
+			}
+		}
+	}
+	return families, relabeled
+}
+
+// addTargetLabels adds the target labels to the series that don't have them
+func (m *MetricSet) addTargetLabels(families []*dto.MetricFamily) []*dto.MetricFamily {
+	if len(m.targetLabels) == 0 {
+		return families
+	}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for name, value := range m.targetLabels {
+				if hasLabel(metric, name) {
+					continue
+				}
+				name, value := name, value
+				metric.Label = append(metric.Label, &dto.LabelPair{Name: &name, Value: &value})
+			}
+		}
+	}
+	return families
+}
+
+func hasLabel(metric *dto.Metric, name string) bool {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *MetricSet) upMetricFamily(value float64) *dto.MetricFamily {
 	gauge := dto.Gauge{
 		Value: &value,
@@ -209,13 +306,19 @@ func (m *MetricSet) upMetricFamily(value float64) *dto.MetricFamily {
 	}
 	metric := dto.Metric{
 		Gauge: &gauge,
-		Label: []*dto.LabelPair{&label1, &label2},
 	}
-	return &dto.MetricFamily{
+	// target labels have precedence over the default instance and job labels
+	family := m.addTargetLabels([]*dto.MetricFamily{{
 		Name:   &upMetricName,
 		Type:   &upMetricType,
 		Metric: []*dto.Metric{&metric},
+	}})
+	for _, label := range []*dto.LabelPair{&label1, &label2} {
+		if !hasLabel(&metric, label.GetName()) {
+			metric.Label = append(metric.Label, label)
+		}
 	}
+	return family[0]
 }
 
 func (m *MetricSet) skipFamily(family *dto.MetricFamily) bool {
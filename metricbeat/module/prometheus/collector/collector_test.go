@@ -20,6 +20,8 @@
 package collector
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/elastic/beats/v7/metricbeat/mb"
@@ -27,6 +29,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
@@ -213,7 +216,7 @@ func TestGetPromEventsFromMetricFamily(t *testing.T) {
 
 	p := promEventGenerator{}
 	for _, test := range tests {
-		event := p.GeneratePromEvents(test.Family)
+		event := p.GeneratePromEvents(test.Family, nil)
 		assert.Equal(t, test.Event, event)
 	}
 }
@@ -376,6 +379,61 @@ func TestSkipMetricFamily(t *testing.T) {
 
 }
 
+func TestFetchWithRelabeling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		w.Write([]byte(`# TYPE node_load1 gauge
+node_load1 0.5
+# TYPE node_cpu_seconds_total counter
+node_cpu_seconds_total{cpu="0",mode="idle"} 100
+node_cpu_seconds_total{cpu="0",mode="user"} 20
+`))
+	}))
+	defer server.Close()
+
+	f := mbtest.NewReportingMetricSetV2Error(t, map[string]interface{}{
+		"module":     "prometheus",
+		"metricsets": []string{"collector"},
+		"hosts":      []string{server.URL},
+		"target_labels": map[string]interface{}{
+			"job": "node",
+			"env": "production",
+		},
+		"metric_relabel_configs": []interface{}{
+			map[string]interface{}{
+				"source_labels": []string{"__name__", "mode"},
+				"regex":         "node_cpu_seconds_total;idle",
+				"action":        "drop",
+			},
+			map[string]interface{}{
+				"source_labels": []string{"mode"},
+				"target_label":  "cpu_mode",
+			},
+			map[string]interface{}{
+				"regex":  "mode",
+				"action": "labeldrop",
+			},
+		},
+	})
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	require.Empty(t, errs)
+
+	metrics := map[string]common.MapStr{}
+	for _, e := range events {
+		labels := e.RootFields["prometheus"].(common.MapStr)["labels"].(common.MapStr)
+		assert.Equal(t, "node", labels["job"])
+		assert.Equal(t, "production", labels["env"])
+		assert.NotContains(t, labels, "mode")
+		for name := range e.RootFields["prometheus"].(common.MapStr)["metrics"].(common.MapStr) {
+			metrics[name] = labels
+		}
+	}
+	require.Len(t, metrics, 3)
+	assert.Equal(t, "user", metrics["node_cpu_seconds_total"]["cpu_mode"])
+	assert.Contains(t, metrics, "node_load1")
+	assert.Contains(t, metrics, "up")
+}
+
 func TestData(t *testing.T) {
 	mbtest.TestDataFiles(t, "prometheus", "collector")
 }
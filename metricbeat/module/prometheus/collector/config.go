@@ -17,8 +17,26 @@
 
 package collector
 
+import (
+	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+)
+
 type metricsetConfig struct {
 	MetricsFilters MetricFilters `config:"metrics_filters" yaml:"metrics_filters,omitempty"`
+
+	// UseOpenMetrics requests the OpenMetrics format, that includes units,
+	// creation timestamps and exemplars.
+	UseOpenMetrics bool `config:"use_openmetrics" yaml:"use_openmetrics,omitempty"`
+
+	// UseNativeHistograms requests the protobuf format, that is needed to
+	// collect native histograms. It has precedence over UseOpenMetrics.
+	UseNativeHistograms bool `config:"use_native_histograms" yaml:"use_native_histograms,omitempty"`
+
+	// TargetLabels are added to all the series that don't have them.
+	TargetLabels map[string]string `config:"target_labels" yaml:"target_labels,omitempty"`
+
+	// MetricRelabelConfigs are relabeling rules applied to the scraped series.
+	MetricRelabelConfigs []p.RelabelConfig `config:"metric_relabel_configs" yaml:"metric_relabel_configs,omitempty"`
 }
 
 type MetricFilters struct {
@@ -32,6 +50,18 @@ var defaultConfig = metricsetConfig{
 		ExcludeMetrics: nil},
 }
 
+// formats returns the exposition formats to request, in order of preference.
+func (c *metricsetConfig) formats() []string {
+	var formats []string
+	if c.UseNativeHistograms {
+		formats = append(formats, p.FormatProtobuf)
+	}
+	if c.UseOpenMetrics {
+		formats = append(formats, p.FormatOpenMetrics)
+	}
+	return formats
+}
+
 func (c *metricsetConfig) Validate() error {
 	// validate configuration here
 	return nil
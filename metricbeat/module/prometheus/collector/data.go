@@ -23,6 +23,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/metricbeat/helper/labelhash"
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 
 	dto "github.com/prometheus/client_model/go"
@@ -51,7 +52,7 @@ func (p *promEventGenerator) Stop()  {}
 
 // GeneratePromEvents DefaultPromEventsGenerator stores all Prometheus metrics using
 // only double field type in Elasticsearch.
-func (p *promEventGenerator) GeneratePromEvents(mf *dto.MetricFamily) []PromEvent {
+func (p *promEventGenerator) GeneratePromEvents(mf *dto.MetricFamily, _ *prometheus.FamilyMetadata) []PromEvent {
 	var events []PromEvent
 
 	name := *mf.Name
@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package disk_health
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Offsets and sizes of the attributes in the SMART data and thresholds sectors.
+const (
+	ataAttributesOffset = 2
+	ataAttributeSize    = 12
+	ataMaxAttributes    = 30
+)
+
+// IDs of the SMART attributes reported by the metricset.
+const (
+	ataReallocatedSectors   = 5
+	ataPowerOnHours         = 9
+	ataPowerCycles          = 12
+	ataWearLevelingCount    = 177
+	ataAirflowTemperature   = 190
+	ataTemperature          = 194
+	ataPendingSectors       = 197
+	ataOfflineUncorrectable = 198
+	ataSSDLifeLeft          = 231
+	ataMediaWearout         = 233
+)
+
+// ataAttributeNames are the names of some common attributes, used to report
+// failing attributes. The meaning of the attributes is vendor specific, these
+// are the most common ones.
+var ataAttributeNames = map[uint8]string{
+	1:                       "raw_read_error_rate",
+	3:                       "spin_up_time",
+	ataReallocatedSectors:   "reallocated_sector_count",
+	ataPowerOnHours:         "power_on_hours",
+	10:                      "spin_retry_count",
+	ataPowerCycles:          "power_cycle_count",
+	ataWearLevelingCount:    "wear_leveling_count",
+	184:                     "end_to_end_error",
+	187:                     "reported_uncorrect",
+	188:                     "command_timeout",
+	ataAirflowTemperature:   "airflow_temperature",
+	ataTemperature:          "temperature",
+	196:                     "reallocated_event_count",
+	ataPendingSectors:       "current_pending_sector",
+	ataOfflineUncorrectable: "offline_uncorrectable",
+	199:                     "udma_crc_error_count",
+	ataSSDLifeLeft:          "ssd_life_left",
+	ataMediaWearout:         "media_wearout_indicator",
+}
+
+// ataAttribute is an attribute of the SMART data of an ATA device.
+type ataAttribute struct {
+	id        uint8
+	current   uint8
+	raw       uint64
+	threshold uint8
+}
+
+// failing checks if the normalized value of the attribute is at or below
+// its threshold. A threshold of zero means that it never fails.
+func (a ataAttribute) failing() bool {
+	return a.threshold > 0 && a.current <= a.threshold
+}
+
+func (a ataAttribute) name() string {
+	if name, found := ataAttributeNames[a.id]; found {
+		return name
+	}
+	return fmt.Sprintf("attribute_%d", a.id)
+}
+
+// parseATAAttributes parses the attributes in the SMART data and thresholds
+// sectors of an ATA device, the thresholds are optional.
+func parseATAAttributes(data, thresholds []byte) (map[uint8]ataAttribute, error) {
+	if err := checkATASector(data); err != nil {
+		return nil, errors.Wrap(err, "invalid SMART data")
+	}
+	if thresholds != nil {
+		if err := checkATASector(thresholds); err != nil {
+			return nil, errors.Wrap(err, "invalid SMART thresholds")
+		}
+	}
+
+	attributes := map[uint8]ataAttribute{}
+	for i := 0; i < ataMaxAttributes; i++ {
+		entry := data[ataAttributesOffset+i*ataAttributeSize:][:ataAttributeSize]
+		id := entry[0]
+		if id == 0 {
+			continue
+		}
+		raw := make([]byte, 8)
+		copy(raw, entry[5:11])
+		attributes[id] = ataAttribute{
+			id:      id,
+			current: entry[3],
+			raw:     binary.LittleEndian.Uint64(raw),
+		}
+	}
+
+	for i := 0; thresholds != nil && i < ataMaxAttributes; i++ {
+		entry := thresholds[ataAttributesOffset+i*ataAttributeSize:][:ataAttributeSize]
+		if attribute, found := attributes[entry[0]]; found {
+			attribute.threshold = entry[1]
+			attributes[entry[0]] = attribute
+		}
+	}
+	return attributes, nil
+}
+
+// checkATASector checks the length and the checksum of a sector, the sum of
+// all its bytes must be zero.
+func checkATASector(sector []byte) error {
+	if len(sector) < sectorSize {
+		return errors.Errorf("sector too short (%d bytes)", len(sector))
+	}
+	var sum uint8
+	for _, b := range sector[:sectorSize] {
+		sum += b
+	}
+	if sum != 0 {
+		return errors.New("wrong checksum")
+	}
+	return nil
+}
+
+// ataHealth builds the health information of an ATA device from its SMART
+// attributes. Only the attributes reported by the device are included.
+func ataHealth(data, thresholds []byte) (*health, error) {
+	attributes, err := parseATAAttributes(data, thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &health{fields: common.MapStr{}}
+	if a, found := attributes[ataTemperature]; found {
+		// Only the lowest byte contains the current temperature, other
+		// bytes can contain the minimum and maximum temperatures.
+		h.fields.Put("temperature.celsius", int(a.raw&0xFF))
+	} else if a, found := attributes[ataAirflowTemperature]; found {
+		h.fields.Put("temperature.celsius", int(a.raw&0xFF))
+	}
+	for _, id := range []uint8{ataWearLevelingCount, ataSSDLifeLeft, ataMediaWearout} {
+		if a, found := attributes[id]; found && a.current <= 100 {
+			// Normalized values of these attributes start at 100 and go down with wear
+			h.fields.Put("wear.used.pct", float64(100-a.current)/100)
+			break
+		}
+	}
+	if a, found := attributes[ataPowerOnHours]; found {
+		// Some vendors use the highest bytes for minutes and seconds
+		h.fields.Put("power_on.hours", a.raw&0xFFFFFFFF)
+	}
+	if a, found := attributes[ataPowerCycles]; found {
+		h.fields.Put("power_cycles", a.raw)
+	}
+
+	counters := map[uint8]string{
+		ataReallocatedSectors:   "reallocated_sectors",
+		ataPendingSectors:       "pending_sectors",
+		ataOfflineUncorrectable: "uncorrectable_sectors",
+	}
+	for id, field := range counters {
+		if a, found := attributes[id]; found {
+			h.fields.Put(field, a.raw)
+			if a.raw > 0 {
+				h.warning = true
+			}
+		}
+	}
+
+	var failing []string
+	for _, a := range attributes {
+		if a.failing() {
+			failing = append(failing, a.name())
+		}
+	}
+	if len(failing) > 0 {
+		sort.Strings(failing)
+		h.criticalWarnings = append(h.criticalWarnings, "failing_attributes")
+		h.fields.Put("ata.failing_attributes", failing)
+	}
+	return h, nil
+}
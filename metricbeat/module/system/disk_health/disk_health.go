@@ -0,0 +1,261 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package disk_health
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("system", "disk_health", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+const (
+	deviceTypeNVMe = "nvme"
+	deviceTypeATA  = "ata"
+
+	statusOK      = "ok"
+	statusWarning = "warning"
+	statusFailing = "failing"
+)
+
+var (
+	// nvmeNamespace matches the block devices of NVMe namespaces, partitions
+	// have an additional suffix.
+	nvmeNamespace = regexp.MustCompile(`^nvme\d+n\d+$`)
+
+	// scsiDisk matches the block devices of SCSI disks, ATA disks are exposed as
+	// SCSI disks by libata.
+	scsiDisk = regexp.MustCompile(`^sd[a-z]+$`)
+)
+
+// health is the health information of a device.
+type health struct {
+	fields common.MapStr
+
+	// criticalWarnings are conditions reported by the device that indicate
+	// that it is failing or about to fail.
+	criticalWarnings []string
+
+	// warning is set when errors are being found in the media of the device,
+	// even if it is still considered healthy by the device.
+	warning bool
+}
+
+func (h *health) status() string {
+	switch {
+	case len(h.criticalWarnings) > 0:
+		return statusFailing
+	case h.warning:
+		return statusWarning
+	default:
+		return statusOK
+	}
+}
+
+// blockDevice is a disk found in /sys/block.
+type blockDevice struct {
+	name       string
+	deviceType string
+	model      string
+	serial     string
+	arrays     []string
+}
+
+// MetricSet reports the health of the disks of the host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	sysBlock string
+	dev      string
+	devices  map[string]bool
+	reader   smartReader
+	log      *logp.Logger
+}
+
+// New creates a new instance of the disk_health metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system disk_health metricset is beta.")
+
+	config := struct {
+		Devices []string `config:"disk_health.devices"`
+	}{}
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	devices := make(map[string]bool, len(config.Devices))
+	for _, device := range config.Devices {
+		devices[filepath.Base(device)] = true
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		sysBlock:      paths.Resolve(paths.Hostfs, "/sys/block"),
+		dev:           paths.Resolve(paths.Hostfs, "/dev"),
+		devices:       devices,
+		reader:        ioctlReader{},
+		log:           logp.NewLogger("system.disk_health"),
+	}, nil
+}
+
+// Fetch reports an event with the health of each disk.
+func (m *MetricSet) Fetch(r mb.ReporterV2) error {
+	devices, err := listBlockDevices(m.sysBlock)
+	if err != nil {
+		return errors.Wrap(err, "failed to list block devices")
+	}
+
+	for _, device := range devices {
+		if len(m.devices) > 0 && !m.devices[device.name] {
+			continue
+		}
+
+		h, err := m.readHealth(device)
+		if err == errNotSupported {
+			m.log.Debugf("Skipping device %s: %v", device.name, err)
+			continue
+		}
+		if err != nil {
+			r.Error(errors.Wrapf(err, "failed to read the health of device %s", device.name))
+			continue
+		}
+
+		event := h.fields
+		event.DeepUpdate(common.MapStr{
+			"device": common.MapStr{
+				"name": device.name,
+				"type": device.deviceType,
+			},
+			"status": h.status(),
+		})
+		if device.model != "" {
+			event.Put("device.model", device.model)
+		}
+		if device.serial != "" {
+			event.Put("device.serial", device.serial)
+		}
+		if len(h.criticalWarnings) > 0 {
+			event["critical_warnings"] = h.criticalWarnings
+		}
+		if len(device.arrays) > 0 {
+			event["raid"] = common.MapStr{"arrays": device.arrays}
+		}
+
+		if !r.Event(mb.Event{MetricSetFields: event}) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *MetricSet) readHealth(device blockDevice) (*health, error) {
+	path := filepath.Join(m.dev, device.name)
+	switch device.deviceType {
+	case deviceTypeNVMe:
+		log, err := m.reader.nvmeHealthLog(path)
+		if err != nil {
+			return nil, err
+		}
+		return nvmeHealth(log)
+	default:
+		data, thresholds, err := m.reader.ataSMART(path)
+		if err != nil {
+			return nil, err
+		}
+		return ataHealth(data, thresholds)
+	}
+}
+
+// listBlockDevices lists the NVMe and SCSI disks in /sys/block, with the md
+// arrays they, or their partitions, are members of.
+func listBlockDevices(sysBlock string) ([]blockDevice, error) {
+	entries, err := ioutil.ReadDir(sysBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []blockDevice
+	for _, entry := range entries {
+		name := entry.Name()
+		device := blockDevice{name: name}
+		switch {
+		case nvmeNamespace.MatchString(name):
+			device.deviceType = deviceTypeNVMe
+		case scsiDisk.MatchString(name):
+			device.deviceType = deviceTypeATA
+		default:
+			continue
+		}
+
+		dir := filepath.Join(sysBlock, name)
+		device.model = readSysfsString(filepath.Join(dir, "device", "model"))
+		device.serial = readSysfsString(filepath.Join(dir, "device", "serial"))
+		device.arrays = mdHolders(dir)
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+// mdHolders returns the md arrays that use the device or any of its partitions.
+func mdHolders(dir string) []string {
+	holderDirs := []string{filepath.Join(dir, "holders")}
+	partitions, _ := filepath.Glob(filepath.Join(dir, "*", "partition"))
+	for _, partition := range partitions {
+		holderDirs = append(holderDirs, filepath.Join(filepath.Dir(partition), "holders"))
+	}
+
+	var arrays []string
+	for _, holderDir := range holderDirs {
+		holders, err := ioutil.ReadDir(holderDir)
+		if err != nil {
+			continue
+		}
+		for _, holder := range holders {
+			if strings.HasPrefix(holder.Name(), "md") {
+				arrays = append(arrays, holder.Name())
+			}
+		}
+	}
+	sort.Strings(arrays)
+	return arrays
+}
+
+func readSysfsString(path string) string {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(raw))
+}
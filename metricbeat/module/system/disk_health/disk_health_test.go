@@ -0,0 +1,205 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package disk_health
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestFetch(t *testing.T) {
+	f := newMetricSet(t, nil)
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	assert.Empty(t, errs)
+
+	byName := eventsByName(t, events)
+	require.Len(t, byName, 4)
+
+	cases := map[string]common.MapStr{
+		"nvme0n1": {
+			"device.type":                        "nvme",
+			"device.model":                       "Samsung SSD 970 EVO Plus 1TB",
+			"device.serial":                      "S4EWNX0R123456A",
+			"status":                             "ok",
+			"temperature.celsius":                38,
+			"wear.used.pct":                      0.03,
+			"power_on.hours":                     uint64(4380),
+			"power_cycles":                       uint64(152),
+			"unsafe_shutdowns":                   uint64(12),
+			"media_errors":                       uint64(0),
+			"nvme.available_spare.pct":           1.0,
+			"nvme.available_spare.threshold.pct": 0.1,
+			"nvme.error_log_entries":             uint64(4),
+			"nvme.data.written.bytes":            uint64(16777216 * 512000),
+		},
+		"nvme1n1": {
+			"status":              "failing",
+			"critical_warnings":   []string{"available_spare", "reliability"},
+			"temperature.celsius": 72,
+			"wear.used.pct":       1.04,
+			"media_errors":        uint64(7),
+		},
+		"sda": {
+			"device.type":           "ata",
+			"device.model":          "Samsung SSD 860",
+			"status":                "warning",
+			"temperature.celsius":   36,
+			"wear.used.pct":         0.08,
+			"power_on.hours":        uint64(28725),
+			"power_cycles":          uint64(312),
+			"reallocated_sectors":   uint64(8),
+			"pending_sectors":       uint64(2),
+			"uncorrectable_sectors": uint64(0),
+			"raid.arrays":           []string{"md0"},
+		},
+		"sdc": {
+			"status":                 "failing",
+			"critical_warnings":      []string{"failing_attributes"},
+			"ata.failing_attributes": []string{"reallocated_sector_count"},
+			"temperature.celsius":    40,
+			"power_on.hours":         uint64(40000),
+			"reallocated_sectors":    uint64(2480),
+			"raid.arrays":            []string{"md0"},
+		},
+	}
+	for name, expected := range cases {
+		t.Run(name, func(t *testing.T) {
+			event := byName[name]
+			require.NotNil(t, event)
+			for field, value := range expected {
+				actual, err := event.GetValue(field)
+				if assert.NoError(t, err, field) {
+					assert.Equal(t, value, actual, field)
+				}
+			}
+		})
+	}
+
+	// Disks without wear attributes, or that are not members of arrays,
+	// don't report them
+	_, err := byName["sdc"].GetValue("wear")
+	assert.Error(t, err)
+	_, err = byName["nvme0n1"].GetValue("raid")
+	assert.Error(t, err)
+}
+
+func TestFetchDevices(t *testing.T) {
+	f := newMetricSet(t, map[string]interface{}{"disk_health.devices": []string{"/dev/nvme0n1", "sdc"}})
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	assert.Empty(t, errs)
+
+	var names []string
+	for name := range eventsByName(t, events) {
+		names = append(names, name)
+	}
+	assert.ElementsMatch(t, []string{"nvme0n1", "sdc"}, names)
+}
+
+func TestATAHealthChecksum(t *testing.T) {
+	data, err := ioutil.ReadFile("./_meta/testdata/smart/sda.smart_data.bin")
+	require.NoError(t, err)
+
+	_, err = ataHealth(data, nil)
+	assert.NoError(t, err)
+
+	data[2]++
+	_, err = ataHealth(data, nil)
+	assert.Error(t, err)
+}
+
+func TestData(t *testing.T) {
+	f := newMetricSet(t, map[string]interface{}{"disk_health.devices": []string{"nvme0n1"}})
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+// fixtureReader reads the SMART information of the devices from the blobs
+// captured in the test data.
+type fixtureReader struct{}
+
+func (fixtureReader) nvmeHealthLog(path string) ([]byte, error) {
+	return readFixture(path, "health_log")
+}
+
+func (fixtureReader) ataSMART(path string) ([]byte, []byte, error) {
+	data, err := readFixture(path, "smart_data")
+	if err != nil {
+		return nil, nil, err
+	}
+	thresholds, err := readFixture(path, "smart_thresholds")
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, thresholds, nil
+}
+
+func readFixture(path, kind string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filepath.Join("_meta", "testdata", "smart", filepath.Base(path)+"."+kind+".bin"))
+	if os.IsNotExist(err) {
+		return nil, errNotSupported
+	}
+	return data, err
+}
+
+func eventsByName(t *testing.T, events []mb.Event) map[string]common.MapStr {
+	byName := map[string]common.MapStr{}
+	for _, event := range events {
+		name, err := event.MetricSetFields.GetValue("device.name")
+		require.NoError(t, err)
+		byName[name.(string)] = event.MetricSetFields
+	}
+	return byName
+}
+
+func newMetricSet(t *testing.T, config map[string]interface{}) mb.ReportingMetricSetV2Error {
+	testPath := paths.Path{
+		Hostfs: "./_meta/testdata",
+	}
+	if err := paths.InitPaths(&testPath); err != nil {
+		t.Fatalf("error setting default paths: %+v", err)
+	}
+
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig(config))
+	f.(*MetricSet).reader = fixtureReader{}
+	return f
+}
+
+func getConfig(extra map[string]interface{}) map[string]interface{} {
+	config := map[string]interface{}{
+		"module":     "system",
+		"metricsets": []string{"disk_health"},
+	}
+	for k, v := range extra {
+		config[k] = v
+	}
+	return config
+}
@@ -0,0 +1,221 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package disk_health
+
+import (
+	"os"
+	"runtime"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// errNotSupported is returned when a device doesn't support the commands used
+// to read its health information.
+var errNotSupported = errors.New("device doesn't support SMART commands")
+
+// smartReader reads the health information of the devices. It is implemented
+// with ioctls on the device files, and replaced by fixtures in tests.
+type smartReader interface {
+	// nvmeHealthLog returns the SMART / Health Information log page of an NVMe device.
+	nvmeHealthLog(path string) ([]byte, error)
+
+	// ataSMART returns the SMART data and thresholds of an ATA device.
+	ataSMART(path string) (data, thresholds []byte, err error)
+}
+
+const (
+	// nvmeIoctlAdminCmd is NVME_IOCTL_ADMIN_CMD, _IOWR('N', 0x41, struct nvme_admin_cmd).
+	nvmeIoctlAdminCmd = 0xC0484E41
+
+	nvmeAdminGetLogPage = 0x02
+	nvmeLogSMART        = 0x02
+	nvmeNSIDAll         = 0xFFFFFFFF
+
+	// sgIO is the SG_IO ioctl, used to send SCSI commands to the devices.
+	sgIO = 0x2285
+
+	sgDxferFromDev       = -3
+	scsiCheckCondition   = 0x02
+	ataPassThrough16     = 0x85
+	ataProtocolPIODataIn = 4
+	ataSMARTCmd          = 0xB0
+	ataSMARTReadData     = 0xD0
+	ataSMARTReadThresh   = 0xD1
+
+	sectorSize = 512
+
+	// ioctlTimeout is the timeout of the commands, in milliseconds.
+	ioctlTimeout = 3000
+)
+
+// nvmePassthruCmd is the struct nvme_passthru_cmd used by NVMe admin commands.
+// https://github.com/torvalds/linux/blob/v5.10/include/uapi/linux/nvme_ioctl.h#L44
+type nvmePassthruCmd struct {
+	opcode      uint8
+	flags       uint8
+	rsvd1       uint16
+	nsid        uint32
+	cdw2        uint32
+	cdw3        uint32
+	metadata    uint64
+	addr        uint64
+	metadataLen uint32
+	dataLen     uint32
+	cdw10       uint32
+	cdw11       uint32
+	cdw12       uint32
+	cdw13       uint32
+	cdw14       uint32
+	cdw15       uint32
+	timeoutMs   uint32
+	result      uint32
+}
+
+// sgIOHdr is the struct sg_io_hdr used by the SG_IO ioctl.
+// https://github.com/torvalds/linux/blob/v5.10/include/scsi/sg.h#L44
+type sgIOHdr struct {
+	interfaceID    int32
+	dxferDirection int32
+	cmdLen         uint8
+	mxSbLen        uint8
+	iovecCount     uint16
+	dxferLen       uint32
+	dxferp         unsafe.Pointer
+	cmdp           unsafe.Pointer
+	sbp            unsafe.Pointer
+	timeout        uint32
+	flags          uint32
+	packID         int32
+	usrPtr         unsafe.Pointer
+	status         uint8
+	maskedStatus   uint8
+	msgStatus      uint8
+	sbLenWr        uint8
+	hostStatus     uint16
+	driverStatus   uint16
+	resid          int32
+	duration       uint32
+	info           uint32
+}
+
+// ioctlReader reads the health information with ioctls on the device files.
+type ioctlReader struct{}
+
+func (ioctlReader) nvmeHealthLog(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	buf := make([]byte, sectorSize)
+	cmd := nvmePassthruCmd{
+		opcode:    nvmeAdminGetLogPage,
+		nsid:      nvmeNSIDAll,
+		addr:      uint64(uintptr(unsafe.Pointer(&buf[0]))),
+		dataLen:   uint32(len(buf)),
+		cdw10:     nvmeLogSMART | (uint32(len(buf)/4-1) << 16),
+		timeoutMs: ioctlTimeout,
+	}
+	// The NVMe status of the command is the return value of the ioctl,
+	// the result field only holds the command specific dword 0.
+	status, err := ioctl(f.Fd(), nvmeIoctlAdminCmd, unsafe.Pointer(&cmd))
+	runtime.KeepAlive(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "NVMe get log page command failed")
+	}
+	if status != 0 {
+		return nil, errors.Errorf("NVMe get log page command failed with status %#x", status)
+	}
+	return buf, nil
+}
+
+func (ioctlReader) ataSMART(path string) ([]byte, []byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	data, err := ataSMARTCommand(f.Fd(), ataSMARTReadData)
+	if err != nil {
+		return nil, nil, err
+	}
+	thresholds, err := ataSMARTCommand(f.Fd(), ataSMARTReadThresh)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, thresholds, nil
+}
+
+// ataSMARTCommand sends a SMART command that reads a sector using an
+// ATA PASS-THROUGH (16) SCSI command.
+func ataSMARTCommand(fd uintptr, feature uint8) ([]byte, error) {
+	buf := make([]byte, sectorSize)
+	sense := make([]byte, 32)
+	cdb := [16]byte{
+		0:  ataPassThrough16,
+		1:  ataProtocolPIODataIn << 1,
+		2:  0x0E, // T_DIR from the device, length in sectors, in the sector count field
+		4:  feature,
+		6:  1,    // sector count
+		10: 0x4F, // LBA mid and high values required by SMART commands
+		12: 0xC2,
+		14: ataSMARTCmd,
+	}
+	hdr := sgIOHdr{
+		interfaceID:    'S',
+		dxferDirection: sgDxferFromDev,
+		cmdLen:         uint8(len(cdb)),
+		mxSbLen:        uint8(len(sense)),
+		dxferLen:       uint32(len(buf)),
+		dxferp:         unsafe.Pointer(&buf[0]),
+		cmdp:           unsafe.Pointer(&cdb[0]),
+		sbp:            unsafe.Pointer(&sense[0]),
+		timeout:        ioctlTimeout,
+	}
+	if _, err := ioctl(fd, sgIO, unsafe.Pointer(&hdr)); err != nil {
+		if err == unix.ENOTTY || err == unix.EINVAL {
+			return nil, errNotSupported
+		}
+		return nil, errors.Wrap(err, "SG_IO ioctl failed")
+	}
+	if hdr.status == scsiCheckCondition {
+		// The device, or the SCSI to ATA translation layer, rejected the command
+		return nil, errNotSupported
+	}
+	if hdr.status != 0 || hdr.hostStatus != 0 || hdr.driverStatus != 0 {
+		return nil, errors.Errorf("ATA SMART command failed (status: %d, host status: %d, driver status: %d)",
+			hdr.status, hdr.hostStatus, hdr.driverStatus)
+	}
+	return buf, nil
+}
+
+// ioctl performs an ioctl on the file descriptor, it returns the value
+// returned by the syscall.
+func ioctl(fd uintptr, req uint, arg unsafe.Pointer) (uintptr, error) {
+	r1, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, uintptr(req), uintptr(arg))
+	if errno != 0 {
+		return r1, errno
+	}
+	return r1, nil
+}
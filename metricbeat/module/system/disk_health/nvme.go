@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package disk_health
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// nvmeCriticalWarnings are the bits of the critical warning field of the
+// SMART / Health Information log page.
+var nvmeCriticalWarnings = []string{
+	"available_spare",
+	"temperature",
+	"reliability",
+	"read_only",
+	"volatile_memory_backup",
+	"persistent_memory_region",
+}
+
+// nvmeDataUnit is the size of the units used by the data read and written
+// counters, thousands of 512 bytes.
+const nvmeDataUnit = 1000 * 512
+
+// kelvin is the offset of the temperatures in Kelvin reported by the devices.
+const kelvin = 273
+
+// nvmeHealth parses the SMART / Health Information log page of an NVMe device.
+// All numbers are little endian, and 128 bit counters are truncated to 64 bits.
+func nvmeHealth(log []byte) (*health, error) {
+	if len(log) < sectorSize {
+		return nil, errors.Errorf("NVMe health log too short (%d bytes)", len(log))
+	}
+	le := binary.LittleEndian
+
+	h := &health{
+		fields: common.MapStr{
+			"temperature":      common.MapStr{"celsius": int(le.Uint16(log[1:3])) - kelvin},
+			"wear":             common.MapStr{"used": common.MapStr{"pct": float64(log[5]) / 100}},
+			"power_on":         common.MapStr{"hours": le.Uint64(log[128:136])},
+			"power_cycles":     le.Uint64(log[112:120]),
+			"unsafe_shutdowns": le.Uint64(log[144:152]),
+			"media_errors":     le.Uint64(log[160:168]),
+			"nvme": common.MapStr{
+				"available_spare": common.MapStr{
+					"pct":       float64(log[3]) / 100,
+					"threshold": common.MapStr{"pct": float64(log[4]) / 100},
+				},
+				"error_log_entries": le.Uint64(log[176:184]),
+				"data": common.MapStr{
+					"read":    common.MapStr{"bytes": le.Uint64(log[32:40]) * nvmeDataUnit},
+					"written": common.MapStr{"bytes": le.Uint64(log[48:56]) * nvmeDataUnit},
+				},
+			},
+		},
+	}
+
+	warning := log[0]
+	for bit, name := range nvmeCriticalWarnings {
+		if warning&(1<<uint(bit)) != 0 {
+			h.criticalWarnings = append(h.criticalWarnings, name)
+		}
+	}
+	h.warning = le.Uint64(log[160:168]) > 0
+	return h, nil
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetSystem returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/system.
 func AssetSystem() string {
-	return "eJzsff9vG7mS5+/zVxA5PIyzZytxZt7sW/9wQCbZuTOQTIw4ebvA4aBQ3SWJz2yyh2RL0fz1B37pbnY3+5vUkuWBjUX2jS2Rn/rCYlWxWLxCD7C7QXInFSQ/IKSIonCDXtybX7z4AaEYZCRIqghnN+h//YAQQvaPSCqsMokSUIJE8hJR8gDo3d1XhFmMEki42KFM4hVcIrXGCmEBKOKUQqQgRkvBE6TWgHgKAivCVg7F7AeE5JoLNY84W5LVDVIigx8QEkABS7hBK/wDQksCNJY3BtAVYjiBG5QKHoGU5ncIqV2qPyx4lrrfBGjRP3f2azklM/cHfwZ/Fk03FL/N53mA3ZaL2Pt9y2z658sacrB2uBn6jQsE33GSGv6LjDHCVi9mjdmjNJulkWrMLyNMIZ4vKcf+H5dcJFjdoBREBEyNgGe/gFeA+NKIVZEEkEyBKbTYGdEVJBAWgfkNxVIh2ABTs9qIRKINphkgIhHToCj5E+J8JJYlCxD5TBEXII0aEYUEZiuQldGM7rxGiqPrMIOkwkLNNeAGn+Kq8Hq4YGjeroFV6N1iIzahIG7ObzX/EWTklpwPlEdRlhKIEWEowfof+5mLz28/vpxV1k5hAtCYpfPNfu0bijhTmDCJKI8wdaMNXVFa3g1m+bP38MKhuNLjeFC0KjkEmscIa0VdUTDzaY5hlGRUEfM9z/rkP1WDU0irRoRPCIkrv85JoZytan/ooEb/aOjvNCq7MEpUlU/+D3RXaIAMAlJcYVrTRdSnj6hTJweg/6JnRThSZAMBs1ERdxB2JkGcHnWf1SPMAEMyxRG0iKRCgSLRg5xGIzQ4nPCMqQOBOTU/R+Y+gGBAx1AxIYN7OTwCHSMRnB+HOUOUb69SQbggapdvEiCHUHMyTu+LksT0DHluUA0AfjpFHgCIbzFRZ8hLhjQwdMEZiol8eDmMjlPaiHH4xB/nx2QJYkMiHY1p93uNWUz1f6yxiLc6gCNMgRBZqnrXo/jjdKyfDLXkS/WU5KLx7kfhY8tmD+QKHsOXHWCWCNtwmjGFxc6aAOfobohQGabmG9s1oTZGXu9SzRLJRWMyE1h6/OJqDSLfArmYNb7wdoMJxQsKiDO605vnV0a+D2LkKe3i+TLIyxocFIFGadYIgjVVUmFfsfcJKk02ZEJBhXItqQDpvC8jAS7VzH6Ys6syXdMYr1wZEm0JpWiNN6DjavydJFniUj58ib5dv379N/RvdrpvZuzGYF5ayB8XUwE43iGFH7R+lIkkpjjCUWTUztqWTXPQABYNZe+I+imEpugTa2Y25GVj2B3PUISZFZrP8iJfuxKAFQj9C2b55icqLxFZop8aw7r0nQCEFfrl9d80tEutV1a5XLZmFqXZLOfmN6s9C0DX/2gVzl8rhP1rBYlPN/z6q0Q7T8hrffbLAxQ+e7fTeLePlPIewEhz0ieRJdvsqLcxBaM4t5/+S1uhNqfk99IzGuSfaE/qLFkwNk19toSM3ejPk5CDdvvzJGn4ln+m+PfY98+Tksk3/ydF5r4ewHkS+VTdgHPj5hAv4DJPhMhQfYwJrgO01zyGL43s3lM5mT7nM92ncQp6hoeJZ30I99hHIfvviI+NfN9N7vnsweeJ1lPCf6izYszxgx7CO3/Q/4luPxXVbwPLbvOf8WcU+t+gPJtlsfqnKHSVMb4eL25Dnp6yT9lAEEzndvMcAW8ghB+lmyGv0rNlrgneIcYVWpg6zA2J7TaOKS2Z3hjT5eh7CBKA45k58Jhw8RhPyfMw9CRaZbSEtMrILNIavswo3fXg2wqi4OgAzSx7IjQcXOzU8BO13BUMfWkP8GYYA6MKG31i6ANh2Xd7xEXqU6GaHyghUly4kcxhT0qJ0zSGsJRZojljPoUk+dP4oX+/fjNIgo/PII1DAZuGR/lgA9nUGLWfbUatavXmnUzbgzEJoTomiDiLZVlQq82KWbGDBPtoEO2a7XUWjw0wjDHmeh+8ffVJVjfxNpA8ndJ5qWPUOLTjkgq+EiD7maYjypnRQAF/ZCDVLAGxAjlPQcwlREGsoai3B2y9fMCYHjelRGZOc3KPLHftKfIWBKA/MsggRoqbBRrDhvTGW44sq7anpcvMeWzCKvI6qaBK9ETKBnqPzj0EdFrJTEuJkYgjoGMHnICMX0sfoPDHG5jr8UR4m+0lCOuYZ1pC8AYEXlVudiy5qGlZUCKKa69YB1H+zal+9TqhVKyKHVMslqTTyaW2aCYSTL7i8WY1137TcUgxHtkFYZa9L7WYNOqBFmAYJcaGH5kOMweiwFZqfRQiTrnMp1Ukm1KB+m3NCQlwM1hCtDL5LuBLQ9Ttq0/TymORyd101NyFTxPiTGjHdbsm0bpKQvumeLHALN6SWK1Rpgglf2I9rWFC+amXM/TeflxilQn7ER5FmQ6mbB2ff583olwa0VcrK3OWAFOCpz47Rie4ylSau1naHHN80grng84XRE2ajizQ6oG1yJpwvVvrj348VeJ1OC81N7G9vmm1J+WcFmmEn1//xy8NKS8JhcolYrRXJrMcplFPXf5pirLqgugT5TlM0tKcNHn8VhxhhjKWCrIhFHScYc7L8h1vFoRuF+l8ZNJ1VGK12o/g26sYNq/0X6+/BRHpeY8ARY9RhwLf1c9hEOYQYJ5y0pJ93BuLGVhbWjN2gzdhNEZbj5gm0OMjxmMwyQK9Rs1vmtl8D5KAR9X2bq3W6OZTc83jlwDYh2mG7yfimpWxx7tujmUSTpvM1hOOhPf4u1sNdFftRKGKUm8wB+1jTqXsSN5W5m1i+ekcXq0ErHBxPIcptSanduGm/OrBN4r2PaD5vWp+HBq05Fk9Mq4snwOW9ZeA2ZMz9Ds3RRL/RVjMty36Z6cOBHVdqyAs6SYjQBp5lVxAMY9kIzsQkALqtsidrOlD38AZiibyBh3GItbWRB2gXjyPBtCs3B6AIfN8OoTWDF4YoCnNpOHpy2YIRDmODzEnOuTTY+Qx7YEG4MX1i7FGWf+JsNV8iSPFxY0O9cYZ5g8e/CLcNP2iEsIyBeE1/OLv54T07w5ri8F5cX1WaK8DcMO4TZnkY+lEQBdQTIq6iWHlj01yHksUYYWZgqJH064WrTqQJvOhMEXHvdjcsM62K9pB7p4dopGycP3WJkhXnCwMMfuaa2LXLcGThh9f9RY7CNYJo1wbr5XVh8ajcjIvYiO/+WHMQZriMMIimsXFhyPObCXKYpe7kxGO1rYLYmPqRbZcgpDoQkIRuzrW4EhlmM5qbkg4CtATDG5Yd7BKvTPT5QRz1gi6zz6GHKR9VgD7BRVNJG/NaGU3VYhNAa4Wb1+o0enSB5ctOoHb7Ajy+OktlFuFBDiLLe1xBNGaDiwCtAC1BddCwK07U4rhJ5ichILdJfRP/ZMohhRYLPPt4dO9Te4lXACKQWFC5SVKja1G0RqihyKw9xbatxaVQI8f6Dl2h+3SrTKHN5hGGTXZhwXWYvF4Ua23syYsb9TwEZLyVMbkLV6lgkevEkgIW/LLJi/0Dxf+hOZrPjgTQ5WWr7B0ZFkd3XaRVaVAmwGi/vnE0Kf7/0bEEIqRzJK6lc51iDDXrjJXoU9FcuHSfR/+aC5sJ0VeqIX7+lC1aDFvaIiJQ71mDg0MZZsHQo1V2ldpvcV1y9Zu81IBS/L9Br34v4as/1f3Aav5H615ZpTSt9LuFJGKRNKeU5WHnBpHpfd0rs2hDG9/euaRkwslMUNV6bHMuvHOxuF9LItYniSPgsszNUsbt+4HYK5ginJP0QxlIKTa5GaqHwFhxwNAWP/8AnCM16ZI7mAYhvfFgKg64AAEQd/1IAhmRLT2CwGemtXOhi3Cou4Ar2BuItP9vNV8yzYlNoVFHmlh05WMMJs/aNh7KlbOzeA1nwZqp/cRZsyGW3bqPoAxERDtaQEOAWjnpfVaIh+fCQZOBkzPVmR8GvUeDd4pwPSE0i1TQBatgIhikgyVtEF7OlG3o+0Vu/3AHJZLEhFgUf3BgSraae2RnTtHi0oMnj2aobeI8i0I30YRFpPI3H4vlUd71lKJbLUyN0oVL8atG7E6C6w4H4cFdu6TsyBox9fZCkLKenIHXANxmvzYvvew9RfeWMszbo8gVy2Sck7P3Rf/6CWLCEOYUh4ZEZXkTBGZ9hBwkG9TrXet6FVrWTGaKLaYRnXKTNPeSiTAllE/LiE5CrTIlE25BPRpJGUyEynNjry59hHGNyAiniRk9NKIYYkzqkKVJYNpOGB9v7fT22rcJRfjwOuNa+bHm3XgoQ2jgcwTfSiG9ehtsfKoFoiEeIH6mNmANQSRZyUwpQscPUwy9bs8sPZYYy4SJJk0vQBkSon+H0vTkneLUx9e0UcB1JYLH9H4o0g3hncW6X7jd4SovIeU/9108VjWym1O1wwC1Hrk6bQ59a2DH9IZgmfqpIWT9evtsvJCWhAiYY+KUEAEpP8Sj02LRQ8w6QUKPzAyYw9k2PGQiALJQMYQNgMhuDgOW+zQrm+NRUTYaoCsToVJAov7ERE2iwXXxvooiAiLeGLq9p3syptdbtoBHDsmQJ6pFe8GWHs6EdMt3jU3y9c61nqPxVY7/CxGv96/RwuIcCbBnV5p101AyoUq0zftPYBq+9FcZkmCB5TIFJvFAhQetl99dDuSvXFk498V5QtMC9NujuaI2g3cf0g6+7eguPjiX9CIaHoEdntnc+YgWl7Yi6ac7cu7numyeMrpvr7vn25OiYKJ5/xAFHRPTKJkUim++xigtHBAK+/Gor28LjeG53Wl5bOyOMYKX/oPUl76r+TWnslE03pdmBJctxgpVuuC7lngqwlZ2WufxfO7zRnrD+Giw29ajXwU10eTtjy+2U9+85tDqE8PmHDPGVf7z9j86pAZoySmhE0s42VGKdKRN2bxlR7epqoUt2/q+g/YXro6ObMtBGp6sFhliSkWkpBigd3eFrwyQFaMC5jjBd/ADXrz+ud/hC2eBLHHUrJt1/dbR9F2X7Hq3ZGwlTuyqNawDp0d2Ga4mbW/nB+oAcA2RHCmJYc2WBC8oC63F9QC+xKRNqGhll/Y67KIfhMAv96/v7RlS9bIfrpH/x02GdVHn9B0OfN3d1+vZAoRWZLIT5anZcPIsenw1ra9aNSpd/tZcqCHZuWN765+vnWwtvmycVqPhLZ4zEmDtacN9hVyoz3OXrTxug70/I7yR723nqWx2S1vlRcoSJIQioUrjApO+zc9S8FIf4KYyJTiXRkpKJ7mJjvvY9psWRlmbksL7ifF4cCL9uXIU75sXxBdvnBfaalRZ3FHz2x0YrsQ7qVdB2x14ph4bW1wp3g7+KmtR6gVTYkubjq9Y9BpTNv8KbSciaa1sJ4aWm7RNm6ooOF1OvY8cOx+1Lff9e1Xj3Q4UmpA3t/ZxVg+u9dY+gW+trq5Vnn+zhwNoXdrLFaALlTgtkcxMrbuSh7NYYZXIPQsyB4wmVJnk3B3IUyO5GXxlKHLutqbVkT2a6qQ8tFOmDWTP4MksV5a96DQPfkTZjVrEeA7j6IsJfZYOsH6H/uZi89vP77slUiUCaEndE4vkmDPwC5b2hDUuXV+e9BoFrWvtjUWj7XczNxxiJhMtvb6CEc8e9yQ+Y1QKD7DhfMF84yK3Z61phh220wjkV7QsAx0erd3HvQu7eKJqY0jT4EdtP3VWptUeSDN+IP3PEoSomaSL0fXegxVEL5Udpa8IqgHeuE9BYesRIXe2BFmaAEoWmu3Kq57dFghzHZm/+1jxRo3gtqpWKGHPhYrvLE1K8ybAwtAAucPyQjOVUsgHFp4ey/JPKOvF5DBI8t+mnYm08vVdK0z+yqWD/aCTgKmj35jRPetomGKgPIso+FM6X3XDiTXJDUlUI0BGWdXmh1uZMNACZUJDP8qyQVjFsbG7Y28G+rJoA1gMHLadPveOBhak7jpGmOpkQhLySNi0mFbotZ2O9VsDscwtyb6Mx0D2Y8K4XzU2/c2KeN6eOejm9EM3fllsOCoeNFxaIsq9R9qfTwm6dHz60FOj+rN7dyvZbaw8dSP0vbfse2+RrHMzHYKprlx5xsQkvDDdxM3jrm97yAXSyy//18/J2kH10isoXH1Re3ijNKsFBSS0RrijII0AR82bwVYuFg+FGVpbpEHx3xrv5NvHpwpwSl1ZnfLi8RyMZWQl+jdb/fGun3+Eh5U/10qzGILJn+pgu7QEhNRDuWMYCq45jThDNNAzbfhjmm14EKTPLbNr8TmAivub26BrNZqhj5/8WAExxWAqQuUa6AkKOm9nh5MAwSdZVS+VlUVgGGyu+me9y7FaEU2wLRjTHijubL9uWU2y8diEAWt/3xz6Q9Nij7txuAleq93SS1n1NvzLFGa4ShSMz0RjmOiBXGpEV2VPPF3hhVnJmT7Z9urEG7sAaVvwd0BDTB+qL5ibt/n9Na1vRNAi+3dC0J40eqfu31scOtoIdvcSWToklOVyjaj1KDRwty86RyzS/KoktPHK5ixdkoHFCG2IJRo88bFQoQhhhl3/Y4HggoHy1VUbdHyIFhDmdOePZ4GjJexreIaABDEEYWnNzpz1pI/HDlOiCCOyrYKuoGsOqEow/B6ceavTh9NqM5t2UekDtsxGeihG8yqEwo1BK/T7kdLOXOORfC2ARpd5d2xxZl5zB7shJuQSOTStXX6fIsErDKKhQ4tW4ey1P8oc39WceP/CJA8ExFIJNc8o7EJ7qG4jjFwL9Q8+SPjCh+fJV9q+fJWxliHE9Nwdw0DKXfnse9LiozlfqR2yayo0QWWKIYlsbmTdi77ytHWKynEPZPvPDbv3jJT0L4C4Y4IzCmDO8MB7ZgXDpTB4zvmrYNW+o7nmZcKW2fe4Xo+Wey8+HZOppljis1h5Tce3iCt9GS19lM6newV6ozXa7Eu2/nbsl7NWcbYhSrUTGTM5CvPgRnmLJyzFUhlomTCMp5Jt+ZaByasluerLuI13kAb18b49k5rjs2m8vKYMzXmzskGU2mMTmXB6EVRNTHtxk0vbcMKoDjtvuHYJF2tBVeKQnxyJmhdkW1SXdheXQ4bujBEEnnZOm5+t3Brq8C0bc8r2NUado5B39c4M52XzXOqy0675Jk7rdUVCdmkOhHI7IVDzX+d48ffQotj5/zBF/sWy0Vtib709tFSID0eRkhQl5pN/j0B54pt3oxiTKsHfQrGeF71ZHwJZ6bKAnKQMguewKLxOYY7Nxq6yI2hsbjA9J9fNpuJ+j9DUw+Sd/gJQxA3UN/nbki+hTivTiEKWCozo5OB/qBU2KRZOStygDm1HTP2keeTeP26JzBBg4MTNKgGwP8JC5RvQKDr16gv5vPJ+OVMyfhlHBk/vT5TOn56PY6Qtr4UTTJ6cgQHUmGt371eVbn5GRa5L7OOQGvSpU+pOWglMR248pEkSUYVZsAz2XIS4hj3bAjOhYxnQ9BHxgBDEFjav2WUtiztxmDewSuOOurcBh2+uqPU/NGrwO20/Of5iKv4eeQjLldrf5qUXnECXHW2K13MtfH3D+F7fO5RZLabj0HVnCPoLSitKJ6OkXCtllObEE1z/o1xcuvOop8PVZe1Gw72TkPGlKu0JBItMxblpQwIs+Jpktw9sAX5WMeu0rRD25A4s0o1LufTff42sntLzhFfhQv1NSdJCY+HCtXDN7VQi/sXuTFpXsu6DAmyeisiONNglh9DWaehq109xwiu7xRwctW6sHdnXo7Xsd5Dwf2k8aVNGv4lnzF6NoLrx9Cuqeg5VL/02MHSONR97Tb/qVy/PXw3L1SzkTUrdJUzBDham4/WdvWOfDaR/dt659UmNM5jdS0PXbGx7azw7LQeyWkd75wmkMxsOU/bVRM0xKz23VcZQbj/ypErk1rsWusWL/ILRkPPCUqCE/z9fIheQ1HO6b8oMzXl9hbFOVJd1iJYl676IoqmNu82sSSNh8HLH9Mr/qW7+NcsNDZZLu8oK5NDN3XNvSUmNDt+gUH1BpE7yqvdZLSXSS5qMn2Jto22FOWPANOaf4y68O2JlSUnj2+RWguQ6zWnYUvq41yT1fpxgOqZxyA9vdEprr5+78PZC97ctZ+oWrep4F6Jv0BKENMoNE9D242Awgba8npDj9co33Yk/MaXEJaq2sZXf3atL5NO7yngkPkT/H3S6Uu1GjI7580XCQ6ZnZurayNmnz+QAScsYyHoQbXODkaiN5JJUegB9Y7WQDDY1svtufmBa8hfBcuvl1ecQvMemXlSxb707Pyl1vGm9KMKZp2rD9m4668DryazKkzpCBoPZdbZu515GZ1TuDrTuq9FHMXZ1Fx7Gt6X3D4d/0tuz84DGwD5jOKOutU1K7t1xIvG8jcRSjMSaaf92ecMEPLscz77nE/A5wzBeDjXjKM7bzha4vHh/DOPdRb0JiBbRx3PmbN3Efmyxp8ut6914L3cwYfzTD7W5TZl9lGPPVdRepamomIjdOjw5d1d+R52o+PZGELP1TT4NqFOccBGtI55iPU0bHoKdsIxq86nhsHo49Le8WPBrfM0GnVBtt+/CgYLnaTbioX68wRVgjsP8iskx6Csl+OIuP3U0V2iBmGAkk4IZAAi+4z+HDMefr+oiu00C+gt42yX8EyWyRb73DNnyD37b+6oXAmIgCm6uzIm6OLD56/tWkOJVJX+9Em6lOhCrhNIXoZ6Ug5n3pLQU+9GvxEKVwscPZTSL5nz4fPXgtw9qDK8PjE9d3rXNBNPLaM1AYFFtCYRpnPLqvl57Rd+NUyRdMxhO5eyeKXEM552Q2i/oTkJu+T2PLlV5pwG8611yCo/9+MbYU/NkuaIK+aisvLaU3j1FbkXpx7BbLZzKmxQgzzaQzsS88LleVF8T/70uhpfWYjI/T+NVLab4mltTopXMDePpJ78Mry2EbhoRVoN2ZUgqxUIk/xNu856DPSR+vAvLuZPgG4DtIdw9OKj/tQL+58SrbUKsbLRq8uQ4Ehl5pLBGkukeFdOIDbfMy/ImI5/MfFboQ7UKDlvzUUdocGEeUBW/2u6TPD8KfjimgXOnzPbg462h3GPTQjPvMj1UFK6GuAPIuUU26KrKNQrRGAmU2xKDIoX+19eIsbbT7amdVyFlHM989lw7ffak7N8iXDByCC/xt2N2OL0bGi9L07495RexmBDIoUXZ7Tjf/Ry1BFmjCvbkyyimCQQD6I0p3JBH0jIho+4BfAr5ZH/yPVz8f/Uxf971P7bC47norE2tV5/69rYmiUIYVOg2oYbNwFTihZGqWK9+FonRx0nWKPYRPhpLvCWDLh99Sl/Bpgz085Lc9t1Z6D0AMJNvyUo36FwrXTM6zeckmjX/gzDoYbAAfjnG20MPuYPrgpIKY70/MbWPFuH01iHEAnT5s9TEFdWT7W83WOAgxPoe6NoDDRpLv5AonpNybGRPL2WWKfri/PcEuu5E85zJ5whuPZucnW6/nbFjejnJfy8hA+l46+xKEsfwD5PJrMkwZU7/oooCppCmy++b34guEA7fFc3RPFQS+3NIpDF25vu4bU1lxUvVYDelPWkfn/Q0NLs4nArR3vc7npcVsI2cIls4PU6RVvCJkJSZuVqj5UOwkLixr3pw4GYBoJjUEgKkB6DJfnA49AonqYQfvHyIDB23FFY/uTJgkwvITvsKCQx4OlZogdtQ4Fu1Y8SbUDsUMYoeQDqUpdE2SfZcJoCFmiRmdYwxjk3DaMxRZKozKVIiEIJ3rlDqTBpGXtgfFs/LDqcupIwr6vx2kZjOiDKaMx+dDlYJQhstCciEJE5olnDRAtcyWeMNru17483ovrfIKPCWYw+XuGyq5mNWduWJFaN5t0HzGtO9YnaXVlRDEAQuvFzAIAva/PSZ2zHrQIIc2DHojk2ndqmQ/HO3aHVgyM7+CUiFsvnt7fvERYC7+x7CHHGYswUChsHIh/ycriJlpG3jlwNhp2kY/5jbvBmBk9IpuUSkUoivuzCZM7EpmeJGTbuZ4m9jzP9/O6eT+/8Zn01U4cdOa1Kz6y9tBol2LxYK/DWoLD2VgZRmuOCaTXHOyY1g/tKs+Y0NmU16Pr1m5+vFjsFOYQueHp9HsEhcficg+0g2tIQYUJmPW8P2sI+gajZrvDeVOw4C1B42J7lhwj5O6J2NnmCTcuUsXvbVJNQ/0onjudG2w6ZTY+CKhtT15wHT5fvhSOmzBaHUymzxdU4IueSsEZexs4ZN8E0JjSVTwonaT4hNTld64uZR8hn7pngHIopdrF7D2ZxHl9dWh9V/5+SKEubT5TnqOE7RPOIxwfx6f72f7/7Px/eIz1O+S63Q/ijRAkmrPHSL6p4t0TZysPDZebLS4/b7LvVnHUDLOZingqQUDf2o2aPwRSYjUFRvE4UnLf3rXRnbYrHZBvPdIe1tv+V8SjNZh2viXbmeRrdSgc9G1pt39hzg274/JVbb41q4PrkJvE6M9VjB83qXSuyqdzBgvH2BFBbLh5acQw6uXaDlA8itDUFGXRSfeq6cVtN0VIl6aFKcfQA+9eojsbl5utDxjN1KLTgtG3llv68BwkqIAR/zqZvxTWwQ8L+L+/u3CiydPDs1nZYTjUmAtqDUkxJ4/JvitW6WDiztu8nZGVrXG6QEllLY+Kyi0FCGq+PDEWgP3fI5JRHmM5I3VTY6Ru/hu84SSncoOv/eDN7PXszu0ZcoDevX1/fvH7/6z9u3v76n+9v/vH3n365ubke59Z/0DjQ7R3CcSxASlev6x7Kxwzd3m1+1pPd3m1+KT40hLaUi/C+HVDxgr439QfIBsHXU/VgEpBwBWfA8M8GyMQcd9SdhOWOgOE8X3M5xoErgP37L1dvrq+vrq///eqnX2ZsO3N/mUU8qVeB9GC++/IZCYi4iIObvshlMkO3SrvofKGweVl2QzASsAEhm9vz7R2inD+0VnTV2ACKxvOUZnLO2Rh3uuDH3uRrLxiWS4hcWVd6ZdOHMTdRwAV8+fD+Ze4ZO15oodnbcpwBSnizxIfiBdAZ+o2LHNmlGUCP9j+vTdj9Ysn5bIHFbMUpZqsZF6vZC83fF/4vGhU9X4pbHFygGBSIhJgjqnx4FPEEpKveZAiSBcQxxCji6a5IimLVeAHQfGGtVHrz6lWaLSiJZLZcku8Gx2BdnoMQjdstBySe/lMP5z60yMm0T2IWMjEa6NQNuZv4PYjzY9m0UcrYt8e1f3PUFpcPE/EkwWxfEIEkzH4okpiSUQuvR2zm4SZHG6oM3YkDvocx9HMCvkOUmasBh/DDPO4xWiXC3xo/cWtKrWfqZUbpfIQqVH3g9tKEe/N3FPj7oZUJfIl4Cqzwn0lZj+ASBAd50M1n1AcmJ5qK/NboMWPWo64LoTcn0RmWu9fN+wLicIGyBmZ42I7OczqJVBAokJgQSzGFcX7CaTMVhT3MPeWiI7D9ZdPz6EY7Q/pi7wEM+1jt/OWHknnC5xItsLRldGVqpnhA3d0pNFftbEItNTXJ5E+YoXdcCJCpeY9M8fxJEAnmTP+Vtpiv5E6+YqBekXTz8ysVpfMEkhn6xOjOezKaM/SBsOz7rP3KUnOloXHZnnaF6pYuGpgA4iJd4+47q+2SHojWILZr3QnJTQuxVvlctO387aSgzYZMTUBuT/r5PsyuHAGfhtZlZ+rwQGqPgMh146DvCADLM0Bv2lHcjCiXMN/i1kavR0FbQ6htxLxEMg8ehlVxK5KcB+wCyBDUcsfmsr1Q9mSgcxxDMQuINueAWeMYgnlJmJFJPRV0ctAFkDGow2/+PwLqN0NQUyzVHEehE5iTgs5xDMGsbc1JdpB+k0fYKoS4CNLiSd3Xr+//Iu6rJuQR3dcsPkf3tVu6aKD7emrnrw11x/8oVkdau5U0OkvwzQ7xrdqZzV3NZqtcVeynXC7hwKO2zCZIZkm4miFwNJAvn/yrtT8TlmZqnn8oIZSScPnAgGLWT/c5rYRVhmqWimUShOzl/R6FYh/4agXxVfEKLUhJOKsnkLt43JJO27vEt7wy7sAEZ5XQuGh0wLxvmX80QvmKaMtVn6LjdvqBNL//NZOuitOMPoQDgUPYA1Horxc1Qp42tAggVCtyiAwK5RtamlI9nggiWXBOoZEf6EWiv2Ye7o6sZcL5yVAnRw4pFQtLJH+wqVb014Eh4lNrhScNa6DjwCxlyT+OG5vV3vXka0CCc4XuhtkEK6P5yCPX3i30beVY0J1Jly8d1QCV/+P/BwAA//8b0c3E"
+	return "eJzsfXuPGzey7//6FIQXi8wsZMX2boK9/uMCjn2DO0AcG55xdoGDAw3VTUnc6SY7JFuy8ukPio9udjf7pWlp5BzDxq4zI1X96sFisVgkn6MHcniN5EEqks4QUlQl5DV6dqt/8GyGUExkJGimKGev0f+dIYSQ+SWSCqtcopQoQSM5Rwl9IOjtx88IsxilJOXigHKJN2SO1BYrhAVBEU8SEikSo7XgKVJbgnhGBFaUbSyKxQwhueVCLSPO1nTzGimRkxlCgiQES/IabfAMoTUlSSxfa0DPEcMpeY0ywSMipf4ZQuqQwYcFzzP7k4As8Pej+ZqTZGF/4XPwuYDcpPip4/NADnsuYu/nLdzg792WOLBajWSBfuYCkS84zbT+Rc4YZZtniwb3KMsXWaQ8coa/jHBC4uU64dj/5ZqLFKvXKCMiIkyNgGe+gDcE8bU2q6IpQTIjTKHVASlfBMoion+SYKkQ2RGmSuTw525LJdrhJCeISsQAVEL/ILGjxPJ0RYTjFHFBpHYjqpDAbEOcTa1Q4DsvkOLoZVhBUmGhlgDY+57RU1w1Xo8WgATabwmryLvH2mxCkbjJ33j+E9jIDjkfKI+iPKMkRpShFMP/mM9cfXrz/npRGTtFCBg1dO7N1+5RxJnClEmU8AgnltrQEQX2bijL596jC4viOdDxoIArWQRozQXC4KibBKKQ0BrDKM0TRfX3LOTSnvWAg1BYCF8Q6o//UpSEs03tFx3SwF+A/hZQmYFRoqp88i/oY+EBMghIcYWTmi/2+mO3Tw5AfwdcEY4U3ZFA2KiYOwg7l0ScH3Vf1KNMA0MywxFZDJBA0ehBBmUY7REwY+CU50w9Eph180tU7gMRjCRjpJhQwb0aHoGO0YhcnvtyhhK+f54JygVVBzdJEDlEmrNp+liUNE4uUOcaVfG1duDnc+QBgPgeU3WBumQIgKErzlBM5cP1MDnOp9qx+MTvl6dkScSORrAag/R7i1mcwH9ssYj3sICjTBEh8kz1jkfx+/m8ejLUkq/V12QXwHuchE9tmyOQK4KTy7MMZYiyHU9yprA4mBBgE90dFSrHif7GfksTs0beHjJQieSiwWyPZUVfXG2JcFMgF4vGF97sME3wKiGIs+SAOEOfGf0ySJFnc4CLVpDTSZTlj1qBRlneWASDHqC6Ix+3qITl0pSGCtVaMkGkzb60i3KpFtr1GWfPy3JNg145MiTa0yRBW7wjCKMUf6FpntqSD1+j+5cvXvwV/U0vveW9pt0g5pWFfLo4EQTHB6TwAwygspDEFEc4irTbmbi/88sI5k8AC0ApTVL5Rv+K+mtYmqIPrFnZkPMG2QPPUYSZMVpJX5b12o0gWBEBP2BGb36hco7oGv29QVbbWJd7sUI/vvgrQIMasK2i2WrNAgp1Tpv3xntWBL38Z6txaou/r3wJ++daJH69y68/y2rnT72a+F+Ql3/LbqfJbp+o5D1AkZALEomM2HpGvYkToh3n5sO/IAoVZCv0/4J+LTOjQfkJZFKXnqQU3w+KYef4ixVk7ER/mYI8ara/UNsMnvIvFP8R8/5lSjL55P9ViXlsBnCZQn6tacClaXNIFjB3/TEy1B+jF9cB2Yt/wN+/oLtGde9r2Zk+Z11y7Cx+NmyPmpjPp8HBc+35IB0xfZ4N3OQz4lMjP3aSOxvui563nE5gM3u5JThR2949iGL7YEUUng1S0u37N5/ukKGPKDNTG+UM1PXrb+/N1PPm7o0eL3LgpkRMdhAQ4T+Cdmy2ww4w5a841dMlzHirhEcPjk0XBPCb6SDcHbICgmE+R/dsl5J7xAW6xwrfd6JJeUyS6eC8B3JVPJ3sJREUT8j/VtPz0g9fL3SNsHPxMCqoeORyOjj/3zix7TyvqgXdKOgtvl9jClH1HlH/10iQjAslUSSootCiuscCWqzlHN3bf+qvUFV8NCUxxYgIwYWcg/kFwQn0tyoSz1FGWAyBgAuUs4gLQSIFmkCSREp/A7MY3fOHe1Px2VPZoiUHaekgTaewt3VprXBlZum0B9s6OhqYH8AeEDnoTRz4VMRZTIFJofW6Hs0WpvutjTYJ38yhrmbCiyVMVblR6qy1xEoJusoVkfem3xozVPwM7IoVWGBFEr5HVAE8QeSWJ3FYp4qk+lxBLsgiIomk+VTzzdtcCFgSeRwaA4OhmGwEIRK9NbzDIPcEi0UuSXz2Jcr/k4qmWFV2Wp0UCV3XQiBs/ym0xRKtCNEZfTzXZsQMkS8RIbG3N1gzNZWIr8DY/pkPEBwlZEfA9nN0e/vOcE3IWpvZjDz4FM9h8RnTCCsuSo9oUWjG90QsOVtseS6mMvivRfDTVH29FCrRjEmMOOsCFh2ihEwPSxNHhnjVcGEwOZN4TZZym6uY79n0gAwDVDCAn2Hmh5cwMG32pQm4JwAlSMR3RJAYxVhhnbNudCes4YhioszZpNVhEFxvOljaoD85akvXn3osvHKchdHZ+elkyHImK/OdNXIJS2fbMEMqjlYECZLiLCNxGG1lCj0hZn+itmZf85w1lWpmIeg8sZP8HiY8tnHihsWATHFRJEVLmWFx/m3xTwTO+gBszR9FOMMR+PnAYRgUopxvzy3OnePsYlsBrSbfHOFGeoeol/IUB7naKKA1ThJZZBkd6tG+s0z4ZkmYEvQEUV1zqKzXEr5BllvTlAhCm86MYCbtQA6xbwF+vVgd1HDczoShL/XI9BN8BSKYzQBqwG0iqnvOemDDGFSEnRm55QrnDcdCB103U9zp8nuzti8pB4Lwlkvin7gsTmGOzKgFpvECC4EPE+JPY/Tpzc07ZOiaPDOXxM+xIO1jOnYByAwLZRYii1kdIdQvKO8toXRAugcSXh8n/Ce6+VCcIhx4fLlRrBnc6zmynlIcGJYxfjlO81A20+IByxJ0EJWpKixNFWAEvIEQvtN1tbLOAN2hVKIUHxDjCnKHTPAdjW3qkySl0hs0ba9jj0AQixa6cTQoTSCIDBBF7zh5pRJgIlHEoVMSXEbmEbRxr/MkOfTgg4hDTg5QczkS4VknkBD4VTmpVGBD7+svlOVfTKswrbNCtf00m86Z6Uk3zWYJLZJsLGWegu30p5Ckf+il8Q8vXw2y4NMryM1dk+jIERuopgbVfrWBFRa1c/udSjtCMSlNEioJlLSk3SawYQW4e/NKECHogDwdRM2+DyPlpwYYxhhzyLhvvv/gAewCyTN5QoyAA/YDM8E3gsh+pUFZ2WTGgvyeE6kWKREbIpcZEbAkDWINLXd6wNaPYQBLZFnCfSpiY+pyMJw4i01WBPUl9HtOchJDIhqq8XSJpf3mzHJpnqcWrGKvsxqqRE+lbKD35CzodslRNdB5LTOtJNoiVoCOFGECMeoLyyDmxaBptlcgDNWsaQXBOyKgQcjbr4a9kqqXBS1iKmqwGe3X07pkMO51Rqtohic1i+ZwRrvUBs1EhrH0Fni3WULedBpRgDK6osyo99pV0gZGgGGS6Bh+Yjk0D5QQtlHbkwhxzmFuYU/kSBANaETqt15NKIDlYAQBZ/JTwGsYwejm+w/T2mOVy8N00pSHJyq9QnEuIHHdb2m0rYrQih5drTCL9zRWW5QrmtA/TE8NKKH81PUCvTMfl7BRbNtuogi2EW0x2r8XLUqgVqd47YSqUwnUfnl2eEyBq2xJsjd0NWmOL1oV1fTliqopM/qCMALCYLIm3BLG07f5lngtzjkc98bmGizj+xnnSVFG+MeL//PjrC7GmiakchnbUYa+L8k0zqWXv5rieHohdFD5AcOHZ/rBKtdFS32EyNO3Kc3nLBN0RxMCCyjdd+xmvEUQuhmky2mb2AqMQLZ2r+P99zHZfQ+/fXkfRDRxM5uDAmTrUMgX9Y8wCH3LyjLjlKlpsWjCEGk17YZuwmi0tw71rSPKBEAfMR5DZ5I5oK5/0qzme5AEIUMRncLbu716LQhZTq01T1+CkGOUpsszQxE9Umual6+7bo1BQ1PHmmh6fMBwJLynn91qoEuszX845GsJE8ysjnnMPGZdylDypjJvEnO7c3izEWSDi+05nCQm5NQuLim/+sip7/gNmnKb3xs3thVlEeSlXfoRw/ouEPbkAv3K9SnQf1EW832L/xnWgUVd1ygIW7qpCCK1vUotoJiXN8Z2WcGHGIjInarpQ9/jle6PUSowr4+JOkAYPE8GEJj3AQyF5/Mh1ODQlQaaJbnUOvXO7jiUCcfxrM/JOrjCkg9ouDXtIwPAs5fPZiF1dQRl+BV0g6wx7Ci+hqXebJTSfvHgF8tNfe92SlmuyCKM9IdLQvqDxSpbwL68KLQvA3DDuKHNZvFUPlHBbACjmBZ9E8OOkTbF+eESxCksMIVELy9CpJdTyaQ/9Gw2MGyPyvW7L4ib1aGYG+gfE5/vDYlGycLeWz9BueJsyxDgY+/bLxEHIZ11+fEZpthBsAI51WnXa+UpTmBtQZZrI/8RiZgTeEYCzoZESR4XH444Mw07q4NLJyMcbc1rEg3Wq3y9JkKiK0lc9rmwqsGRynGyqKUhQT1pBvE5NKVd6q1m5wTmzAEvPh4EeUlryEHeZwwQhFuPJwOQvNHUnNJAGeAQOstc1CWuBY/ar2sqDZmx0/59PjBAGE8gT5/eQLnRZxjNlZ6wDQNVQPB0As+0rIjaE9sHb8ed7jHxC0zWQsFbOuFv/ZMoJnDcRLrp4cOtKe6lcP1kTBSmiZyjTJeWUbQl0UOxsPcGmneytlXpT7TQs+oOxyVz8DTCSZQnuvqwwmAWTxfVfjsTwtyFl+9JWu7K6P3y7+FM+fcpSaH5f45mATzQue0x1F/zwek1VBn5ikhH11XqABzCaGHQ+mgwfz4w9OH23/Y4H0YyT+tR2vkQZfbZD+dCH4riwtx+n/zeHNjWirxwC/v1oW7REt4Ghbj+MDfQSZrhDjdGaYssTg65x9ngmJcJsqZfXqNn/6Uj938/m3VA1pOnplLmVpBOUamgdqb3qcpNTsDhTKvf8HLebM1T4xRKuPqSrnMMW1tcKIUZ6kptPE8NWGdn4/A+VUQsYtY4uDxXiwxvxmu3gilymaImpSFkEHLzcui2IqDsdAAoK77Yyh/6u/BWN8k9GgbwLQmiqlwDEARz10dB0BTR1m8EaMVxoVE7HzYInRSg9CWEUjkLydCbrdowC2S8iDwywmYbGWG2fABrHOlYTpvBYz4N1NbvI8wYZDwHZFj3AYwp3JNxfoCGb3LowAeB9HzAgFtR8Wn0e9Sx6WuTzmjdsgSkmSFBogTTdKilNdrzmbodba/ZzQeWZL2mESUsqj/ceMp4ZHg7tKjE4MWjBXoDN8ER4f3MXYhBpOc8kFlLJfLNJrFn7h3dehCrq8B41dOowPA+uwpmIT1s8w0JOevZE3AAYj35qXPvYeMvPLGWe9yeQLZbJOM8aTHHxeTi771iEWWovH+jFGeoCF0DqkcA6/PHiVDtd/XMAEKdeG0xjesUhYnjnQjqYHBQ4mkFcSjQKle6ch3yp5GSyVxAoedpBYN7JyKepnT00IjJGueJCnWWnGN8vzPsTTcu7DaOAg8T18Jfbw6ZMBrISpbBNWx/lPchtWVavcpsy507EZV84dKUFY4eJmH91i2sPdXogwRpLvVdADJLKPxjDbVlUJoPz0FiRO25eJj1GaXDTe4tDW8v0v7EvxGi8q60+z3cLCXWtXab8VuVI/uSi05aorYjd6fvtgHwQ26GgGpOaAi22rxr3A4BWYQdTQDJykvzQYiUPSlCQSJC+w/xgCIzHD0QNRjoKDCW9kCFnQ6JKJAMVAxli0kvhKuqxV4BZh5nNIgo2/RAAludC5M0t7j1KykWHIL1SRBRFvEUllTOduXJLst2gMZOCZDnasO7AfrdA7CJleybFxkh9AL2Od5hsYeEn8Xop9t3aEUiDHcSmd0rSN3qd6i23wHkFGCD61LmaYoHtMgUk8Xwa57f2xlJLyTt+neT8BVOitCut+aoOgycf2i2+FvQXHz1HxKpcQa7+Whq5kTIIDMVTcnt7m0Puzyekt3nd/3slgkcV56W5y9wIrmTMY3SKQW9efs+IKljZu9C7/XuDib3loaXddmfwCVcGG6Bm+sr220CNtfD1JbQ7c8WoYH0+KwLJxRXlYxQhtW2kHsR+GpKN3BTFWhTiZwEOULRf0yi12MjCI1OZ5p07aDVM5EzuLH52SKIJqPxkeI3vzlE+uwRDI/kuDme4+YojlEK7yWQINOjbQwXicHlXSlm8XMgr2tNUPmVCgtlSiQG99z2yelpIdDTg8UmT3WzkCQZFu6W2eCRAbphXJAlXvEdeY1evfjHP4Miw/HLI4YSfO3YcRTt45HcnFlhdoSOZVORr/awDuVO2G54mDWxd/lIDyBsRwVnYDm0w4LCdrxs94KF/hKE0NCVX2WljTP0syDkp9t3c9O2ZILsh1v073DIqD6e3R73R9fM3378/FxmJKJrGvnF8qx8eKPunqHQPuj5o85sdIBBOt4i8WzQ/S5SHazum1nopPVEaItHsQGs2W2QFNoEdQyx8aJN13Wg59s3ahcoq5S8CxMUl6hVbKElLTry8yzWs+WN8hYKkqY0wcI2RgXZ/hW4FIr0GcRUZgk+lCsFxTMXst17MHbN0KvclqfMvioNk12l/OD/qS7PvPtqLcXQoQTQIrzXgVlb4VM3Rr5oXqlRV7Fdt11CXAi/SVYHbAbcKfFqDt3m7dAnRI/QVTQluriZ9I5BB5iK+7ydEvUTTcDar0h0nlDpnqwqYMx+4Nj5qG++65uvnmhzpPQA906WXWP56t5i6Tf4mu7mWuf5W701hN5usdgQdOV1nRfjoaCMTdJq/zvFDG+I0O+OQMyEu0djW3C3SxiH5NpFDtuTbU9aUdlmlVK/QspgffhcSv5EJI1haN0ShW7pH2RRixYBvfMoyjO4pBbuYMLwP+YzV5/evL/utUhkH7CxSS+SxOyBzcuW9k5tXd4cNFpFrfLJLRZPNdw07zgkTF55+NHHu46Pi2VNFD9Dtdl9Bt600rmgq6iY6il4ih6v+ocwT5eLhnXgxTy9eNBrB7uemDo48oywWe13fdaq6KFaRl5XdSDhnnE2eM5LaErVAp5EfBSkDgfha2W4uI6gHuhF9hQk6QSq044wg33WaAtpVVwTH2Glr+OHqb1PFVss4hOpAkifShUebVCFfrsR3q/B7kFewXktiXVyR6GBd/SQdBV9GEBaVlnep2k4gbjm1jpQgcLyQQ9KlBJQRtM+9ltuAMPTJ83HUZylYd41hOSWZjA5+CVU84dx9hzUYSlrBcoibOjn47T+KsUFHRYWI4c6bXelcP1khDfdvNOLMhhUHPq8rDQS7jXnEdXlsD1VoGUqtZqbqoU/N2uwjb4xkH2n4DUaQ/XmnSnKrA4V6pqaltsdBgtSxauOTVtfRVDTOp2SgLo7HmT9qH65nf2xzFdmPfWdNPfvmOu+RqlMczuH0izd5Y4ISfnjZxNLB0amg1wMMXf+346wglw7uCxvBRQKKCPMGWV5aSgk4RhIDtVDWPBh/VYA5DXa2Yu2NDvIgzTfmO+4yYPDBYhJYsPunheF5YIVvJD59udbnR58ugt7B/xeKgwnSwGMe6kiOaA1pqIkZYNgJjhomnKGk0DPN/w110fYpYlb27ojsc5gxfnNPaGbrVqgT3cejCBdeJ/NLpRroCRsmGOU4i80zdNwGQCrrmmp7Au0AwyUbE+6u7tLMdrQHTxESATl8SJI7oZpnjmLiShk/e3V3CdNi3vadcBL7dM7alsE9SBpPS9FWY6jSC3QDUM4Nu+Fwjup4nlpKH9m2HAGB0bRb22vQnTPDL2zw5Dg1xgxN++cvHVv7wTQEnuPghAetPDn4zExuJVaKDZ3Chk65DQsKDVkNDB3rzppdlnex6Xr1ovGQ5aDg3gHQol2r+xaiDLEMOP2vuOBoMKL5f6rjAfDGoijo3o8DRivYlvFNQAgESc0Hkx0eq9FR+fRRiTipGqroBsG55ymDMPrxWnL6aczqk1bjjGpxXZKBXrohsI5p1FD8GZdGKO1XNjEInjaYJA5h05xmo+eg61xUxoJZ12dk275HgmyyRMsYGnZSspI/510+aziOv8RRPJcwPPUcsvzJIZih/e87sC5EHTye84VPr1K7mr18lbFmCwYJ6HjXhaSS+ex9Q39hrfImcsjISUzpkZXWKKYrPXL3atwNg1/Ks7RdldSSHu63nlq3b1h5p1nIuwWASTbyO7hEEjMiwRK4/ET81aiZTXDJl0NtS68zXXHLLZZfCvZKMutUsz9yu7Ewytom97SzdYv6XSqV6gLHq9WRR2Jadt4pfKIgSrUQsC14ym5CGVAjg6MiFR6lUxZznNpx1wrYcpqdb7qIN7iHWmLcgPVpPNw6zWnVlN5eMyGGhiiYocTqYNOZcDAoKiGmFayemhrVZAEZ3KwhxjR1VZwpRISn10J4CuyzaorKEwU2GADFUMf+ryVrjtbuDe3+UBsdx3saksOhir5ssW5vnkZamt83RmXvHAHM0/FQqaoTgXSc+H1kRo/+cAst7Pdgy+6nQ9d1YbotTePlgZpJdtuqDnCXuFvdbA2lbtXoxTDnlIxXlY9mV4Ws9AXnPiwTy1zQTqFHlxj+GipoSsXDHXEJQwkvLa6fmTpQfKOPGEI4gbqW5eGuCnEZnUKQSe70hytDeCDUuEEAgOHnM7UAJ20HRz7xPNFfPmiZ2EyfHEyrAegVTWFQeHMMXr5wj4lJweJ8eOFivHjODH+/uJC5fj7i3GCtN1LMSrKTSCFxoFuYVS58NOd/TsBoP/+PEM/SfRGK42TgSMfSZrmicKM8Fy27IR8CwTfAsGfLxBUcJmh/TOckwkP7VkbJruVNWtD0jasK+ztVqp79MpufS1m40bhty2uc25x2V57OTvSDwdKeVdtrq4m225vt+h9KDLuzurRGFt2ndcY3M05Qt5C0orjwRoJ13o5IYTgpNTNOLt1V9EvR6q5X49fHeyZhpwp22kJPS45i1wrAzxu6J4mcemBacjHUF4C39CNDdCn/fbj53E1n+79t3EeX2jEd+HCfYETSnlMxuOb2qjF+Qtrs8CxrHnIkNVTEUFOQ0U6ibNOI1e7exYYBkjptrbO5lpX5uzM9Xgfs1CntsZdmzX8Qz5j/Gy4KCfxrqnkeax/Ae0s7xQteOw2ePx2qAMOmAcaVbPCVzlDBEdbbdHarN5KVrc69qZonUebRmas9spD22wMnXnfktaTJa3jk9OUpAvdXNN6YmlQWO07rzJCcP+VI9smtTq09i1euQNG16MFTvGXyxF6S4p2zkJ0Ek8uuR6GFyl12Yug512rBCcjuipvm4CN7FaS+q74a3vwz83kntagGuZtZeVy6KQOfrPGNMlP32BQPUFkt/JqJxm1IdFVzabXaN+4lqL8I2C6GLyFqUnz/ZmdxYnH97DxReR2y5O4Fyd0MjwNUOA8Bun5g44FmuIvfTh7weuz9t3I23KCBqymg3st/gIpQeGOtaIMrUM2SsiOtNX1unIHX5CE71s/M0D/DUFKV23Tq88d/GVS9p4DDuGf4i+Tsi/dagh3ztNZy0eO4s55Oo778oEmyeQQgCgRI5DARDIpCiBI4gCC5j9CeFKSyv2l5YFwhNS8CuaOl9tQpnEiuKZa3ydslrwuX2qlN2UeJfcXnkOW2ZTVGSy8msqqJJethB+vrItPO10bnXW4utK6j0WcJNmU+68l+5L7ryf/kvuLy8AGQL6gdUc96uqR3UrxqjH89QqluRJpl/1bzvkt5/yWc36dOWcIxsOlVhztfsPJCo+e4JeaNdZV0FuAbKU6XjMXnyLydU0/XWlfK+Gj0sGHyyw+Ppyw+gi0l3CB+yWGCqsGDQ2o67vhi/ewbVw4TtBLDQ2FyCRuSByIEa00HxM9tT98DXHCKquup0bA6NPS0evHQluXGTTqhmw/fxVcLHSKbpov6s8TjNjIr4gcE2Uqa1aImw8dt0vUIAxw0gmBDECk3+ogS8x4+P2iwV4x4QB6wzg7pHBcsSi26C083YKu8ZozKs/hJR2mksNznZZc/fLpc7vXJFSqyv30abaW6EpuU5Jeh+6kHK482Hw8s/LgGsXn8Bhaaf1SOb98+lyIe4RUWtdnlucjzJqa8dQ22lIisIi2NMLJ0ozY5WXNF343THF218G2KWXxSokXPM2E0H5CcxJ1yf1laqusOQ3WWyvJqj6P0xtlX1skpSwQLiojr5VsY0QWnxyjqScIm+2aCgfUoI6O8I5Uv3B5WRLDJdBlYvrcQET2/wCpbA/FrUSP0g48KLrUj6QerZdjD8NDPordSsVm4C7TVoJuNkTo4m/WtdejoY/0h/9wsfwK5E7xf7joERw9ew+femb+Ey50zeA+4+KiV1shwZHK9SEDuPBV8VaigmDYtLYvyOgb/2LqX4U6QL+gWbmk7Gxq1Qz1M7Jwm4TidlSVxyywe87sCDn8l2fPKQjPvZXrY0XpugD/3KGvdVq0HYUQGQRmMsP6Bvvixf5raChvswVqjZbHzRlCyiVwvhitlU6iicE/cKHIoL5GyQtmuBhZb4sd/iOtlzOyo5GCp7IuLXXWwT/CDG5w0XeSRQmmKYkHSeqkXCUPlM/G7r5WgP6U8Mh/5Hoxq326ZzP1W/N/X/N/+F7sTln0AZqL8VhTWi+riJqkic1rIiA1U1x35Og0AU4+rrRTxTD4Wpmjjh2sUWqi/GglHamAm+8/uGeAObxZSgRo297OkCSPEBxOU0lSvkNhr9KB2SzjCY0Oi1mdolPGYwOBBfDbKwgG9jWCOdxqlOAI+OtY8y06nCc6hER4bP38f9g7v97GcSOAv/tTEPfSFt0ocW67d81bdnNtA6S4YP88+2hxnBCRSIGknOQ+fTEUKckSJUuW4gRFgMNhEdszPw4pkhoOZ3YbgZm6i3GK/e2KAQ52oB9M0RI0YJY7WqMiLl+XxCeu6NDQ/zR3Z+p4wZRYx8uL854S6z0l1ntKrI6UWPMkuTpefrskeX+E3x/hmR7h/4+H0iO4N4NI52lKd+74G24SQNvbL5Bv7S8EH9CevasT4d/9y/O76u3E1950hdfupd7ZpSrARRmV0kX/o9ln4U6L9qCH3ssqbIvLdYu34nANm4mk8sp5g41h4SyB2UFQ6CgKnQBkL2ESL3gcjZF4BjY/TCF3FMufMl3z+XuoEDuKhAGd3yQotIuCXJu/aLIFjDAUCX+AxLkuuSlKsuFJJVVkndvUMLiDwMQ3MacJ0dzkzkXCDUnpszuUCjctFw9CPorZW1c1rJbVGG8wGixQFmNRCCxGZ32wRnHY4k5E4RGLI4oWTVRFOZsy7TZ+P34Sxf8HDRX2YuyzFa2ymhXvrOEewhfGXM+n157qc/N8goIHEYRu/EwAwBhR7IviJtEuQFC/fhbxCrGlmI/ii7tDi8JJIfwD4QXL18vrK0KVos84IBWwXDAqDAnS4QGtD4eb6TGqPUcuBqNQ0qP/JRd4q7zWSRhZpLk2mshNH5M9E5uJqWYSK5Y5HT3qMdYX2Pz63T2fvfrt89V2Hfb4tHZyZh00qjGGBRkVfbQUxXyrg5T2uGDekVMZqRBeHzR4JcqG1ZDl2fnHE3RAeoQ+PHw+gb0UnxR1RLvE4qszrqnPIt5D60k1qMbcFV6byhVnDYYuBkHXXxFcTSmnTR9h0cKZWtSWqXZDKz2JpGxlR9sUbSjF7REG6Jyszq+FI1Tm6+mt1Pn6ZLhG/OJKcxGHdbI2TEuhjXwyNM28wsT6dFGyK0IekesdFFz43NqDZ4zu/QrrmBaBMNxokmftEuWeGp4gXsWSTbLTt+t/f/nPDVaOZlDV5XaEWPmYcuF3mkGKTMGWy1xP7zIvqWPI5IKbD0Ud7rIiCRekuKxtc2Xu4cvXMzK2xteBeAq0ocrog2bf0B2YYgpjNgzOCQdW79QgBtIX8aOTTdSySjt7WlvrFgTDkEUFGswU7QxsmOAYCu+SDfdBc4VpaXVrhl9G2sXWw5YPLyl1LqwZ1VMTtnOItAgxW+eg4q9eswvJ678HOVy/C3guEcISvXKbZzmyMYCTtNYeDJQ4omMqFgHmUaqHgKruwdEGKYSU46wztUvXgKgT8WNH/1ttXal6alQZBreb43E5ffvIZG6mogXVdgXN1vX2tWWcVhcR2dDpdZVbD70YOkh3pFe/D+xdbDSULYJeforT6WLY0PWI7k4GYIW8RZc1AtusFut3T1emCEcaYgW7dc7tqUILWRvoiZsX4XniZgwOF8e0EBcjoV7eSj1IHgfbow4b4TbW322RlLWHHjmAc9FjgvDeJGgFlFNscKkrqWfuIdi0SreAJ7MqikFO7gWUVfUC/kORQjQ6DjaEG7dzpAl+6G9r6E447NaV+9Zkut0xggDKJm9y8htOCo+gJc7v00ZGIeOgoRFLIcB6Nzt8lHvn+459i8tBiIUzcMBAXdNAmhgyA2wmHi9uNIeCDV6hngnDSQtSdA6J8HDocQthFoVCir/PpPxcHoUcToOPOBhX0O1opwlvJTTB7O/lNjLq+n3K74q43QtiVF6NwSDEhqY8eT6QAG05RTmWKE8i3nwuUewFaf0ZnmiaYXjA8p/n0Vl0Hi3xFOv87Gx5cXb1+deLy8+/XV38+o+fP11cLBs/7ele/O8GOcj1LaGMYXCFu4OEtcTXgMVqrm+3H1HZ9e32U/mlUkxP29A9EWxdYKSX7Ts/PwQfVVUDMsikIJUG3oDBv1qQmS3uWncUk7sGDLc5hkYEqcJbhhLsl08n58vlyXL5y8nPnyLxGLlPolim0Tjm2+9f8RKOVCz4Cqx8n0TkGksyE7nGK+3AyJZjWfktqMC24/qWJFI+5NkwM4BJ2Arv1K+kgEPscXDzcecJmw3OuDZUPTspjkSZtJ7Nv8L3m6u/+RcbZwvstCLXrxRAUtkOW07oGpKI/Esqj4huWyAo7e9LfMkmP22kjNZURXcyoeIukuou+gnt+1P9D83G2F2Q3ZOgDAYGVMpt2I0XT2KJbj7rqqWCQLoGxuyanD37dqCApmD7g3tjsovT0yxfJzzW+WbDnyxH+eW+TkSzrEApqUb04J7B+RuKc1249s0synyXfWJHoBtuxGUXquwWJHYO6yjjLMjavcZ1/3LUEufFxDJNqTgUInCwdBhFyhIuYL5us8UoXdvIjuheDniCAy2BZx25ve44xR5Y5iMaPSTCvxqvuPOYcI9qvImwGjEUvNJi99odbvnNfk4Cn0+NtsQCiHhH3++f3U0wnEDc+cSkHTRt3cwIEw8YyJdJEnyP6Iaog/Q7qbkwEH4p3gPlwawNu+kqDszGBKId4TgjS6nCbn70IoRh4mzOfsE3sMP7Zk8hsW6D7PNEDzDYf3ezmdZfJf3xxweypvixFPUX7ARfjXB7Zu+326un7ngJ/0A0/xMi8kUqBTrDExy8e+fKnGmwcYqnOGOe6md9KsCc8mz78dTEGWamisjveHZKt5QnOJFiOOQNF/lTRDqN2H7S+nt1oH32925fD9cBpcruafNNeGhPD6TF/y6L/Bquk5xadPDGme/abvv2tqBrDpm7AX4+2W/3YfPKC/AhWt8808TD0+11wvU9sJcHrOKaampHWTNOpIbVI+XmmLQNQpwjVhXJquMIoM6Nbtg3gV2CDKHWz2KlQbw6tOcYyqwg3r4FZuQYwrzhwvZJ0xV0dOgSZAx10//zatTnQ6jxeGRF44fXhvYcQ5hxrjnKCtKP7DBCxJ40Z9li6EZnDxNucH5c7VAshm1u3uD29cfVq25fc/YWt68/rubYvh5789dF3fMPj1pEoi6afE0z9hD9UYj4Yydrqk83I+78UCm+5XwJ0SRHActRtBRRqoceDfjHx/+08TEXWW5W/kspTxIeDqbb0zPo5v39m28rFzuiokWzIegH0nttf0Dw+428uwN2UlbWB625FE0Hcp+NOZvPrYhWqdLgOJigVg3UzKf3UtSPRhJ5xwVrq+jJuDOxzVefc+2uq1if4xALBA5hJ1Lgz73m+mgIqg9HTk4guPTqBgdqepTi1KYhsCBZS5kAFWNJ8GeEC8bjYmaiTke/RQJboYk94otQ7gQz9zLEcu5RUesNH1re1uL1J0AZqKFz7QDtSkpDbofNCUUfrUYeue6BwOFQPxZ0Z9JlQoEm0IIQQgghZPG/AQCWIjA+"
 }
@@ -20,6 +20,7 @@
 package service
 
 import (
+	"path/filepath"
 	"time"
 
 	"github.com/coreos/go-systemd/v22/dbus"
@@ -52,6 +53,16 @@ type Properties struct {
 	InactiveEnterTimestamp uint64
 	InactiveExitTimestamp  uint64
 	ActiveExitTimestamp    uint64
+	// restarts, only available in recent versions of systemd
+	NRestarts *uint32
+	// timers
+	NextElapseUSecRealtime uint64
+	LastTriggerUSec        uint64
+	Unit                   string
+	// sockets
+	NConnections *uint32
+	NAccepted    *uint32
+	NRefused     *uint32
 	// Meta
 	FragmentPath string
 	// UnitFileState
@@ -103,6 +114,21 @@ func formProperties(unit dbus.UnitStatus, props Properties) (mb.Event, error) {
 		msData["state_since"] = time.Unix(0, timeSince)
 	}
 
+	if timestamps := getTimestamps(props); len(timestamps) > 0 {
+		msData["timestamps"] = timestamps
+	}
+
+	if props.NRestarts != nil {
+		msData["restarts"] = *props.NRestarts
+	}
+
+	switch filepath.Ext(unit.Name) {
+	case ".timer":
+		msData["timer"] = getTimerData(props)
+	case ".socket":
+		msData["socket"] = getSocketData(props)
+	}
+
 	//only prints PID data if we have a PID
 	if props.ExecMainPID > 0 {
 		childData = true
@@ -128,6 +154,53 @@ func formProperties(unit dbus.UnitStatus, props Properties) (mb.Event, error) {
 	return event, nil
 }
 
+// getTimestamps returns the timestamps of the last state transitions of the unit
+func getTimestamps(props Properties) common.MapStr {
+	timestamps := common.MapStr{}
+	for field, ts := range map[string]uint64{
+		"active_enter":   props.ActiveEnterTimestamp,
+		"active_exit":    props.ActiveExitTimestamp,
+		"inactive_enter": props.InactiveEnterTimestamp,
+		"inactive_exit":  props.InactiveExitTimestamp,
+	} {
+		if t, ok := usecToTime(ts); ok {
+			timestamps[field] = t
+		}
+	}
+	return timestamps
+}
+
+// getTimerData returns the next and last elapse of a timer unit, and the unit it activates
+func getTimerData(props Properties) common.MapStr {
+	timer := common.MapStr{}
+	if props.Unit != "" {
+		timer["unit"] = props.Unit
+	}
+	//timers with only monotonic triggers don't have a realtime next elapse
+	if t, ok := usecToTime(props.NextElapseUSecRealtime); ok {
+		timer["next_elapse"] = t
+	}
+	if t, ok := usecToTime(props.LastTriggerUSec); ok {
+		timer["last_trigger"] = t
+	}
+	return timer
+}
+
+// getSocketData returns the connection counters of a socket unit
+func getSocketData(props Properties) common.MapStr {
+	connections := common.MapStr{}
+	if props.NConnections != nil {
+		connections["active"] = *props.NConnections
+	}
+	if props.NAccepted != nil {
+		connections["accepted"] = *props.NAccepted
+	}
+	if props.NRefused != nil {
+		connections["refused"] = *props.NRefused
+	}
+	return common.MapStr{"connections": connections}
+}
+
 // getMetricsFromServivce checks what accounting we have enabled and uses that to determine what metrics we can send back to the user
 func getMetricsFromServivce(props Properties) common.MapStr {
 	metrics := common.MapStr{}
@@ -220,12 +293,22 @@ func timeSince(props Properties, state string) (int64, error) {
 		ts = props.ActiveExitTimestamp
 	}
 
+	t, ok := usecToTime(ts)
+	if !ok {
+		return 0, nil
+	}
+	return t.UnixNano(), nil
+
+}
+
+// usecToTime converts a systemd timestamp in microseconds to a time, it returns
+// false if the timestamp is not set
+func usecToTime(ts uint64) (time.Time, bool) {
 	//That second number is "USEC_INFINITY" which seems to a thing systemd cares about
 	if ts <= 0 || ts == 18446744073709551615 {
-		return 0, nil
+		return time.Time{}, false
 	}
 
 	//convert from usec
-	return int64(ts) * 1000, nil
-
+	return time.Unix(0, int64(ts)*1000), true
 }
@@ -32,7 +32,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-type unitFetcher func(conn *dbus.Conn, states, patterns []string) ([]dbus.UnitStatus, error)
+type unitFetcher func(conn systemdConn, states, patterns []string) ([]dbus.UnitStatus, error)
 
 // instrospectForUnitMethods determines what methods are available via dbus for listing systemd units.
 // We have a number of functions, some better than others, for getting and filtering unit lists.
@@ -119,12 +119,12 @@ func parseXMLAndReturnMethods(str string) (map[string]bool, error) {
 }
 
 // listUnitsByPatternWrapper is a bare wrapper for the unitFetcher type
-func listUnitsByPatternWrapper(conn *dbus.Conn, states, patterns []string) ([]dbus.UnitStatus, error) {
+func listUnitsByPatternWrapper(conn systemdConn, states, patterns []string) ([]dbus.UnitStatus, error) {
 	return conn.ListUnitsByPatterns(states, patterns)
 }
 
 //listUnitsFilteredWrapper wraps the dbus ListUnitsFiltered method
-func listUnitsFilteredWrapper(conn *dbus.Conn, states, patterns []string) ([]dbus.UnitStatus, error) {
+func listUnitsFilteredWrapper(conn systemdConn, states, patterns []string) ([]dbus.UnitStatus, error) {
 	units, err := conn.ListUnitsFiltered(states)
 	if err != nil {
 		return nil, errors.Wrap(err, "ListUnitsFiltered error")
@@ -134,7 +134,7 @@ func listUnitsFilteredWrapper(conn *dbus.Conn, states, patterns []string) ([]dbu
 }
 
 // listUnitsWrapper wraps the dbus ListUnits method
-func listUnitsWrapper(conn *dbus.Conn, states, patterns []string) ([]dbus.UnitStatus, error) {
+func listUnitsWrapper(conn systemdConn, states, patterns []string) ([]dbus.UnitStatus, error) {
 	units, err := conn.ListUnits()
 	if err != nil {
 		return nil, errors.Wrap(err, "ListUnits error")
@@ -0,0 +1,168 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//+build !netbsd
+
+package service
+
+import (
+	"path/filepath"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+// updateBuffer is the size of the channel of property updates received from systemd,
+// updates are dropped by the D-Bus library if it is full
+const updateBuffer = 1024
+
+// EventMetricSet reports the changes of state of the units when they happen, using
+// the signals sent by systemd over D-Bus instead of polling.
+type EventMetricSet struct {
+	mb.BaseMetricSet
+	*unitReader
+}
+
+// unitState is the last known state of a unit
+type unitState struct {
+	state    string
+	subState string
+}
+
+// Run reports the current state of the units, and then an event each time a unit
+// changes its state, till the reporter is closed.
+func (m *EventMetricSet) Run(report mb.PushReporterV2) {
+	defer m.conn.Close()
+
+	updates := make(chan *dbus.PropertiesUpdate, updateBuffer)
+	errs := make(chan error, 1)
+	m.conn.SetPropertiesSubscriber(updates, errs)
+	if err := m.conn.Subscribe(); err != nil {
+		report.Error(errors.Wrap(err, "error subscribing to systemd signals"))
+		return
+	}
+	defer m.conn.Unsubscribe()
+
+	states := map[string]unitState{}
+	units, err := m.listUnits()
+	if err != nil {
+		report.Error(err)
+	}
+	for _, unit := range units {
+		states[unit.Name] = unitState{state: unit.ActiveState, subState: unit.SubState}
+		if !m.report(report, unit, nil) {
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-report.Done():
+			return
+		case err := <-errs:
+			m.logger.Errorf("Error receiving systemd signals: %v", err)
+		case update := <-updates:
+			if !m.matchesUpdate(update) {
+				continue
+			}
+			unit, found, err := m.getUnit(update.UnitName)
+			if err != nil {
+				m.logger.Errorf("Error getting status of unit %s: %v", update.UnitName, err)
+				continue
+			}
+			if !found {
+				continue
+			}
+			// Use the state in the signal, the unit could have changed again since then
+			if state, ok := update.Changed["ActiveState"].Value().(string); ok {
+				unit.ActiveState = state
+			}
+			if subState, ok := update.Changed["SubState"].Value().(string); ok {
+				unit.SubState = subState
+			}
+
+			current := unitState{state: unit.ActiveState, subState: unit.SubState}
+			previous, known := states[unit.Name]
+			if known && previous == current {
+				continue
+			}
+			states[unit.Name] = current
+
+			if len(m.cfg.StateFilter) > 0 && len(matchUnitState(m.cfg.StateFilter, []dbus.UnitStatus{unit})) == 0 {
+				continue
+			}
+
+			var previousState *unitState
+			if known {
+				previousState = &previous
+			}
+			if !m.report(report, unit, previousState) {
+				return
+			}
+		}
+	}
+}
+
+// matchesUpdate checks if the update is for a change of state of a unit that
+// matches the type and pattern filters
+func (m *EventMetricSet) matchesUpdate(update *dbus.PropertiesUpdate) bool {
+	_, activeChanged := update.Changed["ActiveState"]
+	_, subChanged := update.Changed["SubState"]
+	if !activeChanged && !subChanged {
+		return false
+	}
+	if !m.matchesType(update.UnitName) {
+		return false
+	}
+	if len(m.cfg.PatternFilter) == 0 {
+		return true
+	}
+	for _, pattern := range m.cfg.PatternFilter {
+		if match, _ := filepath.Match(pattern, update.UnitName); match {
+			return true
+		}
+	}
+	return false
+}
+
+// getUnit gets the current status of a unit
+func (m *EventMetricSet) getUnit(name string) (dbus.UnitStatus, bool, error) {
+	units, err := m.conn.ListUnitsByNames([]string{name})
+	if err != nil {
+		return dbus.UnitStatus{}, false, err
+	}
+	if len(units) == 0 || units[0].LoadState == "not-found" {
+		return dbus.UnitStatus{}, false, nil
+	}
+	return units[0], true, nil
+}
+
+// report reports the event of a unit, with its previous state if known. It
+// returns false if the reporter is closed.
+func (m *EventMetricSet) report(report mb.PushReporterV2, unit dbus.UnitStatus, previous *unitState) bool {
+	event, ok := m.unitEvent(unit)
+	if !ok {
+		return true
+	}
+	if previous != nil {
+		event.MetricSetFields["previous_state"] = previous.state
+		event.MetricSetFields["previous_sub_state"] = previous.subState
+	}
+	return report.Event(event)
+}
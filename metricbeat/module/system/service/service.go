@@ -20,20 +20,63 @@
 package service
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/coreos/go-systemd/v22/dbus"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
+const (
+	modePoll   = "poll"
+	modeEvents = "events"
+)
+
 // Config stores the config object
 type Config struct {
 	StateFilter   []string `config:"service.state_filter"`
 	PatternFilter []string `config:"service.pattern_filter"`
+	UnitTypes     []string `config:"service.unit_types"`
+	Mode          string   `config:"service.mode"`
+}
+
+var defaultConfig = Config{
+	UnitTypes: []string{"service"},
+	Mode:      modePoll,
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.Mode != modePoll && c.Mode != modeEvents {
+		return fmt.Errorf("invalid service.mode '%s', expected '%s' or '%s'", c.Mode, modePoll, modeEvents)
+	}
+	for _, unitType := range c.UnitTypes {
+		switch unitType {
+		case "service", "timer", "socket":
+		default:
+			return fmt.Errorf("unsupported unit type '%s' in service.unit_types", unitType)
+		}
+	}
+	return nil
+}
+
+// systemdConn is the subset of the systemd D-Bus API used by the metricset
+type systemdConn interface {
+	ListUnits() ([]dbus.UnitStatus, error)
+	ListUnitsFiltered(states []string) ([]dbus.UnitStatus, error)
+	ListUnitsByPatterns(states []string, patterns []string) ([]dbus.UnitStatus, error)
+	ListUnitsByNames(units []string) ([]dbus.UnitStatus, error)
+	GetAllProperties(unit string) (map[string]interface{}, error)
+	Subscribe() error
+	Unsubscribe() error
+	SetPropertiesSubscriber(updateCh chan<- *dbus.PropertiesUpdate, errCh chan<- error)
+	Close()
 }
 
 // init registers the MetricSet with the central registry as soon as the program
@@ -50,17 +93,24 @@ func init() {
 // interface methods except for Fetch.
 type MetricSet struct {
 	mb.BaseMetricSet
-	conn     *dbus.Conn
+	*unitReader
+}
+
+// unitReader reads the state of the systemd units
+type unitReader struct {
+	conn     systemdConn
 	cfg      Config
 	unitList unitFetcher
+	logger   *logp.Logger
 }
 
 // New creates a new instance of the MetricSet. New is responsible for unpacking
-// any MetricSet specific configuration options if there are any.
+// any MetricSet specific configuration options if there are any. In events mode
+// the returned metricset reports the changes of state of the units as they happen.
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	cfgwarn.Beta("The system service metricset is beta.")
 
-	var config Config
+	config := defaultConfig
 	if err := base.Module().UnpackConfig(&config); err != nil {
 		return nil, err
 	}
@@ -75,11 +125,21 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		return nil, errors.Wrap(err, "error finding ListUnits Method")
 	}
 
+	reader := &unitReader{
+		conn:     conn,
+		cfg:      config,
+		unitList: unitFunction,
+		logger:   base.Logger(),
+	}
+	if config.Mode == modeEvents {
+		return &EventMetricSet{
+			BaseMetricSet: base,
+			unitReader:    reader,
+		}, nil
+	}
 	return &MetricSet{
 		BaseMetricSet: base,
-		conn:          conn,
-		cfg:           config,
-		unitList:      unitFunction,
+		unitReader:    reader,
 	}, nil
 }
 
@@ -87,51 +147,85 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 // format. It publishes the event which is then forwarded to the output. In case
 // of an error set the Error field of mb.Event or simply call report.Error().
 func (m *MetricSet) Fetch(report mb.ReporterV2) error {
-
-	units, err := m.unitList(m.conn, m.cfg.StateFilter, m.cfg.PatternFilter)
+	units, err := m.listUnits()
 	if err != nil {
-		return errors.Wrap(err, "error getting list of running units")
+		return err
 	}
 
 	for _, unit := range units {
-		//Skip what are basically errors dude to systemd's declarative dependency system
-		if unit.LoadState == "not-found" {
+		event, ok := m.unitEvent(unit)
+		if !ok {
 			continue
 		}
 
-		match, err := filepath.Match("*.service", unit.Name)
-		if err != nil {
-			m.Logger().Errorf("Error matching unit service %s: %s", unit.Name, err)
-			continue
-		}
-		// If we don't have a *.service, skip
-		if !match {
-			continue
+		isOpen := report.Event(event)
+		if !isOpen {
+			return nil
 		}
 
-		props, err := getProps(m.conn, unit.Name)
-		if err != nil {
-			m.Logger().Errorf("error getting properties for service: %s", err)
+	}
+	return nil
+}
+
+// Close closes the connection with systemd
+func (m *MetricSet) Close() error {
+	m.conn.Close()
+	return nil
+}
+
+// listUnits returns the units that match the configured filters
+func (r *unitReader) listUnits() ([]dbus.UnitStatus, error) {
+	units, err := r.unitList(r.conn, r.cfg.StateFilter, r.cfg.PatternFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting list of running units")
+	}
+
+	var matched []dbus.UnitStatus
+	for _, unit := range units {
+		//Skip what are basically errors dude to systemd's declarative dependency system
+		if unit.LoadState == "not-found" {
 			continue
 		}
 
-		event, err := formProperties(unit, props)
-		if err != nil {
-			m.Logger().Errorf("Error getting properties for systemd service %s: %s", unit.Name, err)
+		// If we don't have a unit of one of the configured types, skip
+		if !r.matchesType(unit.Name) {
 			continue
 		}
+		matched = append(matched, unit)
+	}
+	return matched, nil
+}
 
-		isOpen := report.Event(event)
-		if !isOpen {
-			return nil
+// matchesType checks if the unit is of one of the configured unit types
+func (r *unitReader) matchesType(name string) bool {
+	unitType := strings.TrimPrefix(filepath.Ext(name), ".")
+	for _, t := range r.cfg.UnitTypes {
+		if t == unitType {
+			return true
 		}
+	}
+	return false
+}
 
+// unitEvent builds the event for a unit, it returns false if its properties
+// cannot be obtained
+func (r *unitReader) unitEvent(unit dbus.UnitStatus) (mb.Event, bool) {
+	props, err := getProps(r.conn, unit.Name)
+	if err != nil {
+		r.logger.Errorf("error getting properties for service: %s", err)
+		return mb.Event{}, false
 	}
-	return nil
+
+	event, err := formProperties(unit, props)
+	if err != nil {
+		r.logger.Errorf("Error getting properties for systemd service %s: %s", unit.Name, err)
+		return mb.Event{}, false
+	}
+	return event, true
 }
 
 // Get Properties for a given unit, cast to a struct
-func getProps(conn *dbus.Conn, unit string) (Properties, error) {
+func getProps(conn systemdConn, unit string) (Properties, error) {
 	rawProps, err := conn.GetAllProperties(unit)
 	if err != nil {
 		return Properties{}, errors.Wrap(err, "error getting list of running units")
@@ -20,13 +20,19 @@
 package service
 
 import (
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/coreos/go-systemd/v22/dbus"
+	godbus "github.com/godbus/dbus/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
 )
 
 var exampleUnits = []dbus.UnitStatus{
@@ -126,3 +132,209 @@ func TestUnitStateNoFilter(t *testing.T) {
 	shouldReturnResults := matchUnitState([]string{}, exampleUnits)
 	assert.Len(t, shouldReturnResults, 3)
 }
+
+func TestFormPropsTimersAndSockets(t *testing.T) {
+	restarts, accepted, connections := uint32(3), uint32(120), uint32(2)
+	event, err := formProperties(dbus.UnitStatus{
+		Name:        "sshd.socket",
+		LoadState:   "loaded",
+		ActiveState: "active",
+		SubState:    "listening",
+	}, Properties{
+		ActiveEnterTimestamp: 1571850129000000,
+		ActiveExitTimestamp:  18446744073709551615,
+		NRestarts:            &restarts,
+		NAccepted:            &accepted,
+		NConnections:         &connections,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), event.MetricSetFields["restarts"])
+	assert.Equal(t, common.MapStr{"active_enter": time.Unix(0, 1571850129000000*1000)}, event.MetricSetFields["timestamps"])
+	assert.Equal(t, common.MapStr{"connections": common.MapStr{"accepted": uint32(120), "active": uint32(2)}}, event.MetricSetFields["socket"])
+
+	event, err = formProperties(dbus.UnitStatus{
+		Name:        "logrotate.timer",
+		LoadState:   "loaded",
+		ActiveState: "active",
+		SubState:    "waiting",
+	}, Properties{
+		Unit:                   "logrotate.service",
+		NextElapseUSecRealtime: 1571936529000000,
+		LastTriggerUSec:        1571850129000000,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, event.MetricSetFields["restarts"])
+	assert.Equal(t, common.MapStr{
+		"unit":         "logrotate.service",
+		"next_elapse":  time.Unix(0, 1571936529000000*1000),
+		"last_trigger": time.Unix(0, 1571850129000000*1000),
+	}, event.MetricSetFields["timer"])
+}
+
+func TestFetchUnitTypes(t *testing.T) {
+	conn := newFakeConn(
+		dbus.UnitStatus{Name: "sshd.service", LoadState: "loaded", ActiveState: "active", SubState: "running"},
+		dbus.UnitStatus{Name: "logrotate.timer", LoadState: "loaded", ActiveState: "active", SubState: "waiting"},
+		dbus.UnitStatus{Name: "sshd.socket", LoadState: "loaded", ActiveState: "active", SubState: "listening"},
+		dbus.UnitStatus{Name: "missing.service", LoadState: "not-found", ActiveState: "inactive", SubState: "dead"},
+	)
+
+	for _, c := range []struct {
+		unitTypes []string
+		expected  []string
+	}{
+		{[]string{"service"}, []string{"sshd.service"}},
+		{[]string{"service", "timer", "socket"}, []string{"sshd.service", "logrotate.timer", "sshd.socket"}},
+	} {
+		m := &MetricSet{unitReader: newFakeReader(conn, Config{UnitTypes: c.unitTypes})}
+		reporter := &mbtest.CapturingReporterV2{}
+		require.NoError(t, m.Fetch(reporter))
+
+		var names []string
+		for _, event := range reporter.GetEvents() {
+			names = append(names, event.MetricSetFields["name"].(string))
+		}
+		assert.ElementsMatch(t, c.expected, names)
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	config := defaultConfig
+	assert.NoError(t, config.Validate())
+
+	config.Mode = "push"
+	assert.Error(t, config.Validate())
+
+	config = defaultConfig
+	config.UnitTypes = []string{"service", "mount"}
+	assert.Error(t, config.Validate())
+}
+
+func TestEventMode(t *testing.T) {
+	conn := newFakeConn(
+		dbus.UnitStatus{Name: "nginx.service", LoadState: "loaded", ActiveState: "active", SubState: "running"},
+		dbus.UnitStatus{Name: "sshd.service", LoadState: "loaded", ActiveState: "active", SubState: "running"},
+	)
+	conn.transitions = []dbus.UnitStatus{
+		// Ignored by the pattern filter
+		{Name: "sshd.service", LoadState: "loaded", ActiveState: "inactive", SubState: "dead"},
+		{Name: "nginx.service", LoadState: "loaded", ActiveState: "deactivating", SubState: "stop-sigterm"},
+		// Same state, no event expected
+		{Name: "nginx.service", LoadState: "loaded", ActiveState: "deactivating", SubState: "stop-sigterm"},
+		{Name: "nginx.service", LoadState: "loaded", ActiveState: "failed", SubState: "failed"},
+	}
+
+	m := &EventMetricSet{unitReader: newFakeReader(conn, Config{UnitTypes: []string{"service"}, PatternFilter: []string{"nginx*"}})}
+	events := mbtest.RunPushMetricSetV2(5*time.Second, 3, m)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, "running", events[0].MetricSetFields["sub_state"])
+	assert.Nil(t, events[0].MetricSetFields["previous_state"])
+
+	assert.Equal(t, "deactivating", events[1].MetricSetFields["state"])
+	assert.Equal(t, "active", events[1].MetricSetFields["previous_state"])
+	assert.Equal(t, "running", events[1].MetricSetFields["previous_sub_state"])
+
+	assert.Equal(t, "failed", events[2].MetricSetFields["state"])
+	assert.Equal(t, "deactivating", events[2].MetricSetFields["previous_state"])
+	assert.Equal(t, "stop-sigterm", events[2].MetricSetFields["previous_sub_state"])
+}
+
+func newFakeReader(conn *fakeConn, config Config) *unitReader {
+	return &unitReader{
+		conn:     conn,
+		cfg:      config,
+		unitList: listUnitsWrapper,
+		logger:   logp.NewLogger("service"),
+	}
+}
+
+// fakeConn is a fake systemd D-Bus connection, once the units are listed, it
+// sends the signals for the configured transitions to the properties subscriber
+type fakeConn struct {
+	mutex       sync.Mutex
+	units       []dbus.UnitStatus
+	transitions []dbus.UnitStatus
+	updates     chan<- *dbus.PropertiesUpdate
+	once        sync.Once
+}
+
+var _ systemdConn = &fakeConn{}
+
+func newFakeConn(units ...dbus.UnitStatus) *fakeConn {
+	return &fakeConn{units: units}
+}
+
+func (c *fakeConn) ListUnits() ([]dbus.UnitStatus, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	units := append([]dbus.UnitStatus{}, c.units...)
+	if c.updates != nil {
+		c.once.Do(func() { go c.sendTransitions() })
+	}
+	return units, nil
+}
+
+func (c *fakeConn) ListUnitsFiltered(states []string) ([]dbus.UnitStatus, error) {
+	units, _ := c.ListUnits()
+	return matchUnitState(states, units), nil
+}
+
+func (c *fakeConn) ListUnitsByPatterns(states []string, patterns []string) ([]dbus.UnitStatus, error) {
+	units, _ := c.ListUnitsFiltered(states)
+	return matchUnitPatterns(patterns, units)
+}
+
+func (c *fakeConn) ListUnitsByNames(names []string) ([]dbus.UnitStatus, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var units []dbus.UnitStatus
+	for _, unit := range c.units {
+		for _, name := range names {
+			if unit.Name == name {
+				units = append(units, unit)
+			}
+		}
+	}
+	return units, nil
+}
+
+func (c *fakeConn) GetAllProperties(unit string) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"ActiveEnterTimestamp": uint64(1571850129000000),
+		"NRestarts":            uint32(0),
+		"FragmentPath":         "/usr/lib/systemd/system/" + unit,
+	}, nil
+}
+
+func (c *fakeConn) Subscribe() error   { return nil }
+func (c *fakeConn) Unsubscribe() error { return nil }
+func (c *fakeConn) Close()             {}
+
+func (c *fakeConn) SetPropertiesSubscriber(updateCh chan<- *dbus.PropertiesUpdate, errCh chan<- error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.updates = updateCh
+}
+
+func (c *fakeConn) sendTransitions() {
+	for _, transition := range c.transitions {
+		c.mutex.Lock()
+		for i := range c.units {
+			if c.units[i].Name == transition.Name {
+				c.units[i] = transition
+			}
+		}
+		c.mutex.Unlock()
+
+		c.updates <- &dbus.PropertiesUpdate{
+			UnitName: transition.Name,
+			Changed: map[string]godbus.Variant{
+				"ActiveState": godbus.MakeVariant(transition.ActiveState),
+				"SubState":    godbus.MakeVariant(transition.SubState),
+			},
+		}
+	}
+}
+
+var _ mb.PushMetricSetV2 = &EventMetricSet{}
@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rate
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	emitRate  = "rate"
+	emitDelta = "delta"
+)
+
+// config for the rate processor.
+type config struct {
+	// Fields are the counters to compute rates for. Path elements can be `*`
+	// to match any key of an object.
+	Fields []string `config:"fields" validate:"required"`
+
+	// Series are the fields that identify the time series of an event. Path
+	// elements can be `*` to match any key of an object.
+	Series []string `config:"series"`
+
+	// Emit selects the values added for each counter, `rate` and/or `delta`.
+	Emit []string `config:"emit"`
+
+	RateSuffix  string `config:"rate_suffix"`
+	DeltaSuffix string `config:"delta_suffix"`
+
+	// TTL is the time a counter is kept after its last event.
+	TTL time.Duration `config:"ttl" validate:"positive"`
+
+	// MaxCounters limits the number of counters kept in the cache, a counter
+	// is kept for each field of each series.
+	MaxCounters int `config:"max_counters" validate:"min=1"`
+}
+
+func defaultConfig() config {
+	return config{
+		// Module labels are namespaced, like `prometheus.labels`, and jolokia
+		// identifies series by the mbean of each namespace.
+		Series:      []string{"event.dataset", "service.address", "labels", "*.labels", "jolokia.*.mbean"},
+		Emit:        []string{emitRate},
+		RateSuffix:  "_rate",
+		DeltaSuffix: "_delta",
+		TTL:         5 * time.Minute,
+		MaxCounters: 10000,
+	}
+}
+
+func (c *config) Validate() error {
+	if len(c.Emit) == 0 {
+		return fmt.Errorf("emit must contain %q or %q", emitRate, emitDelta)
+	}
+	for _, e := range c.Emit {
+		switch e {
+		case emitRate:
+			if c.RateSuffix == "" {
+				return fmt.Errorf("rate_suffix can't be empty")
+			}
+		case emitDelta:
+			if c.DeltaSuffix == "" {
+				return fmt.Errorf("delta_suffix can't be empty")
+			}
+		default:
+			return fmt.Errorf("invalid emit value %q, it must be %q or %q", e, emitRate, emitDelta)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/metricbeat/helper/labelhash"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID = atomic.MakeUint32(0)
+
+const (
+	processorName = "rate"
+	logName       = "processor." + processorName
+)
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+type metrics struct {
+	Counters *monitoring.Int // Number of counters in the cache.
+	Evicted  *monitoring.Int // Counters evicted after being idle for the TTL.
+	Dropped  *monitoring.Int // New counters not tracked because the cache is full.
+	Resets   *monitoring.Int // Counter resets detected.
+}
+
+// counter is a counter found in an event.
+type counter struct {
+	key    string        // Full path of the counter.
+	parent common.MapStr // Object containing the counter.
+	name   string        // Key of the counter in its parent.
+	value  float64
+}
+
+// sample is the last value seen for a counter of a series.
+type sample struct {
+	value     float64
+	timestamp time.Time
+	lastSeen  time.Time
+}
+
+type rate struct {
+	config config
+	fields [][]string
+	series [][]string
+	logger *logp.Logger
+
+	mutex     sync.Mutex
+	samples   map[string]*sample
+	lastSweep time.Time
+	now       func() time.Time
+
+	metrics metrics
+}
+
+// New constructs a new rate processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+processorName+" processor configuration")
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id  = int(instanceID.Inc())
+		log = logp.NewLogger(logName).With("instance_id", id)
+		reg = monitoring.Default.NewRegistry(logName+"."+strconv.Itoa(id), monitoring.DoNotReport)
+	)
+
+	return newRate(config, log, reg), nil
+}
+
+func newRate(config config, log *logp.Logger, reg *monitoring.Registry) *rate {
+	return &rate{
+		config:  config,
+		fields:  splitPaths(config.Fields),
+		series:  splitPaths(config.Series),
+		logger:  log,
+		samples: make(map[string]*sample),
+		now:     time.Now,
+		metrics: metrics{
+			Counters: monitoring.NewInt(reg, "counters"),
+			Evicted:  monitoring.NewInt(reg, "evicted"),
+			Dropped:  monitoring.NewInt(reg, "dropped"),
+			Resets:   monitoring.NewInt(reg, "resets"),
+		},
+	}
+}
+
+func splitPaths(paths []string) [][]string {
+	split := make([][]string, len(paths))
+	for i, path := range paths {
+		split[i] = strings.Split(path, ".")
+	}
+	return split
+}
+
+func (p *rate) String() string {
+	return fmt.Sprintf("%v=[fields=%v, series=%v, emit=%v, ttl=%v, max_counters=%d]",
+		processorName, p.config.Fields, p.config.Series, p.config.Emit, p.config.TTL, p.config.MaxCounters)
+}
+
+// Run adds the rate and/or delta of the configured counters, compared with the
+// previous event of the same series. Nothing is added for the first event of
+// a series.
+func (p *rate) Run(event *beat.Event) (*beat.Event, error) {
+	var counters []counter
+	for _, path := range p.fields {
+		counters = collectCounters(counters, event.Fields, path, "")
+	}
+	if len(counters) == 0 {
+		return event, nil
+	}
+
+	seriesKey := labelhash.LabelHash(p.seriesLabels(event))
+	timestamp := event.Timestamp
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.now()
+	p.evict(now)
+
+	for _, c := range counters {
+		value := c.value
+		cacheKey := seriesKey + "\xff" + c.key
+		prev, found := p.samples[cacheKey]
+		if !found {
+			if len(p.samples) >= p.config.MaxCounters {
+				p.metrics.Dropped.Inc()
+				continue
+			}
+			p.samples[cacheKey] = &sample{value: value, timestamp: timestamp, lastSeen: now}
+			continue
+		}
+
+		delta := value - prev.value
+		if delta < 0 {
+			// The counter was reset, assume it started again from zero.
+			delta = value
+			p.metrics.Resets.Inc()
+		}
+		elapsed := timestamp.Sub(prev.timestamp)
+		prev.value, prev.timestamp, prev.lastSeen = value, timestamp, now
+
+		for _, emit := range p.config.Emit {
+			switch emit {
+			case emitDelta:
+				c.parent[c.name+p.config.DeltaSuffix] = delta
+			case emitRate:
+				if elapsed > 0 {
+					c.parent[c.name+p.config.RateSuffix] = delta / elapsed.Seconds()
+				}
+			}
+		}
+	}
+	p.metrics.Counters.Set(int64(len(p.samples)))
+
+	return event, nil
+}
+
+// evict removes the counters that haven't been seen in the TTL. The cache is
+// swept at most once per TTL.
+func (p *rate) evict(now time.Time) {
+	if now.Sub(p.lastSweep) < p.config.TTL {
+		return
+	}
+	p.lastSweep = now
+	for key, s := range p.samples {
+		if now.Sub(s.lastSeen) >= p.config.TTL {
+			delete(p.samples, key)
+			p.metrics.Evicted.Inc()
+		}
+	}
+	p.metrics.Counters.Set(int64(len(p.samples)))
+}
+
+// seriesLabels returns the values of the fields that identify the series of
+// an event, objects are flattened.
+func (p *rate) seriesLabels(event *beat.Event) common.MapStr {
+	labels := common.MapStr{}
+	for _, path := range p.series {
+		collectLabels(labels, event.Fields, path, "")
+	}
+	return labels
+}
+
+// collectLabels adds the values found in the path, that can contain `*`
+// elements to match any key, to the labels.
+func collectLabels(labels common.MapStr, m common.MapStr, path []string, prefix string) {
+	for _, k := range matchKeys(m, path[0]) {
+		key := joinKey(prefix, k)
+		nested, isObject := toMapStr(m[k])
+		switch {
+		case len(path) > 1:
+			if isObject {
+				collectLabels(labels, nested, path[1:], key)
+			}
+		case isObject:
+			for nk, v := range nested.Flatten() {
+				labels[key+"."+nk] = fmt.Sprint(v)
+			}
+		default:
+			labels[key] = fmt.Sprint(m[k])
+		}
+	}
+}
+
+// collectCounters appends the numeric values found in the path, that can
+// contain `*` elements to match any key.
+func collectCounters(counters []counter, m common.MapStr, path []string, prefix string) []counter {
+	for _, k := range matchKeys(m, path[0]) {
+		key := joinKey(prefix, k)
+		if len(path) > 1 {
+			if nested, ok := toMapStr(m[k]); ok {
+				counters = collectCounters(counters, nested, path[1:], key)
+			}
+			continue
+		}
+		if value, ok := toFloat(m[k]); ok {
+			counters = append(counters, counter{key: key, parent: m, name: k, value: value})
+		}
+	}
+	return counters
+}
+
+// matchKeys returns the keys of the object matching a path element.
+func matchKeys(m common.MapStr, elem string) []string {
+	if elem != "*" {
+		if _, found := m[elem]; !found {
+			return nil
+		}
+		return []string{elem}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func toMapStr(v interface{}) (common.MapStr, bool) {
+	switch m := v.(type) {
+	case common.MapStr:
+		return m, true
+	case map[string]interface{}:
+		return m, true
+	}
+	return nil, false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case common.Float:
+		return float64(n), true
+	}
+	return 0, false
+}
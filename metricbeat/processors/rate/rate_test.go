@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+func newTestRate(t *testing.T, cfg map[string]interface{}) (*rate, *monitoring.Registry) {
+	t.Helper()
+	c := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(cfg).Unpack(&c))
+	reg := monitoring.NewRegistry()
+	return newRate(c, logp.NewLogger(logName), reg), reg
+}
+
+func newTestEvent(timestamp time.Time, host string, fields common.MapStr) *beat.Event {
+	event := &beat.Event{
+		Timestamp: timestamp,
+		Fields: common.MapStr{
+			"event":   common.MapStr{"dataset": "statsd.server"},
+			"service": common.MapStr{"address": host},
+		},
+	}
+	event.Fields.DeepUpdate(fields)
+	return event
+}
+
+func TestRate(t *testing.T) {
+	p, reg := newTestRate(t, map[string]interface{}{
+		"fields": []string{"statsd.*.count", "requests"},
+		"emit":   []string{"rate", "delta"},
+	})
+	start := time.Date(2021, 11, 12, 8, 0, 0, 0, time.UTC)
+
+	run := func(offset time.Duration, host string, fields common.MapStr) common.MapStr {
+		event, err := p.Run(newTestEvent(start.Add(offset), host, fields))
+		require.NoError(t, err)
+		return event.Fields
+	}
+
+	fields := run(0, "a", common.MapStr{
+		"statsd":   common.MapStr{"m1": common.MapStr{"count": 10}, "m2": common.MapStr{"count": uint64(5)}},
+		"requests": 100,
+	})
+	assert.Equal(t, common.MapStr{"count": 10}, fields["statsd"].(common.MapStr)["m1"])
+	assert.NotContains(t, fields, "requests_rate")
+
+	fields = run(10*time.Second, "a", common.MapStr{
+		"statsd":   common.MapStr{"m1": common.MapStr{"count": 30}, "m2": common.MapStr{"count": uint64(5)}},
+		"requests": 50,
+	})
+	assert.Equal(t, common.MapStr{"count": 30, "count_rate": float64(2), "count_delta": float64(20)}, fields["statsd"].(common.MapStr)["m1"])
+	assert.Equal(t, common.MapStr{"count": uint64(5), "count_rate": float64(0), "count_delta": float64(0)}, fields["statsd"].(common.MapStr)["m2"])
+
+	// Counter reset, it started again from zero.
+	assert.Equal(t, float64(50), fields["requests_delta"])
+	assert.Equal(t, float64(5), fields["requests_rate"])
+	assert.Equal(t, int64(1), reg.Get("resets").(*monitoring.Int).Get())
+
+	// Other hosts are different series.
+	fields = run(20*time.Second, "b", common.MapStr{"requests": 200})
+	assert.NotContains(t, fields, "requests_rate")
+	assert.Equal(t, int64(4), reg.Get("counters").(*monitoring.Int).Get())
+}
+
+func TestRateSeriesLabels(t *testing.T) {
+	p, _ := newTestRate(t, map[string]interface{}{
+		"fields": []string{"value"},
+		"emit":   []string{"delta"},
+	})
+	start := time.Now()
+
+	p.Run(newTestEvent(start, "a", common.MapStr{"value": 1, "labels": common.MapStr{"path": "/a"}}))
+	p.Run(newTestEvent(start, "a", common.MapStr{"value": 5, "labels": common.MapStr{"path": "/b"}}))
+	event, err := p.Run(newTestEvent(start.Add(time.Second), "a", common.MapStr{"value": 3, "labels": common.MapStr{"path": "/a"}}))
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), event.Fields["value_delta"])
+	assert.NotContains(t, event.Fields, "value_rate")
+}
+
+func TestRateModuleSeriesLabels(t *testing.T) {
+	for name, labels := range map[string]common.MapStr{
+		"prometheus": {"prometheus": common.MapStr{"labels": common.MapStr{"path": "/a"}}},
+		"jolokia":    {"jolokia": common.MapStr{"jvm": common.MapStr{"mbean": "java.lang:type=Memory"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p, _ := newTestRate(t, map[string]interface{}{
+				"fields": []string{"value"},
+				"emit":   []string{"delta"},
+			})
+			start := time.Now()
+
+			p.Run(newTestEvent(start, "a", common.MapStr{"value": 1}))
+			labeled := labels.Clone()
+			labeled["value"] = 5
+			p.Run(newTestEvent(start, "a", labeled))
+			event, err := p.Run(newTestEvent(start.Add(time.Second), "a", common.MapStr{"value": 3}))
+			require.NoError(t, err)
+			assert.Equal(t, float64(2), event.Fields["value_delta"])
+		})
+	}
+}
+
+func TestRateEviction(t *testing.T) {
+	p, reg := newTestRate(t, map[string]interface{}{
+		"fields":     []string{"value"},
+		"ttl":        "1m",
+		"max_counters": 2,
+	})
+	now := time.Date(2021, 11, 12, 8, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+
+	for _, host := range []string{"a", "b", "c"} {
+		p.Run(newTestEvent(now, host, common.MapStr{"value": 1}))
+	}
+	assert.Equal(t, int64(2), reg.Get("counters").(*monitoring.Int).Get())
+	assert.Equal(t, int64(1), reg.Get("dropped").(*monitoring.Int).Get())
+
+	now = now.Add(30 * time.Second)
+	event, _ := p.Run(newTestEvent(now, "a", common.MapStr{"value": 4}))
+	assert.Equal(t, float64(0.1), event.Fields["value_rate"])
+
+	// b is idle for the TTL and evicted, a is kept.
+	now = now.Add(40 * time.Second)
+	event, _ = p.Run(newTestEvent(now, "c", common.MapStr{"value": 1}))
+	assert.NotContains(t, event.Fields, "value_rate")
+	assert.Equal(t, int64(1), reg.Get("evicted").(*monitoring.Int).Get())
+	assert.Equal(t, int64(2), reg.Get("counters").(*monitoring.Int).Get())
+}
+
+func TestConfigValidate(t *testing.T) {
+	for name, cfg := range map[string]map[string]interface{}{
+		"no fields":    {},
+		"invalid emit": {"fields": []string{"a"}, "emit": []string{"average"}},
+		"empty suffix": {"fields": []string{"a"}, "rate_suffix": ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(common.MustNewConfigFrom(cfg))
+			assert.Error(t, err)
+		})
+	}
+}
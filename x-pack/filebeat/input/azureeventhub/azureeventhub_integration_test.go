@@ -10,17 +10,17 @@ package azureeventhub
 import (
 	"context"
 	"os"
-	"sync"
 	"testing"
 	"time"
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
 	"github.com/stretchr/testify/assert"
 
-	"github.com/elastic/beats/v7/filebeat/channel"
-	"github.com/elastic/beats/v7/filebeat/input"
+	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	pubtest "github.com/elastic/beats/v7/libbeat/publisher/testing"
 )
 
 var (
@@ -41,31 +41,29 @@ func TestInput(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	context := input.Context{
-		Done:     make(chan struct{}),
-		BeatDone: make(chan struct{}),
-	}
-
-	o := &stubOutleter{}
-	o.cond = sync.NewCond(o)
-	defer o.Close()
 
-	connector := channel.ConnectorFunc(func(_ *common.Config, _ beat.ClientConfig) (channel.Outleter, error) {
-		return o, nil
-	})
-	input, err := NewInput(azureConfig, connector, context)
+	input, err := Plugin(nil).Manager.Create(azureConfig)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	// Run the input and wait for finalization
-	input.Run()
-
-	timeout := time.After(30 * time.Second)
 	// Route input events through our capturer instead of sending through ES.
 	events := make(chan beat.Event, 100)
-	defer close(events)
+	ctx, cancel := context.WithCancel(context.Background())
+	inputCtx := v2.Context{
+		Logger:      logp.NewLogger(inputName),
+		ID:          "test",
+		Cancelation: ctx,
+	}
 
+	// Run the input until it's cancelled.
+	didClose := make(chan struct{})
+	go func() {
+		defer close(didClose)
+		input.Run(inputCtx, pubtest.ConstClient(pubtest.ChClient(events)))
+	}()
+
+	timeout := time.After(30 * time.Second)
 	select {
 	case event := <-events:
 		text, err := event.Fields.GetValue("message")
@@ -78,18 +76,11 @@ func TestInput(t *testing.T) {
 		t.Fatal("timeout waiting for incoming events")
 	}
 
-	// Close the done channel and make sure the beat shuts down in a reasonable
-	// amount of time.
-	close(context.Done)
-	didClose := make(chan struct{})
-	go func() {
-		input.Wait()
-		close(didClose)
-	}()
-
+	// Cancel the input and make sure it stops in a reasonable amount of time.
+	cancel()
 	select {
 	case <-time.After(30 * time.Second):
-		t.Fatal("timeout waiting for beat to shut down")
+		t.Fatal("timeout waiting for input to shut down")
 	case <-didClose:
 	}
 }
@@ -7,6 +7,7 @@ package azureeventhub
 import (
 	"errors"
 	"fmt"
+	"time"
 	"unicode"
 )
 
@@ -20,9 +21,26 @@ type azureInputConfig struct {
 	SAContainer string `config:"storage_account_container"`
 	// by default the azure public environment is used, to override, users can provide a specific resource manager endpoint
 	OverrideEnvironment string `config:"resource_manager_endpoint"`
+	// Where partition offsets are checkpointed, either the Azure Storage container or the local registry
+	CheckpointStore string `config:"checkpoint_store"`
+	// File used to coordinate partition ownership between instances when checkpoints are stored locally
+	LeaseFile     string        `config:"lease_file"`
+	LeaseDuration time.Duration `config:"lease_duration"`
 }
 
-const ephContainerName = "filebeat"
+const (
+	ephContainerName = "filebeat"
+
+	checkpointStoreStorageAccount = "storage_account"
+	checkpointStoreLocal          = "local"
+)
+
+func defaultConfig() azureInputConfig {
+	return azureInputConfig{
+		CheckpointStore: checkpointStoreStorageAccount,
+		LeaseDuration:   30 * time.Second,
+	}
+}
 
 // Validate validates the config.
 func (conf *azureInputConfig) Validate() error {
@@ -32,6 +50,17 @@ func (conf *azureInputConfig) Validate() error {
 	if conf.EventHubName == "" {
 		return errors.New("no event hub name configured")
 	}
+	switch conf.CheckpointStore {
+	case checkpointStoreStorageAccount:
+	case checkpointStoreLocal:
+		if conf.LeaseDuration <= 0 {
+			return errors.New("lease_duration must be greater than zero")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown checkpoint_store (%s), valid values are %s and %s",
+			conf.CheckpointStore, checkpointStoreStorageAccount, checkpointStoreLocal)
+	}
 	if conf.SAName == "" || conf.SAKey == "" {
 		return errors.New("no storage account or storage account key configured")
 	}
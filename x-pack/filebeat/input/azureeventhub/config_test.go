@@ -6,6 +6,8 @@ package azureeventhub
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestStorageContainerValidate(t *testing.T) {
@@ -27,3 +29,19 @@ func TestStorageContainerValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateCheckpointStore(t *testing.T) {
+	conf := defaultConfig()
+	conf.ConnectionString = "Endpoint=sb://something"
+	conf.EventHubName = "insights-operational-logs"
+	assert.Error(t, conf.Validate(), "storage account is required by default")
+
+	conf.CheckpointStore = checkpointStoreLocal
+	assert.NoError(t, conf.Validate())
+
+	conf.LeaseDuration = 0
+	assert.Error(t, conf.Validate())
+
+	conf.CheckpointStore = "unknown"
+	assert.Error(t, conf.Validate())
+}
@@ -69,7 +69,7 @@ func (a *azureInput) runWithEPH() error {
 			if !ok {
 				onEventErr = errors.New("OnEvent function returned false. Stopping input worker")
 				a.log.Debug(onEventErr.Error())
-				a.Stop()
+				a.workerCancel()
 			}
 			return onEventErr
 		})
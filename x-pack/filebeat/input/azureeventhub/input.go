@@ -9,16 +9,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
-	"github.com/elastic/beats/v7/filebeat/channel"
-	"github.com/elastic/beats/v7/filebeat/input"
+	"github.com/elastic/beats/v7/filebeat/beater"
+	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/feature"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/go-concert/ctxtool"
+	"github.com/elastic/go-concert/unison"
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
 	"github.com/Azure/azure-event-hubs-go/v3/eph"
@@ -31,171 +34,183 @@ const (
 
 // azureInput struct for the azure-eventhub input
 type azureInput struct {
-	config       azureInputConfig // azure-eventhub configuration
-	context      input.Context
-	outlet       channel.Outleter
+	config       azureInputConfig        // azure-eventhub configuration
+	store        beater.StateStore       // registry where checkpoints are stored locally
+	client       beat.Client             // pipeline client the events are published to
 	log          *logp.Logger            // logging info and error messages
-	workerCtx    context.Context         // worker goroutine context. It's cancelled when the input stops or the worker exits.
+	workerCtx    context.Context         // worker context. It's cancelled when the input stops or the worker exits.
 	workerCancel context.CancelFunc      // used to signal that the worker should stop.
-	workerOnce   sync.Once               // guarantees that the worker goroutine is only started once.
-	workerWg     sync.WaitGroup          // waits on worker goroutine.
 	processor    *eph.EventProcessorHost // eph will be assigned if users have enabled the option
-	hub          *eventhub.Hub           // hub will be assigned
-	ackChannel   chan int
+	local        *localProcessor         // local will be assigned when checkpoints are stored locally
 }
 
 const (
 	inputName = "azure-eventhub"
 )
 
-func init() {
-	err := input.Register(inputName, NewInput)
-	if err != nil {
-		panic(errors.Wrapf(err, "failed to register %v input", inputName))
+// Plugin creates the azure-eventhub input plugin. Checkpoints are stored in
+// the given store when they are not kept in an Azure Storage container.
+func Plugin(store beater.StateStore) v2.Plugin {
+	return v2.Plugin{
+		Name:       inputName,
+		Stability:  feature.Stable,
+		Deprecated: false,
+		Info:       "Collect logs from Azure Event Hub",
+		Manager:    &azureInputManager{store: store},
 	}
 }
 
-// NewInput creates a new azure-eventhub input
-func NewInput(
-	cfg *common.Config,
-	connector channel.Connector,
-	inputContext input.Context,
-) (input.Input, error) {
-	var config azureInputConfig
+type azureInputManager struct {
+	store beater.StateStore
+}
+
+func (m *azureInputManager) Init(unison.Group, v2.Mode) error {
+	return nil
+}
+
+func (m *azureInputManager) Create(cfg *common.Config) (v2.Input, error) {
+	config := defaultConfig()
 	if err := cfg.Unpack(&config); err != nil {
 		return nil, errors.Wrapf(err, "reading %s input config", inputName)
 	}
+	return &azureInput{config: config, store: m.store}, nil
+}
 
-	inputCtx, cancelInputCtx := context.WithCancel(context.Background())
-	go func() {
-		defer cancelInputCtx()
-		select {
-		case <-inputContext.Done:
-		case <-inputCtx.Done():
-		}
-	}()
+func (a *azureInput) Name() string { return inputName }
 
-	// If the input ever needs to be made restartable, then context would need
-	// to be recreated with each restart.
-	workerCtx, workerCancel := context.WithCancel(inputCtx)
+func (a *azureInput) Test(v2.TestContext) error {
+	return nil
+}
 
-	in := &azureInput{
-		config:       config,
-		log:          logp.NewLogger(fmt.Sprintf("%s input", inputName)).With("connection string", stripConnectionString(config.ConnectionString)),
-		context:      inputContext,
-		workerCtx:    workerCtx,
-		workerCancel: workerCancel,
+// Run consumes the events of the event hub until the input is stopped.
+func (a *azureInput) Run(ctx v2.Context, pipeline beat.PipelineConnector) error {
+	a.log = ctx.Logger.With("connection string", stripConnectionString(a.config.ConnectionString))
+	a.workerCtx, a.workerCancel = context.WithCancel(ctxtool.FromCanceller(ctx.Cancelation))
+	defer a.workerCancel()
+
+	a.log.Infof("%s input worker has started.", inputName)
+	defer a.log.Infof("%s input worker has stopped.", inputName)
+	if a.config.CheckpointStore == checkpointStoreLocal {
+		return a.runWithLocalCheckpoints(pipeline)
 	}
-	out, err := connector.Connect(cfg)
+
+	client, err := pipeline.Connect()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	in.outlet = out
-	in.log.Infof("Initialized %s input.", inputName)
-	return in, nil
+	defer client.Close()
+	a.client = client
+	if err := a.runWithEPH(); err != nil {
+		return err
+	}
+	<-a.workerCtx.Done()
+	if err := a.processor.Close(context.Background()); err != nil {
+		a.log.Errorw("error while closing eventhostprocessor", "error", err)
+	}
+	return nil
 }
 
-// Run starts the input worker then returns. Only the first invocation
-// will ever start the worker.
-func (a *azureInput) Run() {
-	a.workerOnce.Do(func() {
-		a.workerWg.Add(1)
-		go func() {
-			a.log.Infof("%s input worker has started.", inputName)
-			defer a.log.Infof("%s input worker has stopped.", inputName)
-			defer a.workerWg.Done()
-			defer a.workerCancel()
-			err := a.runWithEPH()
-			if err != nil {
-				a.log.Error(err)
-				return
-			}
-		}()
-	})
-}
+// runWithLocalCheckpoints will consume ingested events from each partition without the Event Processor Host,
+// partition offsets are checkpointed in the filebeat registry instead of an Azure Storage container
+func (a *azureInput) runWithLocalCheckpoints(pipeline beat.PipelineConnector) error {
+	namespace, err := connectionStringNamespace(a.config.ConnectionString)
+	if err != nil {
+		return err
+	}
+	// the hub is closed once all the receivers have been closed and the leases released
+	hub, err := eventhub.NewHubFromConnectionString(fmt.Sprintf("%s%s%s", a.config.ConnectionString, eventHubConnector, a.config.EventHubName))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := hub.Close(context.Background()); err != nil {
+			a.log.Errorw("error while closing eventhub", "error", err)
+		}
+	}()
 
-// run will run the input with the non-eph version, this option will be available once a more reliable storage is in place, it is curently using an in-memory storage
-//func (a *azureInput) run() error {
-//	var err error
-//	a.hub, err = eventhub.NewHubFromConnectionString(fmt.Sprintf("%s%s%s", a.config.ConnectionString, eventHubConnector, a.config.EventHubName))
-//	if err != nil {
-//		return err
-//	}
-//	// listen to each partition of the Event Hub
-//	runtimeInfo, err := a.hub.GetRuntimeInformation(a.workerCtx)
-//	if err != nil {
-//		return err
-//	}
-//
-//	for _, partitionID := range runtimeInfo.PartitionIDs {
-//		// Start receiving messages
-//		handler := func(c context.Context, event *eventhub.Event) error {
-//			a.log.Info(string(event.Data))
-//			return a.processEvents(event, partitionID)
-//		}
-//		var err error
-//		// sending a nill ReceiveOption will throw an exception
-//		if a.config.ConsumerGroup != "" {
-//			_, err = a.hub.Receive(a.workerCtx, partitionID, handler, eventhub.ReceiveWithConsumerGroup(a.config.ConsumerGroup))
-//		} else {
-//			_, err = a.hub.Receive(a.workerCtx, partitionID, handler)
-//		}
-//		if err != nil {
-//			return err
-//		}
-//	}
-//	return nil
-//}
-
-// Stop stops TCP server
-func (a *azureInput) Stop() {
-	if a.hub != nil {
-		err := a.hub.Close(a.workerCtx)
+	var leases *leaseFile
+	if a.config.LeaseFile != "" {
+		leases, err = newLeaseFile(a.config.LeaseFile, a.config.LeaseDuration)
 		if err != nil {
-			a.log.Errorw(fmt.Sprintf("error while closing eventhub"), "error", err)
+			return err
 		}
 	}
-	if a.processor != nil {
-		err := a.processor.Close(a.workerCtx)
-		if err != nil {
-			a.log.Errorw(fmt.Sprintf("error while closing eventhostprocessor"), "error", err)
-		}
+
+	store, err := a.store.Access()
+	if err != nil {
+		return errors.Wrap(err, "failed to access the registry")
 	}
-	a.workerCancel()
-	a.workerWg.Wait()
-}
+	defer store.Close()
 
-// Wait stop the current server
-func (a *azureInput) Wait() {
-	a.Stop()
+	client := &eventHubClient{hub: hub, consumerGroup: a.config.ConsumerGroup}
+	checkpoints := newCheckpointStore(store, namespace, a.config.EventHubName, a.config.ConsumerGroup)
+	// the processor is created before connecting to the pipeline, as it's used by the ACK handler
+	a.local = newLocalProcessor(a.log, client, checkpoints, leases, func(e *eventhub.Event, partitionID string) bool {
+		ok := a.processEvents(e, partitionID)
+		if !ok {
+			a.log.Debug("OnEvent function returned false. Stopping input worker")
+			a.workerCancel()
+		}
+		return ok
+	})
+
+	// checkpoints are stored once the events have been acknowledged
+	a.client, err = pipeline.ConnectWith(beat.ClientConfig{
+		ACKHandler: acker.ConnectionOnly(
+			acker.EventPrivateReporter(func(_ int, privates []interface{}) {
+				a.local.ack(privates)
+			}),
+		),
+	})
+	if err != nil {
+		return err
+	}
+	defer a.client.Close()
+	return a.local.run(a.workerCtx)
 }
 
 func (a *azureInput) processEvents(event *eventhub.Event, partitionID string) bool {
 	timestamp := time.Now()
 	azure := common.MapStr{
-		// partitionID is only mapped in the non-eph option which is not available yet, this field will be temporary unavailable
-		//"partition_id":   partitionID,
+		// partitionID is only mapped when checkpoints are stored locally, the eph option doesn't provide it
 		"eventhub":       a.config.EventHubName,
 		"consumer_group": a.config.ConsumerGroup,
 	}
+	if a.local != nil {
+		azure.Put("partition_id", partitionID)
+	}
 	messages := a.parseMultipleMessages(event.Data)
-	for _, msg := range messages {
+	if len(messages) == 0 {
+		// nothing is published, but the partition still needs to advance past this message
+		if a.local != nil {
+			if cp := newAckedCheckpoint(event, partitionID); cp != nil {
+				a.local.skip(cp)
+			}
+		}
+		return true
+	}
+	for idx, msg := range messages {
 		azure.Put("offset", event.SystemProperties.Offset)
 		azure.Put("sequence_number", event.SystemProperties.SequenceNumber)
 		azure.Put("enqueued_time", event.SystemProperties.EnqueuedTime)
-		ok := a.outlet.OnEvent(beat.Event{
+		var private interface{} = event.Data
+		if a.local != nil && idx == len(messages)-1 {
+			// the partition is checkpointed once all the messages are acknowledged
+			if cp := newAckedCheckpoint(event, partitionID); cp != nil {
+				a.local.track(cp)
+				private = cp
+			}
+		}
+		a.client.Publish(beat.Event{
 			Timestamp: timestamp,
 			Fields: common.MapStr{
 				"message": msg,
 				"azure":   azure,
 			},
-			Private: event.Data,
+			Private: private,
 		})
-		if !ok {
-			return ok
-		}
 	}
-	return true
+	return a.workerCtx.Err() == nil
 }
 
 // parseMultipleMessages will try to split the message into multiple ones based on the group field provided by the configuration
@@ -5,6 +5,7 @@
 package azureeventhub
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
@@ -14,9 +15,8 @@ import (
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
-	"github.com/elastic/beats/v7/filebeat/channel"
-	"github.com/elastic/beats/v7/filebeat/input/inputtest"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 )
@@ -32,15 +32,12 @@ var (
 )
 
 func TestProcessEvents(t *testing.T) {
-	// Stub outlet for receiving events generated by the input.
-	o := &stubOutleter{}
-	out, err := newStubOutlet(o)
-	if err != nil {
-		t.Fatal(err)
-	}
+	// Stub client for receiving events generated by the input.
+	o := &stubClient{}
 	input := azureInput{
-		config: config,
-		outlet: out,
+		config:    config,
+		client:    o,
+		workerCtx: context.Background(),
 	}
 	var sn int64 = 12
 	now := time.Now()
@@ -110,14 +107,21 @@ func TestParseMultipleMessages(t *testing.T) {
 	}
 }
 
-func TestNewInputDone(t *testing.T) {
-	config := common.MapStr{
+func TestCreate(t *testing.T) {
+	manager := Plugin(nil).Manager
+	inp, err := manager.Create(common.MustNewConfigFrom(common.MapStr{
 		"connection_string":   "Endpoint=sb://something",
 		"eventhub":            "insights-operational-logs",
 		"storage_account":     "someaccount",
 		"storage_account_key": "secret",
-	}
-	inputtest.AssertNotStartedInputCanBeDone(t, NewInput, &config)
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, inputName, inp.Name())
+
+	_, err = manager.Create(common.MustNewConfigFrom(common.MapStr{
+		"connection_string": "Endpoint=sb://something",
+	}))
+	assert.Error(t, err)
 }
 
 func TestStripConnectionString(t *testing.T) {
@@ -144,35 +148,22 @@ func TestStripConnectionString(t *testing.T) {
 	}
 }
 
-type stubOutleter struct {
+// stubClient is a beat.Client that keeps the published events.
+type stubClient struct {
 	sync.Mutex
-	cond   *sync.Cond
-	done   bool
 	Events []beat.Event
 }
 
-func newStubOutlet(stub *stubOutleter) (channel.Outleter, error) {
-	stub.cond = sync.NewCond(stub)
-	defer stub.Close()
-
-	connector := channel.ConnectorFunc(func(_ *common.Config, _ beat.ClientConfig) (channel.Outleter, error) {
-		return stub, nil
-	})
-	return connector.ConnectWith(nil, beat.ClientConfig{
-		Processing: beat.ProcessingConfig{},
-	})
+func (c *stubClient) Publish(event beat.Event) {
+	c.Lock()
+	defer c.Unlock()
+	c.Events = append(c.Events, event)
 }
-func (o *stubOutleter) Close() error {
-	o.Lock()
-	defer o.Unlock()
-	o.done = true
-	return nil
-}
-func (o *stubOutleter) Done() <-chan struct{} { return nil }
-func (o *stubOutleter) OnEvent(event beat.Event) bool {
-	o.Lock()
-	defer o.Unlock()
-	o.Events = append(o.Events, event)
-	o.cond.Broadcast()
-	return o.done
+
+func (c *stubClient) PublishAll(events []beat.Event) {
+	for _, event := range events {
+		c.Publish(event)
+	}
 }
+
+func (c *stubClient) Close() error { return nil }
@@ -0,0 +1,201 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azureeventhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/gofrs/uuid"
+)
+
+// leaseFile coordinates the ownership of the partitions of an event hub
+// between the instances that share the file. Each partition is owned by a
+// single instance, and partitions are evenly distributed between the
+// instances that are alive. The last checkpoint of each partition is kept
+// in the lease, so that a partition can be handed over to another instance.
+type leaseFile struct {
+	path     string
+	lock     *flock.Flock
+	owner    string
+	duration time.Duration
+	now      func() time.Time
+}
+
+// leaseState is the content of a lease file.
+type leaseState struct {
+	// Owners contains the expiration time of the instances that are alive.
+	Owners     map[string]time.Time      `json:"owners"`
+	Partitions map[string]partitionLease `json:"partitions"`
+}
+
+type partitionLease struct {
+	Owner      string               `json:"owner,omitempty"`
+	Expires    time.Time            `json:"expires"`
+	Checkpoint *partitionCheckpoint `json:"checkpoint,omitempty"`
+}
+
+func newLeaseFile(path string, duration time.Duration) (*leaseFile, error) {
+	owner, err := uuid.NewV4()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate lease owner id: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create directory for lease file: %w", err)
+	}
+	return &leaseFile{
+		path:     path,
+		lock:     flock.New(path + ".lock"),
+		owner:    owner.String(),
+		duration: duration,
+		now:      time.Now,
+	}, nil
+}
+
+// balance renews the leases of this instance, and acquires or releases
+// leases so that every instance owns about the same number of partitions.
+// The given checkpoints are stored in the leases owned by this instance.
+// It returns the owned partitions with the checkpoint found in their lease.
+func (l *leaseFile) balance(partitionIDs []string, checkpoints map[string]partitionCheckpoint) (map[string]*partitionCheckpoint, error) {
+	owned := make(map[string]*partitionCheckpoint)
+	err := l.update(func(state *leaseState, now time.Time) {
+		state.Owners[l.owner] = now.Add(l.duration)
+
+		ids := append([]string(nil), partitionIDs...)
+		sort.Strings(ids)
+		target := (len(ids) + len(state.Owners) - 1) / len(state.Owners)
+
+		acquire := func(id string, lease partitionLease) {
+			lease.Owner, lease.Expires = l.owner, now.Add(l.duration)
+			state.Partitions[id] = lease
+			owned[id] = lease.Checkpoint
+		}
+
+		// Keep the partitions already owned, up to the target.
+		for _, id := range ids {
+			lease, ok := state.Partitions[id]
+			if !ok || lease.Owner != l.owner {
+				continue
+			}
+			if cp, ok := checkpoints[id]; ok {
+				lease.Checkpoint = &cp
+			}
+			if len(owned) < target {
+				acquire(id, lease)
+			} else {
+				lease.Owner = ""
+				state.Partitions[id] = lease
+			}
+		}
+		// Acquire free partitions.
+		for _, id := range ids {
+			if len(owned) >= target {
+				break
+			}
+			if lease := state.Partitions[id]; lease.Owner == "" {
+				acquire(id, lease)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return owned, nil
+}
+
+// release gives up the leases of this instance, storing their last
+// checkpoint so that other instances can resume from it.
+func (l *leaseFile) release(checkpoints map[string]partitionCheckpoint) error {
+	return l.update(func(state *leaseState, _ time.Time) {
+		delete(state.Owners, l.owner)
+		for id, lease := range state.Partitions {
+			if lease.Owner != l.owner {
+				continue
+			}
+			if cp, ok := checkpoints[id]; ok {
+				lease.Checkpoint = &cp
+			}
+			lease.Owner = ""
+			state.Partitions[id] = lease
+		}
+	})
+}
+
+// update modifies the lease file while holding its lock. Expired owners
+// and leases are removed before calling fn.
+func (l *leaseFile) update(fn func(state *leaseState, now time.Time)) error {
+	if err := l.lock.Lock(); err != nil {
+		return fmt.Errorf("failed to lock lease file: %w", err)
+	}
+	defer l.lock.Unlock()
+
+	state, err := l.read()
+	if err != nil {
+		return err
+	}
+	now := l.now()
+	for owner, expires := range state.Owners {
+		if expires.Before(now) {
+			delete(state.Owners, owner)
+		}
+	}
+	for id, lease := range state.Partitions {
+		if _, alive := state.Owners[lease.Owner]; !alive || lease.Expires.Before(now) {
+			lease.Owner = ""
+			state.Partitions[id] = lease
+		}
+	}
+	fn(state, now)
+	return l.write(state)
+}
+
+func (l *leaseFile) read() (*leaseState, error) {
+	state := &leaseState{
+		Owners:     make(map[string]time.Time),
+		Partitions: make(map[string]partitionLease),
+	}
+	data, err := ioutil.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lease file: %w", err)
+	}
+	if len(data) == 0 {
+		return state, nil
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to decode lease file %s: %w", l.path, err)
+	}
+	if state.Owners == nil {
+		state.Owners = make(map[string]time.Time)
+	}
+	if state.Partitions == nil {
+		state.Partitions = make(map[string]partitionLease)
+	}
+	return state, nil
+}
+
+// write replaces the lease file, so that it's never left half-written.
+func (l *leaseFile) write(state *leaseState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := l.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write lease file: %w", err)
+	}
+	if err := os.Rename(tmp, l.path); err != nil {
+		return fmt.Errorf("failed to write lease file: %w", err)
+	}
+	return nil
+}
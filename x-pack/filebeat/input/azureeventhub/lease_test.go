@@ -0,0 +1,64 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azureeventhub
+
+import (
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaseFileBalance(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "leases.json")
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	newLeases := func() *leaseFile {
+		l, err := newLeaseFile(path, time.Minute)
+		require.NoError(t, err)
+		l.now = clock
+		return l
+	}
+	balance := func(l *leaseFile, checkpoints map[string]partitionCheckpoint) map[string]*partitionCheckpoint {
+		owned, err := l.balance([]string{"0", "1", "2", "3"}, checkpoints)
+		require.NoError(t, err)
+		return owned
+	}
+	ids := func(owned map[string]*partitionCheckpoint) []string {
+		var ids []string
+		for id := range owned {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	first, second := newLeases(), newLeases()
+	assert.Equal(t, []string{"0", "1", "2", "3"}, ids(balance(first, nil)))
+
+	// Leases are not stolen, the first instance releases them on renewal.
+	assert.Empty(t, balance(second, nil))
+	checkpoint := partitionCheckpoint{Offset: "2048", SequenceNumber: 10}
+	assert.Equal(t, []string{"0", "1"}, ids(balance(first, map[string]partitionCheckpoint{"2": checkpoint})))
+
+	// The checkpoint of a released partition is handed over.
+	owned := balance(second, nil)
+	assert.Equal(t, []string{"2", "3"}, ids(owned))
+	require.NotNil(t, owned["2"])
+	assert.Equal(t, checkpoint, *owned["2"])
+	assert.Nil(t, owned["3"])
+
+	// Leases of an instance that doesn't renew them expire.
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, []string{"0", "1", "2", "3"}, ids(balance(second, nil)))
+
+	// Released leases are acquired immediately.
+	require.NoError(t, second.release(nil))
+	assert.Equal(t, []string{"0", "1", "2", "3"}, ids(balance(first, nil)))
+}
@@ -0,0 +1,422 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azureeventhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+)
+
+// partitionCheckpoint is the position of the last acknowledged event
+// of a partition.
+type partitionCheckpoint struct {
+	Offset         string    `struct:"offset" json:"offset"`
+	SequenceNumber int64     `struct:"sequence_number" json:"sequence_number"`
+	EnqueuedTime   time.Time `struct:"enqueued_time" json:"enqueued_time"`
+}
+
+// ackedCheckpoint is attached to the last event generated from a message,
+// so that the checkpoint is updated once all the events are acknowledged.
+type ackedCheckpoint struct {
+	partitionID string
+	checkpoint  partitionCheckpoint
+	generation  uint64 // ownership of the partition the event was published in.
+}
+
+func newAckedCheckpoint(event *eventhub.Event, partitionID string) *ackedCheckpoint {
+	props := event.SystemProperties
+	if props == nil || props.Offset == nil {
+		return nil
+	}
+	cp := &ackedCheckpoint{
+		partitionID: partitionID,
+		checkpoint: partitionCheckpoint{
+			Offset: strconv.FormatInt(*props.Offset, 10),
+		},
+	}
+	if props.SequenceNumber != nil {
+		cp.checkpoint.SequenceNumber = *props.SequenceNumber
+	}
+	if props.EnqueuedTime != nil {
+		cp.checkpoint.EnqueuedTime = *props.EnqueuedTime
+	}
+	return cp
+}
+
+// checkpointStore persists the checkpoints of the partitions of an event hub.
+type checkpointStore struct {
+	store  *statestore.Store
+	prefix string
+}
+
+func newCheckpointStore(store *statestore.Store, namespace, eventHub, consumerGroup string) *checkpointStore {
+	if consumerGroup == "" {
+		consumerGroup = eventhub.DefaultConsumerGroup
+	}
+	return &checkpointStore{
+		store:  store,
+		prefix: strings.Join([]string{inputName, namespace, eventHub, consumerGroup}, "::"),
+	}
+}
+
+func (s *checkpointStore) key(partitionID string) string {
+	return s.prefix + "::" + partitionID
+}
+
+// read returns the checkpoint of a partition. The returned bool is false
+// when there is no checkpoint for the partition.
+func (s *checkpointStore) read(partitionID string) (partitionCheckpoint, bool, error) {
+	var cp partitionCheckpoint
+	key := s.key(partitionID)
+	found, err := s.store.Has(key)
+	if err != nil || !found {
+		return cp, false, err
+	}
+	if err := s.store.Get(key, &cp); err != nil {
+		return cp, false, err
+	}
+	return cp, true, nil
+}
+
+func (s *checkpointStore) write(partitionID string, cp partitionCheckpoint) error {
+	return s.store.Set(s.key(partitionID), cp)
+}
+
+// hubClient is the subset of the Event Hub client used to consume
+// partitions without the Event Processor Host.
+type hubClient interface {
+	PartitionIDs(ctx context.Context) ([]string, error)
+	// Receive starts consuming a partition after the given offset, or from
+	// the start of the stream when the offset is empty.
+	Receive(ctx context.Context, partitionID, offset string, handler eventhub.Handler) (partitionListener, error)
+}
+
+// partitionListener is a running receiver of a partition.
+type partitionListener interface {
+	Close(ctx context.Context) error
+}
+
+type eventHubClient struct {
+	hub           *eventhub.Hub
+	consumerGroup string
+}
+
+func (c *eventHubClient) PartitionIDs(ctx context.Context) ([]string, error) {
+	info, err := c.hub.GetRuntimeInformation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return info.PartitionIDs, nil
+}
+
+func (c *eventHubClient) Receive(ctx context.Context, partitionID, offset string, handler eventhub.Handler) (partitionListener, error) {
+	// sending a nil ReceiveOption will throw an exception
+	var opts []eventhub.ReceiveOption
+	if c.consumerGroup != "" {
+		opts = append(opts, eventhub.ReceiveWithConsumerGroup(c.consumerGroup))
+	}
+	if offset != "" {
+		opts = append(opts, eventhub.ReceiveWithStartingOffset(offset))
+	}
+	return c.hub.Receive(ctx, partitionID, handler, opts...)
+}
+
+// localProcessor consumes the partitions of an event hub and keeps their
+// checkpoints in the local registry. When a lease file is configured,
+// the partitions are balanced between all the instances that share it.
+type localProcessor struct {
+	log         *logp.Logger
+	client      hubClient
+	checkpoints *checkpointStore
+	leases      *leaseFile // nil when this instance consumes all the partitions.
+	handler     func(event *eventhub.Event, partitionID string) bool
+
+	mutex      sync.Mutex
+	listeners  map[string]partitionListener
+	owned      map[string]uint64              // generation of the ownership of each owned partition.
+	generation uint64                         // incremented each time a partition is acquired.
+	acked      map[string]partitionCheckpoint // last acknowledged checkpoint of each owned partition.
+	pending    map[string]int                 // number of published checkpoints not yet acknowledged, by partition.
+	skipped    map[string]partitionCheckpoint // checkpoint of messages without events, stored once the pending ones are acknowledged.
+}
+
+func newLocalProcessor(log *logp.Logger, client hubClient, checkpoints *checkpointStore, leases *leaseFile, handler func(*eventhub.Event, string) bool) *localProcessor {
+	return &localProcessor{
+		log:         log,
+		client:      client,
+		checkpoints: checkpoints,
+		leases:      leases,
+		handler:     handler,
+		listeners:   make(map[string]partitionListener),
+		owned:       make(map[string]uint64),
+		acked:       make(map[string]partitionCheckpoint),
+		pending:     make(map[string]int),
+		skipped:     make(map[string]partitionCheckpoint),
+	}
+}
+
+// run consumes the partitions until the context is cancelled. With a lease
+// file, partition ownership is renewed every third of the lease duration.
+func (p *localProcessor) run(ctx context.Context) error {
+	defer p.stop()
+
+	if err := p.update(ctx); err != nil {
+		return err
+	}
+	if p.leases == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := time.NewTicker(p.leases.duration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := p.update(ctx); err != nil {
+				p.log.Errorw("Failed updating partition leases", "error", err)
+			}
+		}
+	}
+}
+
+// update starts consuming the partitions owned by this instance, and stops
+// consuming the partitions that are no longer owned.
+func (p *localProcessor) update(ctx context.Context) error {
+	partitionIDs, err := p.client.PartitionIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get partitions: %w", err)
+	}
+
+	owned := make(map[string]*partitionCheckpoint, len(partitionIDs))
+	if p.leases != nil {
+		owned, err = p.leases.balance(partitionIDs, p.ackedCheckpoints())
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, id := range partitionIDs {
+			owned[id] = nil
+		}
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for id, listener := range p.listeners {
+		if _, ok := owned[id]; ok {
+			continue
+		}
+		p.log.Infof("Partition %s is no longer owned, stopping receiver.", id)
+		if err := listener.Close(ctx); err != nil {
+			p.log.Errorw(fmt.Sprintf("error while closing receiver of partition %s", id), "error", err)
+		}
+		delete(p.listeners, id)
+		p.release(id)
+	}
+
+	ids := make([]string, 0, len(owned))
+	for id := range owned {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if _, ok := p.listeners[id]; ok {
+			continue
+		}
+		if err := p.receive(ctx, id, owned[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// receive starts consuming a partition after its last checkpoint. The
+// checkpoint of the lease is used when it's newer than the local one, as
+// the partition may have been consumed by another instance.
+func (p *localProcessor) receive(ctx context.Context, partitionID string, leased *partitionCheckpoint) error {
+	cp, found, err := p.checkpoints.read(partitionID)
+	if err != nil {
+		return fmt.Errorf("failed to read checkpoint of partition %s: %w", partitionID, err)
+	}
+	if leased != nil && (!found || leased.SequenceNumber > cp.SequenceNumber) {
+		cp, found = *leased, true
+	}
+
+	var offset string
+	if found {
+		offset = cp.Offset
+		p.acked[partitionID] = cp
+		p.log.Infof("Receiving events from partition %s after offset %s.", partitionID, offset)
+	} else {
+		p.log.Infof("Receiving events from the start of partition %s.", partitionID)
+	}
+
+	// events published while the partition was previously owned must not
+	// update its checkpoint
+	p.generation++
+	p.owned[partitionID] = p.generation
+	delete(p.pending, partitionID)
+	delete(p.skipped, partitionID)
+
+	listener, err := p.client.Receive(ctx, partitionID, offset, func(_ context.Context, event *eventhub.Event) error {
+		if !p.handler(event, partitionID) {
+			return errors.New("OnEvent function returned false. Stopping input worker")
+		}
+		return nil
+	})
+	if err != nil {
+		p.release(partitionID)
+		return fmt.Errorf("failed to receive events from partition %s: %w", partitionID, err)
+	}
+	p.listeners[partitionID] = listener
+	return nil
+}
+
+// release forgets the state of a partition that is no longer owned, so that
+// late acknowledgements don't overwrite the checkpoints of its new owner.
+// The mutex must be held.
+func (p *localProcessor) release(partitionID string) {
+	delete(p.owned, partitionID)
+	delete(p.acked, partitionID)
+	delete(p.pending, partitionID)
+	delete(p.skipped, partitionID)
+}
+
+// track registers a checkpoint attached to a published event. A message
+// published after a skipped one supersedes its checkpoint.
+func (p *localProcessor) track(cp *ackedCheckpoint) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	generation, owned := p.owned[cp.partitionID]
+	if !owned {
+		return
+	}
+	cp.generation = generation
+	p.pending[cp.partitionID]++
+	delete(p.skipped, cp.partitionID)
+}
+
+// skip checkpoints a message that generated no events. The checkpoint is
+// stored after the checkpoints of the previous events of the partition are
+// acknowledged, so that they aren't skipped after a restart.
+func (p *localProcessor) skip(cp *ackedCheckpoint) {
+	p.mutex.Lock()
+	if _, owned := p.owned[cp.partitionID]; !owned {
+		p.mutex.Unlock()
+		return
+	}
+	if p.pending[cp.partitionID] > 0 {
+		p.skipped[cp.partitionID] = cp.checkpoint
+		p.mutex.Unlock()
+		return
+	}
+	p.acked[cp.partitionID] = cp.checkpoint
+	p.mutex.Unlock()
+	p.write(cp.partitionID, cp.checkpoint)
+}
+
+// ack persists the checkpoints of the acknowledged events. Events published
+// in partitions that are no longer owned, or that were owned and acquired
+// again since, are ignored.
+func (p *localProcessor) ack(privates []interface{}) {
+	for _, private := range privates {
+		acked, ok := private.(*ackedCheckpoint)
+		if !ok || acked == nil {
+			continue
+		}
+		cp := acked.checkpoint
+		p.mutex.Lock()
+		if generation, owned := p.owned[acked.partitionID]; !owned || generation != acked.generation {
+			p.mutex.Unlock()
+			continue
+		}
+		if p.pending[acked.partitionID]--; p.pending[acked.partitionID] <= 0 {
+			delete(p.pending, acked.partitionID)
+			if skipped, found := p.skipped[acked.partitionID]; found {
+				cp = skipped
+				delete(p.skipped, acked.partitionID)
+			}
+		}
+		p.acked[acked.partitionID] = cp
+		p.mutex.Unlock()
+		p.write(acked.partitionID, cp)
+	}
+}
+
+func (p *localProcessor) write(partitionID string, cp partitionCheckpoint) {
+	if err := p.checkpoints.write(partitionID, cp); err != nil {
+		p.log.Errorw(fmt.Sprintf("Failed to store checkpoint of partition %s", partitionID), "error", err)
+	}
+}
+
+func (p *localProcessor) ackedCheckpoints() map[string]partitionCheckpoint {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	checkpoints := make(map[string]partitionCheckpoint, len(p.acked))
+	for id, cp := range p.acked {
+		checkpoints[id] = cp
+	}
+	return checkpoints
+}
+
+// stop closes all the receivers and releases the leases of this instance.
+func (p *localProcessor) stop() {
+	ctx := context.Background()
+	p.mutex.Lock()
+	for id, listener := range p.listeners {
+		if err := listener.Close(ctx); err != nil {
+			p.log.Errorw(fmt.Sprintf("error while closing receiver of partition %s", id), "error", err)
+		}
+	}
+	p.listeners = make(map[string]partitionListener)
+	p.mutex.Unlock()
+
+	if p.leases != nil {
+		checkpoints := p.ackedCheckpoints()
+		// once released, the partitions may be acquired by other instances
+		p.mutex.Lock()
+		for id := range p.owned {
+			p.release(id)
+		}
+		p.mutex.Unlock()
+		if err := p.leases.release(checkpoints); err != nil {
+			p.log.Errorw("Failed releasing partition leases", "error", err)
+		}
+	}
+}
+
+// connectionStringNamespace returns the host of the endpoint of a
+// connection string, which identifies the Event Hubs namespace.
+func connectionStringNamespace(c string) (string, error) {
+	for _, part := range strings.Split(c, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || !strings.EqualFold(strings.TrimSpace(kv[0]), "Endpoint") {
+			continue
+		}
+		u, err := url.Parse(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return "", fmt.Errorf("invalid endpoint in connection string: %w", err)
+		}
+		if u.Host == "" {
+			break
+		}
+		return u.Host, nil
+	}
+	return "", errors.New("no endpoint found in connection string")
+}
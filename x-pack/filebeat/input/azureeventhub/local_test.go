@@ -0,0 +1,268 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package azureeventhub
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/storetest"
+)
+
+// fakeHub is a hubClient that records the receivers started for each partition.
+type fakeHub struct {
+	mutex      sync.Mutex
+	partitions []string
+	offsets    map[string]string
+	handlers   map[string]eventhub.Handler
+	closed     map[string]bool
+}
+
+func newFakeHub(partitions ...string) *fakeHub {
+	return &fakeHub{
+		partitions: partitions,
+		offsets:    make(map[string]string),
+		handlers:   make(map[string]eventhub.Handler),
+		closed:     make(map[string]bool),
+	}
+}
+
+func (h *fakeHub) PartitionIDs(context.Context) ([]string, error) {
+	return h.partitions, nil
+}
+
+func (h *fakeHub) Receive(_ context.Context, partitionID, offset string, handler eventhub.Handler) (partitionListener, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.offsets[partitionID] = offset
+	h.handlers[partitionID] = handler
+	h.closed[partitionID] = false
+	return fakeListener{hub: h, partitionID: partitionID}, nil
+}
+
+func (h *fakeHub) receivers() int {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	n := 0
+	for _, closed := range h.closed {
+		if !closed {
+			n++
+		}
+	}
+	return n
+}
+
+func (h *fakeHub) send(t *testing.T, partitionID string, event *eventhub.Event) {
+	h.mutex.Lock()
+	handler := h.handlers[partitionID]
+	h.mutex.Unlock()
+	require.NotNil(t, handler)
+	require.NoError(t, handler(context.Background(), event))
+}
+
+type fakeListener struct {
+	hub         *fakeHub
+	partitionID string
+}
+
+func (l fakeListener) Close(context.Context) error {
+	l.hub.mutex.Lock()
+	defer l.hub.mutex.Unlock()
+	l.hub.closed[l.partitionID] = true
+	return nil
+}
+
+func newTestEvent(data string, offset, sequenceNumber int64) *eventhub.Event {
+	now := time.Now()
+	return &eventhub.Event{
+		Data: []byte(data),
+		SystemProperties: &eventhub.SystemProperties{
+			Offset:         &offset,
+			SequenceNumber: &sequenceNumber,
+			EnqueuedTime:   &now,
+		},
+	}
+}
+
+func TestLocalCheckpoints(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	store, err := registry.Get("test")
+	require.NoError(t, err)
+	defer store.Close()
+	checkpoints := newCheckpointStore(store, "namespace.servicebus.windows.net", "hub", "")
+
+	o := &stubClient{}
+	input := azureInput{config: config, client: o, workerCtx: context.Background()}
+
+	run := func(hub *fakeHub) (cancel func()) {
+		input.local = newLocalProcessor(logp.NewLogger(inputName), hub, checkpoints, nil, input.processEvents)
+		ctx, cancelCtx := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			assert.NoError(t, input.local.run(ctx))
+		}()
+		require.Eventually(t, func() bool { return hub.receivers() == len(hub.partitions) }, time.Second, time.Millisecond)
+		return func() {
+			cancelCtx()
+			<-done
+		}
+	}
+
+	hub := newFakeHub("0", "1")
+	cancel := run(hub)
+	assert.Equal(t, map[string]string{"0": "", "1": ""}, hub.offsets)
+
+	hub.send(t, "1", newTestEvent(`{"records":[{"n":1},{"n":2}]}`, 1024, 7))
+	require.Len(t, o.Events, 2)
+	partitionID, err := o.Events[0].Fields.GetValue("azure.partition_id")
+	require.NoError(t, err)
+	assert.Equal(t, "1", partitionID)
+
+	// Only the last event of a message checkpoints the partition.
+	var privates []interface{}
+	for _, event := range o.Events {
+		privates = append(privates, event.Private)
+	}
+	assert.IsType(t, []byte{}, privates[0])
+	input.local.ack(privates[:1])
+	_, found, err := checkpoints.read("1")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	input.local.ack(privates[1:])
+	cp, found, err := checkpoints.read("1")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "1024", cp.Offset)
+	assert.Equal(t, int64(7), cp.SequenceNumber)
+
+	// Messages without events are checkpointed after the pending events.
+	hub.send(t, "1", newTestEvent(`{"records":[{"n":3}]}`, 2048, 8))
+	hub.send(t, "1", newTestEvent(`{"records":[]}`, 4096, 9))
+	require.Len(t, o.Events, 3)
+	cp, _, err = checkpoints.read("1")
+	require.NoError(t, err)
+	assert.Equal(t, "1024", cp.Offset)
+
+	input.local.ack([]interface{}{o.Events[2].Private})
+	cp, _, err = checkpoints.read("1")
+	require.NoError(t, err)
+	assert.Equal(t, "4096", cp.Offset)
+	assert.Equal(t, int64(9), cp.SequenceNumber)
+
+	// Without pending events they are checkpointed right away.
+	hub.send(t, "1", newTestEvent(`{"records":[]}`, 8192, 10))
+	cp, _, err = checkpoints.read("1")
+	require.NoError(t, err)
+	assert.Equal(t, "8192", cp.Offset)
+
+	cancel()
+	assert.Equal(t, 0, hub.receivers())
+
+	// Receivers resume after the last checkpoint.
+	hub = newFakeHub("0", "1")
+	cancel = run(hub)
+	defer cancel()
+	assert.Equal(t, map[string]string{"0": "", "1": "8192"}, hub.offsets)
+}
+
+func TestLocalCheckpointsSkippedBetweenEvents(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	store, err := registry.Get("test")
+	require.NoError(t, err)
+	defer store.Close()
+	checkpoints := newCheckpointStore(store, "namespace.servicebus.windows.net", "hub", "")
+
+	o := &stubClient{}
+	input := azureInput{config: config, client: o, workerCtx: context.Background()}
+	hub := newFakeHub("0")
+	input.local = newLocalProcessor(logp.NewLogger(inputName), hub, checkpoints, nil, input.processEvents)
+	require.NoError(t, input.local.update(context.Background()))
+	defer input.local.stop()
+
+	hub.send(t, "0", newTestEvent(`{"records":[{"n":1}]}`, 1024, 1))
+	hub.send(t, "0", newTestEvent(`{"records":[]}`, 2048, 2))
+	hub.send(t, "0", newTestEvent(`{"records":[{"n":3}]}`, 4096, 3))
+	require.Len(t, o.Events, 2)
+
+	input.local.ack([]interface{}{o.Events[0].Private})
+	cp, _, err := checkpoints.read("0")
+	require.NoError(t, err)
+	assert.Equal(t, "1024", cp.Offset)
+
+	// The skipped message is older than the last acknowledged event.
+	input.local.ack([]interface{}{o.Events[1].Private})
+	cp, _, err = checkpoints.read("0")
+	require.NoError(t, err)
+	assert.Equal(t, "4096", cp.Offset)
+	assert.Equal(t, int64(3), cp.SequenceNumber)
+}
+
+func TestLocalCheckpointsPartitionLost(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	store, err := registry.Get("test")
+	require.NoError(t, err)
+	defer store.Close()
+	checkpoints := newCheckpointStore(store, "namespace.servicebus.windows.net", "hub", "")
+
+	o := &stubClient{}
+	input := azureInput{config: config, client: o, workerCtx: context.Background()}
+	hub := newFakeHub("0", "1")
+	input.local = newLocalProcessor(logp.NewLogger(inputName), hub, checkpoints, nil, input.processEvents)
+	ctx := context.Background()
+	require.NoError(t, input.local.update(ctx))
+	defer input.local.stop()
+
+	hub.send(t, "1", newTestEvent(`{"records":[{"n":1}]}`, 1024, 1))
+	require.Len(t, o.Events, 1)
+	stale := o.Events[0].Private
+
+	// Events of partitions that are no longer owned don't checkpoint them.
+	hub.partitions = []string{"0"}
+	require.NoError(t, input.local.update(ctx))
+	input.local.ack([]interface{}{stale})
+	_, found, err := checkpoints.read("1")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// Once acquired again, the partition doesn't wait for the events
+	// published while it was previously owned.
+	hub.partitions = []string{"0", "1"}
+	require.NoError(t, input.local.update(ctx))
+	hub.send(t, "1", newTestEvent(`{"records":[]}`, 2048, 2))
+	cp, _, err := checkpoints.read("1")
+	require.NoError(t, err)
+	assert.Equal(t, "2048", cp.Offset)
+
+	hub.send(t, "1", newTestEvent(`{"records":[{"n":3}]}`, 4096, 3))
+	require.Len(t, o.Events, 2)
+	input.local.ack([]interface{}{stale})
+	cp, _, err = checkpoints.read("1")
+	require.NoError(t, err)
+	assert.Equal(t, "2048", cp.Offset)
+
+	input.local.ack([]interface{}{o.Events[1].Private})
+	cp, _, err = checkpoints.read("1")
+	require.NoError(t, err)
+	assert.Equal(t, "4096", cp.Offset)
+}
+
+func TestConnectionStringNamespace(t *testing.T) {
+	namespace, err := connectionStringNamespace("Endpoint=sb://dummynamespace.servicebus.windows.net/;SharedAccessKeyName=DummyAccessKeyName;SharedAccessKey=5dOntTRytoC24opYThisAsit3is2B+OGY1US/fuL3ly=")
+	assert.NoError(t, err)
+	assert.Equal(t, "dummynamespace.servicebus.windows.net", namespace)
+
+	_, err = connectionStringNamespace("SharedAccessKeyName=DummyAccessKeyName")
+	assert.Error(t, err)
+}
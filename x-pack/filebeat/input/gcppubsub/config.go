@@ -6,8 +6,10 @@ package gcppubsub
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/elastic/beats/v7/filebeat/harvester"
 	"github.com/elastic/beats/v7/libbeat/common"
@@ -26,10 +28,28 @@ type config struct {
 
 	// Google Cloud Pub/Sub subscription name. Multiple Filebeats can pull from same subscription.
 	Subscription struct {
-		Name                   string `config:"name" validate:"required"`
-		NumGoroutines          int    `config:"num_goroutines"`
-		MaxOutstandingMessages int    `config:"max_outstanding_messages"`
-		Create                 bool   `config:"create"`
+		Name                   string        `config:"name" validate:"required"`
+		NumGoroutines          int           `config:"num_goroutines"`
+		MaxOutstandingMessages int           `config:"max_outstanding_messages"`
+		Create                 bool          `config:"create"`
+		MaxExtension           time.Duration `config:"max_extension"`
+
+		// The settings below are only applied when the subscription is created.
+
+		// Deliver messages with the same ordering key in the order they were published.
+		EnableMessageOrdering bool `config:"enable_message_ordering"`
+
+		// Only deliver the messages whose attributes match this filter expression.
+		Filter string `config:"filter"`
+
+		// Time that Pub/Sub waits for an ACK before redelivering a message.
+		AckDeadline time.Duration `config:"ack_deadline"`
+
+		// Topic where the messages that cannot be delivered are forwarded.
+		DeadLetterTopic string `config:"dead_letter_topic"`
+
+		// Number of delivery attempts before a message is forwarded to the dead-letter topic.
+		MaxDeliveryAttempts int `config:"max_delivery_attempts"`
 	} `config:"subscription"`
 
 	// JSON file containing authentication credentials and key.
@@ -43,6 +63,15 @@ type config struct {
 }
 
 func (c *config) Validate() error {
+	if err := c.validateSubscription(); err != nil {
+		return err
+	}
+
+	// The emulator doesn't require authentication.
+	if c.AlternativeHost != "" {
+		return nil
+	}
+
 	// credentials_file
 	if c.CredentialsFile != "" {
 		if _, err := os.Stat(c.CredentialsFile); os.IsNotExist(err) {
@@ -67,6 +96,21 @@ func (c *config) Validate() error {
 		"(credentials_file, credentials_json, and application default credentials (ADC))")
 }
 
+func (c *config) validateSubscription() error {
+	if c.Subscription.MaxExtension < 0 {
+		return errors.New("subscription.max_extension must not be negative")
+	}
+	if d := c.Subscription.AckDeadline; d != 0 && (d < 10*time.Second || d > 600*time.Second) {
+		return fmt.Errorf("subscription.ack_deadline must be between 10s and 600s, got %v", d)
+	}
+	if c.Subscription.DeadLetterTopic != "" {
+		if n := c.Subscription.MaxDeliveryAttempts; n < 5 || n > 100 {
+			return fmt.Errorf("subscription.max_delivery_attempts must be between 5 and 100, got %d", n)
+		}
+	}
+	return nil
+}
+
 func defaultConfig() config {
 	var c config
 	c.ForwarderConfig = harvester.ForwarderConfig{
@@ -75,5 +119,6 @@ func defaultConfig() config {
 	c.Subscription.NumGoroutines = 1
 	c.Subscription.MaxOutstandingMessages = 1000
 	c.Subscription.Create = true
+	c.Subscription.MaxDeliveryAttempts = 5
 	return c
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,3 +33,65 @@ func TestConfigValidateGoogleAppDefaultCreds(t *testing.T) {
 	c := defaultConfig()
 	assert.NoError(t, c.Validate())
 }
+
+func TestConfigValidateAlternativeHost(t *testing.T) {
+	// No credentials are required to connect to the emulator.
+	c := defaultConfig()
+	c.AlternativeHost = "localhost:8432"
+	assert.NoError(t, c.Validate())
+}
+
+func TestConfigValidateSubscription(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config func(c *config)
+		err    string
+	}{
+		{
+			name:   "ack deadline too short",
+			config: func(c *config) { c.Subscription.AckDeadline = 5 * time.Second },
+			err:    "subscription.ack_deadline must be between 10s and 600s",
+		},
+		{
+			name:   "ack deadline too long",
+			config: func(c *config) { c.Subscription.AckDeadline = 11 * time.Minute },
+			err:    "subscription.ack_deadline must be between 10s and 600s",
+		},
+		{
+			name:   "negative max extension",
+			config: func(c *config) { c.Subscription.MaxExtension = -time.Second },
+			err:    "subscription.max_extension must not be negative",
+		},
+		{
+			name: "too many delivery attempts",
+			config: func(c *config) {
+				c.Subscription.DeadLetterTopic = "dead-letters"
+				c.Subscription.MaxDeliveryAttempts = 101
+			},
+			err: "subscription.max_delivery_attempts must be between 5 and 100",
+		},
+		{
+			name: "valid",
+			config: func(c *config) {
+				c.Subscription.AckDeadline = time.Minute
+				c.Subscription.EnableMessageOrdering = true
+				c.Subscription.Filter = `attributes:severity`
+				c.Subscription.DeadLetterTopic = "dead-letters"
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := defaultConfig()
+			c.CredentialsJSON = []byte("{}")
+			tc.config(&c)
+			err := c.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.err)
+			}
+		})
+	}
+}
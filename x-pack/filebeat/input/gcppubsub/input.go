@@ -9,6 +9,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -56,7 +57,8 @@ type pubsubInput struct {
 	workerOnce   sync.Once          // Guarantees that the worker goroutine is only started once.
 	workerWg     sync.WaitGroup     // Waits on pubsub worker goroutine.
 
-	ackedCount *atomic.Uint32 // Total number of successfully ACKed pubsub messages.
+	ackedCount *atomic.Uint32    // Total number of successfully ACKed pubsub messages.
+	inflight   *inflightMessages // Messages published to the pipeline but not ACKed yet.
 }
 
 // NewInput creates a new Google Cloud Pub/Sub input that consumes events from
@@ -103,6 +105,7 @@ func NewInput(
 		workerCtx:    workerCtx,
 		workerCancel: workerCancel,
 		ackedCount:   atomic.NewUint32(0),
+		inflight:     newInflightMessages(),
 	}
 
 	// Build outlet for events.
@@ -111,7 +114,7 @@ func NewInput(
 			acker.EventPrivateReporter(func(_ int, privates []interface{}) {
 				for _, priv := range privates {
 					if msg, ok := priv.(*pubsub.Message); ok {
-						msg.Ack()
+						in.inflight.ack(msg)
 						in.ackedCount.Inc()
 					} else {
 						in.log.Error("Failed ACKing pub/sub event")
@@ -162,12 +165,28 @@ func (in *pubsubInput) run() error {
 	}
 	sub.ReceiveSettings.NumGoroutines = in.Subscription.NumGoroutines
 	sub.ReceiveSettings.MaxOutstandingMessages = in.Subscription.MaxOutstandingMessages
+	sub.ReceiveSettings.MaxExtension = in.Subscription.MaxExtension
+
+	// Receive doesn't return until all the received messages are ACKed or
+	// NACKed. Once the input is stopping, NACK the messages that are still
+	// in the pipeline so that they are redelivered as soon as possible.
+	go func() {
+		<-ctx.Done()
+		if n := in.inflight.nackAll(); n > 0 {
+			in.log.Debugw("NACKed in-flight messages.", "count", n)
+		}
+	}()
 
 	// Start receiving messages.
 	topicID := makeTopicID(in.ProjectID, in.Topic)
 	return sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
-		if ok := in.outlet.OnEvent(makeEvent(topicID, msg)); !ok {
+		if !in.inflight.add(msg) {
+			// The input is stopping.
 			msg.Nack()
+			return
+		}
+		if ok := in.outlet.OnEvent(makeEvent(topicID, msg)); !ok {
+			in.inflight.nack(msg)
 			in.log.Debug("OnEvent returned false. Stopping input worker.")
 			cancel()
 		}
@@ -227,14 +246,13 @@ func (in *pubsubInput) getOrCreateSubscription(ctx context.Context, client *pubs
 		return nil, errors.Wrap(err, "failed to check if subscription exists")
 	}
 	if exists {
+		in.checkSubscriptionConfig(ctx, sub)
 		return sub, nil
 	}
 
 	// Create subscription.
 	if in.Subscription.Create {
-		sub, err = client.CreateSubscription(ctx, in.Subscription.Name, pubsub.SubscriptionConfig{
-			Topic: client.Topic(in.Topic),
-		})
+		sub, err = client.CreateSubscription(ctx, in.Subscription.Name, in.subscriptionConfig(client))
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to create subscription")
 		}
@@ -245,6 +263,55 @@ func (in *pubsubInput) getOrCreateSubscription(ctx context.Context, client *pubs
 	return nil, errors.New("no subscription exists and 'subscription.create' is not enabled")
 }
 
+// subscriptionConfig returns the settings used to create the subscription.
+func (in *pubsubInput) subscriptionConfig(client *pubsub.Client) pubsub.SubscriptionConfig {
+	cfg := pubsub.SubscriptionConfig{
+		Topic:                 client.Topic(in.Topic),
+		AckDeadline:           in.Subscription.AckDeadline,
+		EnableMessageOrdering: in.Subscription.EnableMessageOrdering,
+		Filter:                in.Subscription.Filter,
+	}
+	if in.Subscription.DeadLetterTopic != "" {
+		cfg.DeadLetterPolicy = &pubsub.DeadLetterPolicy{
+			DeadLetterTopic:     topicName(in.ProjectID, in.Subscription.DeadLetterTopic),
+			MaxDeliveryAttempts: in.Subscription.MaxDeliveryAttempts,
+		}
+	}
+	return cfg
+}
+
+// checkSubscriptionConfig warns when an existing subscription doesn't match
+// the configured settings. Subscriptions are never modified by the input.
+func (in *pubsubInput) checkSubscriptionConfig(ctx context.Context, sub *pubsub.Subscription) {
+	cfg, err := sub.Config(ctx)
+	if err != nil {
+		in.log.Warnw("Failed to get the subscription configuration.", "error", err)
+		return
+	}
+	if cfg.EnableMessageOrdering != in.Subscription.EnableMessageOrdering {
+		in.log.Warnf("Existing subscription has enable_message_ordering set to %v.", cfg.EnableMessageOrdering)
+	}
+	if cfg.Filter != in.Subscription.Filter {
+		in.log.Warnf("Existing subscription has a different filter: %q.", cfg.Filter)
+	}
+	var deadLetterTopic string
+	if cfg.DeadLetterPolicy != nil {
+		deadLetterTopic = cfg.DeadLetterPolicy.DeadLetterTopic
+	}
+	if in.Subscription.DeadLetterTopic != "" && deadLetterTopic != topicName(in.ProjectID, in.Subscription.DeadLetterTopic) {
+		in.log.Warnf("Existing subscription has a different dead-letter topic: %q.", deadLetterTopic)
+	}
+}
+
+// topicName returns the full resource name of a topic. Topics can be given
+// by name, in which case they are assumed to belong to the given project.
+func topicName(project, topic string) string {
+	if strings.HasPrefix(topic, "projects/") {
+		return topic
+	}
+	return "projects/" + project + "/topics/" + topic
+}
+
 func (in *pubsubInput) newPubsubClient(ctx context.Context) (*pubsub.Client, error) {
 	opts := []option.ClientOption{option.WithUserAgent(useragent.UserAgent("Filebeat"))}
 
@@ -254,7 +321,8 @@ func (in *pubsubInput) newPubsubClient(ctx context.Context) (*pubsub.Client, err
 		if err != nil {
 			return nil, fmt.Errorf("cannot connect to alternative host %q: %w", in.AlternativeHost, err)
 		}
-		opts = append(opts, option.WithGRPCConn(conn), option.WithTelemetryDisabled())
+		opts = append(opts, option.WithGRPCConn(conn), option.WithTelemetryDisabled(), option.WithoutAuthentication())
+		return pubsub.NewClient(ctx, in.ProjectID, opts...)
 	}
 
 	if in.CredentialsFile != "" {
@@ -265,3 +333,58 @@ func (in *pubsubInput) newPubsubClient(ctx context.Context) (*pubsub.Client, err
 
 	return pubsub.NewClient(ctx, in.ProjectID, opts...)
 }
+
+// inflightMessages tracks the messages that have been published to the
+// pipeline, but not ACKed yet.
+type inflightMessages struct {
+	mutex    sync.Mutex
+	messages map[*pubsub.Message]struct{}
+	closed   bool
+}
+
+func newInflightMessages() *inflightMessages {
+	return &inflightMessages{messages: map[*pubsub.Message]struct{}{}}
+}
+
+// add starts tracking a message. It returns false if nackAll has already
+// been called, in which case the message is not tracked.
+func (m *inflightMessages) add(msg *pubsub.Message) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.closed {
+		return false
+	}
+	m.messages[msg] = struct{}{}
+	return true
+}
+
+// ack ACKs the message. This has no effect if the message was already NACKed.
+func (m *inflightMessages) ack(msg *pubsub.Message) {
+	m.remove(msg)
+	msg.Ack()
+}
+
+func (m *inflightMessages) nack(msg *pubsub.Message) {
+	m.remove(msg)
+	msg.Nack()
+}
+
+func (m *inflightMessages) remove(msg *pubsub.Message) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.messages, msg)
+}
+
+// nackAll NACKs all the messages that haven't been ACKed yet, and returns
+// the number of NACKed messages. Messages added afterwards are rejected.
+func (m *inflightMessages) nackAll() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.closed = true
+	n := len(m.messages)
+	for msg := range m.messages {
+		msg.Nack()
+	}
+	m.messages = map[*pubsub.Message]struct{}{}
+	return n
+}
@@ -15,9 +15,12 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
 
 	"github.com/elastic/beats/v7/filebeat/channel"
 	"github.com/elastic/beats/v7/filebeat/input"
@@ -37,9 +40,18 @@ const (
 
 var once sync.Once
 
-func testSetup(t *testing.T) (*pubsub.Client, context.CancelFunc) {
+// testSetup returns a client connected to the Pub/Sub emulator, and the
+// address of the emulator. When PUBSUB_EMULATOR_HOST is not set, an
+// in-process fake server is used instead. You can start the emulator with
+// "docker-compose up" from the _meta directory. The default address is
+// PUBSUB_EMULATOR_HOST=localhost:8432.
+func testSetup(t *testing.T) (*pubsub.Client, string, context.CancelFunc) {
 	t.Helper()
 
+	once.Do(func() {
+		logp.TestingSetup()
+	})
+
 	var host string
 	if isInDockerIntegTestEnv() {
 		// We're running inside out integration test environment so
@@ -49,15 +61,51 @@ func testSetup(t *testing.T) (*pubsub.Client, context.CancelFunc) {
 	} else {
 		host = os.Getenv("PUBSUB_EMULATOR_HOST")
 		if host == "" {
-			t.Skip("PUBSUB_EMULATOR_HOST is not set in environment. You can start " +
-				"the emulator with \"docker-compose up\" from the _meta directory. " +
-				"The default address is PUBSUB_EMULATOR_HOST=localhost:8432")
+			return fakeServerSetup(t)
 		}
 	}
 
-	once.Do(func() {
-		logp.TestingSetup()
+	checkEmulatorHealth(t, host)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client, err := pubsub.NewClient(ctx, emulatorProjectID)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
 
+	resetPubSub(t, client)
+	return client, host, cancel
+}
+
+// fakeServerSetup starts an in-process Pub/Sub server. It is stopped when
+// the returned function is called.
+func fakeServerSetup(t *testing.T) (*pubsub.Client, string, context.CancelFunc) {
+	t.Helper()
+
+	srv := pstest.NewServer()
+	conn, err := grpc.Dial(srv.Addr, grpc.WithInsecure())
+	if err != nil {
+		srv.Close()
+		t.Fatalf("failed to connect to fake server: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client, err := pubsub.NewClient(ctx, emulatorProjectID, option.WithGRPCConn(conn))
+	if err != nil {
+		cancel()
+		srv.Close()
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client, srv.Addr, func() {
+		cancel()
+		srv.Close()
+	}
+}
+
+var healthOnce sync.Once
+
+func checkEmulatorHealth(t *testing.T, host string) {
+	healthOnce.Do(func() {
 		// Disable HTTP keep-alives to ensure no extra goroutines hang around.
 		httpClient := http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
 
@@ -76,15 +124,6 @@ func testSetup(t *testing.T) (*pubsub.Client, context.CancelFunc) {
 			t.Fatalf("pubsub emulator is not healthy, got status code %d", resp.StatusCode)
 		}
 	})
-
-	ctx, cancel := context.WithCancel(context.Background())
-	client, err := pubsub.NewClient(ctx, emulatorProjectID)
-	if err != nil {
-		t.Fatalf("failed to create client: %v", err)
-	}
-
-	resetPubSub(t, client)
-	return client, cancel
 }
 
 func resetPubSub(t *testing.T, client *pubsub.Client) {
@@ -140,6 +179,32 @@ func createTopic(t *testing.T, client *pubsub.Client) {
 	}
 }
 
+func publishOrderedMessages(t *testing.T, client *pubsub.Client, orderingKey string, numMsgs int) []string {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	topic := client.Topic(emulatorTopic)
+	topic.EnableMessageOrdering = true
+	defer topic.Stop()
+
+	results := make([]*pubsub.PublishResult, numMsgs)
+	for i := 0; i < numMsgs; i++ {
+		results[i] = topic.Publish(ctx, &pubsub.Message{
+			Data:        []byte("message " + strconv.Itoa(i)),
+			OrderingKey: orderingKey,
+		})
+	}
+	messageIDs := make([]string, numMsgs)
+	for i, result := range results {
+		id, err := result.Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		messageIDs[i] = id
+	}
+	return messageIDs
+}
+
 func publishMessages(t *testing.T, client *pubsub.Client, numMsgs int) []string {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -205,7 +270,6 @@ func defaultTestConfig() *common.Config {
 			"name":   emulatorSubscription,
 			"create": true,
 		},
-		"credentials_file": "testdata/fake.json",
 	})
 }
 
@@ -224,10 +288,15 @@ func runTestWithACKer(t *testing.T, cfg *common.Config, onEvent eventHandler, ru
 	}
 
 	// Create pubsub client for setting up and communicating to emulator.
-	client, clientCancel := testSetup(t)
+	client, host, clientCancel := testSetup(t)
 	defer clientCancel()
 	defer client.Close()
 
+	// The input connects to the emulator through alternative_host.
+	if err := cfg.SetString("alternative_host", -1, host); err != nil {
+		t.Fatal(err)
+	}
+
 	// Simulate input.Context from Filebeat input runner.
 	inputCtx := newInputContext()
 	defer close(inputCtx.Done)
@@ -465,3 +534,113 @@ func TestEndToEndACK(t *testing.T) {
 		}
 	})
 }
+
+func TestSubscriptionCreateSettings(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.SetBool("subscription.enable_message_ordering", -1, true)
+	cfg.SetString("subscription.filter", -1, `attributes.type = "audit"`)
+	cfg.SetString("subscription.ack_deadline", -1, "30s")
+	cfg.SetString("subscription.dead_letter_topic", -1, "dead-letters")
+	cfg.SetInt("subscription.max_delivery_attempts", -1, 10)
+
+	runTest(t, cfg, func(client *pubsub.Client, input *pubsubInput, out *stubOutleter, t *testing.T) {
+		createTopic(t, client)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sub, err := input.getOrCreateSubscription(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		subCfg, err := sub.Config(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, subCfg.EnableMessageOrdering)
+		assert.Equal(t, `attributes.type = "audit"`, subCfg.Filter)
+		assert.Equal(t, 30*time.Second, subCfg.AckDeadline)
+		if assert.NotNil(t, subCfg.DeadLetterPolicy) {
+			assert.Equal(t, "projects/"+emulatorProjectID+"/topics/dead-letters", subCfg.DeadLetterPolicy.DeadLetterTopic)
+			assert.Equal(t, 10, subCfg.DeadLetterPolicy.MaxDeliveryAttempts)
+		}
+	})
+}
+
+func TestOrderedDelivery(t *testing.T) {
+	if !isInDockerIntegTestEnv() && os.Getenv("PUBSUB_EMULATOR_HOST") == "" {
+		t.Skip("Message ordering is not supported by the fake server.")
+	}
+
+	cfg := defaultTestConfig()
+	cfg.SetBool("subscription.enable_message_ordering", -1, true)
+
+	runTest(t, cfg, func(client *pubsub.Client, input *pubsubInput, out *stubOutleter, t *testing.T) {
+		createTopic(t, client)
+
+		group, ctx := errgroup.WithContext(context.Background())
+		group.Go(input.run)
+
+		const numMsgs = 20
+		var messageIDs []string
+		time.AfterFunc(1*time.Second, ifNotDone(ctx, func() {
+			messageIDs = publishOrderedMessages(t, client, "key", numMsgs)
+		}))
+		time.AfterFunc(10*time.Second, func() { out.Close() })
+
+		events, ok := out.waitForEvents(numMsgs)
+		if !ok {
+			t.Fatalf("Expected %d events, but got %d.", numMsgs, len(events))
+		}
+		input.Stop()
+		if err := group.Wait(); err != nil {
+			t.Fatal(err)
+		}
+
+		for i, ev := range events {
+			msg := ev.Private.(*pubsub.Message)
+			assert.Equal(t, messageIDs[i], msg.ID)
+			assert.Equal(t, "key", msg.OrderingKey)
+		}
+	})
+}
+
+func TestNackOnStop(t *testing.T) {
+	cfg := defaultTestConfig()
+
+	// Events are received by the outlet, but never ACKed.
+	noACK := func(beat.Event, beat.ClientConfig) bool { return true }
+
+	runTestWithACKer(t, cfg, noACK, func(client *pubsub.Client, input *pubsubInput, out *stubOutleter, t *testing.T) {
+		createTopic(t, client)
+		createSubscription(t, client)
+
+		group, _ := errgroup.WithContext(context.Background())
+		group.Go(input.run)
+
+		const numMsgs = 5
+		publishMessages(t, client, numMsgs)
+		time.AfterFunc(10*time.Second, func() { out.Close() })
+		events, ok := out.waitForEvents(numMsgs)
+		if !ok {
+			t.Fatalf("Expected %d events, but got %d.", numMsgs, len(events))
+		}
+
+		// Receive only returns once all the messages are ACKed or NACKed,
+		// so stopping the input must NACK the pending messages.
+		stopped := make(chan struct{})
+		go func() {
+			input.Stop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(10 * time.Second):
+			t.Fatal("Input did not stop with messages pending ACK.")
+		}
+		if err := group.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		assert.EqualValues(t, 0, input.ackedCount.Load())
+	})
+}
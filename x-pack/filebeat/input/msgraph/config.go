@@ -0,0 +1,214 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package msgraph
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/o365audit/auth"
+)
+
+const timeDay = 24 * time.Hour
+
+// Config for the Microsoft Graph input.
+type Config struct {
+	// CertificateConfig contains the authentication credentials (certificate).
+	CertificateConfig tlscommon.CertificateConfig `config:",inline"`
+
+	// ApplicationID (aka. client ID) of the Azure application.
+	ApplicationID string `config:"application_id" validate:"required"`
+
+	// ClientSecret (aka. API key) to use for authentication.
+	ClientSecret string `config:"client_secret"`
+
+	// TenantID (aka. Directory ID) is a list of tenants for which to fetch
+	// the events. This can be a string or a list of strings.
+	TenantID stringList `config:"tenant_id,replace" validate:"required"`
+
+	// Datasets is a list of datasets to fetch.
+	// This can be a string or a list of strings.
+	Datasets stringList `config:"datasets,replace"`
+
+	// API contains settings to adapt to changes on the API.
+	API APIConfig `config:"api"`
+}
+
+// APIConfig contains advanced settings that are only supposed to be changed
+// to diagnose errors or to adapt to changes in the service.
+type APIConfig struct {
+	// AuthenticationEndpoint to authorize the Azure app.
+	AuthenticationEndpoint string `config:"authentication_endpoint"`
+
+	// Resource to request authorization for. It's also the base URL of the
+	// Graph API.
+	Resource string `config:"resource"`
+
+	// Version of the Graph API.
+	Version string `config:"version"`
+
+	// MaxRetention determines how far back the input will poll for events
+	// when it runs for the first time.
+	MaxRetention time.Duration `config:"max_retention" validate:"positive"`
+
+	// MaxQuerySize is the maximum time window that is requested in a single
+	// query.
+	MaxQuerySize time.Duration `config:"max_query_size" validate:"positive"`
+
+	// IngestionDelay is the time that events take to be available in the API.
+	// Time windows are only queried once this time has passed since their end.
+	IngestionDelay time.Duration `config:"ingestion_delay"`
+
+	// PollInterval determines how often the input should poll for new
+	// data once it has finished scanning for past events and reached the live
+	// window.
+	PollInterval time.Duration `config:"poll_interval" validate:"positive"`
+
+	// ErrorRetryInterval sets the interval between retries in the case of
+	// errors performing a request.
+	ErrorRetryInterval time.Duration `config:"error_retry_interval" validate:"positive"`
+
+	// SetIDFromRecord controls whether the unique "id" field in the records
+	// is used as the document id for ingestion. This helps avoiding duplicates.
+	SetIDFromRecord bool `config:"set_id_from_record"`
+
+	// PreserveOriginalEvent controls whether the original object will be kept
+	// in `event.original` or not.
+	PreserveOriginalEvent bool `config:"preserve_original_event"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		Datasets: []string{
+			datasetSecurityAlerts,
+			datasetSignIns,
+			datasetDirectoryAudits,
+		},
+
+		API: APIConfig{
+			AuthenticationEndpoint: "https://login.microsoftonline.com/",
+
+			Resource: "https://graph.microsoft.com",
+
+			Version: "v1.0",
+
+			MaxRetention: 7 * timeDay,
+
+			MaxQuerySize: timeDay,
+
+			IngestionDelay: 5 * time.Minute,
+
+			PollInterval: 5 * time.Minute,
+
+			ErrorRetryInterval: 5 * time.Minute,
+
+			SetIDFromRecord: true,
+		},
+	}
+}
+
+// Validate checks that the configuration is correct.
+func (c *Config) Validate() (err error) {
+	hasSecret := c.ClientSecret != ""
+	hasCert := c.CertificateConfig.Certificate != ""
+
+	if !hasSecret && !hasCert {
+		return errors.New("no authentication configured. Configure a client_secret or a certificate and key.")
+	}
+	if hasSecret && hasCert {
+		return errors.New("both client_secret and certificate are configured. Only one authentication method can be used.")
+	}
+	if hasCert {
+		if err = c.CertificateConfig.Validate(); err != nil {
+			return errors.Wrap(err, "invalid certificate config")
+		}
+	}
+	if len(c.Datasets) == 0 {
+		return errors.New("no datasets configured")
+	}
+	for _, name := range c.Datasets {
+		if _, found := datasets[name]; !found {
+			return fmt.Errorf("unknown dataset '%s'", name)
+		}
+	}
+	if c.API.IngestionDelay < 0 {
+		return errors.New("api.ingestion_delay can't be negative")
+	}
+	c.API.Resource, err = forceURLScheme(c.API.Resource, "https")
+	if err != nil {
+		return errors.Wrapf(err, "resource '%s' is not a valid URL", c.API.Resource)
+	}
+	c.API.AuthenticationEndpoint, err = forceURLScheme(c.API.AuthenticationEndpoint, "https")
+	if err != nil {
+		return errors.Wrapf(err, "authentication_endpoint '%s' is not a valid URL", c.API.AuthenticationEndpoint)
+	}
+	return nil
+}
+
+type stringList []string
+
+// Unpack populates the stringList with either a single string value or an array.
+func (s *stringList) Unpack(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*s = []string{v}
+	case []string:
+		*s = v
+	case []interface{}:
+		*s = make([]string, len(v))
+		for idx, ival := range v {
+			str, ok := ival.(string)
+			if !ok {
+				return fmt.Errorf("string value required. Found %v (type %T) at position %d",
+					ival, ival, idx+1)
+			}
+			(*s)[idx] = str
+		}
+	default:
+		return fmt.Errorf("array of strings required. Found %v (type %T)", value, value)
+	}
+	return nil
+}
+
+// NewTokenProvider returns an auth.TokenProvider for the given tenantID.
+func (c *Config) NewTokenProvider(tenantID string) (auth.TokenProvider, error) {
+	if c.ClientSecret != "" {
+		return auth.NewProviderFromClientSecret(
+			c.API.AuthenticationEndpoint,
+			c.API.Resource,
+			c.ApplicationID,
+			tenantID,
+			c.ClientSecret,
+		)
+	}
+	return auth.NewProviderFromCertificate(
+		c.API.AuthenticationEndpoint,
+		c.API.Resource,
+		c.ApplicationID,
+		tenantID,
+		c.CertificateConfig,
+	)
+}
+
+// Ensures that the passed URL has a scheme, using the provided one if needed.
+// Returns an error is the URL can't be parsed.
+func forceURLScheme(baseURL, scheme string) (urlWithScheme string, err error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	// Scheme is mandatory
+	if parsed.Scheme == "" {
+		withScheme := scheme + "://" + baseURL
+		if parsed, err = url.Parse(withScheme); err != nil {
+			return "", err
+		}
+	}
+	return parsed.String(), nil
+}
@@ -0,0 +1,36 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package msgraph
+
+const (
+	datasetSecurityAlerts  = "security_alerts"
+	datasetSignIns         = "sign_ins"
+	datasetDirectoryAudits = "directory_audits"
+)
+
+// dataset describes a Graph API collection of records.
+type dataset struct {
+	// Path of the collection, relative to the API version.
+	Path string
+
+	// TimeField is the record field used to filter by time and as the
+	// event timestamp.
+	TimeField string
+}
+
+var datasets = map[string]dataset{
+	datasetSecurityAlerts: {
+		Path:      "security/alerts",
+		TimeField: "createdDateTime",
+	},
+	datasetSignIns: {
+		Path:      "auditLogs/signIns",
+		TimeField: "createdDateTime",
+	},
+	datasetDirectoryAudits: {
+		Path:      "auditLogs/directoryAudits",
+		TimeField: "activityDateTime",
+	},
+}
@@ -0,0 +1,283 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+
+	v2 "github.com/elastic/beats/v7/filebeat/input/v2"
+	cursor "github.com/elastic/beats/v7/filebeat/input/v2/input-cursor"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/useragent"
+	"github.com/elastic/beats/v7/libbeat/feature"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/o365audit/auth"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/o365audit/poll"
+	"github.com/elastic/go-concert/ctxtool"
+	"github.com/elastic/go-concert/timed"
+)
+
+const (
+	pluginName   = "msgraph"
+	fieldsPrefix = pluginName
+)
+
+type graphInput struct {
+	config Config
+}
+
+// Stream represents an event stream.
+type stream struct {
+	tenantID string
+	dataset  string
+}
+
+type apiEnvironment struct {
+	TenantID string
+	Dataset  string
+	Config   APIConfig
+	Callback func(event beat.Event, cursor interface{}) error
+	// UpdateCursor persists the cursor without publishing an event, it's nil
+	// if the publisher doesn't support it.
+	UpdateCursor func(cursor interface{}) error
+	Logger       *logp.Logger
+	Clock        func() time.Time
+}
+
+func Plugin(log *logp.Logger, store cursor.StateStore) v2.Plugin {
+	return v2.Plugin{
+		Name:       pluginName,
+		Stability:  feature.Experimental,
+		Deprecated: false,
+		Info:       "Microsoft Graph security logs",
+		Doc:        "Collect security alerts, sign-in logs and directory audits from Microsoft Graph",
+		Manager: &cursor.InputManager{
+			Logger:     log,
+			StateStore: store,
+			Type:       pluginName,
+			Configure:  configure,
+		},
+	}
+}
+
+func configure(cfg *common.Config) ([]cursor.Source, cursor.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, nil, errors.Wrap(err, "reading config")
+	}
+
+	var sources []cursor.Source
+	for _, tenantID := range config.TenantID {
+		for _, dataset := range config.Datasets {
+			sources = append(sources, &stream{
+				tenantID: tenantID,
+				dataset:  dataset,
+			})
+		}
+	}
+
+	return sources, &graphInput{config: config}, nil
+}
+
+func (s *stream) Name() string {
+	return s.tenantID + "::" + s.dataset
+}
+
+func (inp *graphInput) Name() string { return pluginName }
+
+func (inp *graphInput) Test(src cursor.Source, ctx v2.TestContext) error {
+	tenantID := src.(*stream).tenantID
+	auth, err := inp.config.NewTokenProvider(tenantID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := auth.Token(); err != nil {
+		return errors.Wrapf(err, "unable to acquire authentication token for tenant:%s", tenantID)
+	}
+
+	return nil
+}
+
+func (inp *graphInput) Run(
+	ctx v2.Context,
+	src cursor.Source,
+	cursor cursor.Cursor,
+	publisher cursor.Publisher,
+) error {
+	for ctx.Cancelation.Err() == nil {
+		err := inp.runOnce(ctx, src, cursor, publisher)
+		if err == nil {
+			break
+		}
+		if ctx.Cancelation.Err() != err && err != context.Canceled {
+			msg := common.MapStr{}
+			msg.Put("error.message", err.Error())
+			msg.Put("event.kind", "pipeline_error")
+			event := beat.Event{
+				Timestamp: time.Now(),
+				Fields:    msg,
+			}
+			publisher.Publish(event, nil)
+			ctx.Logger.Errorf("Input failed: %v", err)
+			ctx.Logger.Infof("Restarting in %v", inp.config.API.ErrorRetryInterval)
+			timed.Wait(ctx.Cancelation, inp.config.API.ErrorRetryInterval)
+		}
+	}
+	return nil
+}
+
+func (inp *graphInput) runOnce(
+	ctx v2.Context,
+	src cursor.Source,
+	cursor cursor.Cursor,
+	publisher cursor.Publisher,
+) error {
+	stream := src.(*stream)
+	log := ctx.Logger.With("tenantID", stream.tenantID, "dataset", stream.dataset)
+
+	tokenProvider, err := inp.config.NewTokenProvider(stream.tenantID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tokenProvider.Token(); err != nil {
+		return errors.Wrapf(err, "unable to acquire authentication token for tenant:%s", stream.tenantID)
+	}
+
+	start := initCheckpoint(log, cursor, inp.config.API.MaxRetention)
+	env := apiEnvironment{
+		Logger:       log,
+		TenantID:     stream.tenantID,
+		Dataset:      stream.dataset,
+		Config:       inp.config.API,
+		Callback:     publisher.Publish,
+		UpdateCursor: cursorUpdater(publisher),
+		Clock:        time.Now,
+	}
+	log.Infow("Start fetching events", "cursor", start)
+	return runPoller(ctxtool.FromCanceller(ctx.Cancelation), tokenProvider, start, env)
+}
+
+// cursorUpdater returns the function updating the cursor without publishing
+// an event, or nil if the publisher doesn't support it.
+func cursorUpdater(publisher cursor.Publisher) func(interface{}) error {
+	if updater, ok := publisher.(cursor.CursorUpdater); ok {
+		return updater.UpdateCursor
+	}
+	return nil
+}
+
+// runPoller fetches the events of a stream, starting from the given
+// checkpoint, until the context is done or an error occurs.
+func runPoller(ctx context.Context, tokenProvider auth.TokenProvider, start checkpoint, env apiEnvironment) error {
+	poller, err := poll.New(
+		poll.WithTokenProvider(tokenProvider),
+		poll.WithLogger(env.Logger),
+		poll.WithContext(ctx),
+		poll.WithRequestDecorator(
+			autorest.WithUserAgent(useragent.UserAgent("Filebeat-"+pluginName)),
+		),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create API poller")
+	}
+	return poller.Run(makeListEvents(start, env))
+}
+
+func initCheckpoint(log *logp.Logger, c cursor.Cursor, maxRetention time.Duration) checkpoint {
+	var cp checkpoint
+	retentionLimit := time.Now().UTC().Add(-maxRetention)
+
+	if c.IsNew() {
+		log.Infof("No saved state found. Will fetch events for the last %v.", maxRetention.String())
+		cp.Since = retentionLimit
+	} else {
+		err := c.Unpack(&cp)
+		if err != nil {
+			log.Errorw("Error loading saved state. Will fetch all retained events. "+
+				"Depending on max_retention, this can cause event loss or duplication.",
+				"error", err,
+				"max_retention", maxRetention.String())
+			cp.Since = retentionLimit
+		}
+	}
+
+	if cp.Since.Before(retentionLimit) {
+		log.Warnw("Last update exceeds the retention limit. "+
+			"Probably some events have been lost.",
+			"resume_since", cp,
+			"retention_limit", retentionLimit,
+			"max_retention", maxRetention.String())
+		cp.Since = retentionLimit
+	}
+
+	return cp
+}
+
+// Report returns an action that produces a beat.Event from the given object.
+func (env apiEnvironment) Report(raw json.RawMessage, doc common.MapStr, private interface{}) poll.Action {
+	return func(poll.Enqueuer) error {
+		return env.Callback(env.toBeatEvent(raw, doc), private)
+	}
+}
+
+// Advance returns an action that updates the cursor when there are no
+// events to report.
+func (env apiEnvironment) Advance(private interface{}) poll.Action {
+	return func(poll.Enqueuer) error {
+		if env.UpdateCursor == nil {
+			return nil
+		}
+		return env.UpdateCursor(private)
+	}
+}
+
+// ReportAPIError returns an action that produces a beat.Event from an API error.
+func (env apiEnvironment) ReportAPIError(err apiError) poll.Action {
+	return func(poll.Enqueuer) error {
+		return env.Callback(err.ToBeatEvent(), nil)
+	}
+}
+
+func (env apiEnvironment) toBeatEvent(raw json.RawMessage, doc common.MapStr) beat.Event {
+	b := beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			fieldsPrefix: common.MapStr{
+				env.Dataset: doc,
+			},
+		},
+	}
+	timeField := datasets[env.Dataset].TimeField
+	if ts, err := getTime(doc, timeField); err == nil {
+		b.Timestamp = ts
+	} else {
+		b.PutValue("error.message", []string{errors.Wrapf(err, "failed parsing %s", timeField).Error()})
+	}
+	if env.Config.SetIDFromRecord {
+		if id, ok := doc["id"].(string); ok && len(id) > 0 {
+			b.SetID(id)
+		}
+	}
+	if env.Config.PreserveOriginalEvent {
+		b.PutValue("event.original", string(raw))
+	}
+	return b
+}
+
+func getTime(doc common.MapStr, key string) (time.Time, error) {
+	str, ok := doc[key].(string)
+	if !ok {
+		return time.Time{}, errors.Errorf("key '%s' not found or not a string", key)
+	}
+	return time.Parse(time.RFC3339Nano, str)
+}
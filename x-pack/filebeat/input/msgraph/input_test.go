@@ -0,0 +1,356 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const testTenantID = "7b1c0c3d-3e77-4c3a-9f3e-0c2f6b0e1a55"
+
+// fakeGraph is a fake Microsoft Graph server. It also implements the OAuth2
+// token endpoint, so that the real token providers can be used.
+type fakeGraph struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	tokens   int              // number of tokens issued.
+	filters  []string         // $filter parameter of each request.
+	handler  http.HandlerFunc // handles the requests to the Graph API.
+	canceled bool
+}
+
+func newFakeGraph(t *testing.T, handler http.HandlerFunc) *fakeGraph {
+	f := &fakeGraph{handler: handler}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+testTenantID+"/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "client_credentials", r.PostFormValue("grant_type"))
+		assert.Equal(t, "test-client", r.PostFormValue("client_id"))
+		assert.Equal(t, "test-secret", r.PostFormValue("client_secret"))
+		f.mutex.Lock()
+		f.tokens++
+		token := fmt.Sprintf("token-%d", f.tokens)
+		f.mutex.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": token,
+			"expires_in":   "3600",
+			"expires_on":   strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+			"token_type":   "Bearer",
+		})
+	})
+	mux.HandleFunc("/v1.0/", func(w http.ResponseWriter, r *http.Request) {
+		f.mutex.Lock()
+		f.filters = append(f.filters, r.URL.Query().Get("$filter"))
+		f.mutex.Unlock()
+		f.handler(w, r)
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeGraph) config() Config {
+	config := defaultConfig()
+	config.ApplicationID = "test-client"
+	config.ClientSecret = "test-secret"
+	config.TenantID = []string{testTenantID}
+	config.API.AuthenticationEndpoint = f.URL + "/"
+	config.API.Resource = f.URL
+	config.API.IngestionDelay = 0
+	config.API.PollInterval = time.Millisecond
+	config.API.ErrorRetryInterval = time.Millisecond
+	config.API.MaxQuerySize = time.Hour
+	return config
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+type published struct {
+	event  beat.Event
+	cursor interface{}
+}
+
+// run fetches events from the fake server until stop returns true.
+func run(t *testing.T, config Config, dataset string, now time.Time, start checkpoint, stop func([]published) bool) ([]published, error) {
+	events, _, err := runWithUpdates(t, config, dataset, now, start, stop)
+	return events, err
+}
+
+// runWithUpdates is like run, but it also returns the cursor updates made
+// without publishing an event.
+func runWithUpdates(t *testing.T, config Config, dataset string, now time.Time, start checkpoint, stop func([]published) bool) ([]published, []interface{}, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokenProvider, err := config.NewTokenProvider(testTenantID)
+	require.NoError(t, err)
+
+	var events []published
+	var updates []interface{}
+	env := apiEnvironment{
+		TenantID: testTenantID,
+		Dataset:  dataset,
+		Config:   config.API,
+		Callback: func(event beat.Event, cursor interface{}) error {
+			events = append(events, published{event: event, cursor: cursor})
+			if stop(events) {
+				cancel()
+			}
+			return nil
+		},
+		UpdateCursor: func(cursor interface{}) error {
+			updates = append(updates, cursor)
+			return nil
+		},
+		Logger: logp.NewLogger(pluginName),
+		Clock:  func() time.Time { return now },
+	}
+	err = runPoller(ctx, tokenProvider, start, env)
+	return events, updates, err
+}
+
+func TestListEventsPagination(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	records := []common.MapStr{
+		{"id": "a1", "createdDateTime": "2021-05-01T10:05:00Z", "userPrincipalName": "alice@example.com"},
+		{"id": "a2", "createdDateTime": "2021-05-01T10:10:00.1234567Z", "userPrincipalName": "bob@example.com"},
+		{"id": "a3", "createdDateTime": "2021-05-01T10:50:00Z", "userPrincipalName": "carol@example.com"},
+		{"id": "b1", "createdDateTime": "2021-05-01T11:30:00Z", "userPrincipalName": "dave@example.com"},
+	}
+
+	var server *fakeGraph
+	server = newFakeGraph(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.0/auditLogs/signIns", r.URL.Path)
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+		switch filter := r.URL.Query().Get("$filter"); {
+		case filter == "createdDateTime ge 2021-05-01T10:00:00Z and createdDateTime lt 2021-05-01T11:00:00Z":
+			if r.URL.Query().Get("$skiptoken") == "" {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"value":           records[:2],
+					"@odata.nextLink": server.URL + "/v1.0/auditLogs/signIns?" + r.URL.RawQuery + "&$skiptoken=page2",
+				})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"value": records[2:3]})
+		case filter == "createdDateTime ge 2021-05-01T11:00:00Z and createdDateTime lt 2021-05-01T12:00:00Z":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"value": records[3:]})
+		default:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"value": []interface{}{}})
+		}
+	})
+
+	start := checkpoint{Since: now.Add(-2 * time.Hour)}
+	events, err := run(t, server.config(), datasetSignIns, now, start, func(events []published) bool {
+		return len(events) == len(records)
+	})
+	assert.NoError(t, err)
+	require.Len(t, events, len(records))
+
+	expectedCursors := []checkpoint{
+		{Since: now.Add(-2 * time.Hour)},
+		{Since: now.Add(-2 * time.Hour)},
+		{Since: now.Add(-time.Hour)},
+		{Since: now},
+	}
+	for idx, ev := range events {
+		assert.Equal(t, records[idx]["id"], ev.event.Meta["_id"])
+		assert.Equal(t, records[idx]["id"], getValue(t, ev.event, "msgraph.sign_ins.id"))
+		ts, err := time.Parse(time.RFC3339Nano, records[idx]["createdDateTime"].(string))
+		require.NoError(t, err)
+		assert.Equal(t, ts, ev.event.Timestamp)
+		assert.Equal(t, expectedCursors[idx], ev.cursor)
+	}
+
+	assert.Equal(t, []string{
+		"createdDateTime ge 2021-05-01T10:00:00Z and createdDateTime lt 2021-05-01T11:00:00Z",
+		"createdDateTime ge 2021-05-01T10:00:00Z and createdDateTime lt 2021-05-01T11:00:00Z",
+		"createdDateTime ge 2021-05-01T11:00:00Z and createdDateTime lt 2021-05-01T12:00:00Z",
+	}, server.filters)
+}
+
+func TestListEventsEmptyWindow(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	server := newFakeGraph(t, func(w http.ResponseWriter, r *http.Request) {
+		switch filter := r.URL.Query().Get("$filter"); {
+		case filter == "createdDateTime ge 2021-05-01T11:00:00Z and createdDateTime lt 2021-05-01T12:00:00Z":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"value": []common.MapStr{
+					{"id": "b1", "createdDateTime": "2021-05-01T11:30:00Z"},
+				},
+			})
+		default:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"value": []interface{}{}})
+		}
+	})
+
+	start := checkpoint{Since: now.Add(-2 * time.Hour)}
+	events, updates, err := runWithUpdates(t, server.config(), datasetSignIns, now, start, func(events []published) bool {
+		return len(events) == 1
+	})
+	assert.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, checkpoint{Since: now}, events[0].cursor)
+	// The empty window advances the cursor without an event.
+	assert.Equal(t, []interface{}{checkpoint{Since: now.Add(-time.Hour)}}, updates)
+}
+
+func TestListEventsLiveWindow(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	var server *fakeGraph
+	server = newFakeGraph(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": []common.MapStr{
+				{"id": "x", "activityDateTime": "2021-05-01T11:57:00Z"},
+			},
+		})
+	})
+	config := server.config()
+	config.API.IngestionDelay = 5 * time.Minute
+
+	start := checkpoint{Since: now.Add(-10 * time.Minute)}
+	events, err := run(t, config, datasetDirectoryAudits, now, start, func(events []published) bool {
+		return len(events) == 2
+	})
+	assert.NoError(t, err)
+	require.Len(t, events, 2)
+
+	// Windows don't go past the ingestion delay.
+	assert.Equal(t, []string{
+		"activityDateTime ge 2021-05-01T11:50:00Z and activityDateTime lt 2021-05-01T11:55:00Z",
+		"activityDateTime ge 2021-05-01T11:55:00Z and activityDateTime lt 2021-05-01T11:55:00Z",
+	}, server.filters[:2])
+	assert.Equal(t, checkpoint{Since: now.Add(-5 * time.Minute)}, events[0].cursor)
+}
+
+func TestListEventsErrors(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	start := checkpoint{Since: now.Add(-30 * time.Minute)}
+
+	t.Run("token renewal", func(t *testing.T) {
+		var server *fakeGraph
+		server = newFakeGraph(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "Bearer token-1" {
+				writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+					"error": map[string]string{"code": "InvalidAuthenticationToken", "message": "Access token has expired."},
+				})
+				return
+			}
+			assert.Equal(t, "Bearer token-2", r.Header.Get("Authorization"))
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"value": []common.MapStr{{"id": "x", "createdDateTime": "2021-05-01T11:45:00Z"}},
+			})
+		})
+		events, err := run(t, server.config(), datasetSecurityAlerts, now, start, func(events []published) bool {
+			return len(events) == 1
+		})
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, 2, server.tokens)
+	})
+
+	t.Run("throttling", func(t *testing.T) {
+		var calls int
+		var server *fakeGraph
+		server = newFakeGraph(t, func(w http.ResponseWriter, r *http.Request) {
+			if calls++; calls == 1 {
+				w.Header().Set("Retry-After", "1")
+				writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{
+					"error": map[string]string{"code": "TooManyRequests", "message": "Too many requests."},
+				})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"value": []common.MapStr{{"id": "x", "createdDateTime": "2021-05-01T11:45:00Z"}},
+			})
+		})
+		events, err := run(t, server.config(), datasetSecurityAlerts, now, start, func(events []published) bool {
+			return len(events) == 1
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, "x", events[0].event.Meta["_id"])
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		server := newFakeGraph(t, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusForbidden, map[string]interface{}{
+				"error": map[string]string{"code": "Authorization_RequestDenied", "message": "Insufficient privileges."},
+			})
+		})
+		events, err := run(t, server.config(), datasetSignIns, now, start, func([]published) bool {
+			return false
+		})
+		if assert.Error(t, err) {
+			assert.True(t, strings.Contains(err.Error(), "Authorization_RequestDenied"))
+		}
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, "Authorization_RequestDenied", getValue(t, events[0].event, "error.code"))
+			assert.Nil(t, events[0].cursor)
+		}
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := defaultConfig()
+	config.ApplicationID = "test-client"
+	config.ClientSecret = "test-secret"
+	config.TenantID = []string{testTenantID}
+	assert.NoError(t, config.Validate())
+
+	config.Datasets = []string{datasetSignIns, "mail"}
+	assert.EqualError(t, config.Validate(), "unknown dataset 'mail'")
+
+	config.Datasets = nil
+	assert.EqualError(t, config.Validate(), "no datasets configured")
+}
+
+func TestPreserveOriginalEvent(t *testing.T) {
+	env := apiEnvironment{
+		Dataset: datasetSignIns,
+		Config:  APIConfig{PreserveOriginalEvent: false},
+	}
+
+	raw := json.RawMessage(`{"id":"a1","createdDateTime":"2021-05-01T10:05:00Z"}`)
+	doc := common.MapStr{
+		"id":              "a1",
+		"createdDateTime": "2021-05-01T10:05:00Z",
+	}
+
+	event := env.toBeatEvent(raw, doc)
+	_, err := event.GetValue("event.original")
+	assert.EqualError(t, err, "key not found")
+
+	env.Config.PreserveOriginalEvent = true
+	event = env.toBeatEvent(raw, doc)
+	assert.JSONEq(t, string(raw), getValue(t, event, "event.original").(string))
+}
+
+func getValue(t *testing.T, event beat.Event, key string) interface{} {
+	v, err := event.GetValue(key)
+	require.NoError(t, err)
+	return v
+}
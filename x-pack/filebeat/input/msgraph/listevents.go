@@ -0,0 +1,219 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package msgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/o365audit/poll"
+)
+
+// filterDateFormat is the format of the dates used in $filter expressions.
+const filterDateFormat = "2006-01-02T15:04:05Z"
+
+// listEvents is a poll.Transaction that fetches the records of a dataset
+// created within a time window. When the response is paginated, it fetches
+// the following pages using the @odata.nextLink URL.
+type listEvents struct {
+	env                apiEnvironment
+	startTime, endTime time.Time
+	// nextLink is the URL of the page to fetch. When empty, the first page
+	// of the time window is fetched.
+	nextLink string
+	delay    time.Duration
+}
+
+// page is a paginated response from the Graph API.
+type page struct {
+	Value    []json.RawMessage `json:"value"`
+	NextLink string            `json:"@odata.nextLink"`
+}
+
+// makeListEvents creates a new poll.Transaction that fetches the records
+// created after the given cursor position.
+func makeListEvents(cursor checkpoint, env apiEnvironment) listEvents {
+	l := listEvents{
+		env: env,
+	}
+	return l.adjustTimes(cursor.Since)
+}
+
+func (l listEvents) adjustTimes(since time.Time) listEvents {
+	now := l.env.Clock()
+	// Don't query events that may not be available yet.
+	limit := now.Add(-l.env.Config.IngestionDelay)
+
+	to := since.Add(l.env.Config.MaxQuerySize)
+	var delay time.Duration
+	if to.After(limit) {
+		// The live window is reached. Poll for new events every interval,
+		// taking into account that the request is delayed.
+		delay = l.env.Config.PollInterval
+		if limit = limit.Add(delay); limit.Before(to) {
+			to = limit
+		}
+	}
+	l.startTime = since.UTC()
+	// Dates in filters have a precision of seconds.
+	l.endTime = to.UTC().Truncate(time.Second)
+	l.nextLink = ""
+	l.delay = delay
+	return l
+}
+
+// Delay returns the delay before executing a transaction.
+func (l listEvents) Delay() time.Duration {
+	return l.delay
+}
+
+// String returns the printable representation of a listEvents.
+func (l listEvents) String() string {
+	if l.nextLink != "" {
+		return fmt.Sprintf("list %s from:%s to:%s page:%s", l.env.Dataset, l.startTime, l.endTime, l.nextLink)
+	}
+	return fmt.Sprintf("list %s from:%s to:%s", l.env.Dataset, l.startTime, l.endTime)
+}
+
+// RequestDecorators returns the decorators used to perform a request.
+func (l listEvents) RequestDecorators() []autorest.PrepareDecorator {
+	if l.nextLink != "" {
+		// The link already contains the query parameters.
+		return []autorest.PrepareDecorator{
+			autorest.WithBaseURL(l.nextLink),
+		}
+	}
+	return []autorest.PrepareDecorator{
+		autorest.WithBaseURL(l.env.Config.Resource),
+		autorest.WithPath(l.env.Config.Version),
+		autorest.WithPath(datasets[l.env.Dataset].Path),
+		autorest.WithQueryParameters(
+			map[string]interface{}{
+				"$filter": l.filter(),
+			}),
+	}
+}
+
+// filter returns the $filter expression that selects the records created
+// within the time window.
+func (l listEvents) filter() string {
+	field := datasets[l.env.Dataset].TimeField
+	return fmt.Sprintf("%s ge %s and %s lt %s",
+		field, l.startTime.Format(filterDateFormat),
+		field, l.endTime.Format(filterDateFormat))
+}
+
+// OnResponse handles the output of a list request.
+func (l listEvents) OnResponse(response *http.Response) (actions []poll.Action) {
+	if response.StatusCode != http.StatusOK {
+		return l.handleError(response)
+	}
+
+	var p page
+	if err := readJSONBody(response, &p); err != nil {
+		return []poll.Action{
+			poll.Terminate(err),
+		}
+	}
+
+	// Records are checkpointed with the start of the time window, as there's
+	// no guarantee on the order they are returned. Only the last record in
+	// the window advances the cursor to the next window. Records from a
+	// window that is interrupted are deduplicated by ID.
+	inWindow, nextWindow := checkpoint{Since: l.startTime}, checkpoint{Since: l.endTime}
+	advanced := false
+	for idx, raw := range p.Value {
+		var doc common.MapStr
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			l.env.Logger.Errorf("Failed decoding record: %v", err)
+			continue
+		}
+		cursor := inWindow
+		if p.NextLink == "" && idx == len(p.Value)-1 {
+			cursor, advanced = nextWindow, true
+		}
+		actions = append(actions, l.env.Report(raw, doc, cursor))
+	}
+	if p.NextLink == "" && !advanced {
+		// The window had no records, or the last one couldn't be decoded.
+		// Persist the cursor anyway, so that the window isn't fetched again
+		// after a restart.
+		actions = append(actions, l.env.Advance(nextWindow))
+	}
+
+	if p.NextLink != "" {
+		next := l
+		next.nextLink = p.NextLink
+		next.delay = 0
+		return append(actions, poll.Fetch(next))
+	}
+	// Otherwise fetch the next time window.
+	return append(actions, poll.Fetch(l.Next()))
+}
+
+// Next returns a listEvents that will fetch events in the next time window.
+func (l listEvents) Next() listEvents {
+	return l.adjustTimes(l.endTime)
+}
+
+func (l listEvents) handleError(response *http.Response) (actions []poll.Action) {
+	var msg apiError
+	readJSONBody(response, &msg)
+	l.env.Logger.Warnf("Got error %s: %+v", response.Status, msg)
+	l.delay = l.env.Config.ErrorRetryInterval
+
+	switch response.StatusCode {
+	case http.StatusUnauthorized:
+		// Authentication error. Renew oauth token and repeat this op.
+		l.delay = 0
+		return []poll.Action{
+			poll.RenewToken(),
+			poll.Fetch(l),
+		}
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		// The request is throttled, or the service is temporarily down.
+		// Repeat the request without reporting an error.
+		if retryAfter, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && retryAfter > 0 {
+			l.delay = time.Duration(retryAfter) * time.Second
+		}
+		return []poll.Action{
+			poll.Fetch(l),
+		}
+	case http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound:
+		// The request won't succeed by repeating it. This is usually
+		// a missing permission or an unsupported dataset.
+		return []poll.Action{
+			l.env.ReportAPIError(msg),
+			poll.Terminate(errors.New(msg.String())),
+		}
+	}
+
+	if msg.Error.Code != "" {
+		actions = append(actions, l.env.ReportAPIError(msg))
+	}
+	return append(actions, poll.Fetch(l))
+}
+
+func readJSONBody(response *http.Response, dest interface{}) error {
+	defer autorest.Respond(response,
+		autorest.ByDiscardingBody(),
+		autorest.ByClosing())
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading body failed")
+	}
+	if err = json.Unmarshal(body, dest); err != nil {
+		return errors.Wrap(err, "decoding json failed")
+	}
+	return nil
+}
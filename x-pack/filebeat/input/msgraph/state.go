@@ -0,0 +1,23 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package msgraph
+
+import (
+	"fmt"
+	"time"
+)
+
+// A checkpoint represents a point in time within an event stream
+// that can be persisted and used to resume processing from that point.
+type checkpoint struct {
+	// Since is the start of the first time window that hasn't been
+	// fully processed.
+	Since time.Time `struct:"since"`
+}
+
+// String returns the printable representation of a cursor.
+func (c checkpoint) String() string {
+	return fmt.Sprintf("cursor{since:%s}", c.Since)
+}
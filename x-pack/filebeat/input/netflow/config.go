@@ -5,23 +5,58 @@
 package netflow
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
 
 	"github.com/elastic/beats/v7/filebeat/harvester"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
 	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/ipfix"
 )
 
 type config struct {
 	udp.Config                `config:",inline"`
 	harvester.ForwarderConfig `config:",inline"`
-	InternalNetworks          []string      `config:"internal_networks"`
-	Protocols                 []string      `config:"protocols"`
-	ExpirationTimeout         time.Duration `config:"expiration_timeout"`
-	PacketQueueSize           int           `config:"queue_size"`
-	CustomDefinitions         []string      `config:"custom_definitions"`
-	DetectSequenceReset       bool          `config:"detect_sequence_reset"`
+	InternalNetworks          []string            `config:"internal_networks"`
+	Protocols                 []string            `config:"protocols"`
+	ExpirationTimeout         time.Duration       `config:"expiration_timeout"`
+	PacketQueueSize           int                 `config:"queue_size"`
+	CustomDefinitions         []string            `config:"custom_definitions"`
+	DetectSequenceReset       bool                `config:"detect_sequence_reset"`
+	TCP                       *common.Config      `config:"tcp"`
+	SCTP                      *common.Config      `config:"sctp"`
+	TemplateCache             templateCacheConfig `config:"template_cache"`
+}
+
+// templateCacheConfig configures the persistence of NetFlow V9 and IPFIX
+// templates across restarts.
+type templateCacheConfig struct {
+	Enabled bool          `config:"enabled"`
+	Timeout time.Duration `config:"timeout" validate:"positive"`
+}
+
+// Validate validates the netflow input configuration.
+func (c *config) Validate() error {
+	if c.TCP != nil && !hasProtocol(c.Protocols, ipfix.ProtocolName) {
+		return errors.New("the ipfix protocol must be enabled to receive flows over tcp")
+	}
+	if c.SCTP != nil && !hasProtocol(c.Protocols, ipfix.ProtocolName) {
+		return errors.New("the ipfix protocol must be enabled to receive flows over sctp")
+	}
+	return nil
+}
+
+func hasProtocol(protocols []string, name string) bool {
+	for _, proto := range protocols {
+		if strings.EqualFold(proto, name) {
+			return true
+		}
+	}
+	return false
 }
 
 var defaultConfig = config{
@@ -38,4 +73,16 @@ var defaultConfig = config{
 	ExpirationTimeout:   time.Minute * 30,
 	PacketQueueSize:     8192,
 	DetectSequenceReset: true,
+	TemplateCache: templateCacheConfig{
+		Enabled: false,
+		Timeout: time.Hour * 24,
+	},
+}
+
+// defaultTCPConfig is used for the optional IPFIX over TCP listener.
+// 4739 is the IANA-assigned port for IPFIX.
+var defaultTCPConfig = tcp.Config{
+	Host:           ":4739",
+	Timeout:        time.Minute * 5,
+	MaxMessageSize: 64 * humanize.KiByte,
 }
@@ -59,6 +59,8 @@ func toBeatEventCommon(flow record.Record) (event beat.Event) {
 		flow.Fields["type"] = "netflow_flow"
 	case record.Options:
 		flow.Fields["type"] = "netflow_options"
+	case record.Counters:
+		flow.Fields["type"] = "netflow_counters"
 	default:
 		flow.Fields["type"] = "netflow_unknown"
 	}
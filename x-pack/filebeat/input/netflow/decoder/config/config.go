@@ -12,13 +12,41 @@ import (
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/fields"
 )
 
+// TemplateStore is a persistent key-value store where NetFlow V9 and IPFIX
+// templates are saved, so that they can be recovered after a restart.
+type TemplateStore interface {
+	Put(key string, value interface{}) error
+	Get(key string, value interface{}) error
+}
+
+// Counter is a monotonic counter used to report decoder statistics.
+type Counter interface {
+	Add(delta uint64)
+}
+
+// Stats holds the counters updated by the decoder.
+type Stats struct {
+	// MissingTemplate counts data sets dropped because their template
+	// is not known.
+	MissingTemplate Counter
+
+	// RestoredTemplates counts templates loaded from the template store.
+	RestoredTemplates Counter
+}
+
+type discardCounter struct{}
+
+func (discardCounter) Add(uint64) {}
+
 // Config stores the configuration used by the NetFlow Collector.
 type Config struct {
-	protocols   []string
-	logOutput   io.Writer
-	expiration  time.Duration
-	detectReset bool
-	fields      fields.FieldDict
+	protocols     []string
+	logOutput     io.Writer
+	expiration    time.Duration
+	detectReset   bool
+	fields        fields.FieldDict
+	templateStore TemplateStore
+	stats         Stats
 }
 
 var defaultCfg = Config{
@@ -26,6 +54,10 @@ var defaultCfg = Config{
 	logOutput:   ioutil.Discard,
 	expiration:  time.Hour,
 	detectReset: true,
+	stats: Stats{
+		MissingTemplate:   discardCounter{},
+		RestoredTemplates: discardCounter{},
+	},
 }
 
 // Defaults returns a configuration object with defaults settings:
@@ -81,6 +113,25 @@ func (c *Config) WithCustomFields(dicts ...fields.FieldDict) *Config {
 	return c
 }
 
+// WithTemplateStore sets the store where templates are persisted. A nil
+// store disables persistence, which is the default.
+func (c *Config) WithTemplateStore(store TemplateStore) *Config {
+	c.templateStore = store
+	return c
+}
+
+// WithStats sets the counters to be updated by the decoder. Nil counters
+// are ignored.
+func (c *Config) WithStats(stats Stats) *Config {
+	if stats.MissingTemplate != nil {
+		c.stats.MissingTemplate = stats.MissingTemplate
+	}
+	if stats.RestoredTemplates != nil {
+		c.stats.RestoredTemplates = stats.RestoredTemplates
+	}
+	return c
+}
+
 // Protocols returns a list of the protocols enabled.
 func (c *Config) Protocols() []string {
 	return c.protocols
@@ -109,3 +160,14 @@ func (c *Config) Fields() fields.FieldDict {
 	}
 	return c.fields
 }
+
+// TemplateStore returns the store where templates are persisted, or nil if
+// persistence is disabled.
+func (c *Config) TemplateStore() TemplateStore {
+	return c.templateStore
+}
+
+// Stats returns the counters to be updated by the decoder.
+func (c *Config) Stats() Stats {
+	return c.stats
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"log"
 	"net"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -41,7 +42,15 @@ func NewDecoder(config *config.Config) (*Decoder, error) {
 		if err != nil {
 			return nil, err
 		}
-		proto := factory(*config)
+		protoConfig := *config
+		if store := config.TemplateStore(); store != nil {
+			// Each protocol keeps its templates under its own namespace.
+			protoConfig.WithTemplateStore(prefixedStore{
+				prefix: strings.ToLower(protoName) + ":",
+				store:  store,
+			})
+		}
+		proto := factory(protoConfig)
 		decoder.protos[proto.Version()] = proto
 	}
 	return decoder, nil
@@ -94,6 +103,17 @@ func (p *Decoder) Read(buf *bytes.Buffer, source net.Addr) (records []record.Rec
 	return handler.OnPacket(buf, source)
 }
 
+// CloseSession notifies the decoder that the transport session with the given
+// exporter has ended, for example because its TCP connection was closed.
+// Any session state, including templates, kept for it is discarded.
+func (p *Decoder) CloseSession(source net.Addr) {
+	for _, proto := range p.protos {
+		if closer, ok := proto.(protocol.SessionCloser); ok {
+			closer.CloseSession(source)
+		}
+	}
+}
+
 // NewConfig returns a new configuration structure to be passed to NewDecoder.
 func NewConfig() *config.Config {
 	cfg := config.Defaults()
@@ -108,3 +128,17 @@ func (p *Decoder) stop() error {
 	}
 	return nil
 }
+
+// prefixedStore adds a prefix to the keys of a template store.
+type prefixedStore struct {
+	prefix string
+	store  config.TemplateStore
+}
+
+func (s prefixedStore) Put(key string, value interface{}) error {
+	return s.store.Put(s.prefix+key, value)
+}
+
+func (s prefixedStore) Get(key string, value interface{}) error {
+	return s.store.Get(s.prefix+key, value)
+}
@@ -14,6 +14,10 @@
 // and expiration internally so the caller doesn't need to take care of
 // maintaining sessions nor templates.
 //
+// sFlow version 5 datagrams are also supported. Flow samples are mapped to
+// the same NetFlow/IPFIX fields, and interface counter samples are returned
+// as Counters records.
+//
 // Status
 //
 // IPFIX
@@ -21,8 +25,17 @@
 //  - Working implementation as of rfc7011.
 //  - Options records supported.
 //  - Variable-length fields supported.
+//  - Templates are scoped to the transport session, so that they can be
+//    discarded when a TCP connection is closed.
 //  - Missing: Support for RFC6313 data-types (basicList, subTemplateList, subTemplateMultiList).
 //
+// sFlow 5
+//
+//  - Flow samples (raw packet header, Ethernet, IPv4, IPv6, extended switch
+//    and extended router records).
+//  - Counter samples (generic interface counters).
+//  - Missing: Vendor-specific (non-zero enterprise) samples and records.
+//
 // NetFlow 9
 //
 //  - Working implementation as of rfc3954.
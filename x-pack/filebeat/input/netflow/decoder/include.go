@@ -9,6 +9,7 @@ package decoder
 
 import (
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/ipfix"
+	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/sflow"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/v1"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/v5"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/v6"
@@ -23,6 +23,7 @@ type IPFixProtocol struct {
 }
 
 var _ protocol.Protocol = (*IPFixProtocol)(nil)
+var _ protocol.SessionCloser = (*IPFixProtocol)(nil)
 
 func init() {
 	protocol.Registry.Register(ProtocolName, New)
@@ -32,3 +32,12 @@ type Protocol interface {
 	// the protocol parser might be using.
 	Stop() error
 }
+
+// SessionCloser is implemented by protocols that keep per-exporter state
+// which must be discarded when its transport session ends. This is the case
+// for IPFIX over TCP, where templates are scoped to the connection.
+type SessionCloser interface {
+
+	// CloseSession discards any state kept for the given exporter address.
+	CloseSession(source net.Addr)
+}
@@ -0,0 +1,484 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sflow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+)
+
+// Sample formats (enterprise 0).
+const (
+	sampleFlow             = 1
+	sampleCounters         = 2
+	sampleExpandedFlow     = 3
+	sampleExpandedCounters = 4
+)
+
+// Flow record formats (enterprise 0).
+const (
+	flowRawPacketHeader = 1
+	flowEthernetFrame   = 2
+	flowIPv4            = 3
+	flowIPv6            = 4
+	flowExtendedSwitch  = 1001
+	flowExtendedRouter  = 1002
+)
+
+// Counter record formats (enterprise 0).
+const (
+	counterGenericInterface = 1
+)
+
+// Header protocols for raw packet header flow records.
+const (
+	headerProtocolEthernet = 1
+	headerProtocolIPv4     = 11
+	headerProtocolIPv6     = 12
+)
+
+const (
+	addressTypeIPv4 = 1
+	addressTypeIPv6 = 2
+
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+	etherTypeVLAN = 0x8100
+
+	ipProtoICMP   = 1
+	ipProtoTCP    = 6
+	ipProtoUDP    = 17
+	ipProtoICMPv6 = 58
+
+	// interfaceUnknown is the ifIndex value used when the interface
+	// is not known.
+	interfaceUnknown = 0x3fffffff
+)
+
+// xdrReader reads the XDR-encoded (big-endian, 4-byte aligned) primitives
+// used by sFlow. Once a read goes past the end of the data, all subsequent
+// reads return zero values and err is set to io.EOF.
+type xdrReader struct {
+	data []byte
+	err  error
+}
+
+func (r *xdrReader) next(n int) []byte {
+	if r.err != nil || n < 0 || len(r.data) < n {
+		r.err = io.EOF
+		r.data = nil
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *xdrReader) uint32() uint32 {
+	if b := r.next(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (r *xdrReader) uint64() uint64 {
+	if b := r.next(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+// fixed reads a fixed-length opaque of n bytes, including its padding.
+func (r *xdrReader) fixed(n int) []byte {
+	b := r.next(n)
+	r.next(padding(n))
+	return b
+}
+
+// opaque reads a variable-length opaque.
+func (r *xdrReader) opaque() []byte {
+	return r.fixed(int(r.uint32()))
+}
+
+func (r *xdrReader) ip(n int) net.IP {
+	if b := r.next(n); b != nil {
+		return copyIP(b)
+	}
+	return nil
+}
+
+// address reads an address union, prefixed by its address type.
+func (r *xdrReader) address() net.IP {
+	switch addrType := r.uint32(); addrType {
+	case addressTypeIPv4:
+		return r.ip(net.IPv4len)
+	case addressTypeIPv6:
+		return r.ip(net.IPv6len)
+	default:
+		if r.err == nil {
+			r.err = fmt.Errorf("unknown address type %d", addrType)
+		}
+		return nil
+	}
+}
+
+func padding(n int) int {
+	return (4 - n&3) & 3
+}
+
+func copyIP(b []byte) net.IP {
+	return append(net.IP(nil), b...)
+}
+
+func copyMAC(b []byte) net.HardwareAddr {
+	return append(net.HardwareAddr(nil), b...)
+}
+
+// splitFormat splits a data format into its enterprise and format parts.
+func splitFormat(format uint32) (enterprise uint32, typ uint32) {
+	return format >> 12, format & 0xfff
+}
+
+// DatagramHeader is the header of an sFlow v5 datagram.
+type DatagramHeader struct {
+	Version      uint32
+	AgentAddress net.IP
+	SubAgentID   uint32
+	SequenceNo   uint32
+	Uptime       uint32
+	NumSamples   uint32
+}
+
+// ReadDatagramHeader reads a datagram header. Errors are reported through
+// the reader.
+func ReadDatagramHeader(r *xdrReader) (header DatagramHeader) {
+	header.Version = r.uint32()
+	if header.Version != Version {
+		return header
+	}
+	header.AgentAddress = r.address()
+	header.SubAgentID = r.uint32()
+	header.SequenceNo = r.uint32()
+	header.Uptime = r.uint32()
+	header.NumSamples = r.uint32()
+	return header
+}
+
+func (h DatagramHeader) ExporterMetadata(source net.Addr, timestamp time.Time) record.Map {
+	return record.Map{
+		"version":      uint64(h.Version),
+		"timestamp":    timestamp,
+		"uptimeMillis": uint64(h.Uptime),
+		"address":      source.String(),
+		"agentAddress": h.AgentAddress,
+		"subAgentId":   uint64(h.SubAgentID),
+	}
+}
+
+// readInterfaces reads the input and output interfaces of a flow sample.
+// Only interfaces identified by their ifIndex are returned.
+func readInterfaces(r *xdrReader, expanded bool) (input, output uint32, hasInput, hasOutput bool) {
+	var inFormat, outFormat uint32
+	if expanded {
+		inFormat, input = r.uint32(), r.uint32()
+		outFormat, output = r.uint32(), r.uint32()
+	} else {
+		in, out := r.uint32(), r.uint32()
+		inFormat, input = in>>30, in&interfaceUnknown
+		outFormat, output = out>>30, out&interfaceUnknown
+	}
+	return input, output, inFormat == 0 && input != interfaceUnknown, outFormat == 0 && output != interfaceUnknown
+}
+
+func decodeFlowSample(data []byte, expanded bool) ([]record.Record, error) {
+	r := xdrReader{data: data}
+	r.uint32() // sequence number
+	if expanded {
+		r.uint32() // source ID type
+		r.uint32() // source ID index
+	} else {
+		r.uint32() // source ID
+	}
+	samplingRate := r.uint32()
+	r.uint32() // sample pool
+	r.uint32() // drops
+	input, output, hasInput, hasOutput := readInterfaces(&r, expanded)
+	numRecords := r.uint32()
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	fields := record.Map{
+		"samplingInterval": uint64(samplingRate),
+		"packetDeltaCount": uint64(samplingRate),
+	}
+	if hasInput {
+		fields["ingressInterface"] = uint64(input)
+	}
+	if hasOutput {
+		fields["egressInterface"] = uint64(output)
+	}
+	var frameLength uint32
+	for i := uint32(0); i < numRecords; i++ {
+		format := r.uint32()
+		body := r.opaque()
+		if r.err != nil {
+			return nil, r.err
+		}
+		if enterprise, typ := splitFormat(format); enterprise == 0 {
+			length, err := decodeFlowRecord(typ, body, fields)
+			if err != nil {
+				return nil, fmt.Errorf("flow record format %d: %v", typ, err)
+			}
+			// Length from the raw packet header takes precedence as it
+			// includes the full frame.
+			if frameLength == 0 || typ == flowRawPacketHeader {
+				frameLength = length
+			}
+		}
+	}
+	if frameLength != 0 {
+		fields["octetDeltaCount"] = uint64(frameLength) * uint64(samplingRate)
+	}
+	return []record.Record{
+		{
+			Type:   record.Flow,
+			Fields: fields,
+		},
+	}, nil
+}
+
+// decodeFlowRecord decodes a flow record into fields. It returns the length
+// of the sampled packet, if known.
+func decodeFlowRecord(format uint32, data []byte, fields record.Map) (length uint32, err error) {
+	r := xdrReader{data: data}
+	switch format {
+	case flowRawPacketHeader:
+		headerProtocol := r.uint32()
+		length = r.uint32()
+		r.uint32() // stripped
+		header := r.opaque()
+		if r.err != nil {
+			return 0, r.err
+		}
+		switch headerProtocol {
+		case headerProtocolEthernet:
+			decodeEthernetHeader(header, fields)
+		case headerProtocolIPv4:
+			decodeIPv4Header(header, fields)
+		case headerProtocolIPv6:
+			decodeIPv6Header(header, fields)
+		}
+		return length, nil
+
+	case flowEthernetFrame:
+		length = r.uint32()
+		src := r.fixed(6)
+		dst := r.fixed(6)
+		etherType := r.uint32()
+		if r.err != nil {
+			return 0, r.err
+		}
+		fields["sourceMacAddress"] = copyMAC(src)
+		fields["destinationMacAddress"] = copyMAC(dst)
+		fields["ethernetType"] = uint64(etherType)
+		return length, nil
+
+	case flowIPv4, flowIPv6:
+		size, version := net.IPv4len, "IPv4"
+		if format == flowIPv6 {
+			size, version = net.IPv6len, "IPv6"
+		}
+		length = r.uint32()
+		proto := r.uint32()
+		src := r.ip(size)
+		dst := r.ip(size)
+		srcPort := r.uint32()
+		dstPort := r.uint32()
+		tcpFlags := r.uint32()
+		tos := r.uint32()
+		if r.err != nil {
+			return 0, r.err
+		}
+		fields["protocolIdentifier"] = uint64(proto)
+		fields["source"+version+"Address"] = src
+		fields["destination"+version+"Address"] = dst
+		fields["sourceTransportPort"] = uint64(srcPort)
+		fields["destinationTransportPort"] = uint64(dstPort)
+		fields["tcpControlBits"] = uint64(tcpFlags)
+		fields["ipClassOfService"] = uint64(tos)
+		return length, nil
+
+	case flowExtendedSwitch:
+		srcVLAN := r.uint32()
+		r.uint32() // source priority
+		dstVLAN := r.uint32()
+		r.uint32() // destination priority
+		if r.err != nil {
+			return 0, r.err
+		}
+		fields["vlanId"] = uint64(srcVLAN)
+		fields["postVlanId"] = uint64(dstVLAN)
+		return 0, nil
+
+	case flowExtendedRouter:
+		nextHop := r.address()
+		srcMask := r.uint32()
+		dstMask := r.uint32()
+		if r.err != nil {
+			return 0, r.err
+		}
+		version := "IPv4"
+		if nextHop.To4() == nil {
+			version = "IPv6"
+		}
+		fields["ipNextHop"+version+"Address"] = nextHop
+		fields["source"+version+"PrefixLength"] = uint64(srcMask)
+		fields["destination"+version+"PrefixLength"] = uint64(dstMask)
+		return 0, nil
+	}
+	return 0, nil
+}
+
+func decodeEthernetHeader(data []byte, fields record.Map) {
+	if len(data) < 14 {
+		return
+	}
+	fields["destinationMacAddress"] = copyMAC(data[0:6])
+	fields["sourceMacAddress"] = copyMAC(data[6:12])
+	etherType := binary.BigEndian.Uint16(data[12:14])
+	data = data[14:]
+	if etherType == etherTypeVLAN && len(data) >= 4 {
+		fields["vlanId"] = uint64(binary.BigEndian.Uint16(data[0:2]) & 0x0fff)
+		etherType = binary.BigEndian.Uint16(data[2:4])
+		data = data[4:]
+	}
+	fields["ethernetType"] = uint64(etherType)
+	switch etherType {
+	case etherTypeIPv4:
+		decodeIPv4Header(data, fields)
+	case etherTypeIPv6:
+		decodeIPv6Header(data, fields)
+	}
+}
+
+func decodeIPv4Header(data []byte, fields record.Map) {
+	if len(data) < 20 || data[0]>>4 != 4 {
+		return
+	}
+	headerLength := int(data[0]&0x0f) * 4
+	proto := data[9]
+	fields["ipVersion"] = uint64(4)
+	fields["ipClassOfService"] = uint64(data[1])
+	fields["ipTTL"] = uint64(data[8])
+	fields["protocolIdentifier"] = uint64(proto)
+	fields["sourceIPv4Address"] = copyIP(data[12:16])
+	fields["destinationIPv4Address"] = copyIP(data[16:20])
+	// Only the first fragment carries the transport header.
+	if fragmentOffset := binary.BigEndian.Uint16(data[6:8]) & 0x1fff; fragmentOffset == 0 && len(data) > headerLength {
+		decodeTransportHeader(proto, data[headerLength:], fields)
+	}
+}
+
+func decodeIPv6Header(data []byte, fields record.Map) {
+	if len(data) < 40 || data[0]>>4 != 6 {
+		return
+	}
+	proto := data[6]
+	fields["ipVersion"] = uint64(6)
+	fields["ipClassOfService"] = uint64(binary.BigEndian.Uint16(data[0:2]) >> 4 & 0xff)
+	fields["ipTTL"] = uint64(data[7])
+	fields["protocolIdentifier"] = uint64(proto)
+	fields["sourceIPv6Address"] = copyIP(data[8:24])
+	fields["destinationIPv6Address"] = copyIP(data[24:40])
+	decodeTransportHeader(proto, data[40:], fields)
+}
+
+func decodeTransportHeader(proto uint8, data []byte, fields record.Map) {
+	switch proto {
+	case ipProtoTCP:
+		if len(data) < 14 {
+			return
+		}
+		fields["tcpControlBits"] = uint64(binary.BigEndian.Uint16(data[12:14]) & 0x01ff)
+		fallthrough
+	case ipProtoUDP:
+		if len(data) < 4 {
+			return
+		}
+		fields["sourceTransportPort"] = uint64(binary.BigEndian.Uint16(data[0:2]))
+		fields["destinationTransportPort"] = uint64(binary.BigEndian.Uint16(data[2:4]))
+	case ipProtoICMP:
+		if len(data) >= 2 {
+			fields["icmpTypeCodeIPv4"] = uint64(binary.BigEndian.Uint16(data[0:2]))
+		}
+	case ipProtoICMPv6:
+		if len(data) >= 2 {
+			fields["icmpTypeCodeIPv6"] = uint64(binary.BigEndian.Uint16(data[0:2]))
+		}
+	}
+}
+
+func decodeCounterSample(data []byte, expanded bool) (records []record.Record, err error) {
+	r := xdrReader{data: data}
+	r.uint32() // sequence number
+	if expanded {
+		r.uint32() // source ID type
+		r.uint32() // source ID index
+	} else {
+		r.uint32() // source ID
+	}
+	numRecords := r.uint32()
+	for i := uint32(0); i < numRecords; i++ {
+		format := r.uint32()
+		body := r.opaque()
+		if r.err != nil {
+			return nil, r.err
+		}
+		if enterprise, typ := splitFormat(format); enterprise != 0 || typ != counterGenericInterface {
+			continue
+		}
+		fields, err := decodeGenericInterfaceCounters(body)
+		if err != nil {
+			return nil, fmt.Errorf("counter record format %d: %v", counterGenericInterface, err)
+		}
+		records = append(records, record.Record{
+			Type:   record.Counters,
+			Fields: fields,
+		})
+	}
+	return records, r.err
+}
+
+func decodeGenericInterfaceCounters(data []byte) (record.Map, error) {
+	r := xdrReader{data: data}
+	fields := record.Map{
+		"ingressInterface":     uint64(r.uint32()),
+		"ingressInterfaceType": uint64(r.uint32()),
+		"ifSpeed":              r.uint64(),
+		"ifDirection":          uint64(r.uint32()),
+		"ifStatus":             uint64(r.uint32()),
+		"ifInOctets":           r.uint64(),
+		"ifInUcastPkts":        uint64(r.uint32()),
+		"ifInMulticastPkts":    uint64(r.uint32()),
+		"ifInBroadcastPkts":    uint64(r.uint32()),
+		"ifInDiscards":         uint64(r.uint32()),
+		"ifInErrors":           uint64(r.uint32()),
+		"ifInUnknownProtos":    uint64(r.uint32()),
+		"ifOutOctets":          r.uint64(),
+		"ifOutUcastPkts":       uint64(r.uint32()),
+		"ifOutMulticastPkts":   uint64(r.uint32()),
+		"ifOutBroadcastPkts":   uint64(r.uint32()),
+		"ifOutDiscards":        uint64(r.uint32()),
+		"ifOutErrors":          uint64(r.uint32()),
+		"ifPromiscuousMode":    uint64(r.uint32()),
+	}
+	return fields, r.err
+}
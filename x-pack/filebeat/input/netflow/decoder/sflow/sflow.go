@@ -0,0 +1,118 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sflow
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/protocol"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+)
+
+const (
+	ProtocolName = "sflow"
+	LogPrefix    = "[sflow] "
+
+	// ProtocolID is the version this protocol is registered under.
+	// sFlow datagrams start with a 32-bit version number instead of the
+	// 16-bit one used by NetFlow and IPFIX, so the upper half of the version
+	// word, which is what the decoder uses to select a protocol, is always
+	// zero.
+	ProtocolID uint16 = 0
+
+	// Version is the only sFlow version supported.
+	Version uint32 = 5
+)
+
+// timeNow is used to timestamp records, as sFlow datagrams don't carry
+// an export time.
+var timeNow = time.Now
+
+type SFlowProtocol struct {
+	logger *log.Logger
+}
+
+var _ protocol.Protocol = (*SFlowProtocol)(nil)
+
+func init() {
+	protocol.Registry.Register(ProtocolName, New)
+}
+
+func New(config config.Config) protocol.Protocol {
+	return &SFlowProtocol{
+		logger: log.New(config.LogOutput(), LogPrefix, 0),
+	}
+}
+
+func (*SFlowProtocol) Version() uint16 {
+	return ProtocolID
+}
+
+func (*SFlowProtocol) Start() error {
+	return nil
+}
+
+func (*SFlowProtocol) Stop() error {
+	return nil
+}
+
+func (p *SFlowProtocol) OnPacket(buf *bytes.Buffer, source net.Addr) (records []record.Record, err error) {
+	r := xdrReader{data: buf.Bytes()}
+	header := ReadDatagramHeader(&r)
+	if r.err != nil {
+		p.logger.Printf("Unable to read sFlow header: %v", r.err)
+		return nil, errors.Wrapf(r.err, "error reading header")
+	}
+	if header.Version != Version {
+		return nil, fmt.Errorf("sFlow version %d not supported", header.Version)
+	}
+	p.logger.Printf("Datagram from:%s agent:%s seq:%d samples:%d", source, header.AgentAddress, header.SequenceNo, header.NumSamples)
+
+	timestamp := timeNow().UTC()
+	metadata := header.ExporterMetadata(source, timestamp)
+	for i := uint32(0); i < header.NumSamples; i++ {
+		format := r.uint32()
+		body := r.opaque()
+		if r.err != nil {
+			p.logger.Printf("Sample %d overflows datagram from %s", i, source)
+			return records, errors.Wrapf(r.err, "error reading sample")
+		}
+		enterprise, sampleType := splitFormat(format)
+		if enterprise != 0 {
+			p.logger.Printf("Ignoring sample with enterprise %d format %d", enterprise, sampleType)
+			continue
+		}
+		var (
+			recs []record.Record
+			err  error
+		)
+		switch sampleType {
+		case sampleFlow, sampleExpandedFlow:
+			recs, err = decodeFlowSample(body, sampleType == sampleExpandedFlow)
+		case sampleCounters, sampleExpandedCounters:
+			recs, err = decodeCounterSample(body, sampleType == sampleExpandedCounters)
+		default:
+			p.logger.Printf("Ignoring sample with unknown format %d", sampleType)
+			continue
+		}
+		if err != nil {
+			p.logger.Printf("Error parsing sample format %d: %v", sampleType, err)
+			return records, errors.Wrapf(err, "error parsing sample")
+		}
+		for idx := range recs {
+			recs[idx].Timestamp = timestamp
+			recs[idx].Exporter = metadata
+		}
+		records = append(records, recs...)
+	}
+	return records, nil
+}
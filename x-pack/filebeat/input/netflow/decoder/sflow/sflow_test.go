@@ -0,0 +1,252 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sflow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/record"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/test"
+)
+
+// xdrWriter is used to build sFlow datagrams.
+type xdrWriter struct {
+	bytes.Buffer
+}
+
+func (w *xdrWriter) uint32(values ...uint32) *xdrWriter {
+	for _, v := range values {
+		binary.Write(&w.Buffer, binary.BigEndian, v)
+	}
+	return w
+}
+
+func (w *xdrWriter) uint64(v uint64) *xdrWriter {
+	binary.Write(&w.Buffer, binary.BigEndian, v)
+	return w
+}
+
+func (w *xdrWriter) opaque(data []byte) *xdrWriter {
+	w.uint32(uint32(len(data)))
+	w.Write(data)
+	w.Write(make([]byte, padding(len(data))))
+	return w
+}
+
+func mustDecodeHex(t testing.TB, s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestSFlowProtocol_New(t *testing.T) {
+	proto := New(config.Defaults())
+
+	assert.Nil(t, proto.Start())
+	assert.Equal(t, uint16(0), proto.Version())
+	assert.Nil(t, proto.Stop())
+}
+
+func TestSFlowProtocol_OnPacket(t *testing.T) {
+	now := time.Date(2021, 5, 1, 10, 30, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	// Ethernet + IPv4 + TCP headers of a sampled SYN/ACK.
+	packetHeader := mustDecodeHex(t,
+		"0050568a1b2c0050568a3d4e0800"+
+			"4500003c1c4640004006b1e6c0a80001c0a800c7"+
+			"01bbd4310000000100000001a0127210fe300000")
+
+	var flowSample xdrWriter
+	flowSample.uint32(
+		1,    // sequence number
+		3,    // source ID
+		1000, // sampling rate
+		5000, // sample pool
+		0,    // drops
+		3,    // input
+		5,    // output
+		2,    // number of records
+	)
+	var rawHeader xdrWriter
+	rawHeader.uint32(headerProtocolEthernet, 64, 4).opaque(packetHeader)
+	flowSample.uint32(flowRawPacketHeader).opaque(rawHeader.Bytes())
+	var extSwitch xdrWriter
+	extSwitch.uint32(10, 0, 20, 0)
+	flowSample.uint32(flowExtendedSwitch).opaque(extSwitch.Bytes())
+
+	var counters xdrWriter
+	counters.uint32(3, 6).uint64(1000000000).uint32(1, 3).
+		uint64(123456).uint32(100, 2, 3, 0, 1, 0).
+		uint64(654321).uint32(200, 4, 5, 0, 0, 0)
+	var counterSample xdrWriter
+	counterSample.uint32(7, 3, 1).uint32(counterGenericInterface).opaque(counters.Bytes())
+
+	var datagram xdrWriter
+	datagram.uint32(Version, addressTypeIPv4).Write(net.ParseIP("192.0.2.10").To4())
+	datagram.uint32(
+		0,    // sub agent ID
+		42,   // sequence number
+		1000, // uptime
+		3,    // number of samples
+	)
+	datagram.uint32(sampleFlow).opaque(flowSample.Bytes())
+	datagram.uint32(sampleCounters).opaque(counterSample.Bytes())
+	// Vendor-specific samples are skipped.
+	datagram.uint32(1<<12 | 1).opaque([]byte{1, 2, 3, 4})
+
+	exporter := record.Map{
+		"version":      uint64(5),
+		"timestamp":    now,
+		"uptimeMillis": uint64(1000),
+		"address":      "127.0.0.1:6343",
+		"agentAddress": net.ParseIP("192.0.2.10").To4(),
+		"subAgentId":   uint64(0),
+	}
+	expected := []record.Record{
+		{
+			Type:      record.Flow,
+			Timestamp: now,
+			Fields: record.Map{
+				"samplingInterval":         uint64(1000),
+				"packetDeltaCount":         uint64(1000),
+				"octetDeltaCount":          uint64(64000),
+				"ingressInterface":         uint64(3),
+				"egressInterface":          uint64(5),
+				"sourceMacAddress":         net.HardwareAddr{0x00, 0x50, 0x56, 0x8a, 0x3d, 0x4e},
+				"destinationMacAddress":    net.HardwareAddr{0x00, 0x50, 0x56, 0x8a, 0x1b, 0x2c},
+				"ethernetType":             uint64(0x0800),
+				"ipVersion":                uint64(4),
+				"ipClassOfService":         uint64(0),
+				"ipTTL":                    uint64(64),
+				"protocolIdentifier":       uint64(6),
+				"sourceIPv4Address":        net.ParseIP("192.168.0.1").To4(),
+				"destinationIPv4Address":   net.ParseIP("192.168.0.199").To4(),
+				"sourceTransportPort":      uint64(443),
+				"destinationTransportPort": uint64(54321),
+				"tcpControlBits":           uint64(0x12),
+				"vlanId":                   uint64(10),
+				"postVlanId":               uint64(20),
+			},
+			Exporter: exporter,
+		},
+		{
+			Type:      record.Counters,
+			Timestamp: now,
+			Fields: record.Map{
+				"ingressInterface":     uint64(3),
+				"ingressInterfaceType": uint64(6),
+				"ifSpeed":              uint64(1000000000),
+				"ifDirection":          uint64(1),
+				"ifStatus":             uint64(3),
+				"ifInOctets":           uint64(123456),
+				"ifInUcastPkts":        uint64(100),
+				"ifInMulticastPkts":    uint64(2),
+				"ifInBroadcastPkts":    uint64(3),
+				"ifInDiscards":         uint64(0),
+				"ifInErrors":           uint64(1),
+				"ifInUnknownProtos":    uint64(0),
+				"ifOutOctets":          uint64(654321),
+				"ifOutUcastPkts":       uint64(200),
+				"ifOutMulticastPkts":   uint64(4),
+				"ifOutBroadcastPkts":   uint64(5),
+				"ifOutDiscards":        uint64(0),
+				"ifOutErrors":          uint64(0),
+				"ifPromiscuousMode":    uint64(0),
+			},
+			Exporter: exporter,
+		},
+	}
+
+	proto := New(config.Defaults())
+	records, err := proto.OnPacket(&datagram.Buffer, test.MakeAddress(t, "127.0.0.1:6343"))
+	assert.NoError(t, err)
+	if assert.Len(t, records, len(expected)) {
+		for idx := range expected {
+			test.AssertRecordsEqual(t, expected[idx], records[idx])
+		}
+	}
+}
+
+func TestSFlowProtocol_OnPacketIPv6(t *testing.T) {
+	var ipv6 xdrWriter
+	ipv6.uint32(100, 17).
+		Write(net.ParseIP("2001:db8::1"))
+	ipv6.Write(net.ParseIP("2001:db8::2"))
+	ipv6.uint32(53, 40000, 0, 0)
+
+	var flowSample xdrWriter
+	flowSample.uint32(
+		1, // sequence number
+		0, // source ID type
+		3, // source ID index
+		1, // sampling rate
+		1, // sample pool
+		0, // drops
+		0, // input format
+		7, // input
+		2, // output format (multiple interfaces)
+		3, // output
+		1, // number of records
+	)
+	flowSample.uint32(flowIPv6).opaque(ipv6.Bytes())
+
+	var datagram xdrWriter
+	datagram.uint32(Version, addressTypeIPv6).Write(net.ParseIP("2001:db8::a"))
+	datagram.uint32(1, 1, 1, 1)
+	datagram.uint32(sampleExpandedFlow).opaque(flowSample.Bytes())
+
+	proto := New(config.Defaults())
+	records, err := proto.OnPacket(&datagram.Buffer, test.MakeAddress(t, "[2001:db8::a]:6343"))
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		fields := records[0].Fields
+		assert.Equal(t, uint64(7), fields["ingressInterface"])
+		assert.NotContains(t, fields, "egressInterface")
+		assert.Equal(t, net.ParseIP("2001:db8::1"), fields["sourceIPv6Address"])
+		assert.Equal(t, net.ParseIP("2001:db8::2"), fields["destinationIPv6Address"])
+		assert.Equal(t, uint64(17), fields["protocolIdentifier"])
+		assert.Equal(t, uint64(53), fields["sourceTransportPort"])
+		assert.Equal(t, uint64(100), fields["octetDeltaCount"])
+		assert.Equal(t, net.ParseIP("2001:db8::a"), records[0].Exporter["agentAddress"])
+		assert.Equal(t, uint64(1), records[0].Exporter["subAgentId"])
+	}
+}
+
+func TestSFlowProtocol_OnPacketErrors(t *testing.T) {
+	proto := New(config.Defaults())
+	source := test.MakeAddress(t, "127.0.0.1:6343")
+
+	t.Run("unsupported version", func(t *testing.T) {
+		var datagram xdrWriter
+		datagram.uint32(4, addressTypeIPv4, 0, 0, 0, 0, 0)
+		_, err := proto.OnPacket(&datagram.Buffer, source)
+		assert.Error(t, err)
+	})
+	t.Run("truncated header", func(t *testing.T) {
+		var datagram xdrWriter
+		datagram.uint32(Version, addressTypeIPv4)
+		_, err := proto.OnPacket(&datagram.Buffer, source)
+		assert.Error(t, err)
+	})
+	t.Run("truncated sample", func(t *testing.T) {
+		var datagram xdrWriter
+		datagram.uint32(Version, addressTypeIPv4, 0, 0, 0, 0, 1)
+		datagram.uint32(sampleFlow, 64, 1, 2)
+		_, err := proto.OnPacket(&datagram.Buffer, source)
+		assert.Error(t, err)
+	})
+}
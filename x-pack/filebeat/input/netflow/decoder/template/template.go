@@ -37,6 +37,9 @@ type Template struct {
 type FieldTemplate struct {
 	Length uint16
 	Info   *fields.Field
+	// Key identifies the field as defined in the template. It's only set
+	// for templates received from the exporter.
+	Key fields.Key
 }
 
 func PopulateFieldMap(dest record.Map, fields []FieldTemplate, variableLength bool, buffer *bytes.Buffer) error {
@@ -104,34 +104,41 @@ func (d DecoderV9) GetFields() fields.FieldDict {
 func ReadFields(d Decoder, buf *bytes.Buffer, count int) (record template.Template, err error) {
 	knownFields := d.GetFields()
 	logger := d.GetLogger()
-	record.Fields = make([]template.FieldTemplate, count)
+	record.Fields = make([]template.FieldTemplate, 0, count)
 	for i := 0; i < count; i++ {
 		key, length, err := d.ReadFieldDefinition(buf)
 		if err != nil {
 			return template.Template{}, io.EOF
 		}
-		field := template.FieldTemplate{
-			Length: length,
-		}
-		if length == template.VariableLength {
-			record.VariableLength = true
-			record.Length += 1
-		} else {
-			record.Length += int(field.Length)
-		}
-		if fieldInfo, found := knownFields[key]; found {
-			min, max := fieldInfo.Decoder.MinLength(), fieldInfo.Decoder.MaxLength()
-			if length == template.VariableLength || min <= field.Length && field.Length <= max {
-				field.Info = fieldInfo
-			} else if logger != nil {
-				logger.Printf("Size of field %s in template is out of bounds (size=%d, min=%d, max=%d)", fieldInfo.Name, field.Length, min, max)
-			}
+		addField(&record, knownFields, key, length, logger)
+	}
+	return record, nil
+}
+
+// addField appends a field with the given key and length to the template,
+// looking up its definition in knownFields.
+func addField(record *template.Template, knownFields fields.FieldDict, key fields.Key, length uint16, logger *log.Logger) {
+	field := template.FieldTemplate{
+		Length: length,
+		Key:    key,
+	}
+	if length == template.VariableLength {
+		record.VariableLength = true
+		record.Length += 1
+	} else {
+		record.Length += int(field.Length)
+	}
+	if fieldInfo, found := knownFields[key]; found {
+		min, max := fieldInfo.Decoder.MinLength(), fieldInfo.Decoder.MaxLength()
+		if length == template.VariableLength || min <= field.Length && field.Length <= max {
+			field.Info = fieldInfo
 		} else if logger != nil {
-			logger.Printf("Field %v in template not found", key)
+			logger.Printf("Size of field %s in template is out of bounds (size=%d, min=%d, max=%d)", fieldInfo.Name, field.Length, min, max)
 		}
-		record.Fields[i] = field
+	} else if logger != nil {
+		logger.Printf("Field %v in template not found", key)
 	}
-	return record, nil
+	record.Fields = append(record.Fields, field)
 }
 
 func ReadTemplateFlowSet(d Decoder, buf *bytes.Buffer) (templates []*template.Template, err error) {
@@ -0,0 +1,98 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package v9
+
+import (
+	"strconv"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/template"
+)
+
+// sessionSnapshot is the persisted form of the templates of a session.
+type sessionSnapshot struct {
+	Templates []templateSnapshot
+}
+
+// templateSnapshot is the persisted form of a template. Only the field
+// definitions received from the exporter are stored, so that fields are
+// looked up again when the template is restored.
+type templateSnapshot struct {
+	ID          uint16
+	ScopeFields int
+	IsOptions   bool
+	Fields      []fieldSnapshot
+}
+
+type fieldSnapshot struct {
+	EnterpriseID uint32
+	FieldID      uint16
+	Length       uint16
+}
+
+func newTemplateSnapshot(t *template.Template) templateSnapshot {
+	snapshot := templateSnapshot{
+		ID:          t.ID,
+		ScopeFields: t.ScopeFields,
+		IsOptions:   t.IsOptions,
+		Fields:      make([]fieldSnapshot, len(t.Fields)),
+	}
+	for idx, field := range t.Fields {
+		snapshot.Fields[idx] = fieldSnapshot{
+			EnterpriseID: field.Key.EnterpriseID,
+			FieldID:      field.Key.FieldID,
+			Length:       field.Length,
+		}
+	}
+	return snapshot
+}
+
+// templateStore saves the templates of each session into a persistent
+// store, and restores them when a session is created.
+type templateStore struct {
+	store    config.TemplateStore
+	decoder  Decoder
+	restored config.Counter
+}
+
+func storeKey(key SessionKey) string {
+	return key.Addr + "/" + strconv.FormatUint(uint64(key.SourceID), 10)
+}
+
+func (s *templateStore) save(key SessionKey, templates []*template.Template) error {
+	snapshot := sessionSnapshot{
+		Templates: make([]templateSnapshot, len(templates)),
+	}
+	for idx, t := range templates {
+		snapshot.Templates[idx] = newTemplateSnapshot(t)
+	}
+	return s.store.Put(storeKey(key), snapshot)
+}
+
+// load returns the persisted templates for the given session. An error is
+// returned when there is no snapshot for the session.
+func (s *templateStore) load(key SessionKey) ([]*template.Template, error) {
+	var snapshot sessionSnapshot
+	if err := s.store.Get(storeKey(key), &snapshot); err != nil {
+		return nil, err
+	}
+	knownFields, logger := s.decoder.GetFields(), s.decoder.GetLogger()
+	templates := make([]*template.Template, len(snapshot.Templates))
+	for idx, ts := range snapshot.Templates {
+		t := &template.Template{
+			ID:          ts.ID,
+			ScopeFields: ts.ScopeFields,
+			IsOptions:   ts.IsOptions,
+			Fields:      make([]template.FieldTemplate, 0, len(ts.Fields)),
+		}
+		for _, field := range ts.Fields {
+			addField(t, knownFields, fields.Key{EnterpriseID: field.EnterpriseID, FieldID: field.FieldID}, field.Length, logger)
+		}
+		templates[idx] = t
+	}
+	s.restored.Add(uint64(len(templates)))
+	return templates, nil
+}
@@ -0,0 +1,195 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package v9
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/test"
+)
+
+// memStore is a TemplateStore that keeps encoded values in memory.
+type memStore map[string][]byte
+
+func (s memStore) Put(key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s[key] = data
+	return nil
+}
+
+func (s memStore) Get(key string, value interface{}) error {
+	data, found := s[key]
+	if !found {
+		return errors.New("key not found")
+	}
+	return json.Unmarshal(data, value)
+}
+
+type counter uint64
+
+func (c *counter) Add(delta uint64) {
+	*c += counter(delta)
+}
+
+func TestTemplatePersistence(t *testing.T) {
+	addr := test.MakeAddress(t, "127.0.0.1:12345")
+	templatePacket := []uint16{
+		// Header
+		// Version, Count, Uptime, Ts, SeqNo, Source
+		9, 1, 11, 11, 22, 22, 0, 33, 0, 1234,
+		// Set #1 (template)
+		0, 16, /*len of set*/
+		256, 2, /*count*/
+		8, 4, // sourceIPv4Address
+		12, 4, // destinationIPv4Address
+	}
+	dataPacket := []uint16{
+		// Header
+		// Version, Count, Uptime, Ts, SeqNo, Source
+		9, 1, 11, 11, 22, 22, 0, 5000, 0, 1234,
+		// Set #1 (data)
+		256, 12, /*len of set*/
+		0x0a00, 0x0001,
+		0x0a00, 0x0002,
+	}
+
+	store := memStore{}
+	cfg := config.Defaults()
+	cfg.WithTemplateStore(store)
+
+	proto := New(cfg)
+	flows, err := proto.OnPacket(test.MakePacket(templatePacket), addr)
+	assert.NoError(t, err)
+	assert.Empty(t, flows)
+	assert.Contains(t, store, "127.0.0.1:12345/1234")
+
+	t.Run("restored after restart", func(t *testing.T) {
+		var restored, missing counter
+		cfg := config.Defaults()
+		cfg.WithTemplateStore(store).WithStats(config.Stats{
+			MissingTemplate:   &missing,
+			RestoredTemplates: &restored,
+		})
+		proto := New(cfg)
+		flows, err := proto.OnPacket(test.MakePacket(dataPacket), addr)
+		assert.NoError(t, err)
+		if assert.Len(t, flows, 1) {
+			assert.Equal(t, net.ParseIP("10.0.0.1").To4(), flows[0].Fields["sourceIPv4Address"])
+			assert.Equal(t, net.ParseIP("10.0.0.2").To4(), flows[0].Fields["destinationIPv4Address"])
+		}
+		assert.Equal(t, counter(1), restored)
+		assert.Zero(t, missing)
+	})
+
+	t.Run("other session", func(t *testing.T) {
+		var restored, missing counter
+		cfg := config.Defaults()
+		cfg.WithTemplateStore(store).WithStats(config.Stats{
+			MissingTemplate:   &missing,
+			RestoredTemplates: &restored,
+		})
+		proto := New(cfg)
+		flows, err := proto.OnPacket(test.MakePacket(dataPacket), test.MakeAddress(t, "127.0.0.2:12345"))
+		assert.NoError(t, err)
+		assert.Empty(t, flows)
+		assert.Zero(t, restored)
+		assert.Equal(t, counter(1), missing)
+	})
+
+	t.Run("dropped on reset", func(t *testing.T) {
+		cfg := config.Defaults()
+		cfg.WithTemplateStore(store)
+		proto := New(cfg)
+		flows, err := proto.OnPacket(test.MakePacket(dataPacket), addr)
+		assert.NoError(t, err)
+		assert.Len(t, flows, 1)
+
+		// Sequence number goes back: the exporter has been restarted.
+		reset := append([]uint16(nil), dataPacket...)
+		reset[7] = 1
+		flows, err = proto.OnPacket(test.MakePacket(reset), addr)
+		assert.NoError(t, err)
+		assert.Empty(t, flows)
+
+		proto = New(cfg)
+		flows, err = proto.OnPacket(test.MakePacket(dataPacket), addr)
+		assert.NoError(t, err)
+		assert.Empty(t, flows)
+	})
+}
+
+// countingStore is a memStore that counts the writes.
+type countingStore struct {
+	memStore
+	puts int
+}
+
+func (s *countingStore) Put(key string, value interface{}) error {
+	s.puts++
+	return s.memStore.Put(key, value)
+}
+
+func TestTemplatePersistenceWrites(t *testing.T) {
+	addr := test.MakeAddress(t, "127.0.0.1:12345")
+	templatePacket := func(seq uint16, fieldLen uint16) *bytes.Buffer {
+		return test.MakePacket([]uint16{
+			// Header
+			// Version, Count, Uptime, Ts, SeqNo, Source
+			9, 1, 11, 11, 22, 22, 0, seq, 0, 1234,
+			// Set #1 (template)
+			0, 16, /*len of set*/
+			256, 2, /*count*/
+			8, 4, // sourceIPv4Address
+			12, fieldLen, // destinationIPv4Address
+		})
+	}
+
+	store := &countingStore{memStore: memStore{}}
+	cfg := config.Defaults()
+	cfg.WithTemplateStore(store)
+	proto := New(cfg).(*NetflowV9Protocol)
+
+	for seq := uint16(1); seq <= 3; seq++ {
+		_, err := proto.OnPacket(templatePacket(seq, 4), addr)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, store.puts, "resent templates must not be saved again")
+
+	_, err := proto.OnPacket(templatePacket(4, 8), addr)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, store.puts, "modified template must be saved")
+
+	var snapshot sessionSnapshot
+	key := "127.0.0.1:12345/1234"
+
+	// The first cleanup marks the template as unused, the second expires it.
+	proto.Session.cleanup()
+	proto.Session.cleanup()
+	assert.NoError(t, store.Get(key, &snapshot))
+	assert.Empty(t, snapshot.Templates)
+
+	_, err = proto.OnPacket(templatePacket(5, 4), addr)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Get(key, &snapshot))
+	assert.Len(t, snapshot.Templates, 1)
+
+	// Expired sessions are also removed from the store.
+	for i := 0; i < 3; i++ {
+		proto.Session.cleanup()
+	}
+	assert.Empty(t, proto.Session.Sessions)
+	assert.NoError(t, store.Get(key, &snapshot))
+	assert.Empty(t, snapshot.Templates)
+}
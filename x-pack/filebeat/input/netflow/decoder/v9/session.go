@@ -7,6 +7,7 @@ package v9
 import (
 	"log"
 	"net"
+	"reflect"
 	"sync"
 	"time"
 
@@ -42,6 +43,12 @@ type SessionState struct {
 	lastSequence uint32
 	logger       *log.Logger
 	Delete       atomic.Bool
+
+	key   SessionKey
+	store *templateStore
+	// restored is set for sessions recovered from the template store,
+	// as their last sequence number is not known.
+	restored bool
 }
 
 // NewSession creates a new session.
@@ -52,12 +59,15 @@ func NewSession(logger *log.Logger) *SessionState {
 	}
 }
 
-// AddTemplate adds the passed template.
-func (s *SessionState) AddTemplate(t *template.Template) {
+// AddTemplate adds the passed template. It returns false when the session
+// already had an identical template with the same ID.
+func (s *SessionState) AddTemplate(t *template.Template) (changed bool) {
 	s.logger.Printf("state %p addTemplate %d %p", s, t.ID, t)
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
+	prev, found := s.Templates[TemplateKey(t.ID)]
 	s.Templates[TemplateKey(t.ID)] = &TemplateWrapper{Template: t}
+	return !found || !reflect.DeepEqual(newTemplateSnapshot(prev.Template), newTemplateSnapshot(t))
 }
 
 // GetTemplate returns a template by ID.
@@ -72,6 +82,38 @@ func (s *SessionState) GetTemplate(id uint16) (template *template.Template) {
 	return template
 }
 
+// Save persists the templates of the session, if a template store is
+// configured.
+func (s *SessionState) Save() {
+	if s.store == nil {
+		return
+	}
+	s.mutex.RLock()
+	templates := make([]*template.Template, 0, len(s.Templates))
+	for _, wrapper := range s.Templates {
+		templates = append(templates, wrapper.Template)
+	}
+	s.mutex.RUnlock()
+	if err := s.store.save(s.key, templates); err != nil {
+		s.logger.Printf("Failed to persist templates for session %v: %v", s.key, err)
+	}
+}
+
+// restore loads the persisted templates for the session.
+func (s *SessionState) restore() {
+	templates, err := s.store.load(s.key)
+	if err != nil || len(templates) == 0 {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, t := range templates {
+		s.Templates[TemplateKey(t.ID)] = &TemplateWrapper{Template: t}
+	}
+	s.restored = true
+	s.logger.Printf("Restored %d templates for session %v", len(templates), s.key)
+}
+
 // ExpireTemplates will remove those templates that have not been used
 // since the last call to ExpireTemplates.
 func (s *SessionState) ExpireTemplates() (alive int, removed int) {
@@ -96,6 +138,9 @@ func (s *SessionState) ExpireTemplates() (alive int, removed int) {
 		}
 		s.mutex.Unlock()
 	}
+	if removed > 0 {
+		s.Save()
+	}
 	return total - removed, removed
 }
 
@@ -103,12 +148,19 @@ func (s *SessionState) ExpireTemplates() (alive int, removed int) {
 // given sequence number.
 func (s *SessionState) CheckReset(seqNum uint32) (prev uint32, reset bool) {
 	s.mutex.Lock()
-	defer s.mutex.Unlock()
 	prev = s.lastSequence
-	if reset = !isValidSequence(prev, seqNum); reset {
+	if s.restored {
+		// The sequence number is unknown after a restart, so the first
+		// packet can't be used to detect a reset.
+		s.restored = false
+	} else if reset = !isValidSequence(prev, seqNum); reset {
 		s.Templates = make(map[TemplateKey]*TemplateWrapper)
 	}
 	s.lastSequence = seqNum
+	s.mutex.Unlock()
+	if reset {
+		s.Save()
+	}
 	return
 }
 
@@ -121,6 +173,7 @@ type SessionMap struct {
 	mutex    sync.RWMutex
 	Sessions map[SessionKey]*SessionState
 	logger   *log.Logger
+	store    *templateStore
 }
 
 // NewSessionMap returns a new SessionMap.
@@ -144,6 +197,11 @@ func (m *SessionMap) GetOrCreate(key SessionKey) *SessionState {
 		m.mutex.Lock()
 		if session, found = m.Sessions[key]; !found {
 			session = NewSession(m.logger)
+			if m.store != nil {
+				session.key = key
+				session.store = m.store
+				session.restore()
+			}
 			m.Sessions[key] = session
 		}
 		m.mutex.Unlock()
@@ -151,6 +209,32 @@ func (m *SessionMap) GetOrCreate(key SessionKey) *SessionState {
 	return session
 }
 
+// Remove deletes all the sessions for the given exporter address, regardless
+// of their source ID. It returns the number of sessions removed.
+func (m *SessionMap) Remove(addr net.Addr) (removed int) {
+	remote := addr.String()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for key := range m.Sessions {
+		if key.Addr == remote {
+			delete(m.Sessions, key)
+			m.forget(key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// forget removes the persisted templates of a session.
+func (m *SessionMap) forget(key SessionKey) {
+	if m.store == nil {
+		return
+	}
+	if err := m.store.save(key, nil); err != nil {
+		m.logger.Printf("Failed to remove persisted templates for session %v: %v", key, err)
+	}
+}
+
 func (m *SessionMap) cleanup() (aliveSession int, removedSession int, aliveTemplates int, removedTemplates int) {
 	var toDelete []SessionKey
 	m.mutex.RLock()
@@ -170,6 +254,7 @@ func (m *SessionMap) cleanup() (aliveSession int, removedSession int, aliveTempl
 		for _, key := range toDelete {
 			if session, found := m.Sessions[key]; found && session.Delete.Load() {
 				delete(m.Sessions, key)
+				m.forget(key)
 				removedSession++
 			}
 		}
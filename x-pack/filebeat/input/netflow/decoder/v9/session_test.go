@@ -272,3 +272,15 @@ func TestSessionCheckReset(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionMap_Remove(t *testing.T) {
+	sm := NewSessionMap(logger)
+	sm.GetOrCreate(makeSessionKey(t, "127.0.0.1:1234", 42))
+	sm.GetOrCreate(makeSessionKey(t, "127.0.0.1:1234", 43))
+	other := sm.GetOrCreate(makeSessionKey(t, "127.0.0.1:1235", 42))
+
+	assert.Equal(t, 2, sm.Remove(test.MakeAddress(t, "127.0.0.1:1234")))
+	assert.Len(t, sm.Sessions, 1)
+	assert.True(t, other == sm.GetOrCreate(makeSessionKey(t, "127.0.0.1:1235", 42)))
+	assert.Zero(t, sm.Remove(test.MakeAddress(t, "127.0.0.1:1234")))
+}
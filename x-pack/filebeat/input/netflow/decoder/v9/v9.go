@@ -31,6 +31,7 @@ type NetflowV9Protocol struct {
 	timeout     time.Duration
 	done        chan struct{}
 	detectReset bool
+	stats       config.Stats
 }
 
 func init() {
@@ -43,13 +44,22 @@ func New(config config.Config) protocol.Protocol {
 }
 
 func NewProtocolWithDecoder(decoder Decoder, config config.Config, logger *log.Logger) *NetflowV9Protocol {
-	return &NetflowV9Protocol{
+	proto := &NetflowV9Protocol{
 		decoder:     decoder,
 		Session:     NewSessionMap(logger),
 		logger:      logger,
 		timeout:     config.ExpirationTimeout(),
 		detectReset: config.SequenceResetEnabled(),
+		stats:       config.Stats(),
 	}
+	if store := config.TemplateStore(); store != nil {
+		proto.Session.store = &templateStore{
+			store:    store,
+			decoder:  decoder,
+			restored: config.Stats().RestoredTemplates,
+		}
+	}
+	return proto
 }
 
 func (*NetflowV9Protocol) Version() uint16 {
@@ -71,6 +81,14 @@ func (p *NetflowV9Protocol) Stop() error {
 	return nil
 }
 
+// CloseSession discards all sessions, and their templates, for the given
+// exporter address.
+func (p *NetflowV9Protocol) CloseSession(source net.Addr) {
+	if n := p.Session.Remove(source); n > 0 {
+		p.logger.Printf("Closed %d sessions from %s", n, source)
+	}
+}
+
 func (p *NetflowV9Protocol) OnPacket(buf *bytes.Buffer, source net.Addr) (flows []record.Record, err error) {
 	header, payload, numFlowSets, err := p.decoder.ReadPacketHeader(buf)
 	if err != nil {
@@ -127,6 +145,7 @@ func (p *NetflowV9Protocol) parseSet(
 			return template.Apply(buf, 0)
 		}
 		p.logger.Printf("No template for ID %d", setID)
+		p.stats.MissingTemplate.Add(1)
 		return nil, nil
 	}
 
@@ -135,8 +154,16 @@ func (p *NetflowV9Protocol) parseSet(
 	if err != nil {
 		return nil, err
 	}
+	changed := false
 	for _, template := range templates {
-		session.AddTemplate(template)
+		if session.AddTemplate(template) {
+			changed = true
+		}
+	}
+	// Exporters may resend their templates in every packet, only write
+	// them to the store when they are new or have been modified.
+	if changed {
+		session.Save()
 	}
 	return flows, nil
 }
@@ -19,5 +19,5 @@ func init() {
 // AssetNetflow returns asset data.
 // This is the base64 encoded zlib format compressed contents of input/netflow.
 func AssetNetflow() string {
-	return "eJysXc2S2ziSvvdTKGYOe9nusF1lt+3DnmYndg67O4c57A0BkSkKXSTAAkCp1E+/AfBHpAhQyAR96Imx9X3ETyIBZCYy/5r455e/Hv51FuZwEjUchDlUIEFzC+Vvh7+pg1T20KhSnG6//ZLM+OvhDW4/DxLsqVbXXw4HK2wNPw9/+R+wf6/V9S+/HA4lmEKL1golfx7+45fD4XD4u4C6NIeTVs1h+OWBy/Lwj3/+/R//d3BU5rdfDoeT/9lPD/n1IHkD80+5P/bWws9DpVXXDn9Twol3tWUe+/Nw4rWB6Z9WDXnamN+Gn82bMm+Oa8D0l2N73uB2Vbqc/X3k0+7Pv87gYQd1mj6voVC6HFBHKA/H28G6qYMLSPvbL6tmwEertAW9asp8aJ405L/B8pJbftBQO6k4WHWwZ5i4DyVcRAEHe+b2Ljt9u/oG/zbjexyweWt5WWowZvFv8bF70mz35z+HJv6bcfJxVfpt/MZByMM//vnT/fPhpHTD56M3b5NRnS6Aiccv962qlaxwTfrfowF94e6fD6VquGvH39yQXs+iOM9H7XAER28iDbOiAWN50wYbVnILuIb9SzTg5dtBndD18xv5ete677NG1LUITxh+aP5LXT1qKV2tVoWbsDM3hyOAPOhOSiGrf3dT2H8fCiXL2DhdQBuhZLCNQlqoFqsjoZnjYhyID52BMrD0+NGourPAQGu1XoCl6o41BGC9fLJWqZqdRXVm9qzBnFVdrjj8EG8z1OqaQaAta3jbClnlNmXGtFuTWtCsM6CJbTsVrNXKqkLVK8goGJso5v4qquTXUKnkrRF/+rXPTjWvDOK7C7CF4izFe7f+epygbWtR9PBjZ4QEY37VUMOFy2LNExmzGUnBLVRK37CjMKOYLTQagd/FMhpwtrZlnRbMWG6FsaJYT4k5K21TaAzoX3kF0lIoxFpsE1D0npvuuMcMWs1PJ1H8WtTcrIcuIkTasqIWIC0bNmXW7yX8QzRdk8si5A4shsDQ8uINLGoUVCctc4cqpsG0ShrAwyVcWaGkhMLNCB5P//KEZGdhrKo0b9ixc4PweUeuLztyvezI9boj19cdub7tyPU7gctqLk0jjCFJo0dztChnKpJMDZKhOsaxp7b8AY9u+hKPa7u7yIDOHfsgC7ofIRZSb3InJExD7U/+9OB3Jassr7PHIciCHoYQC24UeoaZWsnr0JqI2KcVEaJbxkBzrKFkJ82rxh1evPJMhXelGBABw1AEcwHNK2DueqG51uLiuiACx8Yw/li17rRvhezPjdww2TXH5O87vIQPy3j5By9cj8kMZ9Uy0V5e2draNFyh2ufob2h0q+GS1/rBEkWBXngtSmFv/p6znrPITeMoTu6uXgrdny3TcbIkDLFD+VXhL/nuP+m3W3//CF2iwmPS/xx37fEYcRpvPiArIQFxbytUXcNCcdxNVysOb76LUSjNCtC2b0vybN7B6KlZQHGif4eSp1Y1jZKs1ap1nQbMNCt5EiXIAlgNF1ibeWJGOHefogyTlBndHK9wbidgZaeHi3pEQqI9HlnmGwxmxKTVvHhLh5Tccna8WUBtQh5VC/nm9jC3++FUzAou/kTYwh7RQWfNNro30RumoRb8KGphbyuGo1I1cBlggNpy5pUrasxmGyhaOFfgVsNJfORgWQ2ysufkOVuy4PTICkxv/rf85je8iLY+uoHMCahaohQVGMvO3Jzdph4wAEdER7hvF+MtW53YclCivaHRbcgmkTAuLamEoyMvv68T0w7dvHOheygN4509Ky0st+KSfBBwwAJ39nGQwIYQF1NpWPPB4KM4c1khP9R8+AUKGkKOic1vSlMiT3Ue9cF6TyxmBFurKd96J0A0doNyoA70bbo4YzqmNXozloYZxRl8tEIna6QBhLs/jygNJw1mrbufoaxeb9PbGANa8PXJcRPUEATDKK4pMH3BbiEjSgunOtbj8RRpua6A8MUriOqMxFmLGXv7YZk7niHGUNnP76zojFUNaFaCQBzjHrG5B4QlX3SCYstwCR92lj1acql58A4RnzmPJwwmrc8G9EW4XVQay+VjtM6m0ITxOzXD8grHgB9ordoWSlbzG+gvTBUWLOuvF6ibRYimt1NSaHKbkfv93uad0YCBgNACY0efOBOyhPUFJYyDyoe3HLXiZcGNpbdgYPKG2hNPjux4hIWPHJvY9nwzouA19dudFLt0/aJPyesfZKFvrYWyD6lRtarWiz6qJtE2wAEQHNsYxJ5BS7DsDLwEjby0TuiW32rFyxg8qmAmgn466HDUCbY/SRL9G33kIF4Ke9gU3LWOG9we6R5uCtsyYzXwBqXIB/jMLhALTdv8vhuzWl0H8xRlDY00DRjDK8ihoKrxMfSTYuWesGgD2RyJs01NSKpVpyfwIY19sGv6AQY+LGjJ67HBTAOv17epyGidhIYrr2vm48cRKGOZP8wzqSSDprW3adMdvUXJkXQ93YoIZ9LtSQbokUsZ8I9F9biPxWRclszwpq2dDKSOv19tvLDi0nunVYeY9x5srRbHzgYCsLaBaL9cjxodC40otMJ5Fx4JNhxYGwQgy+FoRm2CY9jEht1nMyza9TZhJZfUz2rgBjlbDkb8mrkZ1rUYJ72H4iRflDVV7t/g5o7ITrsHwuM3vlnzI9ReS2NQfmU7Feudr/5wcEm26wQYTMsLIdf3uy0CqKGYtmj87WRJQr1kLVnIV6UZjdKM15W7MJ/XO8+2EBjLtc3UBj0HVR+MaJpG6NFEndCD6UDaArf8WAM71Z0599s+ftp7ihb4Gw6r9JXr0i0hY7nt0k8I4zUg/GjiGUqUIO0UuZHa2BGtTieDsXaeruxY8+JNdX5uk0XZR3GfRNW5iw/Gt+eQ1jCjC2aq5Lm4DpMfDoxIASG2iiuDDxs5ZG4N5IRivMb0bIKVYArEOfDKzrw+MdWCxEn2HHgWVapjYoHToWtOFNfwD2YAFWV9ujLd1QgHw+nKTNc0XN9Y+4bUE1f2p5LAWi4wp+k5KugMCeOqWh1nd6CsN2uVBnc2Sfy198QPXnnV2TZwDIqavD22PwuE3Y/RpeGRQgoruNu2NEpf9OB2MglFFFwaOHhL24D2Y8Q0l5UPwqVjA+O1gZ0OPKQvP6LTv21t6/S6dcooaAOLLj4PHa0wMWPUNrq/cLD2rHnAB/wM+975UBNl1rKRBm3AntV62aWBI07GbXB/rGCFKhGmg+n1Hgu/3ot+UhRN67/ljU2px5IFan2F2UK5X2x8MNrDNXj93QQwupcjKr2X1YBKB0jlzkuzmDyCwXFkGdxfQ1BgLg3V/DnS5OLJfhQh+4wIPsy5Bn8K9gOMmpkQCeW9b4gIY1kP4oOuORRF8HSC6kS/lxyhSt5N4iwgU483IQ4DDZeYx88hkk6KwDOjjTHdzeU5UmE9Piscxuk5gpuutpl+y5GK7EAdCbI9qCMRxoU6HEOZLVrc/bwHWqV7TZd6i7nDcE/b7rNMVEMjHLvyLegGSsEt4N07Hk1274iW9S9d1GkMTkFAS3HqYf2poVUi3UUkWpq/+o4bvUA4F4fIeeQlct54ze5EkS5HZviOI3e51VBAGQyXjYMMFMy06witaDM3IwDiqEAAX7xRSKe7n2JxRnzh8spUi3ld7iFadRY0C9iSwqJw+cbgw4I0PjuIF+jk7822lORufQjuNtKrCbsuU2BIreagfd4v7AcHFOV70jAuzRXj2p2AXhEGfKzPkT6UmoAbryMfmCulB5v+gShhhIxlhbDYjC53rDsr6Fv4Ap0OJ3695lbYLvDlU614VJwcUMmKhtRQ+VxGtP4OaIlHD3FnrBDtGS3OI/gNbuiArAUBziDlod5YclYGfQGawJ1e7zkpMIqBxqFFYwQz3bFPmYgd6/p3xtsWcWCbgQgjVHMLskg1PnuIhgvZFzwRkN3AnsHogqiwHJKqsDyWrrDmcOLXSQrLA0kKyyHpCmuGJnTXnjVwG7E7hs5AS1DA3vgEhNNNNdcVjFKMkd+FyQ8v/pkWw8yw/QDcdI2/7b13XCen9Mo0WAbgOc0wUMWMfFu45e0p/Awoci4NEmD9/kESXBBjLSSwgusS0XdVMdUmd1RdQbNCsFo0Yt23WDqEhuu3xPY0/IMdxZGBtFokz7xDDYgpqycGOsReoyJpHDAnmvABj44fWuDxEUQLOAE6hLe48ZY+JEfDkFco9fThaEixurPQBYKc3I9yCJRouobRbBgjetBOGQwIU0gDXA6R/OlRIR40zAgK1vuYm/IrK85QvIUyUUXb2WNNodJdQw1Y0KRo9wasVgwuRQgSPRzcURgHViOOrOCt7fSYdwzrJfEMSlofjoR9KjQH485rDukdXOjwWodsVNnVWPOMA6rjH1AQfXwz/BisF9gANkZqAJNbbW7SBkJCUqA+9IYdQ86v5w3uwbhcbCt4xbtAjoVE8DoF+jPtvWKIZ9QIHfJX+P7caKwOhVOnDqHCrawF1q9uK4q35A3lkaGTRlQSktWYOPoEzBuyHgNK4mFHDNsJOVh6xYA/8CwZCEeeJQEanHMIGNAZh4CBAXMIUEdRgzdgITRTD2qMMCVGAysprPK78vg24n6wxQ51gGsmNVi2tjbDmw9jefHGSmjTR/0RjJuzRzTSNRfDf/6Uy7BOio0kWGfCRhIkR4LFCNY5r5EEyVFlMYJ1dmskwfdcgh8oApJ/eYGkeZg9hVXt0AP4SLY3PCDRHvk1HueUf8CT8r49cNAVwZ0Dswc8IDEXGAe9tLL3XrM+91jVCRPyOEU5bDEd7zC3lxYdDtRDxmgiH29o4L3z2TtRCW97ovHSPVoTkSYKz6GhAHEBzax6g9QgvXtAmIZ7oYwTL9LvYZLHHstExs4BhDSiBGYuBSY6ckAi0+Y4lNKi8umOZEWLTvIknaW22pc3QrXYI3BXRAd775TlDD4KgBLKyMTEv+oO+pHLzeZnp3cr6C9ilIQEawruTpkFHyuhoB8bjCSacc698RY5ync82m26xuIctgG8kAXXQyIvlOZZclloWl/bJadDnRQ2t1f3oj/uEN610xP+XcgQ70/vRAUvzsA0lEKPUjdLXVwojVFGiaykhMgz8p5Q2+SleIfOunbmQvoIQ4yHbYMqfU8OklAGotPaPwIVBUgDjs10TbLx405UHllRW0o0yYLDHRWO3NDXWXlktapErCZbQje2K8I9nY/yyDS843eJRwKK4h/ApsVdK9bwyJNuHDxvDDp8IOMd7y1LNAnoX/n1+qGvkFEMNQLKsHpMaE6Mszek7MGqQZagd2vkQLdH+0jvJR9I+ie1ZGkY3yKOKZb/DG+YGKZCqTeR15iT0ld2OtIEdCKoMwhwz0EDBNhHoQEKXVyyBsHhc8agT06NiCQMcHR6bQJA4QnhkAGai0Dkyg3gP4acHlA60cqgMrmybXJl27BaRTKEYLqRJ5wmUzgNMz4ALlPTzGnWpuZknv5QQexL/EgRNwtMFO426y5Mgze75YGDTUI3Rhp0oMKKgliyNc5jQTdTNQnKFWyTEJWtc8lZnLmUPjtMahXQKN6XlcEfx5YsqfVDo/hdWpFaeTSK36UVqTVLo/hdWpFa7TSKz2lFf1jNunPOeMTaFRLyQwSxNe9kEbLFpy60nsbbT7VVEYMCgewqZKmuSIt5kC5Lj3gK36ASap4alRgl+UPY9BibKMvw5vhe5zZzxIfZwxuCHik+shdFb4jfoS02qy132xS+QsWSanhp2FfwYFKR2pN5j3QUNKOCQ3o1RZf++ve7rsG8K1qxDNVos1i8tmMNOD0uDCJR5JJnrPDbtSW3ELdepLTpgWvDdJHCRl05Y+niDAkbKPbZCuZkO2wFPV2mHp+RZOjxGct+enxGmicBefr3HlCfsSBGkqqjuBXmDBoGRZ7s/w/zGCD7mOY0wwr3yXaYgfU7fgLZ8PCCRJXjIx39LjRrv9/NWl4B2ct2ZyCBP2aOI4oHrDHi7twL2jmejoFWna81LIiSNWhIawmGnglLMPKYm6lVNSYFpMjOwICtb7QicMNmLG/W159nfcj0wnbyTaqr/PL7OoQyGYq3S0xQvDFhguItABMUf22foPi79gRNTa8egK4jK5Oh65jKZOg6mjIV+p0uTd/p0vSdLk3f6dL0nS5N3+nS9J0uTd/p0vSdLk3f6dL0gy5NP+jS9IMuTT/o0vSDLk0/6NL0gy5NP+jS9IMuTT/I0vTyiSxNL5/I0vTyiSxNL5/I0vTyiSxNL5/I0vTyiSxNL5/I0vTyiSxNL5/o0hR4epIMpUvTZ7o0faZL02e6NH2mS9PntTQlHMwnNF2gPtMF6vNaoBBt/rKWqQTbyYSmi9UXulh9WYsVps2I5NsB9Fq4MGhE7u4Ami5fX9byhfnwWsQQ6JcsEXtZixgGTZeyl7WUIdbVy1rKMGi6Cnuhb4gvdPl6oeuvF/qG+ErfEF/pmuuVLlOv9A3xlb4hvtKl6ZUuTa90aXrN0lavWRvi17VMYdBrscKg15KF6PdXunB9pQvXV7pwfaUL11e6cH1Lfj69xgZMN8lYunnghX55faXfyl7pt7JX+knlNXDWSB3i18Bun46lT+0rfeW9fksf5Os8vgKfcLBPj94naMfUdVkV90V9VFlm+up/6kpITzjhM9McTjzZBOT6DOpoQF/6GM0hMCXZhxLA4jyQcwJfDID27R6KcV7O0T5XDyF9TYADncBmxYFPYbOioMDHCrIE+SEmGs3OMEpdMtk5RVXL38ccU8mhCMPT9prRq8kHKXDJKyYKN9mGFappa7DJ7ywf4EPZFCq81WDSX6FP4CyFZdhJyAo0a3Wo9EhcUWFTUSuDf/PefmmZheIsVa0qRHkAcqZt8qbR8tIn+MStgSFlDKZjfY4ZkFarNjm0YJnUBlmbrlW1KG7sXQ31HaYiv+wsQHNdnFPDzWZM7x34vHihEmFp4FKrNlVBPWJx39WITdj/Ol4ubGOQJ5zsGub+L+JNzgzt4yuJSGiRQYhtHzpr7PimpeFFVP3GhdqzKPv5nRWdsaoBzS41D2qxJ03xJDRsRh2nEZ9TzGnkwJcZ8sjMJOdrDoIKdByNT8qzR2sCTFlt2qExO7SCvjOtOKjtICcaa/v3PO1yyftYM69G3H+Qi86zGdXpAnKJlq1CnyVjLLjj5MQy9InejDsBoQVZsp4l5XnynSfZw5DR9yD8xqHFpY9StqBbLQwyi5p/8qo54y3mUDyAfDqW2E71FD3mfyi4hUoF08M/5YjXJtvuLnwMBRdDcfgbg92j/bX8CGd+EZjX8CO8qgxhsDNyZiwoTqIGXBKYBbzmsupQYclLOPrx/QKOzsnwgKZVLA+S4K+SSxbTKmkA/fh9wYJKL7FEEhJLjATBTMXboiNkoZqN22kiGlcKdgU3Z966/0Vd3GIksVdyT2duKvZK0j1uAQ0pVoNJWoI78iP2Gx4byTS9PWiqs5UiT/uEpk37BM+Z9hUJedpbrVrQgccYT9fbu4K7+I0714omUlIsSDKKIIVkGpGclkwk1JZoTlBcGhplgbh47mDC6tEyUFP2mcQMb4jcx3CGzjkB6WhmSCeUe8merZIHT2mICTVHuN/ZxmMGm5WwR1H1ef7wVUpaA12p2FVonzHRalUz/5FHfETtzOB5l8kZUfrpeAYKnjCjIquBm+REo/2PmQVMyVwNNbfiAgy0Dhy6YzXN+nNWCcjq83cYbu/R4I6FwPjRqLqz+NYOcKnkrRmy0UUSC2zMRYjEOzHEe4ea1IFolj5ouKxha2mGqEglhEJElVYdNp9wiAdTbCqEz2+B6Y7ZI3wB7R/t+mSSWosLrzHPX0eeY9UuNBA3OMPCnMfHk/DyD164u2s2E6nqf4wFZ9uas7QaLvv0ajAb5VBceC1KYW/+PplsuB8ZerdDejKAJY4mqA+ePHTltIlH1fWY+vlpxMf2QA5USrMCtO3bRumZkifhHSishgusz7bP1P8seMt0zZTHOxpF8qxXebmxRx5fq+F4s7gX/Qt0LeTbUEU3Vlrj6eiuiFD+whgL9rgzY9FQKF0apqEW/ChqxIP7iccbq71ZkTS2OSfFKElfRmUPDmQplggbTVOvSPK79W2/bpE8BSEiqutq4hIVGMvO3Jz7YndYEfRlZ4oh5JOp08OgRXuZR7sh65nEcWnDEk9+tP0Zd+x+irfvCecyfqKEtZH42cp4ZNhroSx5sWlRIjQ5zr4IJdr9t+QhD3neiAxmJkKBoSc8OzfLckTFgSUXfWK0alsoc+NRNunwzupHur2atVd7yD78CFFGi4x1e+vpJApUrOCIh8pXzDpqxcu80JkHRn+/P/GAdRcHD598kzja880I71HKa0snxa5Dc9EntAYCWehba6EkRfveWYiX2AEYnItnUHsGLWF6sEM7lE4sT6pPPlV4E9Fm8VwEDeliNlZPLmzLjNXAG5L2nqL6s+wBPQupEv5IcRIarryucfUCZ2hj2ep1Fu3m3JMNFEcuJcbHM5HUvDKMy5IZ3rQ+XSJ6SNxtmRfeJ2BFA6ojXHt6Emu1OHahVMZpBP0+lWF46lmExpUbXaJHI9Hmq6mUZtyJ8voDspxGJq9JfYXqPTjye7T1GCyRIuIsS5nlIRlpztfNzTBcrtUFhSjr3PV2r/JLaYBXGE6ZemvvWHk8n8m0vAjVJEshghoKO77Xo59al2S5h/IlW/aRekanNON15a5mZ0SC7yWRT8K9j3oYi87vw5KnInqWTCXRk+QT0Fd6X+7Jxz1h6v1NeM37csyo0tAr9Bh+EQkZTWyDOp0MEHRVpYG9QerLshHlLaiDNVV1tg1oySfd9wy9JvFPKfEt9wxDiW1hQJMsuj1JO10IIsOIIwkeORMo+pEcnok1HHsLD3AExjWBY1KnWS15ZEG3RRRN27/wEu1lnbbjiYAt0MnJHRZo98uNBjyV0DUJ4jnomoQ8CiMaPwrVgMYDpdJQzl2HGRaPkW2wBw6+zL3ocs15s+A7BjV4bez7TRq4EFlOCFGIkGIkCPIETYUkKlp0RYip1za+OMIOExl/YoznMtBwaUWBPiqEyDopMC+X71S7m2pHSqp9dIWnGGtHkqar7U521pEy2wA8Eu1mAR4JKSbg4cDEbNHSzq1D7g2FjjV9hNNiTu9SkqkWRxqq5rGgGygFt0A3eGa8hZ9R5DyHn9FkWdbv+NHsSo18EnvEP4o9wh9nJ3rUS8IAfodBwScsmIENFMy0qe/ZZsBN/8ZztF2brJ439hJ5c/gUeXll4kz44uWVKa9H0MrIQbXqLGhmAm9fNoXr8s0/LpK+DFO/eNDfn2142G7XXFdASWF3J5idxukWt50O9TuFHgRoSKm6gnw79S4jg9gDn4EqdiFIwS+1mr/04FVbkIpqzQqS0TyAtZDACq5LwuioK2hWCFaLUC2+Z4HRDf+YXLsk66gj2MOl9MBDthkveOhW4wUNnSLLL9zwD9F0TebWOLIMy3AHJsJOO1ReY035lRVnKN5Mt/ZzPF2+I4spFN7a0YAFnRUy0IDVisGlIL1ruqMptppGyMx1KuROvp0VE32tLpkyVuuSiEyyx3IbWHZYbgMTZbkpKazS03PwC6/vepU6PAHOmQRQWdvaDO5rY3nxxkpo8SP1SEIb70cW8g0qxhSoIUTmWmfbJlMham08o0IU3nhGtc7NTaZCFBZ6RrXOrU6mWueVJ1Mhii4sqLJMDguGXKMDPYNdhIFszlnz0Cw6DzxZD5weuPZQT3c2yv7ywEA50DiKSyt7k8bwfKbqhDlToh/vpngN98ffJ17YQK6AbbGW3NJiQh1wyN/sQ1MHoWEaeL0+7CaQtconzCC03yNpxmYHp8xndomEOxGtVMKEzymZcCfZp/TByJddQyBEhKwlEKegzDeutkB6i3JfnKNqDSCpiDSZZsLd7IPZQryXRXC7tsDzNZBfZWCTirbfZ1YdiNBgqw9EaHBVCFYkOdUIJjJqVYIZAVFnEqoUjFhqtYIJnxsknO3AR9cjeABi6xI8wkn1CSYSVKL+BYqQsD+AJyTuD7AgE/gHGdCJ/O8seyT0X7Lt9Mg6J8H/gmOH4IY9Ev4/ctH96HsUAIhzZWiT3QoCPGPcpY07Nm7HVuVvCjsUDlhyZZtf9i0ksGbNLCjwQJhZWOAZG+0EmV1oYIMoo0W7rKFdVs8+62afFbNHrhJagYIJnVmo4M5Dzs06UeTlaA3R7LM+KTlbQ+CgheTpDJEzrI5wYqbVRzgt+lWfipevXz+xP4R1F+MM+86KiWzdeWCi23a0vQdMUsxfDm/gvfM5CSPL7ql8bLzUf/L5EdmoEt30EUu7TI9ozWWpGupr4emhcPzVa0ovHEP/hjqzGX3wAOm98sSR24ZhX9qJxnUG+3jgzqHarqY8Eb0zaHWMpW58pvcmEsLbxl3esq+fsaOb4ZVSbk6PMahwME6WsX3guaLJDHXsn1f2CVMHnYfeTvd46D5x0L8uxseBvWF0LAy8E934DjWbrn2zOzbOs+W2jbhfgPFB56SYPdPwus7x4+XepOZ4QprTNZwYAJB7kZvjs/qRmal1j1vTTpYAo4usPHTG8gy5sjxzECyXJdelr9c2FubIy08foMxNXWZuxkLjnVjZjl1btIwXbxn39oFBqmsNZTW8BabcmB3ReM09kp7GOob5DZcmwv5dpZCZQ5L1Fs8R0N4zOWRrzpmN1ybHkuMYnt3iEhh6hUSfQ3PLncNOV/kMbj14dwflLus4rkKW7h5V8JpgLZkzkBxd81hrYhaN+6aSa/WcbU+5VkvbSQl1lhu6K/fQN45lfINB3RIcR9aC6aR3LFCflHdtm/NeytfCop2EfcogH+jocyN6T2y4bOaTLlyEtl0ffX/f+P37csq7lDgbsZsPfPuwdB2pb1Tb+kWzE63hV/fJ4sz9ksUb9jzcGEpnr7YlninzDb35Bt5swy7VoJtjyMUbcCmGW5rBNs9QSzfQZhlmcwyyVENspgE2x/BKN7hmGVopBtYswyrdoJpnSM02oFINpzkG0xxDKd5AupNhdCeD6D6G0H0MoETD51gkA4simEmNqN8Yb4eoHYSUUM2qVHMq0YyabT6lmk2J5tJsM2mOeTTTLBqEm8gtKc5CNKoaU/uKAMyAFryOHcTinZ/wopLcdhqz9EbsVPFRKsv4KWQGQZIc4aRoTcGlJRpxQ0EFnxMV+VXRoh7eeUwtQNLaqppWDxoPdwd2aHX8A4rIq6XNBg/AcO7eTWTbHWtRsDe4bezKqQwRnbAJHyqXR6YpvqgGHHIzorg2yC6NHV0ZeBdGTMxQjouS28Apm+6wyHZU0B0UeY4JukOC7IjAOyDojge6w4HuaCA7GOiOBbpDIceRQHcgkB0HFpq2dvslxkRoT9YJeQ04nephQatPHCIaMJY361j8yOiPv78Xy78nbfkVeWXPcKaMB0CMGS7f8bKDwyXD0ZLnYMlxrJAdKkRHCtGBQnCcOAgOke1iuYjWQs19YopkEwrKHxP98D5emDzvS5LXJdYDrK/l0ly5DpRP5NYito2BZZ3Ym0RjQfI+C76NKL3AyTeMDbx2TcPiVvGSYHzLkTxpC/TM3kJo/Mz6QUeTOn/5cLI3pMfTKnB2iHXfL3Psqxe8c/DKuXGH1Eb8yQersE+GmvpFolOR4EwkOxE//EFqvgWO+W9EyxLlac2BE4ceP0gS9fNJgvj/AQAA//9/k9FY"
+	return "eJzEfUGT4zaS7r1/BWPm8GYi7I6qkrrc1RF+hxfzJtaHnZ2DI3ZvCIhMUXCRAAsApZJ//UaCoIqSSImZYNteh3e3W9+HBJBMAJmJxF9n/vPpr9mvO+WyraogUy4rQYOVHorP2T9Mpo3PalOo7fHzpx5x759PP2avcPyWafDbyhw+ZZlXvoJv2V/+Bf6flTn85VOWFeByqxqvjP6W/d9PWZZl/1RQFS7bWlNn8ZeZ1EX2y7//+cv/ZEjlPn/Ksm342bcA+THTsoZhU/g//tjAt6y0pm3inxSwlW3lRcB+y7aycnD6qytB7grzOf5sKMpQHBTg9Ie9PK9wPBhbDP58omn899cdBFhmtqfmLeTGFlHgDRTZ5ph5nDrYg/afP12JAe+NsR7sgPl6aO4I8p/gZSG9zCxUqBWZN5nfwYk7K2Cvcsj8TvoP3enk6gTuB2tswIbSyqKw4NzZ302P3R2x8d//H0X8Pw7142Dsa99GpnT2y7+/4V9nW2NrORy9M5lK0F7ckkw1NKF++fdJCLMNI+nC5MoS9JQUzrQ2B6Eu+99JUBld0mT4r40Du5f411lhaomj8Q+c2MNO5bvh3GUbQHo3JVi7EUHw5WT75R/n4+LazY+hiZsaNiqdVzU4L+vLGepEK6QHmmi/qhqCQUIoStl9AxOttw22L2pVVcotNDj/YQ4Bdf4FNtbk4Fy2ky7bAOjMtlorXf6Aat61D7nRxdQs7sE6ZfS4emsPJViamL3BisRZ62A4R327aitcA1B8ujMwN1rrdERpD3Yrc8hy0+L//Tn75fRHoYkwFBvlXdaAzbrhGJeoUBZyfz4c30Gqom0qeMelFb5lD9nPWatftTnoH7LH7Ods21bVj91Pfsiesp+znay2pz9YZT9nSv+QrbOfM9P68X44L33rvm8nujY+Z/9P+ewB9w6olrKolY5/Fb6WjfLZY9BY0+DqoIyWVfzBuOxKC5N78N9F/F+Nl1Wm23oDFj/iriW0JKD2UGRGB1lPyEkR21w6L5rX7yPmv04CtlphS1kj81eepHVbefUHSXtqK0HejTWy+IPkPbWVIG+hXC5t8Z0lVXpjWl2c5IytTplWpQVYa+wfLFVYpXOjvVQaiqwTYVLCaPVEY403f9b4ZRvIZesA1Vfq3hJnxmatdm2DazwUWRAxN9V4X0zr/3ib5a3Urlbez1ZWFPPPsls8af9c28WT+c+1XzyZ49fwnaU1rZ/4CKcl+yOs2JVc0Yy1VRFcIBs4G9aBwbhl4BprauXy1rRO4E7ve/Thv3fgd2DPpxn3Ykpng/YzbD/72+Pf0axhj/729PcRoeXGmar10C0dn67OTKbdVDAC6060ojGmEjtV7oTfWXA7U01s7m8zVOaQQGC9qGXTKF2mijJgWkykBqxoHVimbNtc9AvRp6mz2k2UQKJPU/6Va6g2+lir38O2XWwrWTpCu2dgD/lOq7cWCARNU6m8a3vTOqXBuR8tVLCXOoe5YzYgyaWH0tgjdRQGFIOPlEcQnG8JAuy8b0RrlcAjlHJe5ddT4nbG+jk0DmznXuFQqIKD4vfctZslZtBbud2q/Me8ks7NVSLrRV4pdHVFX6Lo3DvyXdVtncqi9AIsjsEQ1zsKDlcegb5gYcE1RjsgDWKAaziI3GjduVroeH7LJ6TYKedNaWUtNi0OwuOCXE8Lcq0W5FovyPVlQa7nBbl+YnDFzZ1zLG0MaElW5URDkmhBEkxHP/ZcyS/wSifhabJj5ANs6tiPsii9AAurN6kTMk6j9BI0nA7RVyWPHpLkcRhlUXoBFtoodHIMzEpah66JlF6GiNAt56DeVFCIrZVljZuXYDznwttCRQTYuZg9WFmCwOOFldaqPXZB1TATvykbUYDzSnfbT+lEFzYg4DW8Y/z4N5ljj9kMO9MI1ezXI6HoeIRq7qOfyejGwj5N+hi65kD3slKF8sdwzoG5J42NwkSRG2G8SZwuGEOsdNF9FeGQj/+5xk0dy8P5Y+wQNT4m3c9px56AUdv+5AO6VPoikeDmqOSmquDMcHxEk684MCw+SWGsyMH6ThYgtm8sfWrOoM9MKHtqTV0bjU6XBjsNlGk2eqsK0DmICvZQzXbC4XmKM0xaJ3SzP8LhDlIUrY0H9QkNmexxzzJcYCgjpr2V+et8CGY0ic3RA2kRCqhK6Vdcw3BRp5mYK7j6HeaP9SV6NMfsNrrL6XHCQqXkRlXKH68YNsZUIPUIA1ReimBcSWM2WEDJyjlcfQO4sbBV7ylYUYEu/W72nJ2z0OzIhQjPUYQUbIL4tcwnpZ9cQIYEXCtRqBKcFzvpdmIvqxbmqg46N3Uet33CbM92Y6qZ7A2Pbr9emvA5mbDP/GuWY9qvF+Qi91A7IVu/M1ah83oPsxVZO5HT9j6FHl11p9VUO1G/C3jPd1KXxIbq9/Bxg4WxwMTNNrUriLs67Jl+F13qJmUEG285bb0xIJa6QGkn3lqwx9PBmdIxa8mLsXbCGSngvVEWCOqLINr5uUdZ2GK0j4zy9kjEOLBKVjRQzVAMZ6TlwOyeuoT0KKuMHdu23EV6aUtgtHgAVe6IOO8pY+/fvcDNHWEMjX98E3nrvKnBigIUYRt3iU3dIJzzTU7Q1Gd4Do8ryxKS7Cs5eoaYnrmAZwwmr8/o6VS4ImvnMZo9JuuE0ozjFxLDy5LGQB9oa5oGClHJI9inLm1OdMcL0slijKZzeHJoUsVIbb/zeScIEAkYErgQwsKYuFC6gPeZOChxFznMduNKEJlO+UtM2PiW4ya22R2dymX1QULDxyTH5K7v7Xb29w86t8fGQ9Gl1JjKlMf5ZpLsA4yA0bGdgmBKmgYvdiALsMRD6wndyGNlZDEFnzQwJ4JuOvjwsS5Pw8JOkhnf6K4S0bWwg/UpYeL6Ks/tke7gLveNcN6CrEmGPMIHfoEoB619jAmhW6lzT3G+oZ6mBucw4pNAwTXjkYDl5T5hb57OVXMb+cxDcr06HQFmWcb7Z2OqMzVWHqyWVS+wsCCreu5obZWFg6wqEa69ElDOi7CZF9poAXXjj73ZPkWLHI3uiojm0u1kitCN1BrsfDsecjGF1IVwsm4qsPPHPzhxMYy776LTpiXMewf23qpN68ERgeS4XIfqAwu1yq2hRRcuCW4EsG4QgC7i1owrAjLcxI6HzwZYcujthNVSc5u1IB1xthDGbM0dnWgbSpA+QGmar4qKq/evcMQtMlp3YyltVnIDVbDSFFT4stHEorTd5mAvKz6Da2SudEkiAAzA9ks0/XRyTsI9ZJ2zRIuZRGOskFWJzqRdTVQC5zG1Js0adBxce9CjeRahQzNtQgfmA3kfuJebCsS2at2uW/bp095RNCBfaVhjD9IW+BFOXKmeMoX9MWD80sQ9FKYAxH3k2Go5IWyPNtuto3g7twexqWT+ivetHORubnshi3urytZCQYrtbQ8i9+jTzYUrZ8/FIU7+eGLEHBBhk3QQmLs1vsm8NZAnlJCVZzSGUcScsA88CKwOIEwDmqbZQyDeRuLg7NgxZxJXy3fhgJRlvT0I21ZAGg/X1rW0R7w9Th2R340G0UhF2U0PUaPBkHFcWZnN4AyUdGettCBe4Tjz1yESH6PypvVN6+e7vAM2GPSJ8OPkpxGQSiuvJC5blmQvOnBzcglNGLh54NFT2g1oN0bCYlgYs4r5WKUp2NOGh9XyJXp+23jBDK/wg57wgU1+fOFSWe+FmXJG3UZ3Bw7R7Kx0QMa+tSHVxDjPhNbgd6ZggieCjLfB3bZC5GMXhKc/pv72nhi/vTfZpMprnNwipG+s525LzlDPJBT+4kaDkz28Bj9zwORe9qj5vSwjaj5AG9wvDXLyGA7HniWGv2JSYCoN1/3Z06Ti49GOQaC7Qm7o5IEKggM+DDBpZsZIOPd9x4hUQdDfMfxoaI5EQUvVGGPoVqINlLNXk2kW0EUCh4Naasrl5zGSVivvKGO6WMizp6JGfK5w46bnNnhQVCVVfnYAVemFIqhK00OocRsqfN7QzucdEK8G3Kr2cwNGu9r2MctMM9TDqV++B1tDofCWNzm8o3RKeEc1ItxaCUmrXY4LAVqobQfrthyNUdoT4Kx49Qcu6jAxFVGlXPK6ANOCgIMz0USXJ2b4A8fucoN17IrRdNlpkINcuEbNF/NmBsA0ylcEoYhBd9yRCrUjtLBfC9NQbpeHJqxpPVjh8pmqsH9GHxRo9M5EhZ7d3mBJmd2tdyXFxpqDGw9dzoERrRpCu3LF1AYjitMeZrxrdwDLAAZDCI6BDKnUDFx/HHn3VLDrLogyRsh5keM9Ty4W9wr2OH6Ang9ntl5Jr3w70vK2MnJSnRBodMlDWijxE2X2N6I1HR3zzkSumh1YJhgjqBPmeHrbPSQY3e7ebjv4hnbGkQ9AJ3BrFQvGcdAgWtVOCdducM83dmv6Nrr6ScimGbNxE+Z7AGKMENYC0vlc53OA4L1ybiz4RMAOAwcGDD3xDBYiuQYrYPkGawhnts4yWNguz2Ahkm+wBmhGd7G6nvQTfsfmLuiZDKLZpgq9xf0GmqK/Zy4/uvqfwenn7Qjnfr4jcLw7jqe9t1ZacByexF508BQxHPRhexLu/PQ0fg1oYl86SkCN+4+S0JIYK6wogYWUCX03pTDN7I6aA1iRK1GpWl33baocQi3t60x5MCy9URsB2ls1e+YRFRGnqp4UaMy9JmXSYJunZDlG9tAFnpw/dIanZxCdwRnQmN7iRAM6pORgsDDUFZq7+0CaXt1Jaj5IXWDoycdWjoDCEk2C58Po0dE6JTAQXCE1SB0z+ednhQRQnBFCMkkfY66LLyLfQf46VolqUs4O63LTwHyQB8vKdq/BWyNgn49BJjcHHyh/JEipsEJq41vb1x2jRkkCA8b+3z29XNAQTNuvITLcPSOn1yKyNkVbUd0zCDSb3yBnxvgG+D5ZDyxlpCKYLbU7ai/fWdCQeiM2Y8Gv+wJ3YFottit4KdsSuODeSl/Cp633FcN0RQ3VzMB3207n7Vg69dwhNKpgY8PX7VX+6riD2GqnSg0FAY8FmG/o+hRQMzc7SouzdGf6dueSgb7hOWdgbHnOCcjglE2A0qmbAKXJmwCzURUEBxbBMnWg2ilXUCyw0cob/ARPdyM+NrbUoR7hGmgNla2pXLzz4TxWJiugmT/ql2DanF2i47Fu9gRO4B8fUhmeUglWqQTrVIIvqQTPqQQ/pRJ8TSV4IRGw4stnSF6EOVB408QewHvDRJIj8tf45xQ8q+7bBcfZ/DE5KGvABZJygEHovtFd9Fp0dczKVrmxiNMkB6b1xCGnnF4acjpQB+mzidB9Jxy8tZjVQCt42xH1h+7em0h0UQSO+HydFd68gp7b/CkhzMLHQxlbmc8/h2kZ76/MHTsEKO1UAcLtc1XM72hEEsvmIMpYVYZyR7rkZScFktZzpQ4PJJEkDgjaEREbemuNx+JmOUABxcTETLeKG/2Jw83NZk/3VsgtUrwcGrzLJe4yc9m/hEK+bNCTWCGlDJFa4ih/4Mlh02ssLWA7glc6lzYW8iJZnnMuD3WD8dykDmFWbWqvTkYobMLb5nSFfxEywv3TD6Jc5jsQFgple60blC7OjaUYo5msrILIA/KO0HpPhw66tpNKh/RESoTtBpUqqJ/6GQlnIFprcSQqlQM+ypAb7doa6ETFRuSV52STnHHgVmEjHf87KzaiMqXSE/uVGd04FQ0aFeHufBQbvG5FXyUuCTiGP4JdQztWXMNvvZI9G542Bi09kfEDH9xjPA0ICUrR4ASPR7QyoIvx6/kzxJniDDZ8EVYLGhOtlxIy0i0hH+u+5AVJd6WWrQ39XcS+xPLv4wsmhSk35lWlCbM19iC2G56CngiqBALaddARAuql0BEKm++TBgHxKWPQFae2SX1obZWGZ6RDjtDslUzCv8eaHljUxtgEKpeq2y5Vt52oTJ76lbtE5XSJyumEA59uaYY0T3yeblPB7Mv0lmLaLXCiULnEw0ofzW6k33G60dOQExWuKE7HJu6CdMmDt8r6atOjqy1VsDPCsZV35rBjyX+NztrikSXTAB+elXGJLE+p+EWkWKXiF5FinYpfRIovqfgUKbrNatKZc8CjmllxiFFsJVudj/ni535oXVeC/9R6M5GyyyA7KF2YA9FjPko3uoMnUQSBCqjk3KzESZLflJ+fYzPJEu8cf7xzmzjicfZ8qlz2PfmjCDxuAVl8kiwfvqnJKvwzRzjeNOxe8BDasORJPEdil3hOBUQGM8XX/uqnOC3Ee0VXLPE12iSWYO1EDWjHlau5k9q/8Ns2mOcz7b2YI9MF1w3XxRw27pcTBzdFwyLFMkvBkGyBpaCjS7TjA5IEOz5gWc6OD0jTNCDN/n4k1Cd8ED1J2aqC7kgeMuDl52DIXRqPA3aMaUgTv/BQbAfTX5YgixcvWFQpMdI+7jK6ubo7uGE1azBnnxtl+2Bggd8HgaMxK3WXwal+VZvwc9wdA2taXDasYmpWtJDebzd8LMPJ446uMmX/NANHdyID9X2jKwL8IJ2XdUPuQ2IUttWv2hz0008PfOgjH/rEh6740DUf+oUPfeZDf+JDv/KhL2zoV742feVr01e+Nn3la9NXvjZ95WvTV742feVr01e+Nn3la9MLX5te+Nr0wtemF742vfC16YWvTS98bXrha9MLX5te2Nq0emBr0+qBrU2rB7Y2rR7Y2rR6YGvT6oGtTasHtjatHtjatHpga9Pqga9Njw98KF+bHvna9MjXpke+Nj3ytenxmbMxP6H5CvXIV6jHlxSZnx44vpMTmq9WT3y1elolybxOQn9JQj8nofn69fQ1qeGXFPQqScVWj0lovpatVinf1WqdhOabsNUzH8rXrxXffq1e2ND1Ax/Kt1xrvk6tV3zomg/la9Oar01rvjatk6zV+iXl0/vykIR+TEI/pfT7C1+5vvCV6wtfub7wlesLX7meV3TPaY/9moDluwdW/MPrmn8qW/NPZWv+TmX99MIe4vXqKQHLn9o1/8tbP88f5MMwv4JecLCrrR7qWZPedbl63JfUqMFaH+H1P3OIpTdY+JE6hSyeZII4CHQGs8HQU5ejGRNTVMHH0iKQQ4LwGACv7Q5KCV4O0Rh44pSvGeEgF7C54qCXsLmi4MD7F2QZ+sMsNJpcYZT7ySTXFDWNfOtrTLnZCtddba8E/zX5UYpnHgXaPSdyg0+T+9n3LC/g8dkULryx4ObfQj+BR4zOfKPhxFbpEqxo7NjTI9OGilqK2jj6nffmCW945zttKlMeCThupW32otHIIhT4pH0DsWQMpWMBECp6muZIbAcvipR+N1EreuoA05hK5UfxZuL7DqdHfsVOgZU23x3nDtIH01sLLUw8ETYPXFjTOCaW1q4llEkOv55+LmzylDjA6bYW+P86FjrkVzKR0BCTEJsudRZr3nQaUct80vxOK3VgMf7xTeSt86YGK/aVHLVid0QJJDxswjtOPT7lMaeeg/7MUECebbsZdu+Kg2ECkaPO5SUTU5oRpiSZFhBmASn4K9MVB1cOdqGxANeyOf/kQ65ZMCP4H+JHF9icaW0OqUTnUpH3klMszzyW2Ce+GB8EDAmSdD1Jy9P0O02z45Dx1yD6wmHVvstS9mAbqxyxilq48mrxiihlUxxBoRzL1Ep1F93Xf8ilh9KMloe/yxHv/qiC2F18ti08uGg95TuPaDwriQ3s5F5RbsP38LJ0jMFOqJlxRrHFurGkIjBn8ErqspUlt3X65fszOLkmwwWa92L5KAn9KHnO4hqD6f3Uy+9nLKTyEudIRmGJnmC0UvFt1VE6N/WN0+lMdLzOw4W7nWzwf5MOblMkU7fk7s6c0im2Bz+gWGJ1tEiLamZgn+nYiUrTtwfNtL407Gk/oXnTfoKnTPsVCXvaG2sasP5I/97eDHyoX79yzX1SbJREaT7JaUTgfQESriRWevpAWqiNB+bH8wFmfD1W53SNiXeIsDGao3NIwNqaOdYO5ePJnltPHtylYRbU7OF45+60zRCDJ+xJVF2dvxvdmDqhOmgLIw7K4s079JFWIjRyiZ8wOwN42mFyQKQ4rY/uMCdVFl+VMnpmM92PBT6YM39OLOAzjHsQYO3IpnvqTbNun4WBbNIS9AGjrT0WcFsIQm6cqVpPlzbCtdHHOlajmygscGMuxkhCEEO9tcAgGpQPioc16luaY1SsJ4TGiEpr2mYBgVSRhk+XAItIp47wHmy4tIsLq7ToHqgo1197nk3ZnDmipKM5FoY8IZ9EFr/JHM/7yUysV/+nWJ7ZLA0+ybtIr6LbKIViLytV4Au7eJ6EuatVzxCiamP6PwvHU9SLSB755bQTj6mqmOl0P+Pj9kBGKmNFDhbX/Vx6Ts+M3uLOIUc/yx4qsvkfJG9hNkRfx1twe5VWG7vnwQLMoeIPyS96hq6Ufo2v6E49rXF3dK+ISPHCKRbqdmfAgjUwbIE1RiolN6oiXLg/8QRndXArssY2Zac4SdI9o7IEx5S78e6onLHxLPUVSXq3npfrFitSMEbEDV2duFSJntGddLvusTuqCoZnZ/KY8okR7KF0N94OTKPdr78X8fNixHF1V83yjPv1d+Bk9/w8f6IARf4yLhmW+lDOeallUSZo4ogtKRk5/HfOwx7ytBGJbibGA0N3eBYWy0vCiwPnXPyJsaZpoEjNR7lJRw9WX9ItJdZS8rBj+BNECRI5j7vm7VblpFzBHg8lWmexsUYWaakzF4yoeXYrc0iEj+98Z3E0u6NTIaKUJkur1aJDs7dbsgUCndtj46FgZft+sDAPsRE4Ohf3oH4HVsPpwg5vU3piOU/UpRu8E9HNx3MJNGNDch8eX0/OPZaXtyBrlvU+ZfUn+QM6FtZL+D3FVlk4yKqaeCTuzuRulcWksMvbWbRM23OySLHBYt2WPiTBpS0kllaUeN/BMoYET8voy9h3D/eb1jM6E0i8t2rTjpUynkfQLZwJjqdw8i+UpT03eo7unUQ3b03NEeODKK0/WDizH5k0kZBpGY70Ht26DDaTYiJYNmeWYzHSlNbd0QlardUzClVUqd/bxyu/HAGCwUBjikKcXh5PZ3KNzMfeJJtDBOg27u/r8Xet52Spm/JztmiwF6EzVsgKUzb9jlDg+5wIL4ksZB76R+eXYUkzER1LopHoSNIJ+F9699wTfmUT+Yl38FZ2zzGPh67vWboe3adfTKSMzpTBbLcOPF1PSwviFY7EZoMHNXpTTeub1lO7HxjCNHZXKemSB4buJigqs2V5dDuS5vSo/MQw0khGt5wzKLqRjNfEavmezqE0h+NkTpMkuWQhy6LyuulueKEPmKpgZ+hnFhp/eUOAuxp6TfKcQsIehR5NH4UyoulAbSwUw9BhgsejZ4v+wBjLXIou1Z03SL4TUEGwxqHfrIEbI0tJIRqTThUMNRzjGXUVsqh42RVjTJ3NCo8jLDCR01eM6VwOaqm9yslbhTEyfEvdccZ+cVet0hfO1lT8uMWZR1K3lV/Iz6r0Qg5gpRf2ACvNdwHHDZPwecPbt3YE3pBzTS/hvJzTDy1JNIs9Ddfy4BOTUGAVEr7DM+Eu/ICCdB1+mibJs/6Bj9rNznxSS+Q/XpA8c0n6Hf3EoNyd3R6/wKDQCxYMwA5y4RpF78DN+MZ9tK8Ywk7dObyL3K+F2jFa3K+FCXbEkbu4XwtrWiyJ5HKicu2fw+UijaV64sdDbn+w4FG7XUlb9k+HsVahs9043+N2RsNfEyNNqjtxhIZVqmuUb6HeJVQQu+Bz0LugWPhzqxYOe3TTNkrF9WaNkvEigBXGuHNpC8bomANGU5Wo1NhbfPcSo2v5fgrtsryjSHCKhCR4jC942D7jMx6+1/iMhk/RawdLK2r5ruq2Tlwae5b4GS7AxFhp48troi6+iHwH+atra/rn27O43NC9HTV4sEkpAzV4awTs8zHoDOF7NMdXUyud+J0qvVBsp1Z6ofjOBVPC13pOxCZZ4nNTeqnPTWn252a08saeroPjjbWTXeUOzwjnQAO4rFi5qgtfOy/zV1FA43epJLzxvmSJazrjU59genxYjutpOarVclTr5ai+LEf1vBzVT8tRfV2O6oVJleRyOGM43wYzxWFVsJtgYLtzrnmel+BJuuB0wXU298ljzVlfLhg4Gxqk2De6c2nE6zNlq9yOk/344Yq38HH5eyvx6ilRrbFQHysnFIGxfjN2pVcaYUFWNYesMaFgBkP+gOQ5m7EXnA1q8hMJH0S8pxJO+JQnEz5I4osDI84QDt9IOW/e9CS8JTBNwZnvIUu8Jc4+UYxwsc8UV1z8U8UVFZMm0U24mH8wWYmX8gjeflvg/jcw9kQAb7EfpXpOo+K9OjBBQ319YIKG9grBFcmI+aKbHe6rBAMCps1kvFLQY7mvFZzwqUnCcRHjf7Vxh84RnfUuwSWc9T7BiYRUqP8MxSjYP4JnFO4fYSEW8B9lIBfy/2BZoqD/OdtCl6xTCvyfcSyQ3LBEwf9LLn4cfYkHAKa5EqzJR8n6JaVb5GGAEcYFhVtQqmjTFxErfX1Ie0DgjGaxhwSuWWNtiIUIz6Vkbx+n2J7T2GJf08X6IEqQaJFvaJGvZ5nvZpkvZolaJbwHCk7oxIcKPnjYtVlPFGk1Wsdolvk+B4QqRZpRD8ndGWJXWO3hzEqrl3Be9qvd5qsvXx7Eb8rjwTjBv3PFxPbuXDDxfTvWfyRMjk7uHY1HvMN3C3Q++dnd1Y8bN/XvNN8ja1MAF8s7TPdoK3Vh6lMomDj8p4vC07de5/QC3ezobkkWI5Dw7iufOFJliOvSQjTYGWBzmKatOFdEPxis2UyVbrxn904ko+fdmQKk3GU/J2GNZLBuqTU9YoSvd07GC+yOYWgSUx2765UoysnmkZfTJS66nzj4rav+ciB+8i76+GEpuv4eajJd8+oXFC6wpcrGXC/AhaRzVs6eq7FCT/yKOFv11JPUEM8oc3oNZyYApB7khvikfiRWal3i1LSQJ8DZPKkOnfMyQa+8TBwEL3WBCeP4Xls8JSXWpx+hPPfhM8b46DzgJlH55MAuXiLEtBf+uT0yaHOooCi7q62sEzMS9cfcDetqLDIMT7g8FUaWrdKJQxJzLXgfNIrAu8+EyMbtEoW3LsWT4/P7p7gZDJ1B4s+hO6bOYWvLdAb8HkJCCFhePw5KF3iOymUFaQysQNcw15pZReNjUUn1eg6Wp1SvpW+1hiopDN0WS9gbZOnvYHCXBOSIKzhTCI0dYF8pb5sm5b5UeAuLtxMOJYNComOojYi9mHg2804X9sr6FnNw/GDh38rxQ9l9sSbZmN284FuGpW1ZfeP61vdWbHmCH7DJfIdVPEeTN+/MbYA7pwpGy75h7inTHb3pDt5kxy7XoZviyO2dofPnmeO47TE0hUxz1PIdtEmO2RSH7AnLbTP6GRLhFHfhB5bqcE1ytHIcrEmOVb5DNc2RmuxA5TpOUxymKY7SE5beWppjdCGH6DKO0GUcoCcWot2Nj2RQUQw3qVPVK74l2WXtELSE61blulOZbtRrGNHtyHWbMt2l1zCevKxdXMQOzp2k49Uo3E2ckqZZmE5V56rwQiAmXmLFsImN2HTnT3hVaulbCwzs6cVHvP0jtx5sKskGtoYnCq0sUY+LDyqEyqrEVlVDungXMJUCzZPV1HgjwdHPwNiu2fwG+cStpZsCR+B47d6byKbdVCrH4sU3VuW5DBM24SY8vlw+MU3TH1XEERcjTmgD4yE8w7VcKIMewphSM1LgosBHTK44+AGL5EAFP0CRFpjgByTYgQh6AIIfeOAHHPiBBnaAgR9Y4AcUUgIJ/AACO3DgoW4q6UfPZtOgrUclr4BmUwNs1OszDVE1OC/rZu7o97//eCz/o2jLj8Qje0Iwpd8AUtxw6YGXBQIuCYGWtABLSmCFHVBhBlKYARRG4AQhNERyiGWvGg+VDIUpZrtQpiMoitDwFAdxBC540tBtS+gBNdayrw/Snp4Y/Oiw9N6SWZRehMaDll0VfD9h9Jq52GculvYVnxP0dzlmT9oZOpoRZsc/0M98NKvz+/dKnsrjWVPB7O43mnHrhR4cPEjpcJNaq99l9AqHYqhzW2QGFRnBRHYQ8T1spIZLYKTAe7Az9emag6YOHT5qErf5WYr4vwMAFNmiPg=="
 }
@@ -6,6 +6,8 @@ package netflow
 
 import (
 	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
 	"net"
 	"sync"
 	"time"
@@ -16,6 +18,8 @@ import (
 	"github.com/elastic/beats/v7/filebeat/harvester"
 	"github.com/elastic/beats/v7/filebeat/input"
 	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
 	"github.com/elastic/beats/v7/filebeat/inputsource/udp"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
@@ -23,7 +27,9 @@ import (
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder"
+	decoderconfig "github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/config"
 	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/fields"
+	"github.com/elastic/beats/v7/x-pack/libbeat/persistentcache"
 )
 
 const (
@@ -34,6 +40,8 @@ var (
 	numPackets  = monitoring.NewUint(nil, "filebeat.input.netflow.packets.received")
 	numDropped  = monitoring.NewUint(nil, "filebeat.input.netflow.packets.dropped")
 	numFlows    = monitoring.NewUint(nil, "filebeat.input.netflow.flows")
+	numMissing  = monitoring.NewUint(nil, "filebeat.input.netflow.templates.missing")
+	numRestored = monitoring.NewUint(nil, "filebeat.input.netflow.templates.restored")
 	aliveInputs atomic.Int
 	logger      *logp.Logger
 	initLogger  sync.Once
@@ -42,12 +50,18 @@ var (
 type packet struct {
 	data   []byte
 	source net.Addr
+	// closed signals that the transport session with source has ended.
+	closed bool
 }
 
 type netflowInput struct {
 	mutex            sync.Mutex
 	udp              *udp.Server
+	tcp              *tcp.Server
+	sctp             *streaming.Listener
 	decoder          *decoder.Decoder
+	templateCache    *persistentcache.PersistentCache
+	closeCacheOnce   sync.Once
 	outlet           channel.Outleter
 	forwarder        *harvester.Forwarder
 	internalNetworks []string
@@ -112,13 +126,30 @@ func NewInput(
 		}
 		customFields = append(customFields, f)
 	}
-	decoder, err := decoder.NewDecoder(decoder.NewConfig().
+	decoderConfig := decoder.NewConfig().
 		WithProtocols(config.Protocols...).
 		WithExpiration(config.ExpirationTimeout).
 		WithLogOutput(&logDebugWrapper{Logger: logger}).
 		WithCustomFields(customFields...).
-		WithSequenceResetEnabled(config.DetectSequenceReset))
+		WithSequenceResetEnabled(config.DetectSequenceReset).
+		WithStats(decoderconfig.Stats{
+			MissingTemplate:   numMissing,
+			RestoredTemplates: numRestored,
+		})
+	var templateCache *persistentcache.PersistentCache
+	if config.TemplateCache.Enabled {
+		templateCache, err = newTemplateCache(config)
+		if err != nil {
+			out.Close()
+			return nil, err
+		}
+		decoderConfig.WithTemplateStore(templateCache)
+	}
+	decoder, err := decoder.NewDecoder(decoderConfig)
 	if err != nil {
+		if templateCache != nil {
+			templateCache.Close()
+		}
 		return nil, errors.Wrapf(err, "error initializing netflow decoder")
 	}
 
@@ -127,11 +158,34 @@ func NewInput(
 		internalNetworks: config.InternalNetworks,
 		forwarder:        harvester.NewForwarder(out),
 		decoder:          decoder,
+		templateCache:    templateCache,
 		logger:           logger,
 		queueSize:        config.PacketQueueSize,
 	}
 
 	input.udp = udp.New(&config.Config, input.packetDispatch)
+	if config.TCP != nil {
+		tcpConfig := defaultTCPConfig
+		if err = config.TCP.Unpack(&tcpConfig); err != nil {
+			out.Close()
+			input.closeTemplateCache()
+			return nil, errors.Wrap(err, "failed parsing tcp configuration")
+		}
+		if input.tcp, err = tcp.New(&tcpConfig, input.streamHandlerFactory(inputsource.FamilyTCP)); err != nil {
+			out.Close()
+			input.closeTemplateCache()
+			return nil, errors.Wrap(err, "failed to create tcp server")
+		}
+	}
+	if config.SCTP != nil {
+		sctpConfig := defaultSCTPConfig
+		if err = config.SCTP.Unpack(&sctpConfig); err != nil {
+			out.Close()
+			input.closeTemplateCache()
+			return nil, errors.Wrap(err, "failed parsing sctp configuration")
+		}
+		input.sctp = newSCTPServer(&sctpConfig, input.streamHandlerFactory(familySCTP))
+	}
 	return input, nil
 }
 
@@ -153,6 +207,7 @@ func (p *netflowInput) Run() {
 		if err := p.decoder.Start(); err != nil {
 			logger.Errorw("Failed to start netflow decoder", "error", err)
 			p.outlet.Close()
+			p.closeTemplateCache()
 			return
 		}
 
@@ -162,10 +217,40 @@ func (p *netflowInput) Run() {
 			logger.Errorf("Error running harvester: %v", err)
 			p.outlet.Close()
 			p.decoder.Stop()
+			p.closeTemplateCache()
 			close(p.queueC)
 			return
 		}
 
+		if p.tcp != nil {
+			logger.Info("Starting TCP input")
+			if err := p.tcp.Start(); err != nil {
+				logger.Errorf("Error running TCP server: %v", err)
+				p.udp.Stop()
+				p.outlet.Close()
+				p.decoder.Stop()
+				p.closeTemplateCache()
+				close(p.queueC)
+				return
+			}
+		}
+
+		if p.sctp != nil {
+			logger.Info("Starting SCTP input")
+			if err := p.sctp.Start(); err != nil {
+				logger.Errorf("Error running SCTP server: %v", err)
+				if p.tcp != nil {
+					p.tcp.Stop()
+				}
+				p.udp.Stop()
+				p.outlet.Close()
+				p.decoder.Stop()
+				p.closeTemplateCache()
+				close(p.queueC)
+				return
+			}
+		}
+
 		go p.recvRoutine()
 		// Only the first active input launches the stats thread
 		if aliveInputs.Inc() == 1 && logger.IsDebug() {
@@ -186,7 +271,19 @@ func (p *netflowInput) Stop() {
 
 		logger.Info("Stopping UDP input")
 		p.udp.Stop()
+		if p.tcp != nil {
+			logger.Info("Stopping TCP input")
+			p.tcp.Stop()
+		}
+		if p.sctp != nil {
+			logger.Info("Stopping SCTP input")
+			p.sctp.Stop()
+		}
 		p.started = false
+	} else if p.queueC == nil {
+		// Run was never called or failed early, so there is no receive
+		// routine to close the template cache.
+		p.closeTemplateCache()
 	}
 }
 
@@ -228,7 +325,7 @@ func (p *netflowInput) statsLoop() {
 
 func (p *netflowInput) packetDispatch(data []byte, metadata inputsource.NetworkMetadata) {
 	select {
-	case p.queueC <- packet{data, metadata.RemoteAddr}:
+	case p.queueC <- packet{data: data, source: metadata.RemoteAddr}:
 		numPackets.Inc()
 	default:
 		numDropped.Inc()
@@ -236,7 +333,14 @@ func (p *netflowInput) packetDispatch(data []byte, metadata inputsource.NetworkM
 }
 
 func (p *netflowInput) recvRoutine() {
+	// The template cache is closed once the queue is drained, as decoding
+	// the remaining packets may still update it.
+	defer p.closeTemplateCache()
 	for packet := range p.queueC {
+		if packet.closed {
+			p.decoder.CloseSession(packet.source)
+			continue
+		}
 		flows, err := p.decoder.Read(bytes.NewBuffer(packet.data), packet.source)
 		if err != nil {
 			p.logger.Warnf("Error parsing NetFlow packet of length %d from %s: %v", len(packet.data), packet.source, err)
@@ -251,3 +355,31 @@ func (p *netflowInput) recvRoutine() {
 		}
 	}
 }
+
+// newTemplateCache opens the persistent cache where the templates received
+// by the input are stored. The cache is named after the listening address,
+// so that templates are restored by the same input after a restart.
+func newTemplateCache(config config) (*persistentcache.PersistentCache, error) {
+	hash := sha1.Sum([]byte(config.Host))
+	name := inputName + "-" + base64.RawURLEncoding.EncodeToString(hash[:])
+	cache, err := persistentcache.New(name, persistentcache.Options{
+		Timeout: config.TemplateCache.Timeout,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open template cache")
+	}
+	return cache, nil
+}
+
+// closeTemplateCache closes the template cache, if enabled. It is safe to
+// call it more than once.
+func (p *netflowInput) closeTemplateCache() {
+	if p.templateCache == nil {
+		return
+	}
+	p.closeCacheOnce.Do(func() {
+		if err := p.templateCache.Close(); err != nil {
+			p.logger.Errorw("Failed to close template cache", "error", err)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package netflow
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"golang.org/x/net/netutil"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+// familySCTP identifies the IPFIX over SCTP listener in logs.
+const familySCTP inputsource.Family = "sctp"
+
+// sctpConfig configures the optional IPFIX over SCTP listener.
+type sctpConfig struct {
+	Host           string           `config:"host"`
+	Timeout        time.Duration    `config:"timeout" validate:"nonzero,positive"`
+	MaxMessageSize cfgtype.ByteSize `config:"max_message_size" validate:"nonzero,positive"`
+	MaxConnections int              `config:"max_connections"`
+}
+
+// Validate validates the sctp configuration.
+func (c *sctpConfig) Validate() error {
+	if len(c.Host) == 0 {
+		return fmt.Errorf("need to specify the host using the `host:port` syntax")
+	}
+	return nil
+}
+
+// defaultSCTPConfig is used for the optional IPFIX over SCTP listener.
+// 4739 is the IANA-assigned port for IPFIX over SCTP too.
+var defaultSCTPConfig = sctpConfig{
+	Host:           ":4739",
+	Timeout:        time.Minute * 5,
+	MaxMessageSize: 64 * humanize.KiByte,
+}
+
+// newSCTPServer creates a listener accepting SCTP associations, each of them
+// handled as a connection by the given factory. Associations use one-to-one
+// style sockets, so that the messages sent over all their streams are read
+// as a single stream, as RFC 7011 section 10.2 allows.
+func newSCTPServer(config *sctpConfig, factory streaming.HandlerFactory) *streaming.Listener {
+	listen := func() (net.Listener, error) {
+		l, err := listenSCTP(config.Host)
+		if err != nil {
+			return nil, err
+		}
+		if config.MaxConnections > 0 {
+			return netutil.LimitListener(l, config.MaxConnections), nil
+		}
+		return l, nil
+	}
+	return streaming.NewListener(familySCTP, config.Host, factory, listen, &streaming.ListenerConfig{
+		Timeout:        config.Timeout,
+		MaxMessageSize: config.MaxMessageSize,
+		MaxConnections: config.MaxConnections,
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// +build linux
+
+package netflow
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// listenSCTP listens for SCTP associations on the given address. One-to-one
+// style SCTP sockets are used like TCP sockets, so that the net package
+// can poll them and the accepted associations are net.Conns.
+func listenSCTP(host string) (net.Listener, error) {
+	addr, err := net.ResolveTCPAddr("tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	var fd int
+	if ip4 := addr.IP.To4(); ip4 != nil {
+		sa := &unix.SockaddrInet4{Port: addr.Port}
+		copy(sa.Addr[:], ip4)
+		fd, err = bindSCTP(unix.AF_INET, sa)
+	} else {
+		sa := &unix.SockaddrInet6{Port: addr.Port}
+		copy(sa.Addr[:], addr.IP.To16())
+		fd, err = bindSCTP(unix.AF_INET6, sa)
+		if err != nil && addr.IP == nil {
+			// hosts without IPv6 can still listen on all the IPv4 addresses
+			fd, err = bindSCTP(unix.AF_INET, &unix.SockaddrInet4{Port: addr.Port})
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for SCTP associations on %s: %w", host, err)
+	}
+
+	f := os.NewFile(uintptr(fd), "sctp:"+host)
+	defer f.Close()
+	return net.FileListener(f)
+}
+
+// bindSCTP returns a listening one-to-one style SCTP socket bound to the
+// given address.
+func bindSCTP(family int, sa unix.Sockaddr) (int, error) {
+	fd, err := unix.Socket(family, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, unix.IPPROTO_SCTP)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create socket, the SCTP kernel module may not be loaded: %w", err)
+	}
+
+	err = unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_REUSEADDR, 1)
+	if err == nil && family == unix.AF_INET6 {
+		// listen on IPv4 addresses too when bound to the unspecified address
+		err = unix.SetsockoptInt(fd, unix.IPPROTO_IPV6, unix.IPV6_V6ONLY, 0)
+	}
+	if err == nil {
+		err = unix.Bind(fd, sa)
+	}
+	if err == nil {
+		err = unix.Listen(fd, unix.SOMAXCONN)
+	}
+	if err != nil {
+		unix.Close(fd)
+		return -1, err
+	}
+	return fd, nil
+}
@@ -0,0 +1,74 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// +build linux
+
+package netflow
+
+import (
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func TestSCTPServer(t *testing.T) {
+	// Fail early when the kernel has no SCTP support.
+	l, err := listenSCTP("127.0.0.1:0")
+	if err != nil {
+		t.Skipf("SCTP is not available: %v", err)
+	}
+	l.Close()
+
+	p := &netflowInput{
+		queueC: make(chan packet, 4),
+		logger: logp.NewLogger("test"),
+	}
+	config := defaultSCTPConfig
+	config.Host = "127.0.0.1:0"
+	server := newSCTPServer(&config, p.streamHandlerFactory(familySCTP))
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	addr := server.Listener.Addr().(*net.TCPAddr)
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_STREAM, unix.IPPROTO_SCTP)
+	require.NoError(t, err)
+	sa := &unix.SockaddrInet4{Port: addr.Port}
+	copy(sa.Addr[:], addr.IP.To4())
+	require.NoError(t, unix.Connect(fd, sa))
+
+	first := "000a0010609b3ac0000000010000002a"
+	second := "000a0014609b3ac0000000020000002a00020004"
+	data, err := hex.DecodeString(first + second)
+	require.NoError(t, err)
+	_, err = unix.Write(fd, data)
+	require.NoError(t, err)
+	unix.Close(fd)
+
+	var messages []string
+	for len(messages) < 2 {
+		select {
+		case pkt := <-p.queueC:
+			if !pkt.closed {
+				messages = append(messages, hex.EncodeToString(pkt.data))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for the IPFIX messages")
+		}
+	}
+	assert.Equal(t, []string{first, second}, messages)
+
+	select {
+	case pkt := <-p.queueC:
+		assert.True(t, pkt.closed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the association to be closed")
+	}
+}
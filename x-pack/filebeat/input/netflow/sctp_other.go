@@ -0,0 +1,16 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// +build !linux
+
+package netflow
+
+import (
+	"errors"
+	"net"
+)
+
+func listenSCTP(string) (net.Listener, error) {
+	return nil, errors.New("IPFIX over SCTP is only supported on linux")
+}
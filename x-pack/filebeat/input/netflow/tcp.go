@@ -0,0 +1,87 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package netflow
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/filebeat/inputsource/common/streaming"
+	"github.com/elastic/beats/v7/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/v7/x-pack/filebeat/input/netflow/decoder/ipfix"
+)
+
+// ipfixHeaderLength is the length of the IPFIX message header.
+const ipfixHeaderLength = 16
+
+// streamHandlerFactory returns a factory of connection handlers that read
+// IPFIX messages from a TCP connection or an SCTP association. Once the
+// connection is closed, the decoder is notified so that the templates
+// received over it are discarded (RFC 7011 section 8).
+func (p *netflowInput) streamHandlerFactory(family inputsource.Family) streaming.HandlerFactory {
+	return func(config streaming.ListenerConfig) streaming.ConnectionHandler {
+		return func(ctx context.Context, conn net.Conn) error {
+			dispatch := func(data []byte, metadata inputsource.NetworkMetadata) {
+				p.streamDispatch(ctx, data, metadata)
+			}
+			handler := streaming.SplitHandlerFactory(family, p.logger, tcp.MetadataCallback, dispatch, splitIPFIXMessages)(config)
+			defer p.sessionClosed(ctx, conn.RemoteAddr())
+			return handler(ctx, conn)
+		}
+	}
+}
+
+// streamDispatch queues a message received over TCP or SCTP. Unlike UDP packets,
+// messages are not dropped when the queue is full, as losing a template
+// would cause all the following records in the session to be dropped.
+// Blocking here applies back-pressure to the exporter instead, until the
+// connection is closed or the input stopped, when the message is dropped.
+func (p *netflowInput) streamDispatch(ctx context.Context, data []byte, metadata inputsource.NetworkMetadata) {
+	// The scanner reuses its buffer, so the message must be copied.
+	select {
+	case p.queueC <- packet{data: append([]byte(nil), data...), source: metadata.RemoteAddr}:
+		numPackets.Inc()
+	case <-ctx.Done():
+		numDropped.Inc()
+	}
+}
+
+// sessionClosed notifies the decoder that a session is closed. The
+// notification is dropped if the input is stopped meanwhile.
+func (p *netflowInput) sessionClosed(ctx context.Context, source net.Addr) {
+	select {
+	case p.queueC <- packet{source: source, closed: true}:
+	case <-ctx.Done():
+	}
+}
+
+// splitIPFIXMessages is a bufio.SplitFunc that splits a stream into IPFIX
+// messages, using the length field in the message header.
+func splitIPFIXMessages(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if len(data) < 4 {
+		if atEOF && len(data) > 0 {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		return 0, nil, nil
+	}
+	if version := binary.BigEndian.Uint16(data[:2]); version != ipfix.ProtocolID {
+		return 0, nil, fmt.Errorf("unsupported protocol version %d in stream", version)
+	}
+	length := int(binary.BigEndian.Uint16(data[2:4]))
+	if length < ipfixHeaderLength {
+		return 0, nil, fmt.Errorf("invalid IPFIX message length %d", length)
+	}
+	if len(data) < length {
+		if atEOF {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		return 0, nil, nil
+	}
+	return length, data[:length], nil
+}
@@ -0,0 +1,120 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package netflow
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/filebeat/inputsource"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestSplitIPFIXMessages(t *testing.T) {
+	// Two IPFIX messages: a header-only message and a message carrying an
+	// empty template set.
+	first := "000a0010609b3ac0000000010000002a"
+	second := "000a0014609b3ac0000000020000002a00020004"
+
+	for _, testCase := range []struct {
+		name     string
+		stream   string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "complete messages",
+			stream:   first + second,
+			expected: []string{first, second},
+		},
+		{
+			name:     "truncated message",
+			stream:   first + second[:20],
+			expected: []string{first},
+			err:      true,
+		},
+		{
+			name:   "not IPFIX",
+			stream: "0009001400000000",
+			err:    true,
+		},
+		{
+			name:   "bad length",
+			stream: "000a000800000000",
+			err:    true,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			data, err := hex.DecodeString(testCase.stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+			scanner := bufio.NewScanner(bytes.NewReader(data))
+			scanner.Split(splitIPFIXMessages)
+			var messages []string
+			for scanner.Scan() {
+				messages = append(messages, hex.EncodeToString(scanner.Bytes()))
+			}
+			assert.Equal(t, testCase.expected, messages)
+			if testCase.err {
+				assert.Error(t, scanner.Err())
+			} else {
+				assert.NoError(t, scanner.Err())
+			}
+		})
+	}
+}
+
+func TestConfigStreamRequiresIPFIX(t *testing.T) {
+	for _, transport := range []string{"tcp", "sctp"} {
+		t.Run(transport, func(t *testing.T) {
+			cfg := defaultConfig
+			err := common.MustNewConfigFrom(common.MapStr{
+				"protocols":         []string{"v1", "v5", "v9"},
+				transport + ".host": "localhost:4739",
+			}).Unpack(&cfg)
+			assert.Error(t, err)
+
+			cfg = defaultConfig
+			err = common.MustNewConfigFrom(common.MapStr{
+				"protocols":         []string{"v9", "IPFIX"},
+				transport + ".host": "localhost:4739",
+			}).Unpack(&cfg)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestStreamDispatchStopsWhenCancelled(t *testing.T) {
+	p := &netflowInput{queueC: make(chan packet, 1)}
+	ctx, cancel := context.WithCancel(context.Background())
+	metadata := inputsource.NetworkMetadata{}
+
+	// Fill the queue, so that the following calls block.
+	p.streamDispatch(ctx, []byte{1}, metadata)
+	dropped := numDropped.Get()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.streamDispatch(ctx, []byte{2}, metadata)
+		p.sessionClosed(ctx, nil)
+	}()
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("dispatch still blocked after cancellation")
+	}
+	assert.Equal(t, dropped+1, numDropped.Get())
+	assert.Len(t, p.queueC, 1)
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded zlib format compressed contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzs/Xt3GzeyKIr/n0+Bn2atn6xsskXqZVn3zL1HkZSJ1vFDY8mT2YlniWA3SGLcBDoAWhSz7/7ud6EKQKMfkilbdOxs7Ycjkt1AoVAo1Lv+Qn4+fvv6/PXf/n/kVBIhDWEZN8TMuCYTnjOSccVSky97hBuyoJpMmWCKGpaR8ZKYGSNnJ5ekUPLfLDW97/5CxlSzjEgB398wpbkUZJgMh8kg+e4v5CJnVDNywzU3ZGZMoY+2t6fczMpxksr5NsupNjzdZqkmRhJdTqdMG5LOqJgy+MqOO+Esz3Ty3Xd98oEtjwhL9XeEGG5ydmQf+I6QjOlU8cJwKeAr8qN7h7i3j74jpE8EnbMjsvm/DZ8zbei82PyOEEJydsPyI5JKxeCzYr+VXLHsiBhV4ldmWbAjklGDH2vzbZ5Sw7btmGQxYwLwxG6YMEQqPuXC4i/5Dt4j5Moim2t4KAvvsVujaGrxPFFyXo3QsxPzlOb5kihWKKaZMFxMYSI3YjVd545pWaqUhfnPJ9EL+BuZUU2E9NDmJKCnh7RxQ/OSAdABmEIWZW6nccO6ySZcaQPvN8BSLGX8poKq4AXLuajgeutwjvtFJlIRmuc4gk5wn9gtnRd20zd3BsOD/mC/v7N7NTg8Guwf7e4lh/u7v2xG25zTMct15wbjbsqxJWP4Av+8xu8/sOVCqqxjo09KbeTcPrCNOCkoVzqs4YQKMmaktGfCSEKzjMyZoYSLiVRzagex37s1kcuZLPMMzmEqhaFcEMG03ToEB8jX/s9xnuMeaEIVI9pIiyiqPaQBgDOPoFEm0w9MjQgVGRl9ONQjh44WJv9rgxZFzlOAbuOIbEyk7I+p2uiRDSZu7DeFklmZwu//HSN4zrSmU3YPhg27NR1o/FEqksupQwTQgxvL7b5DB/5kn3Q/94gsDJ/z3wPdWTq54WxhzwQXhMLT9gumAlbsdNqoMjWlxVsup5osuJnJ0hAqKrKvwdAj0syYcuyDpLi1qRQpNUxElG+kBWJOKJmVcyr6itGMjnNGdDmfU7UkMjpx8TGcl7nhRR7Wrgm75doe+RlbVhPOx1ywjHBhJJEiPN3cyJ9Ynkvys1R5Fm2RodP7TkBM6XwqpGLXdCxv2BEZDnb22jv3kmtj1+Pe04HUDZ0SRtOZX2Wdxn6NSQjpamfjXzEp0SkTSCmOrR+HL6ZKlsUR2emgo6sZwzfDLrlj5JgrJXRsNxnZ4MQs7OmxDNTYG27itoKKpcU5tacwz+2565GMGfxDKiLHmqkbuz1IrtKS2UzanZKKGPqBaTJnVJeKze0DbtjwWPN0asJFmpcZIz8wavkArFWTOV0SmmtJVCns225epRO40WChyfduqW5IPbNMcswqfgyUbeGnPNee9hBJqhTCnhOJCLKwRetTbsjFjKmYe89oUTBLgXaxcFLDUoGzWwQIR40TKY2Qxu65X+wROcfpUisJyAkuGs6tPYi9Cr7EkgJxosiYUZNE5/f44hUIJe7mrC/I7Tgtim27FJ6yhFS0EXPfTDKPOmC7IGgQPkFq4ZrY+5WYmZLldEZ+K1lpx9dLbdhck5x/YOT/0MkH2iNvWcaRPgolU6Y1F1O/Ke5xXaYzy6Vfyqk2VM8IroNcArodyvAgApEjCoO4Up2OccnzLPF8ys3SPNFdZ/rOU908SWe3honMXs92qhrKJm7fcY88LTtBBtm1lWiEG8DIcAqpWHaMByeNIsJR/ghD2hNQKHnDM9azAokuWMonPCX4Ngg+XAfxzGEw4jRzZhRPLe0EYfR5cpAMyDM6zw72tnok52P4Gb/+9YDu7LLDyeFkdzDZHwyGY7q7t8f22P5edpi9SMeHO+l4OHieBhDtegzZGewM+oOd/mCf7OweDQdHwwH5j8FgMCDvrk7+FTA8oWVurgFHR2RCc81q28qKGZszRfNrntU3lbnteISN9XMQnlnON+FMIVfg2p2PZ3wCFwvcPnqrucXcSihqDlKfF8xpqqS2G6ENVZZNjktDRkghPBvBMbMHrL1Dh3TPInpSQ0Rz+Y9D0+8E/82KrQ9fdxCjLOdBfgXvLUBeGzMC3Il3EKBbXlZbnv13HQt00iiwzZjRt3ZQE4pP4S2HksWU3zAQR6lwr+HT7ucZy4tJmVveaDmAW2EY2Cwk+dHxacKFNlSkTjxtXDPaTgx3jSUSJyWRSkpiBVXAGcLYXBPBWIaK5WLG01l7qsCwUzm3k1m1KVr3+cTyD3+hwFLxpvFfyYlhguRsYgibF2bZ3sqJlLVdtBu1jl28Whb3bJ+/xOwEhOYLutREG/tvwK0V8fXMkyZuq9Oy8F0rpCUVakS4igNWq2eRxN1EY1Y9ApIJn9Q2vtqxJgHUNn9O05lV9doojsfxeHaMew2o/oe7EurIbsB0kAySQV+lO7F0qmuiaWmkkHNZanIJN/1HxNRjQWj1CgoH5Nnx5RYeTCd0OsBSKQQDQ8C5MEwJZsiFkkam0t/7z84vtoiSJdyGhWITfss0KUXG8J62t6+SuR3McjepyFwqRgQzC6k+EFkwRY1UVo71ujub0XxiX6DEijE5IzSbc8G1sSfzxsvMdqxMzlHApoY4cwQuYj6XokfSnFGVL6sbEHSXAK3MeboEfWHGQGSwC0xWloNEOR8HOfW+qzKXQRirbYW7EnAcQvNcpiAzO4ha2+TEyPB1IHi3i26gZ8eXr7dICYPny+rG0agTBdTjmTivrTsiveH+8OBFbcFSTangvwN7TNrXyOeICaB9XsdYjlidV9tJW5MnIDqruY4lGnKfuNPYgzfRmmC+Fh7+JqWlwZcvT6IzmOa8oSKeVN/coyMeuzftYfP0SLUjQG64PQtI+n6b3BF0sq8HDnU/xaZUZaATWJFfCt2Lnkd9YMzRjMqloDmZ5HJBFEutulyzSFydXLhR8WaqwGzBZr+wj0eQwQHUTARN0D5z+Z+vSUHTD8w801sJzIJGjMKxkNZUaC20ol1tUq/CKpC1mbZwOCXLY8koKjQFYBJyKecsqD2lRvXRMDUnG94EKtVGZTBRbOK5lQNFNBao8ei5n516jzs7ZkG9BfU+QoA7lhYsMfXbXE0Rw4+GCkdEfgJ7e5W6tAhxo1Z6NRcWvH+XAjcA1GxUnL2BumOwCr9CmtaQVrDC/erDifaWwWBPxPG2/TzBAgyHB0U1mmVEszkVhqfA+9mtcVIdu0V5vYdClOcIOsh2RpIbbpfLf2eVzcQulCnQ4DQ3JXXbcT4hS1mqMMeE5rknPn8jWG46lWrZs496oUQbnueECV0qJ4E6s7MVXDKmjSUPi1KLsAnP88DQaFEoWShODcuXD9CXaZYppvW6dCqgdjSOONpyEzr5J7CZ+ZhPS1nqfInUDO8EhrmwaNFyzsDcTnKuwRx5ftGz6jHes1IRai+WW6KlpZOEkP+sMBvkwUo6wnOg6MLD5Ol+lLgvRoiyupQpCDeREJmVaBLGq3GU8GJkQRklCNaoRzJWMJE5MR9ldCkqIMBS43askqKS/3EXONXJ0x0eW7KWhumPiPbR3qPdp/5aDZAf7A9otAuOM3cmHUkg62xv1eFeDTAk7DUoHY6H4/hJbc4pk0nKzfJ6TQaCEyuzd+7OK6sjMGdKrIEjheGCCXOdymwdMF0tZD9nxjB7kWSs7tQMs2/qbrhfH3/3EULtXsyaEPw6sryEydpAS2Vm5HjOFE9pB5ClMGp5zbVcF85PcApyfvkGkN6C8OT4TrDWRZoOpM5dPqGCZm1MAa//uGVgyuR1IXm4aOsOLCmm3JQZCh85NfChBcHmf5GNHNyh/ee7ycFw73B30CMbOTUbR2RvP9kf7L8YHpL/3mwB+bgMvmHQ1Ez1vXAR/YTqi0dPjziDDoqUckKmiooyp4qbZSwlLElqpRWQoSNp4MQLAcFchhTOFYqHKbPXn9MkJrmUyt2iPTAPzXglp1fXLYKXk2K21Nz+4b1wqedROgLhtTRRqAH4GDkaUeZw20+Z9KttG5XGUhsp+lna2ptCakPzdZ2yzQsYHtka1VqmvPLHoR/bgVwt9B/OqV/Juc7dElwrwSk4ZuSDkAthtRpK7FJgIqnIL+cXJFoTAdIG4fKGqiVZ8MzKNHA9ulONThr4s42/F3uDvcFD2KxiUy7FOhnYW5jhPv7V//vJXXCtiYM5mDoZ2N9LNmZt+rNy/u+VlPyo16rVt/mckd/B5jepEVwveCLPj18fR891Au8uqu1jNYVrmW7/UDIh9fUxV5EQ9hHC4MVHVhkeqK3j/CLoLf5eRfnp2fnFzZ6l9vOLm4Otuhw1p+k6zvOr45NuYBoGeiFN8JTOqRNE3/54Qp4P9nbAp4xhbSw7ImdWnZCpYYY8A1WY6x457I95JZhbWXcL3ZxONHJRUwtJfi2LgqmUavYvMmO3NGMpn9OcZHzKDfg5rBhlIYVwoTCmAx8ntgxEkFJoPnWBJWzKVEIuyxT82DfuQRdshP4ZhIGGEWfLYsY6uO9g0B8M+vtn8O9uf2e3tlOCmqRJGZ33Yzd1bF4pKjTaTs4v7KqcJQHDEF8fXwWzHHnGkmnibMyWK1fGQoI2KG9+rjk8w6UTWaKIURScEmJKckkzMqY5FSncgROu2ILmOVr+lCzt1djQe+2iC6nMw9Rer/poo3i3Lhxjw47/reADLV4P0AJrq77Atz9J59upw9Hak1VU0bv348LtQcwo4vnsfaQNUyy77tI2H09OtExpxqczpk00qccRzt2DhRQFyzzIuhx7JTXs/4+V5xflvWg4Z6Gy8srGRMrEPZekcr5h2ddG/EXTJY3Rk87VnDHD1Byk2kKxlGsrr4DYRNEqBnE3EDVajnOeEl1OJvw2jAjPPJsZUxxtb+Mj+EQi1XQrIVdqCWxRoqB1y60UiULWeEk0nxf5khj6odpXtKLlVBtguxg6iTKVkIaAMWjB8hxWf/XytIr12UhlUn7YaDPGCBs1qghoXyc1hEmA6IPKMCnt0f6tpDmf8GpL0UeOMWqRCJ/nnlRAXifsNmWFqULJ4LXKD9ki9wR8z5QUVBkemdhJCwJgHhznsv/vfkdpptJrQAEp7Z7YmVMqKhs7qdNVL8JAiC1tLWjMcrnoJvPuM1E/NzFuNxaLRcKoNsl86UZAwsCTQbXZiDzyCIQbZUZ1FRoKawXxI0xTSXMbuhzvJLocD2uHr1cj4go8VCickdfHaFVjbPTwzAlpGTzPwWHLFJcdYS52AatKgkYW17CML8D12GRiL6kbZmd1hOJW/4xdvTzd6qEyFTSpCu8Bacg6et4RB0zAkqynleiQJG0G2Zw3DBsF0dhdAjr4tjkjcMW7mGK1E6uxR/i+RjelZipZL8nE9jv02UqFnlA7OYZnzBl4COTkrmuRCvLy9PgCgjtxxadhqJhWNturY3PK8zUt7p1dAUzglZikDYDlnh0K8jfpk7AL3tTVhQDmKHpDeU7HeYeam4+ZMuSMC22YI7EabsDF+IcRIMy+fgrERa4t/LQdgumjiXF9PkoMnHHbRU6NFbM7CBXhXKNxNd4JnKwNxIzq2boowWEK+I6dB010SjGr37XisaljUIJQIcUyTohBTSUilXeauTjOEayCZ+jLhQ92daMgDKRSTHCvaF6bk4qsQ76CuMIOolpLOO8d0byIso7Nejyz3x/G0S5nVqNEEzxkS3DRXnTE0iiwtDYqlMybTtdHI9xjpSikOAFBwkze/wB2xnryUwPgzV83PvAxFfQa4g03emRDMZCixfTaDohJQvfgrIoOkyUCHoLD/Bd3x4ZhjijBMxZiCGAoUEDERNGQN1YtA+1iGHfsjQMQfUzuzICZkFdVZgLXcYg0FeTsZAc1KHvMJsykM6bB1xKNTrjRLumoAtIe0XquXC3piesQelsHwY2rSuGymRSbSxMCdYksjeYZi2ZqQoYwUeLSbfyCPOmI6lXnJ6qn9eGg1UCQV+Qm9wYcOyzXFagOYQ8JA0rBybG+623zqkIQzgX5VHFwBM9CjpxjXUuS8cmEqdj8Bt4wDplh9sK3DKdvmKDCECZuuJJiXrc7V7R1/PNlmJxnPR94AfRP3rz9GznPMIsNAgHLJhdtS+IHBwfPnz8/PDx88eJFJzrX6eJsI9SzP5pzqu/BZcBhwNHn4RJFyBY2M66LnC5jgSrWizGfvZ+xm1XVYyeh8pyb5XXbO/R4jDqaB70/3AduAadA3qIYWpCBZKpTSFycVYuDl7rPqDb9Yd3b5fMB1nf0zn0eyPmpv2OAhXqG1wSU94c7u3v7B88PXwzoOM3YZNAN8RqpO8AcZ+y0oY7cWvBlO/Hk0SB65XlulINyLxrNTjJnGS/rNkxXEOKLMFo3V8zCuo5y7eBehHd65Ph3e5lX33QkES77bpJVz7Bf/5fhjB4D6L1dde3Iz+qr72Zi8yV5+PpveLZSYOdnh1l5FMCEiV91XB6BLnSPULvQHpmmRWUOlQodpTSXKaOiLT8vdG1ZGECwpkW5+IFHZcI16H0m9JchSg+mz6+L84Ezrq1EX3I988/phsQI6fLVXe4tAVinAS5yv+U9wqZwUVup+UaTl3Q+zmiP/O3kgvzt5IzcVALAcVGQMzHlIhD+P17ZV+z3Lge76/jQoiDMvWb/diD33EpVKXpkQtWUGtYjOUzfPkT4/YrKi8zYteZTQa2iUdNiZMbIZe2Xu9WZqxnTrFkJoabFg14w5oKqJYYphUn16klYmC77EdV6LGXOqOgimh/wJzBw0AJUK46ZZw4WSz4u8qGtMRpVso/ohNER4FNLY2tMr7YHIDJJePHUToxmeawN0JGR25JkXQUDX4qFzKkoJ9TVLBkvLYZ8CYsbJjKpkmhMVmXiK5azG4rO8OPC8sbv31wSKfKOWK9UzhM7J0tuizQplLxdroxbQ025thSL4yzjLoGqTcFwDzBl0CXIHCjdOJ6Uua81MYUQY7UsjJwqWsx4SphSUukqRC8e9YbmPItDJqUiRpXa+PnIS0ZvGClFlCM08cE38Gr1ir+9qvHDsAur1oh0xtIPXSUNzt6+ffP2+t3rq7fvLq/OTq/fvnlztfIelVjWaE0hcJc4fE2cDWwlSANV0DlPlbQ0TE6kKmQt6fvjXkRG52s+x3aKxzzMMJ5U7rS69Fp/hF1BnKQ6u5Xe+rAzfPb3n/75y+Grw+N/rIxLS5JsFVzew8Y3L41UDK1O8bHoIHWSzuou9H/Y80GNj1W764jgexACi5WQfFJSD+xRVmIKQ9ac2xZR9aI44yUxUubaVR8BLwnUs2DpB7Q44ZluYfdhFw4c/M/Ea/f9iB4iEF7rN+UNUxg6QafUKrEBI/aNcNdbaSy2eXSyLlpD/kf40iqIqQQcEEYcSwiyTfzlPRm84cF6lqbLn2wVWItKPrnyMQ7IAAVxkZekKo9nqS8aJKrWF8lUM5YXkVMGzJcYaReG1s4wKpZWTjQ86FerSFbr9JtUi+dZ3czA53S6Vi0hVt9gspAvgwBZQsOyOlJ0gWbodE2QVZTl4KLThpc8qiF4//RRLcF7qgk2DUIwqyvMV5t3jdtRLboK/w4aL9LsulReHN1KtnSKzJ/rihBawj7WMIz4iD1x19ooRucxJzm1B/Gy+vojFeOiUfzJNvQDwyAfLjB9199Ngi1cqapqfGLBgRoA6YxFNphz0fVK/eEqODv4/aNHg/IGRf88wmUzFcdeYCXNa686n8tE5rmEaohzKgRTR2T0X9GCwZj43/3aV/ZvzUzjWwiJK2jK/nuUBGbIodKd8zVFlRHhwg1e5BmFWqbK37bKScSEah9JVeGRgcQQrUQn5JVUjRx/RyoYFTWRpchwwVyHsrIQcYf29ySV2+NcTrep6HNhQu3BvpF9M2P9YKanhvZx1j7uUh936Vf7toOxkNr8K+zxsSBn+LZmVKWz2h6kUmirHDdrr4xp+gHr1GU8ZRrFl2AcqJMKZADNdS3krPG+y5cipyVD4sBTdGNlTyl67XE1mVsJC0LfkEDsUOzWk6Zi2iju6y7UYks6aZ9pFw0QKhmO3o96ZLRt//ne/vP/2H827D//y/7zf9t//l/7DxmRZ0BWFZlseYhHvRGYvEZ/GSW+dLBmeGTqSIf6EUykmC5FK2XxDmKYljxj20z4gsM4zHYYZjstlWLCbDsM91PFqGF9wFIyM/P8L41faMH7BTWzfkEVnetfYxT+6wF3vjt8K3BcS1yGCnN9z4WyUVkC7FmJCvGZGXI0asgcKlZqJjTz6o1TWd4H+fB9JBd5JpW8F60KliMx5eI2oZA5Yve3UHLOzIyV8ImJDFIxR/HIzKRIZDUKBdDASbngIHIZrCoH32dYrXpmhVaHMaKZiUddsFB4BNnr+w0ovsHT9xsh6si/C08kZIRhCe7bkVMf4lFhxlDICgemmow6+OcoeS9+YEsJmkmDYOMhO66GVHHDFKd2kVaSt9co+s1HATace0Z1RO7xsDEBHr0XhHxPXtlDH1cXHvVH+MtrCakNKAoLMhwMIq690byH4z1eVRMK18djUfYxRpj49NcwfgLyJfwZNANX24QCp4Pzx8U0Rpa7cZL34pUV1e3ImtBcMZotvf+euaRXz3SxAiBdeuUTqal2ZLrueyEX3nbtxhgzbUhhkc1ThumcDp0JseDEQyJkEGPrVbU4OxQMVyP39ihxZe9CuW6rJEHVVKjVEo9rbxQo7RHevZt463dFnVbjMR3ZjsLWxEQLUkvFp++h1njIzyTcqurwyqaR1Xytq1Ds5rEg8oYpi0LgvcuC1RiRo5c49RpvoXyJpMuy2K21kcup3gDi28A6p3ojIT8zwm4LlmLNH3vB0ywjG0bZ87ARRoa39FKYGbP7ulFVQ6KKTEpTqg5HoJ1wNQU/quJTE8wbX98jmEePVoIlWj+agiDzxeTrdbsC9Fj0aBvTzJK6Cg+mt6r+EgYx1UpC9Vx+mpVEvDoHoTOu4A9SpN3QqMYy7SyL5Te2Y21RoamPlZjCe3gS+hkQZ/C/o4hSNAGIoxlksaAUqaHkflwwEisIuVHHvvwSGINEfcX6rgk9GurI9MclrhJWaz0AtBrG9mZX5/uo5/4CvHGJaggzhAtSdD/nsRBWiQn0AdNfZS2pWimslQpKhePt+iw8UkGpMCwUlnoqKPVUUOp/dkGp+GD6DGPXVKW5X1+qqlR8pTyVlnoqLfVUWuqptNRTaamn0lJPpaWeSks9lZZasbRULNd9HfWlIoieikx9BUWmeAGm4ohOPlJZidVKKhWK31jGe/rql62uokpw/QAT/6rqSkEhoyg2xq0UImYq3BhpN8ti4pRBoPnjr3AdlaIeoMx9uXJRtXNPvqKaUVlLz3wqHPVUOOqpcNRT4ainwlFPhaOeCkc9FY56NCCeCkc9CgE+FY56Khz1VDjqqXDUU+Goe3EWPLQ53qM+YunlS/h4fwrBKmnWYELP+VhRxZkm2VLQORpFPEIlzXxff5dZCJ4K9zMEJmKzzrgFueucJ8mGnlGo6lybZ8O1fw+Z9KCgeMF+7APpnUTPDI6nXbRk0I18EsGRh+Z7cooL6OdcfHDzLcmzUZLl+WjL9f/0Bh8pyM9cZHKhq/cvEdw3mDL6bJRo2fXeO8Fv+yCcttbegqUGxjLn464B5zR9c7l6uE89Fz/5hpLdG5A/5b6vL/e9ieqnVPivPhW+uWV/nsz4xsqeEuUfL1G+idqnvPk15c03EP2URn8HnqyCl8yz/TWd7len+zjFg+DRMzpcE0CXPx0PPw2inf2D9cG0s3/waVDtO5/iWqDaH+58ClQ6Y2wVb/knQXV5enZ28TCo1nQl10xmTnFoXlBVt+45LXRX4vWE5wxLlekP7cP8gSnB8t2dxGuVq9TOo2ZdtqEfyzxHiO0krbU3gD85eu+UtveXoHDt7rz/pAWxBFKeDEtD0bI1VDG4eEfiaYihaspMMBPaZbeWeHuw94BV2IuTiuWaFnAeOnHhNC0y6/m0wYxQA0/xnPWhpMijyo8FSyLA1r3aRmzpJyz2gsYBuB9fnB3+GvqVrX91bppPXNlBspu8OBgMkuHzveH+A5bI58U6TczHaFgOJVwKqYwrVX1xhieNHAvioCD9Pnjh4TESwUXsL84/6fWACRdTpgrFhStMCCkyN0wQOjFMEcUQYy4tzJextvJiH9ZZyWmKCh3UY42Z2zKFxP6s5zKKFujChgRBLM1gFK2KB1joMeOyLuMpgQ9TUyswMOGKsSUwCiw3YWaKUdNXzNUX2BkM97YHw22jsIBDf05zq9T0ETl9OyEXUygw0BHllh4cDnbTPfZiZ2do/8hSuv/iYJfSbPcgyyYPIBCp+JQLml/DYVijNySchM/hZpcXx+evr5Kzf549YIlOT1z3utw0n7O+jcCu398en3lLKfz9Jtg88QreuB8BwYgtUKHzRuzXl/DxHiO2a33gounthKevL8lvJYMDCOVJhF4wVR0E+7trf+C0RcbhLIYIUjBrimnOwlhLUiguwVw9ZQbW5YZ1gz4bZUJDTZojeH60RfD+XvpJ4tHBQ+vze9G15MzvJuRC4rQhZVhjYAGtBe04GFCnXTA0MuDehRB5GKcNJb462npIAmltxSvXkGrlklNwmET5x1S4NzBugqYzNxfR2KmTKGZKJSIP3xgNLrpZ/PYK+i9wYc+Gw0uVu+k3APGsmZu1npo6XpKzk8vKLPuWpVJlbizgxcBBYwvmvFoO/ugnF2Rh3zo7uXTDNxM47F5aGsMseojehLhkBr/U88Xtc56WybEhcy74vJz33JdhXL8oqJQT0RWW4BhZ4CCDubUMrqtggZ5VHMKQEI+VwsXJwUJlV0Q1KaTWfIwO+Azy9a38F1VH8FWpZESuLUCpJmmpjfRVo5rJsW7NaU7Xlr2M5Z8pxq+HDfEFvaoSTb6gNVznql3A+vx1J+hRC5S12DEB2ogFYgCfjwauHw5Gsf+IT0PCVwsmMu2DEaDAA3Alj5J4QL/21jU/HCT+/zqxsM60r6t6WJqluKgaeQN0UjAFAZARbs7B3AXmRjkhJ6+PX50RKHHiykrJ/MZKXxFz2tzUWCJkFLEYE+WyS+gBA5eoUkwX0qI4uCWiQeBcJuQ88CohjQ89a47p5B8y+q1kOiROj+z1wqKCANG2QBzmHaG3fmuMWSUI66749JBYA6kDN+DfsawbFgwY6NwFb9al6Szm7GwCjKmWdM91SlXGsoT8wpT0xUTmYBadOWc78tAKgeMKazhFR5JzN6GusU/F1azqUfGJPAZos27+YjRj6nqS0+n6nHc+ymGHuNRkyyZxZgIz10rQFyw1taovR+T4uEeuTnrk7WmPvD3ukePTHjk57ZHTNx3G5F833p5u9MjG22MfAHFXbdFH3Rq7JszViN1CVLvkHyd1FEpOFZ0j6aGpzUQUjPHaTGFpi3ggKG9X8KoqA7IF3aFB7wyH9S4IsujIHHz0xbtYBSnQy4cCFFafda6eD1xAwgTKpzWRlZA505pOWRIH8nIN8RkOd46BGe8ew2FQBAbMQBhJPOadOPr7u7O3/1nDUeCJX0xWUE46xHsC1Y6PigU11r3OGxGuwgZo8Y0XjMKNrmZCij6YMqwoGJfBfIYJArs7UH7FQkCGOwdbcby91LU3KiYeJ+hRTZhOaWHPFNWMDAc+sU6TZ+9PT0+3KgH8B5p+IDqneuYUut9KCaUtwshuqIRc0bHukZQqxemUOa3BVanMeVSEZcJYFo8ARSeVSwZ7b3rkvcK33gugP+b8hQ+7XcM+/+HJT08JT19TwlOgiy+c+cRrxgO3wvvSlVrM4htK0FksFt1If8rGQRb4lI3zsGycioC+jHrgtKT7JYvj4+N6XRqvql5/TuL4cctCl+fk/MIKcgw6fY1iy8aoYWLwP468pc/RDp9MeFrmYEAqNeuRMUtpqYP1+YYqzszSq0Yxpc6p0VYljGoBJ+Ts1kDt0QBfVGzOA2pmTDGsDyp0EiFnVMmsUEWYm2DNgrAuqBRqZmwOJSGioVEuwJfgd0Y1h0jmMOIN1yXN+e/MiStWwp3Ijg6lm79uREYTq+9UH4dNxcfLwV9CDfBzdZcSef0GAhhr0K3xUGzGpyJY730wVNZzGLYSKRBe/dpaylJFNaAj6z8EiU35DdP2odhv0IMv4lgyLJYdxs2EDqNMELamA2BVKCoAvDXf2fprQDTml8LXWiyYcut/Jgu0uuZLO4SWMtwoTlfDY7GVkGORQcvjVIpKbW2VBbWH6m4vhLfjWy3OMYMWfQeDb2i5ltb8O2cnH/PvvGKG9mMjtW+F4qzQq3d763ScRwE5iv1WcsUy6Cr0CFE6ZyeXwYsOF1jAL5bFNzIhI5bqxD00wlQ3D0bF/UAkAp5TaoNN68BlneeOhCJK+3nGBO4ZbGCqpI4kNV8Aut93xlHnuLAAQdhrzqczk3f1dY5WA+9HyRc5M9glcaqcx5pm/7ag+qIU6YzNaQP/pJYW00E6w2SQDGLKySc1ynn5I/kJjE8fIaDOJJeXXJS35OyWpSWquC+5+AB//IhFaZ6dvfxxCzryQHXtlYnsC8QL+U63tZghh0yLle54ocOD/uohQ+OlYddSra8P6g9Lw4hmv5XQ+UBO7gb8JTcmZ+RMZJyuHkhelNdrvI9OLt7V+gzfCfy5MGzlaDPg8FyK66xi9PdBHj1Wg85JRSwjC8sMglAT6sZYUDd1Rdzk3LIFapiGN8IB5SZuaKR8KEAGjgoriPmSaxP6AW2jLigElyKVXjlSkt1CJM4Kq57k1BhWeYLrhQs5RpHjcCwjLGfzkDuGIePLgq0OF5q3Ezrma467+kc93MpS1HGURfQDhm1D7MaEpow8O/7hfOuhy1inURR5cd1h2DwXq8K5Rm8pNFhClh8B6eZ9IJhMGLWMi2g+Wt0qh8xqgs/FqGvjtV4a3twY3A6xQ0nwUnqAqwt+ZZD1NR3zNYH68bPlMY6SwpvLh2J8jdePo477bqBVofziTO2BJ81FaD7WvYDDPcK94MKSVgFMsCjT6pNCoXwMVGuvw01t9ePPiXfS5biP0mwYEqzCglEzIyOWTxK/4uT70epHObyUzvgq6SIdTLJWFr8uhc14X/9Wusy6MR3znJsltGhXfFzGKHNwPHh7gcXLYpXA+QeBfjmjQkhB3PAkpXlausjgIKZ9MtDrDAOwxHfp6BFOlfP8PxTGNTo+WyDGBVxXh9AXYb6Wk8lqbc0eBVic7TPA1fz3VTD7kE4CLSBDgWo72cNhXePd2ALVDvVwCG+4MiXNr1dvmfIg+a4FpZuvXqbqUwD+9N3/BGgfuPtTe+V+qSsTJvujr0xc8QOvTPfSA0SMTz0oDmseWYGYHgzrmg90A86HHWko1nIdysqsCUwvYbpSNlW1HEgPgtAnrqPFrAy/YflkjRlRfniil/OxdIlD9hitqFEEA45SstYT/Sx8sXIBIiqiHAlXxAOcC0uIOQuH9x02x5zjccfnXHB+UTBouZQzMmEGu9V5Nw3UCkupRjOXisNq0TLPjWb5JCqOKnD0B2RIrKm0PyATA/IaQd4IYF1nytYAwd1V7DogcEGAHwEjKhLWsVgfSFg/zIamH66hI+CDz8MfVsLsCmtLpNBRz/eh5RoRZ2myyCFBg90GrgDO58aQMr3GYNrPaPb4uREJYb97cfQIFu0Aw1+cS4a1kSMNJpjF/01vaJJTMU1el3l+ISFq/Mw/HnOVG++C8lwlfPGRzuh4omstCl1OFJS+uDV3VCCqmmUDDRrF0xp3qLpn20cJtHFxHe10q9Fgo7Ui9LOrmhcjt6pCOl7KwKvA0ecbE4c6ctSElAYIiRHTagwS+hnLSbQIN54fivp6NpYgocQcsauH2tW9qPWji4DGyJNQdN6N6fOUIEgnLqvew5Z8fpBUCuGkxjEzC8bsTFF/Q1rvhIiTccENdoSxW5VLbdd27Hfi4+jGXuX+yEsF/fCgVUdO5ozqUoHhR4fOt23MRo+B/8PQDyzQcIzmmDwqHM/ZXEK6INN2GD9cVmHa9Z284YGLGTaHsOtSsYRcuj7hrqWzvfpGrpM/Zmk5d7I3C9X7hocjHGeOOUihq6ShpuF0XcmvmbZT6h+nmAU0IcSV+IAOHyruKiZGMnvcNglTuOP0NRG9Rc6NJRcggSpkYEaFx2tKDZtKiNPw44fNtQxjBAjp0yxzzcDtuenDuWHwlZWOXA/1bISR/j7evXZxQDxGpDm4zHO0QLKOoKBSM9UvqNYWmX3MEa1vxpQJc82z6zWXa5viSbGHyK/DxQWhQ1EqX1/JiyMjAC3hWRVdhTECrk25iyJ1HSax+W0ko3Foleu9Mzf1GlH1Fi3YvURCw6uZFUpSTwT1MrGmaqrqmqyGuAUMS6vUN5fIr9gkhy7EM0ZkaVLprzRqAkjyrmr4rjAToGFzU8dMkOsYVt9MZH718tIzozCiAzhlKmpaa8c9Pw2Zv1OGtcIqxgWPW47FtS6xU27lzK3vjqdU4THvQvRccSdfOqpZjstuYG1En4dFDcIQTleDRI9CVSqMcsmyKgUGexWHYavG+VGTJqyV0GglXSntUIW3Fm6FvcPdXkFlSqYsYTi0OD94FGs8ZkTOuTGs0f21o3/1UfXAqFpW34U+BhRHhI8Ign4RcV4UkWOX+hVdrwSoJAo9qSabcw0DfWSyTDINAbBhWxrzVriO5793Xs3F1E2rsBKYkO35Yw5st9dtQWx3GdlZrv0s13cNXYMFdDckbfd8fMwrvAUpDg/H+Wmbt/r9WlX99rfEem4+rCTo6HwiSwVhVic4p+8hiwUOMOqUh0iNmF1gHJ+L8nZ7YAfywJMZx+btcZmo5jVY6d7IajbGfErGJTQe2oAQnWpEznQ90jzi9rlhygmWjSmO3CU6Iksnl4cINgJFvl3kt3us2tfU8Btuli6pLJRIBfEQ7qTQxsnNaDdl5Cul+FqNNG60qMuxB6spYITxfYSjmxfiyoEbWAgLpgI2fg+tvnXoga0jPkmNpSzYmhCKF2GyHTVZu9I+YmB4vPv+vN65HMwNoYqE6yjPJxh6CsWEI8xFzb99FYNSsyCfZ0zXamU67V6TUkSdwHtEsSlVWR7vPgja8DSxKmNp/5CK2OWB8RdCDVGmlzdMgUAPRXz8lez1ba5rV5crVoIqZSev2DvYO6wjH4W9j/CCu+KyNt1pwEHq97p9ZxtUkYW/nVwjdGoJUkVVHBWjWEhYIJsDrWu8xADjghfQGP9Oms64VddS10Xsf0N7dUPnBbINauKvquaiDtYa/gBaFmLNgtsyhH40pfdzQeZW+9HclBgn2nMmdrOQJEzrDtqYdUSjopTtP6ZxfnetFpP3p6IilbEcEs1RB40DsV3qrnMiNASRmoYI2wKv4t0CexKKnWeEG8clGpDMpeBGViUvqiGsdCirHbMfvQvbSPKBsYKUBcqI8FJ8uOpYTal2VoI6Hq3gjicupXkv3tmG5NROS9gZDA/6g/3+zu7V4PBosH+0u5cc7j//pZ6QYO/mlmv08UscumkatRpEDSOYbgQZolgMy2p6UH/Bma5yObXodtcNNjqkae2eyeW056xyuZxu9eLJ44q/qE4uq9b+0Xm12kJUklbJuA3FOWw6FAKbA8+GMvZWUvNB3zC8VTFrc4NVLdSNmMuszCvSxz4vWOfelxjPpOlVcm48TMdlU9B0xpIIF2F7S7VKK+kOX2LjTS6K0lyHsAgqpKsN4U1tpYkfoPoVz3Pe+QwmnQGNDDsJ59RNXQsvJ5AeF6atUxLyKcS6PfP4mYkMDhAm5pkqEa5W6qOLF3lGA7OLzFtd7J7yVgcaJlapZnDXlVKB2rpNmhcJ0pu9OP33XqwKgNu7BvLo5Bgsc1nd+L5GL9RPVM/Is4KpGS20PXzagB+qKqkH8XiKLtxNZiDumGKuVmSRn0uhjbLLB+ss5CRYybFJ9MOd3b39g+eHLwZdfx3/cHJaW/o6XSrnp3Y13qoV270aMB/Svcn+YJDVIRNT1q7kvbpMchXuBKCLwFWpUvyGBY0uZcIomrsSK0aqloQBsoVv1QHCwKi6cGJZvEGXXlzIl6HEYeI4ZXUT51q2Rq9JU/EEc+aqqPti2ajr2/vaAhTd7+4u13TRaW48F87uZU8X2letGqZ1ObcSg5DErg20nV6QFNzd67O2ZkoKmctprV+KvWrkB58qy/VRDVfkfzUXV33jt3u00p29nwwHw19WDj34wJvM6CvTc31hg09SdNGOjsl2dqC+H6XpBoKKbV5siH+OQak5HjRmpduXncskylGD44Pl1nW9FVC3Bu3sTF5rQX6HYvu05HpGaM6U8YIMnIWaI6JhvnKGpknb8Fl1MMD8rxk2/tRY4QUgqFVJjC44MqMig0yQqxlbQhbZwqrKwkTHVDG7ZvALVV+imAEIUTKvVs1N1cR/xvICg2m0gS79Mwbmv1DjKZVztAERaiBjblrmVIXiU5XqqKxw1SHy5M0eUTWZam2CLM4SlV2DckCwlqak6BJGnfoACgryqrLAmrWOrKD9rFWRYWjUKPJyCpJA25JSZaxSOAnCS88oDx+DKAj371bPnxscedSoyVBTBSuvL3hc7PN3yZk1rHve/yC819H71vJudmuCjcBSrTBchUP2zlH5ncJBTC4hMAQrdNrn/MDPXF0yXeQcC4ByYzW02KhTUGX0lqXk+LB44b5HAMsTqYhi4OK/U023OgE8wVB+rLz/GIfGtZV9QiYWVjsjWKuXZdWxsvqFy5oHQIzi7MZr66Nr3P0R+GVKzaBpDvbdkzdMKZ45YqVRlrBPjPfg9kiRM6uBasbI6EdkV5BlsyyYHnk2PTqzoiVPEUbyljmxueMmu2QFGb4gg8OjnYOj4QB9pidnPx4N/v9/Ge7s/V+XLC3txuEngqWK51TQKVP43TBxjw4H7o9KyLWsTmPrfuz9rI0sCpb5F/C/WqV/HQ4S+79Dkmnz151kmOwkO7owfx3u7O58FyHjIYEazqH0VV+zVpH81FvWrW/kS3VkTEiXWBh4ON6dkbmZ+g2BAIJKe6Y8t/JbMC0VTPlKTOEmFQYsJvbOxoLG6OFpCXOvpXHVzFDQ9QV+odg2DR46L4dntXONfBOLPzZEAHtr+Z4p0T1X3eINxPTsFehslygd8Mo6FC0wAv3YXooiwO9FcoqFM+A6LGTpNVfyLKzN+WGwAiVKKmHQqnoPCqdujWD1qNrqVWGxoS1MsEOgZGFHj5idDmUZ8IqyfITmebzBK23rTZx77jY2ruvwY6mAniq0CFdN191xYESEar1Wztdapi6sBPfhDuHL1Lhw1UTDDl6hYNKIDbKU4WeF4N/ghDiystWoF18xVCyD8AY3DoeCosHVHHnvPlS7o5nQHZeqQ2uNxbgK0OtK3d68DEUsus4ZmtPhVKGg4gvtXC61s8G1re8v5TSyNs9RbKyJGFXlDK+ihixkpzTHoWeh5dY9BRrdYYEr+XKp51ZOnRlTZFtgUcfWXeXYhSp4P3Sjk2MY8Rn2F+lVDSz6bol9f131j0urRIrp1l3tVmrbqBjV60vVfAujk8VsGdem8OFkbSbVNjx3BOPY0QBvVg7iKQjljrVajDoCD1E+tXidMO7PIIL5MAJ4e1TnKW7IwD+ca8q9gngbVaBVV/9sWTX/sosPwV2NXtJkwcYE2ii60laiAU80pD29GRPcXTtW1rNMMCgz4W5ogBfYaG2fEUgkytE4lxCMoblhow6iuYJKXMCRqCClCE7+utj/Ub1fsboJcw3E5iYg796+JDkXH3yNr/sbQnq6bFKdHwWbvUJIG0/jELkQYouM4jjSmHtB6KnVko+MBEegHtqLWjG8XedSgDcTrtzgEQV8tnfFt9VABhEXvduGObb/MhiArXHl7eH6w7WOZMS7pMZJLmlnRPVbrj8QGAH0Q8Wl4lhmq8kIteNVRMscMih1VIfznWbOewZLA/+V8/WhLGBPbnIH7NdCqvkKBHbnIjZfgy2O/84yGPYjC+phvKVOKbiAwyIGlmaGg0GH/XJOuWu365qFL2UJ+173KLkbATkJFAbWEUC67kC0QyycPdIqSNSZFGEZiDVXpAekJGwP3PAR+DopK2DvQXldm5e+AAtmqt51pUMAe+NRKFWE8HvPG6ZFteIAeuAGpR/qZczZLU0NgRIzrhi9k4migIA4HMDDVvkwgyeoha0bFqn1D/Ba3YMpqKWLgcRhgvr5qV2Y9zlsfw7lyoOyEEaMy5pHRfPwKe9X8vEVsVLuuZNOnJOxLPzFHYWahp2AwGQ3K3c2hVQKzbWJ5W5HmbGpEUQBK2l19hZwMl5Yz5hZNEODpVEup4mG3xP/e5LKjI0Sz3z919X1Glvzq9QgTI52U7QElZpXGLnahCu2oHlkbjw/vdwK0ai1N4L47ciacKOJXIgwI1Zls/d7VW4tjJvKAgN8715uFKYUFty+RZ7XadpQtUoG8v1+QnRCftRT6EKcY19hRBHoM6ziUu5wFtpz+rsUa6wIeL+SWluSPRAV47A7HBaEhjaXaOFgrssiuWI08zKZu6w9oVcOn+iaxAPoiaOKZ401+jRlBVahCZP6IpdQKp/a4y8FqH7np27yjbNSyYJtH8+1YSqj842o7jYdjxW7QR3XP355tbGFKif56aej+bxiJpzm/qn+YP9oMNjYarDRdkbRV2alMjOuPjHmEcID6waoRijfhi7HfQx+3ICbvockhYGE0d1BKkG+FVAZxeTqHmHC7reOIiQdX80gwEBGhi9cFBTALZTdUhA6nVHH1xZtRqF/wdhFZ1eCiil1qinVKm1EPolwmqqDgLGhk5mXyCQwNy4gsv2GacOnfnV1C88KWoXAkHM3NPoFuOhnrDCz1uh4JTmnX2XsQX+2iHP+XOFSAYonKXKasjv1kzv0kurIf5Z+Ml92aCgwxfb+zvNhxrJxf7I/HvT3doaH/cPnk0F/j6Z7h88HdPdwwu7XXjw9TLjzqrn8vR/953vS946x0Wsj1wtaSLQcspBGp8nYykX16EyXjmZ/hWBVn4AFxahw4X7/f4ROuC7N34ldkcUQDjj4IPwO+Qw3/5mKbFuqarGkFmbWcz0Rgnl6vMQpz70Hiryq3Hy//nj+6l++N5+uctnsJctTprcSfNmlNjpjXyPfC6wkUO+aZYjNxnr8cYzCMJxF80E5YRj8+BmCyeZL6sIyiK9MCKKFH7rTgO8tvdVWaoyXhOaUYIFCY3NHvBU1WBxobYUAqj45iPcwX3z9hy9dASpgzzdULS1tFDk1lsYT8hNTGBcK/TjY7YyWGqzkuas2gHdLnVtbrhAsQTxOeHLVSW9YD1wGUM0665GMK5YaqZb2jkrVsjCxB5FBjVTWIzOeZUz0IP4Y/5UiX/Ych+yRheKmw0K9+euGf3ajRzbwaV/ZepUEjHo/fQw8/4hgck8z/e5e+rTeQd/JBa0IrId1uG9Abv/iYnq9tvZFm41eQyFclU9RPHJmwQ7LR4gE8fH5wECiGnlzKsoJTUN2Jq1M7DdMZFIlNT0wiCGK5ezGKXDHhZUdvn9zCQW1uwruzxM7J0tuizQB8+unonq9aZH3Gy1rCXMEQelG+aTEzgS5nE4t64ezJ6eKFjOe+sIWQbyMR4W8qkboglGlNn4+8pLRG0ZKUalE3NfYx1erV5z4Eo1fybZUk1K4pMD2jkER+Ot3r6/evru8Oju9fvvmzdWnblmJFSrX1DL/EoevOYkgvwX6eqnmwj6rC3ljZYbR+ZoPvZ3iMU8+jCeVO9rOFebPuxNNkuqgx+aahxz4s7//9M9fDl8dHv/jU1Fr6Xcl+eOeG2Hz0kjvWYrPUMe5wPaQERb/Uc9Qvus8ubaSvt2hnCNjBT8bJJlEqUk1mwZ0HqgZNsZLYqTMdeXIumH5EvN2UQtBBtC+hz/n7gKm8Zlo7r550RA65QbKiUV3sE/U9KnIkZDDsL/60odV1juct9kere3FR3jaA/HUlRH2MAkYpHlf+xtF1lWFXgjERmKrvV8XedMo6vmxZPRKrHWaA+bpYq2CbqkdfwutgGEYt4Eo/5YFWCNHczvVyBkuuD0rTJMRrCJyvLocMUz/w1blwTZtH+0Rza3m7YbzNhEPd9zwvIGvWmWOR68/DYO7QB7dCG0IADWJUme0DqIX2dcFpR8/Doh0SnkugXXF3WrjSjWZ4jdRKBl0CnXGs8ig1Vrh9kzO2TbNPebDSu1w1zjM5y62k7hPFShU2NH0ntXWzWvAmP1dV0lhwse1dAZhR1l3RcFUSrXrm10zQoNkkge3Vtz3dVWuxPJJ8udoRGFX8q03o7Br+CYbUgDg/5ObUuST5GttTGFh+5M0p4iW8tU3qIhg/dqbVESgfguNKiJwv6VmFTHY32jDimgJX3nTigjSr71xhQX1a21eEbdzWAG4/8kNLGovfmNNLGqwf0uNLGqAf8XNLGpwfrUNLWpQfhtNLbpB/nobW9Tg/WqbW9Sg/FYaXHQC/fU2uYjbPnyhq/VbbXRRe/EbaHZRg/crbngBcH7jTS/sGr7yxhdx71LDxDo1VfAQhVl6hN2meZl5p2POKPydyXvSnYNJGxzgMxr1hA4Da/LMhwIaqpLp71s9sHOHMWE2qA8lYmN2yOd7tjH9faMH1uwNHGGjI2utcPzXo2+6Nt/4heJzqyhDFBuEUf/t/HTrXkfL5nAwGNbdQVUM3LohjIMTOqFru0cs1SbzbH9N8L063ccp2pPqGR2uadbLn46H90y7s3+wvol39g/umXp/uIoC+IlT7w937pxaZ4ytiwgvL0/Pzi6iqVfgeVysrw7tuR27is73fA49chUr80G8zRO8s3+we7hbP8NzPmfr9L+8On91hqYt7xGNw2lQ+IxPNpHKiqIQZjOpqSeElFDf2kdpLxaLhFNBE6mm25htCBLI9pxlnPbB8BP/ndzOzDz/9fz49XEYUU4mPOU0RzPRv3rOzel9MAn52V4RHWUzufZBgOOc9WrZF1jJNZS5ipYeyrGvSErz9VHSK0tIMdq5IDI1NK+oi5quBjWbg4O9QYOEPjOKoiOIIkQ/UKiaBOEu9cO/RqPC68Zl4xzQoa9UJW740mYYquMCA1oo86JD83qXC7E2xy0G7tsJNkHmVLFh5J5bs6DmEcXKP6yx1I9lnsNa4piaXmMjg9TXEbBRk+yyKBDiYQEb23ftfcG+RBjCycW7egiCoWrKTOj+0R2GsHoMQgGpMQUV64q2AUeOgDKTME1LEOz5NA8Ic3Ie7z6GijXW9VmRqwVLIsDWvdro209c7AWtXJqrLM4Ov2a3YljdTd2F9MCVHSS7yYuDwSAZPt8b7j9giXxerFFp3jxGPdktyjm4oBAjuTjDk0aOBXFQkH4fWnfAY3H9YGJ/aVShjIr9YnwnZ5gvR+jEMIVlswvunAtSuZ4wqcwYlvKsuJmiQofAfI1VoHxxWV8oduHqs1Ix9QUeFA2B/QA9ZtfUo0uVE5SoqYloE64YW2IO3TiX020sSte3QoblTds7g+He9mC4DSlKXEz7Liqlj8jp2wm5mCZWemvrzIP04HCwm+6xFzs7Q/tHltL9Fwe7lGa7B1k2eQCBeGf3NRyGNQoY4SR8Dje7vDg+f32VnP3z7AFLdBHq616Xm+Zz1rcR2PX72+MzHzwIf78JlaYuMWVqVQQ83DjeYW2yg9hjDapJdALexHGPKCRAHzSXUs012bAfN9okPDzYPdyrAYrX9PWfRBi7QqEDxDHI1l7OIZm4URZhfToo7BsoYs+QCjOuIAXWQbLVor6Qrxqyt9dmiruaAaNXYIV7B1Y4VRUbilJXnl02THQo369iqLvdH7xIaA6n0/AbZG5rN327xKJoXheX8ezy+PVWgnoWKN4hwbMr04qWZoZFjKjIavkKsKXj0rhE76obCzm/8O40pnvk9PUliVdMyDMonszzLKUq01sYa8zmlOfVe23Efp8wrNSapHJlZw7gHtrOqQThXOfV4pHvUt+BAT87eQ10Y4GAZLoIhQG5rdW6jo9g+SM/8emMHGtdKipSRi6hOyk5Of40JJTCrC0+v0IAzEKenWxhk6Xm+t5dfgrwUbVxlq1zI0/jidw+nn7KPp789d1lj7z5q9/Pc5H2yJt3f7WyWVTfoEdOXv/1nj0PR+ez9j6XKc1bFageffP9NJ7fvNxqiU+WPCyn+Adni09ZiVRTKlyJkDWvJp5Kk2dvPuMwn4v0cxdL8+tS8HWJkF1rpjmxM9qlv/uEtTcI/VPWD52Br6W6BvF1feUgw9UJnYihfh/OFy7Oqx65BNHlokXSJzTnE6kEpw9aopDmGtTIFdZ0lwX3qtUpOt4aKNoOUjUopULzjCms8cez1nbtDHYG/cHz/vCADHaPhvtHuy/+YzA4GgwevCqsSr/OZWGZrxWWNHzRHxzCkoZHe4Ojnf1PWBJUXUuvP7DlNc2nltZnq1SN/KSQaj9+MEH4QsGYMw2Q2JFbS317+bB7IVpUWqqbdR0sK2TD+FH8BSMsz+0DqfupWhYJCMbstnD5cV39FHw8LSQIrk2xvzP8VEyw20IK9tCUhEZSEQ4RNjBjYMRubF9Idl9hVQf7+7vPPdZXLe7/Cav/TC0dSnJaHd1pStGu6oKmqLtz0xbvdwaup+WqMGumOM2vayHAj024rhMWTlVVGIUm1o6Ku29BqNscClemy6ifzCTu2QZ7X8yoK+HZIzyOhENToS+NJEHVyq0UYvWlkKoZhk5nFFLZVBu7+/s//vDDi5Pnp2c//Dh4cTh4cTrcOTk5fhi3CGnwa+eA55XePbGIjOuWhFz8iEv8zKrmheinDkjBq3sCbQi4IH+T5CUVU3ICJU1cZNgyIZeMBbvplJtZOQaT6VTmVEy3p3J7nMvx9lQOk+HetlbpNtZE2baIgX+SqfzLy93d5/2Xu/u7LfxjAEf/ofzZKfF/jOaqg+rqwWiuCsPrkmkuxzQPUp5gK7s+Gov8IzTTz1RMPfBfg2baKvHjTEDYiuQO1fTy6q+V6NojL/96SQX50SqdXKcyUl17Vn1JQFF93H3/arTS2so/aSl/tFp610GtbeFnr+wr0EEbC33YWv7M+qTz7q5XLIqyEO2kTk5pUd3u/ZCHOFaGh81V4vyb+3hPIc6/MenLTKZQkFypJbb2wlpntAoAgzIZFtaoF3+okFCvtgrC+JTJ8EpcRM0XPWfYfRRTyFg6AwGx6iVjITu/8NKeVM6PrPq6LIqch7o+q1SxTLlZrqvs2IlnhG3PphRGMVpv+YLVa5kw1+02/48Cz9VC9l2pk7QVZBlm39TdML9eWaqqFrImxL6u1XFzk7UBlsrMyDHI/LQBIIgn11zLdeH6xElA55dvANltweC4E6R1kaIDp3NnT6igjRJD/nh+BJQpk9dxZYE6Z5Ziyk2ZMdCkcmrgQ9vl9F9kI5di44j0n+8mB8O9w91Bj2zk1Gwckb39ZH+w/2J4SP677u5bo/C3+c7yEp8D1YhTogE1PV90Cut1ywmZKirKnNa6SpoZW1reyZBrRs70k7iReBRFwJVrcwpNGzT2Fp7kUiqnG/eCettucoTg5VUmI4qlPeBzeCPW00RI1XgLzChcWAVbzoGNR3y67dIfS22k6GdpbV8KqQ3N13WqNi9geGRfzSLNsBce3FqVPmic2ainHvV6C92axr7hO5SctkuBiaQiv5xfxIoMdnep6vYueMbyJV5YXveBeuTwZxt3L/YGeytbRhWbWmFjjczqLcxwH6/q//2kC6Y1cSsHTyez+nvJxqxOc93dJh7nynRNZ8jvrmNBTGS9IJGcH78+jp7rBNxdRNvHagpXLt3+oWRC6utjrthHunW1s5G8HBe+uFuSs2vAFCMnzllu1NFYBZ7RVRnpRoGzuMFKsrJ8lcl51S/40Tl1LAiE4HT4hAuGPkVz5tobxQ0kax3fBHl5enxhz/kx9qGsKuAh/Lij9RtobdEyzh7K6+a5alHYi9xV3t4O9cK/1PUY4xwASr6r5yvF9PmT/3yPomHpE/MvkTwrioy6H3Gz4No9F2yScRckvDkbQZvYj9hbJ5U3vNlRmKvPT16d7vcg6WyLYB0O5q7+hBxnmQdqEkrxY+CpG2K8hH65KqXah9zXQcSbnXqLqWtsDr3LNCuogppkbmRav6WeaUE/YFsH1ztaz+ju9f5wZysssEr0rO4zzUyo7dteNDwc1aItoTfCTVBjKVEQFGvlGSag5RWGAZIzECX6QetzA3oe+G+668J9EQOB+0Kbi6xK3kIQoRRo8DounepInpkcLe8F6xHFfGfYfLn1AKXuS6dEfvlsyD8mEfKPyYH8StIfA4uTrhyxZ3H+871NTaCDSLOpiesw7M4hNJUX2lARdVY7O7mEd5PvPSe6sytzuwkITAp9Xt0x8zJJo92rVCi6VkEAc0Z1qbDQVNQo1a7VhTPWk4hmVGULqlgvFM6YY3lQ3SOnMv2AURGGcgEqjT3g/6ccMyUYtMGQWai6tsr5vjut6FGEvjeNNr+1+bpKml4f1GOb06JMSk2nq1zB0KIyu7678eUFU1YVhEQn4Om4SxBxEnpJOj9p1cnRPg0NKWsaCzfI3V07P+yGGYlFlynN7bon1Eo/FkO1VpmR1HQEHygxCwnzAK31Yq9rRafQTuKGKTr1gkOlHrvXdS80OBwApMOgNGmE6TqGaVXVKeP6Q6IYzZI4y/ZTXe5GmspB7TN3ybMpLadsC3qi+I7ck9Iq1M/odKrYtNZsFvBO8xxA01uuTHWoreD6iqcyzx9YNguWil1Y1r9WO49h4o9c7pfTK1ADkJOIPXpu7pWLuzSLqiQ0now0PhJ2kM1NfZfOEUaUirxm5ofzN5c1bQRmwnKP7bEroKOZwoig7fhG8R2Vpt+8vnpz+WbVrZgymXxFZnQA589iSq8v5is1pyOQX51JPQbrKzGrW5C+etO6BfLJvP51mtft3jyZ2B/dxG7R+jWa2SO4vg5TuwXoz29uryv7a8L85k9u7FhKiw7VuXEKXpXzp8nCt48fechGYN+zZ0UxUyqhvX0YZFSnhX/EdP0463F2a5SN4y5AxzrgEdVWQ2i+oEtNSnilB+1rXff74H6YMyq4mE5K6D6MPfXFDVcSSiBFw5/5LXcR8Apjwp22ORozauA+GzWxUHwEC+GBeilzWF/RTKIMtkearotYyKvjk3jagAG7cCGNK7yM9aaAUb798YQ8H+ztWLTrcjqFgqNH5IymMyJTwwx55noR9chhf8yrbGir721hSzcn2Torw0KSX0NU9L/IjN3SjKV8TnPs5KXJlN94WzjsaaXIIJ3jxBQ6MpUCWvDZi9GwKVMJuUSVkt+4B9Fd5Wzlrv1kGHG2LGas4/Lc/HVjMOgPBv39M/h3t7+zu9EjrS/3fCvSu/0mj7N9r+895xBf5dJ64YRHpzs61e8Ev3UmKS+3gOL9W0lzKB8Vxoz0RLDuUZSAnOm+sheV2qIc0gmscKeI3coMmq5YVbe+fUba5xuHyDVDTtgUCvs+hunhLqMDuIRkCRZNmuehDzP3nRB0RL0VC3w0k0NjqQVNP7CV6vyutlg33le3XC7Wt7WKpQxC/fyiv5K1rntvw7r/oPVKnUzonOfrCgd/c0lwfPLMy2yKZTNqeiRjY05Fj0wUY2Od9cgCDWTtAhX4ZAvuMs8fD+o/rGBIy8eAvLpevS1UkXJWpm4jGE0tvl/Jf9Ob1i5/YEqwR8TX/WvA2QLYoOIpunB111uQ7yV7yaA/HO70nae5Cf3jWiG+tr2OKys6lN21uf9sYsZHfXypnfXzufOcMmGk7pFyXApT3neGqVrw1hleYx2czXcaOeTIzTNyFghoPGvYVCr+Oz4hm4vkwshKWK0U0LGSNAM1iymopAq8jTdKI/nHNSMTmedyYUd2Sk29OCp55mNG2NYRybkob3tWbwCMCn5b5TEuWv0kzxGkN5dWI9rchGL96LMDA41Tr1ysRc7RJ8fqfYXtE+OKjIMbOSEXOaMaykCSUoOhxt4/smC+AQGkZeJUZyeXPYvVQslCaka4iexkrkl6WzKHZT7gmlpvvb4Wna/KuoaDZLiXDGvQtqn6cXSHK9c0q6E3/CgVOcllmQVPjncyYRYFuPFdj06oIpTzD4yMzE4yZxkv56PEEtPNvKK2thsp+Ox72HMi+LV8/b04e6NS2MOIXYp7XX8oixUr694laF2yVIpMV0LSjGoyZkwQjEyrb9vuzn59eqsA/VExilASa50hirA6KPm0psW9syuo1ZRK2gBYie2RkzX/sKvcLnhTg97tJbYJoTeU53TcUWf2OB8zZcgZF9qwxj0IuMEAyj9vkGy0yK86XjaC80uHzjaAWGf9Vocp4DsQpAkOFOVCL2NePgGjETIoQaiQYjnnv8eBHYDC8PGdZmConZARrIJnI0sp+MGbqdHAlUoxwb2ieW1OKH1HhZCRFdJVk+8gqrXYsNuk5HYLpmwD8Xjmwj+Mo13OpPJVTBXJ5bQKG6kWXaunbllaGxVK5msrt3gMrbDkBAkSZvLeYfACOXij1IS6LfgDH1NBr2k252KjRzYUK6SyYt+1HdDbgD8e+WlMLfnip6urC/h8d+Tnjz6kPeTF2pcItRwUSpgEdaVUuVdVNIPMDG0iWrLboXK/UsV+K5l+QBqGf2Ess+WnWPI+2kvvMi4Z2wCTwKzNfTk8fH43iK45wp9AYrhyZnrc+Hsx8hPLc0kWUuVZN2bWsG9XEOen79u9ZxZY4M7YBbdDzR/u7XZv5tou/c1j5zxs3vvQF6+G69oll8up9qGmYS/TnDNhMO9AQ+1HqGoOlcSpbxQXnrY7yrMqhwJVJIztIEKKvjZUZFRlCAYirXJej/7Zf4uQ9c9PR2FUe1v+s3/iAOVS2F87Cinv7LK9/YPnfXb4Ytwf7mS7fbq3f9Df2zk4GO4Nn+89IDrWb9KcmZlc20bV9gKnipB5obgV1iQEug+Tg2TgmuZ4C8q05BkUZF1QHUprH1UDbFwF8wbGHc9Le75YHB1tZMjSwciU30qmllav36h5qeWkAgPtJmF2CAcqFEvRCclSWjrOHfqHw+iN+GZcr6eV0klJIFHNab4kGTPOdE/Im9pAEPkytiq8yGohtVwAkDvJIBm0yONvZ1c9cvHm0v77zv4jL6+693zNPZE2X3FX+ThY0SwXad5f0aEKgeOwgVDquJbjMKYaHSbeMBE1vozZai/i28Y/PzrBF/pXYBJ07bzJiZwXVHlz+zwGmYZBF9xUnQui2TY3NYmHdaN6+8uM5YXbbbfLMI1i1GgSsskImXMNIvEUuug5VtQ++HxOp2x7yleu9u9xrNiEKbW2MiVv3fBVxFd84Fs3hS//Nc7lNC5Zut2AXRdSaPbF5RWcdlWBJQbyzyux3IeTu0UWj5svLbM4aD9NaHFA/9HM0YHxeNwx2sJHZI9u1A7+iL98CoOsccMwqhPKHoUrOuRqQ02pO0I9P7+ZdP3c4ETdEZ97g3ri2Hq9HQDXXZ7GIXgzQmycD0SI9d3z2pf3FxwIA8RFB3yhVsVSqazADNEW2JgA/6zPS2r2Ieg3gqq7iwMTRI6RNFwu94QrtqB53iNKltDJLJfUHo7cCnFqK4xaHZPbcEzCWDMqMnCp0RCYkUohgqB27l5Hec+NSYnmYppHw1QoQOD8WJoJLRWEfhBdUEHsirbwTMdw+GiUDlR05ICubgugOafrstQEEsFZMPCj2rHKDtvriIz3u1eJupY0575PI6bwAyo5VKPuEVka94ci2fx3MF+lYFb0YAg67/LfuRdX5RprU2MrfJ2fNpFVI+8KW5evX120zgkh56cdN9zKquAajd7n8V6wuymi3VPSzD4Cf1WtahrzqZfu4z0J2aetXGkwHtobK5fTKdxELJ1RwfXc2UXhSzAJWOijgnZgVKjysy2jq3broznarencuJ5XplZhgDyfbStg+/kjg2fdT6OXOpfTMNGYRVcXFJ8gIwsuPpZ8P6otxL9VtXaVzoELGeNalsoe7doKrRhhF8GyePzvR17QGJeGKOq8xWSEMH8P7gEunBvZKrSIvgdkgUPnqcftH9XohdXssGkRC12JQM9BnKSWqCdKzhvBW+Fg3teCk6zSerM64pGZmyyoFpubBtOOMc02wNcjmYR98dQXpeK0ZajtG6q2czndnpQCGpXpxB+oFThH3HzvUcMegjnEriokgfltqFeVDLhxFBo7BLzRSDsEuaEUaEylVSTYDVOQxWUadfDhNhauwMpUQtUDJG8YBCMo4Hy4eTPJcFfwAC3t25XAvZQlWIKK0sSnKpxpy308MASaNKPgcIlH2v+0FSfsyznzO4msZ7SgSox6ZMSUsv/h8E8lO9C8w6rIlHIWiYilTps2g0dL8YmzXnAid6PbO8/1nEZZy9fuL3UJzCY+WPEoaU61j1rnghvuLX9hBpARnOZBSVpqI+fdAZVSTX0TLGzfmIylNNooWiQ/+L9qyEITIDQYTXLezIzqZEgu3+EuDNlRovhhEzeNplx4lcyRHQSH4uKdNTI2GDaOTGO1ezt3LmWdSaBNMnis1YXvuyoj+fC4UPwspQX2DI6YO+ZDgMcgNfheNVn3K3ZcYAvhSuo4Y4F0kn/TG9qJ9FKk7czgR20QUkO5m84eDGenbmL5I7TDfYHq+kLow+6DpmQWrOxW3FdsLm9AoifC6mpz+m+pQpieYhCw6PhEfa8g5algSrMMRU0ve3ggR00Di+f48cUzZxoyySA4wWfh+dSb2hO1VjyE6CLnBmv6GFIWdgFg0StyRgqqTC2oE9N/FEVvFVhk3LDeJ4w7EycKUWFJA3pRZDBipYtWp8KN0osPQW0ZfrG91oISl5kUxoRGujS3AseSaHvxYNv61GlnFIsvY5whE6kEUUgqItgCGJrG3WT185QzKiyCGiA3N6d2gKE3C8tgV6qmLHD/ZVzTcc4yoqXFfErhPh4z8PnEOVJjH3oNZjV3MyhmFGeh+PToGnlQx3G+ZAUZviCDw6Odg6PhABPDIQDx1ZJU8tPKXWRCtSu47FdgARLqrN910J3MMGeGQrp7LBG5DPdIkkQZZM5NzFlvOHXDhMBgzRh5++OJJvt7O3t2a3eHB3tJB/zJhKY852aZrMPAthmt0DV1IX7ClpDYDNEL6ztOU6lQXJfRqixN2WXdUcyICn93VxWLwpD23Z3dNrHs7N6LozVetBGmrLzbRzvxyshqrAOI/HnXWgrFpVqtv8TDtrqxzX6eNkF/4hazakiuySH5vkLOfwSRO6nzotCjyL6vgO8Tdluw1MX4BBbtqKdR2urFsMOvv7vfhdYAwMOP0UdPTFA1Vj4xNQXUSWfQewq6l0cMI9a5qiKezYkrTgNYappwz08vt3qxemX1oxbw7mROpUW8u+H9j6PkXtCttgbXidfWLLDacJGaSCm0Wpu9HWSB6lNewZ3KAi1YDQ2tE5TWlnfyhLDh6xa+/2hiCBPWk4xXIgKw2t9BAZF2/gdufgRFa9/PnLLdSD6NLZivo68+Ujk3pI7Wakuie2M+L4UTz9COJW+YcqIkrQpZEhTScJy4NqSuGQd9kuynVKL0o/vARzdss76MlWlvqiSRlbwZlblgXcflGNWmKb9hAlubxLM6g1KhpJGpzJ0u4i0NasyNoopHhEO1q3ngIibEVKPMPOepkpqpG55CQcTSYM0cO9kSFYPqYf1hWUS2JZ7+1rM3FxtL+aFHzMLKcsoBs6hln3FBNDelk9oXYGjCHESRRXEp0KIdYKn6sthbKAuRbNifJSjq2xnThpxfYM923QO/lu7FsS4LrlhoZBPdqZ8RwQVN5bDkXVoGX1GlhqHXjmyce1+SZU5nJ5cb7YNJ+bxGWh2xCy1V9iFxC5sYuIABCyBxQ+gM7MhY2nMDGRWNcMPzCRkhgjGYYgRCxMgi2yrpXIrwvXLFlXpk5A+r+wlFFV7thC7nHTfSwWENAY6DmOX1OuM4IRNBToJ3QUBVML84cn7hCkIjNVFNFizPHZML6/HHryqzVed/URtQYqTM+3QqpDb25vPRmkb6WNLqrE7yevrlS0aVIHMr8FHT1cvQEkjOpzOzHZDX5xkUze4Q+o5mb/5Dv9776T9e/W3/1X9uH87O1T8vfkv3fvn774O/1rYikMYaTLIbp35wf/t7dm0UnUx4mrwXb33nR5aRSts+ei/I+4Cc9+R779N/Lwj53jn18W8uxrIUGX6QpYk+gTNT0Ny9dOs/xSOT70kpgLjfi/fi5xkTZE6Lwh5muDG094HYW81pOXMpuJHKl2Rkt6YXD9nhHKlYGpTM1AQq8Fms3HC26Lka7sFqoMn7Db/gjXhoqcj7Dbf6jeReeD2qpSIFU3zODFMt+OOx/VLuh78GeHNbw0Q1fHQuDrdpo0feb4RNg09h0zbcav22RYhI3ovKDFt7xdlx7H0HswaICExBFWeuTDTXaK6NIYVev1ibryHleE3LLCRsoQa5wsV7hEkStA7by7U2LIJZrSRMXpvRHYqOuXztqnhQP5o37EVAXFX5tlF2bRQobL89v7zQRKp4yH9cvA5Xc8j9TTba1lnAZY2NTKRaUJWx7Ppzql+dX/h0T3RXRsb66CdnTi2UvG0HDg5f7CTDZJjUvQ+cCrrernpQOu7CXxavUZF/5hn5YrFILAyJVNNtlNOsyKC3/fXSR+DaXyS3MzPPtyqd49JdKyC+5K5JoX9Lu82nOZ8Kd6GBAPyamR9zucBMBPjLpQ6FcSGBAUV4H4HetaZ2a+s6ooVYCcV3Gxlfh5I5gqk49oFmmbuBXREAS/leHLnJqXAPx0bg6mxB6Jhgam7p7B8vj18jhf3W56L/G35hKEZMcE1cabKEHOdWPIzSExEe72a30yYc7cXwt/PHA+wRTI3QBitLVLKrhUMzkbk4EOABsGnBrn842EmGvxEmUlroMncSttUYGsFfDXX3F8Y+9MjPXDE9o+pDshUQ/rG4JLuAxK1uTScGcN6OTqpFqrVO98qBR9EK1mjxeOPUd1zMXXFIdy7ngdFi684gRkUUC3NgAxlIF3SqQ1Xn1h+65nL+BmkNP/MJr4HdWfTqPoWnS7nxla4+Rb1x73YoONUvHSqO/7HShZ2y063k7NRDbj1LXoNcvfnyuWeTlX6CnIfdJqA99EgO7PrfNLVae4juCtaEr09LDlmwIRnBQ70OFF66sxoqtFUSAlpIoLQCzSLp9f/gPPExDLUnKwzndGlv/jIresSkRY/w4uagz9N50SPMpMnW14d5kzYQv6aCMy6++c3lOXklM5ajgrGIC8N4sn5psZhY3O0hBiOLVKFZ2iMFnwNCvz50WqBr+PyW79E/ww0aokjcKPC0s4i/ib+7r9NSFDTdbLcEln4aqi32LLWUaOeXqsOQnDFQsXwkLiap9Pz4GFCG0bkfHbFfF+OdCcDec1jEUdcbZIciTCFSzTdYwkEhJRVKdLilguYZKh+1MmiMJKoUqyOAaDkxdrrEV1duNnzyHhrdIws2BiUPVHYujCohNiektm4XCtYL4/rqtV4ermwc3/kTbAVkN2wMUjQjRDTkUoMC0BraYvX44lVIGvquYjuBPiMfBsU82ztcGO7e8EkLfEKoCDlUgHVcpw50oX2sNtKGroT/e/ANq3CjYjiW4mlCXrnYot9KVuLA5OzqJfQLkwJIyJs7CyWhbHJlXwrDhPaCiqHRRUKspZXMPD60yyp+gN+Fxbkpn6ZC+jPtKuqSmUSdrcpzAU9HlMyB6rpFAxRfCmzfcj/ceCgIHw8BIUZWlZ8sfZKRt2oScokpO1TNa+a26jpxno6m/tZI3vGeMEjhsVp5M4WHRIUF4yqEDpBVmbyrPBwQkjyl8jxYOWvh8E+f29Na8beZ7NNa0LcsrsVL+Malttai2r1BHs/84biwbw/iXRLBHnfP6u7iwZD2VLkbqWIQLVm/K1wD3nPnweiRM2fWr+6g01e/9MhPb3vkJZvaJ6wS2UToRTnOeXqNwzCzKmKfOqw9dVh76rD21GHtqcPaU4e1pw5rTx3WnjqsrVbvpNFgrS7nVh7IR7RkeH1/7aaMYFj4Vm0ZvtXOkzHjc+qStJD4p7dmtJf8rZsz/Iq+ZXtGbQ1/GoOGX9UXtGhwkcp5HGH0aRaNqlILxVEbt4XjVi1rBlgxwqAfsWacvvplZUx+WrRhFU1YlfjrvsXX1Haz1nGzDUHA1FMHzgZtPGIHzsfTi0+qqh/37qVPFIAHYXtcBkycAhTerCX8+KKGUUBvJTZMqlDB4M0MHkaKCfUsr0rlYZa/VFMq+O9NlfB8QoSMC5lAUDVjGcvink8Orvz/Y+9tm9u4kUXh78+vQGmrruxcckTqzZarUufSkhyrVpYUUU72JDpFgTMgidVwwAAYScyt+9+fQjeAwXAomSI5jp1VtjYRyRmgu9FodDf6hQ00YeOJnmPItXsQo9v9qbQQLz0C7Q/fWt+4lx6BLz0CX3oEvvQItP+89Aj8jnoETqRI8niNVcIrzj07wyNKzgyIatt2i/BVCJjkNK03Bcc5y+xk1hVWVudr66U4KtfdLkypEcNbC4i+AwvM6O9lFV+ijmX4mbnrFZfaU4w0nTAVzavM55Kv5E2xe2+cIghl+hIF/5nAf0Apgz9EmjIo5oceO/NXEeA2p8JAyWFV1JYO0rvXSdRfYODFGK47HdNMz7i85+7ftYDmWS04O4taZfGIKQ0JFPCuizSd/f6L3Z1sGGCQwiQZGuAuU8v30SzN6mTcmGZ0iPGcsSb5xJ3xi5U/4L165V/n4sSLvD4zG8OXpqVSTolTT2iYnuwX/K7dZ5q2F5XmcZorPbexWi1N+Nx0zwUvl7V11Lw8dcAZwjtSWxZZBeZ6ueQqNEWWIy5c7k4KT3EdCw/jG9UH92XBt5A0RG8hFzmlcdWEus37rIkawMKqSWC413WWBlMQOhhgCSrrJ8RSLq8GQqJd09Qso5muSj4Ccdc6n8zRzm0l1EVxdpLva+1gN58VTVgNsRChYfYMU7o5EUkzTuL7hdVLj0+N9ohr8Lw4Ljb7e1EkaoQ9PC3djkcNC1NbzbaRGdNMNUgmxjQBR0Uq8mQg8iyR0yrHFa88jd+j28wf5DPff6GSUjiOSw9gmTRyAjRDNJ7ChqW+vFEsxhOaOU+ZkDZqpaRVzkR6hKWVlO+SMWLpBIpOUSlphkrBgKeGqDAO9IR0jkGoLQnGVwYPOueiB6PA5zl11f+CRr1lF+fsobA+cP46907IW85FV5iwJbb19ma3OG2ebrZy7qrn+8qD89l01puxuJr5XXqCX9zAMzR63A38HfuA/y4SYs0O4O/Y+/vi+n1x/S6U8/yt+33Dyhp0yMJT/iL46snDvdANHz/bQRdUmqZY9xxTd92sDr4TXVR+Bzk6234fh3KvFbGgKIiCA0bxP8NRIerYD20BwTFtFm0xFrZrl0GIM1nU0SXjEdcs1rmsSzjYNSlNVVndh7f7vf1y3YB+ztOkZi/cZsfumbmrBmLIQDHrg/FsUWxnxxX+m6B9lC8pZaQZ16T7sYM5AxmmsDOoS+eGmFM/crA7eMPeHiTJfrvfOnj7tt/eZqzVavUP3h7s77/df/Om3YqTRTd4PGLxrcrrOsMO7fAVYjkMwT65Y9I3OahW1Xrb39k+SOjB24MdtrPbOjiI3yRvabIX9w/ig93y5UoweU0YHZVzPaD8WlkKeMjPJyzzZZulGEo6hluPlGbDHHyNwrKUgnDXLclSTvsp22KDAY95ka9OimoBZTsSydlTsajtPD/JEliabEhG4j5EGNoa+BW1+Xu5YrIJCSYNMkxFn6YVuuDX8xBhi9jFCdVz1bsrI/ighNhc+MqUS3nMMlWbDnSKw9t2bYUnNITMbfZAThjViRolQWp7JgBNUZPAEUPTXoox6V4c/Yu46U650lhuONAtlOL9lBUV+NQkeYDqe3ZItfW6Kmc6ExqPmB94O2rVaBHMPSKCKQrOEWUFvL7OdBdUj4LCzW7deIWhwi5vuZJbwPpbhyxNqdwaiq121N6ODmZ7b0OF9trc8B/F2ICMvi0/WXjx4TUY0FO5KlQSXnS4ebxJha/KK4wsM8y06HljFJsFsH5WAwvHMaV21dVzZHt7p/3VjCDncK7qAhDKaO0Ap2+GLIbdD6cT1nA9HfWIlh/Bq6riYgE8Eb6MzDsiJ+MGSSa3wwbpS3bfIJn5YsjGDZLl8PW/qazueTlZ+N6lXk3MLWh5lrCP8nZ0ECr/Zb3/mHyELtjLaP6/or1HLoTUhvXJ8QOLc/zz1cXxa9/u55tSqw8vPpemIZrKIdPe+QtN0ypq9v7uwlpiyfleSwpIBumaME0pzgGbbdoavAmhGp7iKYM+mlVHDRT4FwNNDoWcCFkuNvUFNOvXHj2qSVWNfCamFzTMq/4CZmbsms0nj9qMffRMtPajnehgv9WK2m9223uL4sfHkxFVtbWpLSrogxEzhkL5WAL/4ti2NOxkDgrSbELrXXiMBHAR84uNFndhBwOeDZmcSJ5p0ucZlOWGa2VCB5pJaORuyIW2qJC2nW8sEtYMG8MSWw/Uma0Km8mJOM6lNNo5KqFYYjAewc0XFNnXknqzF6BHj9kXK/Lf399HAy4ZmzIoy99PxXBLjySjuikZtvXc2m61d7da7S0taXzLs2FzTFOjdzSROE0zIc+G0UiP0+qB1Ir337Z24l12sL3dNn8kMd072N+hNNnZT5LBotzhOvD1YBvUncxmCLmKBOtedE7OrqLjfx0vil+9IY8eqXlxj89EbsPL5+uHzrE7beHv2Uu5jaexD3CPXW6xUwCCr56+kF7I8+emmH+dbLazv1KGlqbQMMDWhSuNh/133HCEJ1sBKwb9pX13SLh5vHHTT3hyQ8RAs4woTafK+ZhxKsK1YumA0MyvrsFqwlHMmAfR7nZtDOAaC8Et/MSL6TPDunKINztS0qkt4w5EonIINUhVwyAttfezQ1xRX4k018x1EC9E4YgR5hW3QJR9olMjfPG+HykzkcJoTZCqzTW/KyWEz826Ajuvz7MtpUYbDbLRTM2/c8Wk+W+7FZn/tfdn064M3XpQ6uF5BtCMZ4FlQ+2PIscbZmwIaJjOb+pZHDouc8qVe7VdMQzG5lM/j2+ZJjSj6VRxRURGRuLeDzk26plfE3Jv7GO/+bXANQq2DPkEp4Z/YYz0D1qecuteQoVB5WrCYy5y5ftYVZfgGWprwnqKDzMKfmb2wNUXi2/3hUgZzebR/j3+FLYo5gNCiZ8hrJdf4Rstc7a5JOTmL54Na+wRZCRN4Fpy2omZuMRo1Rw+96hr7IM7Myi8OaZZPqBgl2BmY+F9wIDpsPUjK7rQSpayO9sZpDOZpIz8cN6FoglVlojFODJzsuhhEkcQ1r0sqTXVeX3y7UtXDDGTGn3hjCAo80nutnEqsFNDLKcTLYaSTkY8xmbzqhCU4ah3NOVJWH7I2IgyV9rNZ/S9O0byrKjXapvZuleLV1wcajG+H/aeKpJncL/Akjkt+i4vzy97n8+uLj93r46Pepfn51fLLlkOVUPqqi7TxeFLag8EgEBWr1yrBTqDmWZ0XPOmN1Osc+fDeHCnAykLcLVV3JeiBhkVG704B5634Y9//viv395+etv5ZVnSGv5d6L7hiRNhs6uFZMrWvS/20Jx9YWwuPlNviieo8BavP/aeOziNcg2C1Vh0NEvAeeuHLMUYQHWZUtXl/hT6srkmSuZ8ZekUrwDRK4wCoKrzrHJ2gdBYkczzT14I/eRDrmlaPoPxPtGYI0PKM6VLeiEY2VPs8ZXpsplbFXu0tBZfkGnPpdN4TLOkl/J1lr5Yc3RVeR0+5Gnq4IZ+88hKoPUZWVCIi9ngOaeq+7msyj6jqiNT0zQtdMZghSDhq6JMrqDMh5o8aUJnQ0m8Ar/oQrJ0EH0FJ+8nGo8MyUuOXiswjk8/POLkfbvfXNzPazDpTzXrCZnUlpz4fmp0G/ZHDpdSYvA48Kdc65SR4yzhdOEj1OAQT/Jejbc7hxefSymOjyJwkmnvoFsMcMmAVXvBJfcyd+HHD1picgNeiLtLYN+80IC7qYrbDHfNz7DfXHEsaxB6/ZynGiNfwbuTRBDsRDNfEHFAb/GIsR4+rM0viuYAC+HPHsC1ugDmg5RqzTKWzEP/1GaM4nAsIQyLiWECFB6ckEP6HNhGcKkU0X7d6YC/lB3ohrs6gT31Hg+wonDWq877k9fLoAKl7mpCAq/gsJreY/vkObAaLq0J1COqKcErvgBQO+8SoLJMy2lY9nZtN92WqMUE66As1lysO7huo/XQ3gCT1XnjPdCFxvkssFWP9nlN4H55vznKn/IsfyDn3WUoX+MRZTnlqVPqOZB+dYG3xO6z13LrOjtwuDWdHa5z4ALAZSywSyudl8DH624rbEg4bmCsh0Xjke/QVVn3wIJnZqGsJVjExlvnpb1ZgMqkZmgsruDKMfSnROX95kyLUYh0y5jR6m9CjKMfbp63vf2L8YhvLydAS7U1ZuJReVP9kVtfRJ/2ecr1lCTcyNx+HpLNwrHUMsMRICaLBEw8C/zuiGaZyIgdnsQ0jW0T1EK1WwnwQUpru90xjNi1vAm7DCZbDs4aL3ErYIaJXs+D0pVY74nBQLG6LmgrAONsK4K8fKQexN8tBKgvQR/mOzwP3hrP0Aq4ZqjloLzjUuc07dlCl+vWCyuQ2vlcYc3VgK4jZvNRiJfghCG4g77S0QqT/dVHK2K8xNFqX3yGOrLsxrGUcwTzTLUUvDVv8hlYn7/NR1SypJfyvoT4rZpAdZopTkf8dHgRAxVJuAoQehYOmqWDGkPo3PBETcd9YSPNzLZ6vlUCdR9qvBV7vIbCvKswNlOqHkUDXpBBM1C4iOgHhSowIwhbK6uwhJkfVQzIPZTELeWsQjICdIlxgGCyNlxVfP58ctQg3akai8wFQJGfPp8cqSKXFbqZukBGmDkHVNOpv8LDzk++9SXc3lWxPhSZ0jKP4fKK2riidGqHCykHNX5jMTZQTSSNNY8h7WnMNR+Gt8UXJ0dEslxBsfF7lqaQyhjcLVJHzdjHBgmoBcfHrEFoLIVSs+U5iGvSYKgnlJ5ztRVvx7t7e8nB4OBg583ewmlzxY3F+rjwL8uL78yEUYW8XgqjmqFdeHsyQx0+r2nZ8wKdruBiiGu8ay/HOxXtjYDBNJNj11wOKjLN7elenMW0j0cxjOkrDhaTuf1uqztBDlYYZ2j+gTuvOYlT7Z03izKR2YrRONmrSZB9OtrDKaqTqpGvF7fuWbsfO+0npt3e269v4u29/Sem3msv4k5Ycuq99vajU6uEsUldU3ePjo8vgqkX4Lu/STmPTXfMoX3u3++KMTOngiKxTTXG3FsbwCOJ4mOezkuAmpVjEyqNMHkJeH1ewOsiEesFZV9CYr9mSKwl/PcbGTsfgZcA2foCZB+h+Euc7DcfJ/vIyv19wmXnI/gSNbu+qNlHKPwSPFtT8Ox8er/E0H6JXC+htH+LUFq7nn+fiNoAoe89sDZA5buMrw3h/w8Osw3I8K1G2wYg/k2CbqsYffOxt1WQv/UQ3CrE30MkbhXq7ykgdw7032lcbhWTbzw8twrwtx6lG0D8rQbrBiC+xOwuS7HvLXR3HgrfUwTvPPi/4UDeeeB+s/G884D9PsJ6n4T8243unQf2NxvkOw/Y7yXW9ynYv92Q3xLUL5G/y1HsewgAngf2NxwHHIL7nYcDB6h8N1HBDuaX4OCX4OC/OjjY8eK3HyNcTxjwc0j0Eii8OLW+arzwM8H6ehHFzwfsK8YcPx+4rxiV/FzgvrW4ZQvcNx2+/JUilBen1oR9jZv0uhsQFMj8h7QiKBD++zYlKHD8u7cnKDB9aVTw0qhgET7527cs8Jj+JzYvqNJhuJDL4ln+1pPC0rb4Ql3/INnIBt86G6/PzPjGsn6uSjaZhb4SSf68hl6+Q0K1UfX27vZzgatAt46WY2ZoR7lNMpkPavuZoIKtuACsj7ZAHDHs/xouqw0prebdbLfa+83WXnN756r19l1r793ObvR2b+e353qWQZYm0fqpfAUDk5OjdbCBhbJGUWrBzeb1hcfZm63nAs319+I+8sYOwDzjWDG8CN830LeI1g/cdRt5TJXnVqAeOQxCHhM+gA6G+p0fchD006WkL8W9YpIopkEEc22BcE6se9bHdoigYmQ6xY7aQfj5ouuRTwzkz+Pzst3LYpElZbk7okb2sozkk2qn653t52qZ90IaDaaXcMliLeQabaU6+cewiQWdeNBnkzpnybM1EmO2RVMes4Wp9PcwiP9zLOG/tQn8H2D7vhi95MXofZpB/vbW7n+8mfst2rceuK9vvfqp/2rb1AHyLVmeXqP8C+3KGRi+BavRg/RN24RPCIO/j8Ho6PPXmYMOgu/H2FucMdZgCTo4JRtypS1VbHvYy/C7x/vDfgDECfZzBWXQ9YZ3AxieQFtGLd4NHtJAwxjqtavD5y6dC2Yh95JrzWz32T5VbH+XsCwWidHsik33QUiPoKwi2CAqj0dm33WZ/sXooMcPkF5zyYY/50xO7XeNclEF6DCrJsjjoghzg5Q5DH27SSc9891N5EtkiInVePu5dnpLMWafaad63zHp8kAMLEHMra8/YHb+5fFPvfcnZ53L/0bMWeLU6IpS+9vP7/POYavzy8/vrzqdTgc+4z8/LqrswBLj6fOlmkpLLfAhll3AnGOzvGaj4Hwu28kT68ITgipCXajzvDdhXewaOQaIgC0Uz4ZB2Jx93jMJTEleGSJ3f2sAsY//ddE5O+p1f3uN/BCGVHkYuC4sL5ExO66d0maxQ5ShnRAY2Iz+6fPp1QnMBWO74dKU9Aso76jkkE+aQnUwHDbLx0zyGHAtONqMefTr+eURMvTxT72fzacS6AH3BczlCwclLOZjmhLJbLQ3GoSvWDQkNxvtjZs5AWCbv28cvruWml5LlvS0nlz3eXY9ntLJJGIP7BnV1IDhqrHR6ynno2mWUJmU1xsPVCtFXB0QNYshssSiWIz4XR0IdPp9ye44rBdYRc4FZ+arHCMf/3n6aVGAb9m0Bng/8jvWxLbcdzYWUwwgsaACbPf8w9Wvncvj68JicyL87Or6EHUXm7Z5fTI2Cs0HnjJyDMGchkHPYVJ1fc8zA6jhu4VNOqpHNaAPdVHM2GHZE7NUDTMc7FCQ3fMW7nplgvhtPocw10esnw+HQV7fFygUwrlOEp0Ftj3M4c74CoMsBnGhLIFUK+tKxVdPttL3ZRUV0+YIHzNbkmpAY3NAU83IhN8JjAqXIs8SQsmEM6hU4uAzcsydXVChBh6AQyAsA2eddMooyVDeKZuSSUrNkzwzJ8zxYdfG95KrEAQ7NLq/DCRWFowbRGnwoLnTSQygdA5MgbqCPRu5DJSawr60RfkycmOpGN14TDpGQMaSaR/Nbyh0cuEytphy/j/nfcwSJiEovUFEXzF5x2TDpQYUHKFtKHODxClnmW4Q96jZJRnTRomOBkLeU5mwpMcnETkZkKnICZ1MmK0OdHLh5LYWBfR8ctOAJw1I2qgLSDSgGCVDfscyg4KW/I7TNJ02SCbImIJqdj9ins25hskoeDn706LIZjDVu/bBdtSKtqP2nstrWkSVrtGn3ElTPCOoGjGFbCAyQxDpGMtqVliVybF/A2jlpUiu0LyE0nAF/eyohrQjlk4M2yiuc+sZBuqZqTalYQWVSwYZH4W9ZQEjNB0KyfVobPjpFVY0Y5INBLxhGMqITDj0PACL1yyBpIga6WvGxzR97zc3XwUZIvMJf4x7yJMjfB6PDEY+/Hx0phokEWPKMywG0ACbUlnNzH5lmDnlVD2jQgBfJCTaP1TB2srtk4u5yJW9C6q2UlGOv6FK18wiwHfzFsFnOnyBVv48ye0qucPEfX7iJDHP2M0O6UzuIsaVrAMPkM31wRSkbOplJBGOXnRoDCQDgE3HdMlQhKZM6gDbTGDZGUCssJwsk8EUQWKWHQ3vaZwdgCZTALjlwndOBjugkjFXcP9mFGYpUnNoaXOsqYZ71AAGu+DkqLt1ctEtfhhwye5pmhpGZn03ZFAPJXggl6mt5aYahGUJVqhJmLbpt0ZU4BGmGHl1fHT5mihw6PuEMKbjZ0himuuRqItXjbpjduqQZvxPe+AJSSaK5YnIpmO3pRAI2NLwl5GkAutjsaQkPGGtHGd5zgApXuLv0FTraiqbp0Imz7DLYqrZcK0+ufImdhNYslhl0A4VpIEy2zTFnkeOBJ4m5kwqmMPlKM4nRUdrNp4YY+ok0MhOGb1d2Fqt/Tb/CizyykU+LLtdbkeH+Ui+T0V8SyT7I2dKg+Y3yfspj8nRWRczCz9eXV10yRa5Ou1CpUQRi1QtfITUlZ7aQRxPjlBMceWyLu+5HmH+OVGxwKJDRtcdgtrodczCH+PE41zGeRbDtFsLR0GmPGaZquuiJTSb7ExW00Zd6WnJ4EmDuX7GxKEJI/SO8nRuWmRnQuMRI9vRwjF/tV4ysdKVMOAJrj1X8nWxfXF6fvjP3tFZt2c2Qe/qtLsobpLBlUxcF4Kbl24C8vny1Kwe/VIJ8XCt/erOPQ38r4aMZnijueOZah2mWLd5c1ORRMR5ke9dng3ML7MzNzcLfsqELrioYYyFsN4jJSnPbgEfjPFAAFO8nkIS9J0NUhxytq4YKDtVz6MLEmFZdM9v+YQlnEZCDrfMp62lltdoWrVVwjmb4VzFdINMRMrjaQM1E9QI8OLbnbrGrIKd/ayzHxOFx2zcLwqbFQ436wztXViR3/uAWtaidMrzb0T2g59GSB8y4WkER4IqzgQ0koLDACtMfPk4KAvM6rHQbrXw/4vSrt4YuSvYxRget0Uku+NqVnXoM4M18A54QWxDkCpq0Rdw8kEXQOHQROoW3zxhJHXsc2aRXQEZquzNDTimzG8Zod54iEWW2eUZeEUdTR4i2ZBKcLMqBuaJagTP4/r3OV7EojwdpOIe7t9kUlhMH4QkV4cXdtSGLWTmwETYYsbvinAdnnHNaUq6/31GJjS+ZfqVcvUb7aBmwAIWvMRBXvRK1+xMVkCm0wo9/r9CCji6QFQetYODx9HaQYTGOse6FYrZEv1yTDb8eBtGfsCpFgzroMhmAFdYu9/+bK1EK7yNFNeUp6o4LOyICArUms+GboGLKUI8rGukW5oA7WfAwo4YlGUHI/TfeYZMARdZ6EW0b88brCBtJnRlyAGIYLOMGPo4a1If4vBbDoXyXRm6w2iSEMXGNNM8xmulBzhjaUbYA8ZFNkpCnStwoQ3y1Dx2xw26/E9W3DQbRJnUtORjc35Q6ecYGMPZjZmhCHUHCTpC7RWm0jxNCUO3HFZ6As8A2NSBUxYINuBp6mUTnUykmEhONfO9GRYyrheuI7aU4gRcj0efXRjvli5VqKLjPh/mIlfpFLkZ3vFSHu5flU9sT7nSZtVOLhqEOj8cuI7zjD8QJQyfRIT8d0FZmt7TqUJHfPnIpvcOJsf3N5H94gZJVtbRMqNFFVfOSe7aPoCLO+KTGwPKTYRg3TRIwiYMvPlEWJ2BiCzwMJrjdCb0h6qoVOVxmegfW0wIxyE0TYWH0jo0RCbGIldWFCDdi689gFZS2IFedbpnryvleyBymcajwtOEpMTQUTbnhN5r7x/M4hy6Yb7tSgyLxxudBzjNj8P7SYhhysjp6WGJHnPCeBYJHX20guJ7CNiBkjNBLVDYk5YlUERXl+rtbtnFAoz9BciWigZAaHD8srd6yEQUcz2tq6/QIdfT+avzSWRaMppWwRGZ5hnL5tUYWgtMV/eimWI4EtQD8keai4qA2TfVfLjPOouqvmVkaiLwWalhk52sCrSQekQ6EEdD5wCZZ1pOe1yJumh+iFOQk+45EL0C4WHnUbDqYk0L0txVPqQZTaqUAllfMWcq4AyZ6IUF7MsVAEU25DpPUPlIqYYPVe/1/yUbqcg23pHmm51ov737dqfVIBsp1RvvyO5etNfaO2i/Jf9vswJkjR6pzc+KyaZTLma8tZQ48jQIRf8JqpRiQIaSZnlKZdiaTY/YlMRQ/s7o0KVqdFYJ0GUPGJeoHsYsw1sSSJRIBQaJ9ZksKoc5Pb04bhG8tChwi17SBomdjApD8M4ElJI0D6I5Adq3OcXHcNoPmXDYVl01faG0yJpJXFmbiVCapnXtss0LGB7FGlVKxLwc8eZBLjXlyo3SWOi5Nr7CB4eM6dR7tm4zcZ9BVCIxqGDxNkl+O7kgAU4EWBuUyzsqp+SeJ0angePR7mq4HsQ/q/Q72G3tLuyANWSVbMhFVqcAu4QZnpJfzZ8PH4OrJglmYZorwH7OWZ9V+c/o+X+K2X5b6zlWXTKJGd+77L1EcHGbJ52zTvDcXODtQbXVkUM4lunW+5xlQvU6XLLFL2wmX8By/n1/EeJTVKYH/enVycXdruH2k4u7/ddlPWpM4zr286fO4XxgZtzbmbCua9RWcaddfjgkb1q721C3NB8OoZryO3JszAkRa6bJK+t0bJC3zT4vFHOj677GNoVWNbKXkveC/J5PJkzGVLH/ISP2QF0QLfSjU2TI75yXMYykIw58nBjDmvMMuksayarZkMmIdPM4ZkrxO/sgGrOKTah0/fyoH3E0nYzYHOnbajVbrebeMfx7p7m9U1qpjOpohWiQzStJM2XdMZC2FroP+tQcFGedK++Vs/UlubXXisNPkInkd0bcHn367XWwnOVDB0R3KmhC+jSlWQzHXhA0ICSRIjen4Yypa/CciIXSw56VhhUSAJJxv10SoF/rGbbeTEYevL2UZTeTL1hZhhVzFS3ZQ3FAyGz+DJMs6c2zKdfbVHXEhyOmdDCpoxHO3QBEJhOWeJDzvjNF/ZJ/KFK9GkEyAwxn/VBGK9kYCBHZ56JYjDeMkNoIv5jt3YrRFTZEM2FYSxaKN7KYK6OVAHtR9H2l/NYmQ2LkgMoHA/7gR4RnXo20nrzb2sJH8IlIyOHriFxhkKQWqE498LG/pupPieLjSTolmt4W64q+spQqDcI1pX2WKtScMqEh+A1rJxvsr06PlD9HN2IR5bcbVfEXUKPEFZ7sdXKDnwSY3hsGg9zs5j9ymmLx7CDEz8VdBYp6EXCHQW7sIWYTNCggygpewyCAMqtYdo8IOcmMhkql5oEjnVQgAOFhG6qa/9vfbWyWt17AzMhTm4Ae06zwpJMyXzUCCtjWBKqKUJ+l4n4+m8/fE+V9E9J24/7+PmJU6Wg8tSMgY+DOoEpvFI3IT2zrWBxlRIvy2ogrJu64aQqdbUPl/e1I5f12afM1SkxcgFcqyGypEIyx0cA9lwmiJeWp2TITJrmY01jWILCovqfFpAdofAWpxwYDBt2EzayWUSz2r9jV6dHrBppM3l4q6O6JhqKj4S7aQAgYlnW8EmySqCogZ+f1wwZZs2aVgA++b8kIUvExoVisxGLiEb4v8U2umIzqZZnQS1ckx/pY3iB6gYjBY8cizcjpUefCiKwOYnzkhwp5ZbOKHRtTntaE3GeDAUxQbmZTAsBIzzWXCPnLbh4MwpuqOBDA6fRESFnaZ1KTY54pzSyLlWgDF4l/GQNiLEntHIhI1hZH83iHDxsrY0Np4Mpty0Vwz2FUhLNGF2q4EjhZFYg66y5ZSoHcgXwUcMRJzOspBd1h0hAKqIzQTGTTMf8ziMpGEvqPnxUb5KnZDDeABU/wxhY+GOxuvDIQi2yAazUb6Jclc/QrYwbOY6ovloBZDyvZ1YIpq0Csz7n3l0m07shYlJmtY5+KIc+qSAcijYJIq5JCirS2Cgkdl+EHDAkzuVsG8CZaeB9NBbjlfZrRHk3GPNtokA3JQIvOhj0z4BfzA8LoL1e3MAj/cl89mW7J3NuVYCId/obpEOBxKGKcgzbO91SRWKQpi6FMj/32CrqU2YEhQW0qcjLgWYKbym/xVAyV3du+/46bGxJ1MZ7uGbEubDJiYyZpWmMLp2M3R2VjcuXBf8UHUJSAPXCl1etZjZcnsE3As4RhScq1GZIMyh4p7OF0YwcEEZYIpozeWVUl39LdwV6rNSgRoxaZNKeDlY9xzDKMAkSInY3nSMIV1A2TXAWCWwww/TYTCbO3aCWUiygcX7sHGAYU8ISpOYS1r1TaT4XA2FohY3rLFOG66LwfHkGFSWH41DDkmGnJY+RZKDkxw7XlJFazYcDwj/OUSoDXD8nGXEOoTiEo/G9nQtvQMI7ZthnDoB7FWPGCwn1ZAgN8EqJE9sIyDoLQMLcLVRGqyY15z56L5piEj4b6oCjSOcZwsvOG7bH+gLUo2493D95sJ312MGi13+zS9v7Om37/7fbum8F+iR9runsqaZSO2TB2L5BOQK3Zu4o5L0KLJrszQb5DqrLlF5qm4h6X3/cNDpjZjmGT/GQOWY/erwH5sGUdB/0uLqJSaQolS8BvXeyQzLtrAvBP8NuYKsDg2FinPLY5wqVd5NSd0AOCDuNcaR9+RgLj/j2jWs0bBE1keyxB77WJr6vkHzULeVMoZpjXPjAbA31sQee6OU6WEI+m3W5lJhIJqzWuwHET9SwBU87ImYAT9L1AWeRZyYzgXnZS0an95jfYpkHSSFhzDK7JIVAPE7EbwSI41L1YLMIC+q7fnh/UHiceMpd070ZbjJdmRHIAQpWjZgAwz+KaBxkEZUa1PBgZEMz0Lnm9tJMFU9nmZqFfQuVTG2QE3lhAzs/WmPHOCumAtKnKYY3WQo8VsKN5Nsy5GvlVKzYlbGlzXpB8Ujrq7TknlAGVhOaCrTxl6ZIx5e6fvEgohp+RQmWuKQSM457XpIlSwdPYIjWmGYadKzZHTXDzNVv2n3ZZQqugyMVaA5ywcgqOP4Nr2Y6pqQoRqLwuq+nZ5wS8OFPZF435OfpsSU/wJ3SgmDtMgkmO3QKdDHAQIf0YVLIZ6GZ36COi995pTjclqXrzBalbWo65OTPrWZFfyrWk3YL4wPuSbVFdlUIGa0FSIW6NCUZtrj3TRGTpdNa2CMpXe+lepcZOtB3thnYWxOeXzKzimyesLHzK2UGuAEElWYMoBvdHKMVcPoZNVtjCi+NonmVlGCPInjCMQcsJHQ177xymYEGgftGq2i8NQlUCIkxuKarqhEgFGSJfyA0J7+VtgkiB02MpEMEsscgUT+B2ytDMqEgpz1hYtg/j//8/f6Ri8gR4RLMy3uqxCR0ZysR0vB7m+pwENj7er/ixnWUU0zB53CbHALxFkhZB9wGWfZn/nKOCxxLD3zy5v81MEEvfl0yQl0yQl0yQbyQTBPekK6NaiL2/MB0EQXpJB3lJB3lJB3lJB3lJB3lJB3lJB3lJB3lJB1k0HQT1p28kHQSAeUkH+WbSQSx3fCENwkhl8DkUx5/wGRJzUyGCuiRESwpetWz4zaeGPEqOaEV6fIOpIYubel8xP8TKB/It5YeEBuhLfshLfshLfshLfshLfshLfshLfshLfsjagHjJD1kLA77kh7zkh7zkh7zkh7zkhzxJs1LrYUTdxi1dFd88Hre0YRuXms2WUqX4YOoCzim0fIL+JzSOBRb9hdLiOBfR9EFkYjy9thBeeyXHIPzp5OrymHSurv7X4T+hHfhA0jGDJlPXWSW0yexpg28JkmJgCwdG6nirhUvfaAV9OidH3QY5++nDrw1oSfLaxaJSEovx2MhaC3JUDA3+YkAo0jTWPI5+AIh8T7KwmcyID0dWu/WFw4Uz08wYxbgI0fUGH09orK83XkelqVg8gv0c/RCSoTIpBJUUg97yDNwVoKzSeASFu33nDrhv0hhCh/M0YMHiWIwnKVd4+TIUNEXoinGvN4K+L5kRfsbgwpg5Azq2bl8oJyeTPB6NgwaPT224DJzBc7cVqu4G1+ICiWeJsYGFVET0/81irex8pUYE0NGspK27ur0CC+r7IbnItgqAF27qFOAYeZAWwBZhnoftOfwSROo9gvUcbFeDOvpPikdahUjfTwDTajFKS9PIqU61Ne3YOPEMcN3TI3bdK6a87mE3kuueOTmGGUuue/2pfcxG5svr3rWnG/QkuO51r07+FY5DVExTpiJy/DBBOYrtHN9dZ4T8ALfxXZfP0iBnImONYsxrciruG+QTS3g+bpCPfDjC11rNdgv/6iRjLim0oO6amcirdnP/Nf52dHbivtxrHuy9vs7CockP5NfjC/fAydjnsDXJIVYsf70xu7QGgtUWtf7uS0cuQAIPUdeKBIOJY5EleayLzlT29JxF9OSCPETwPwgkt90oEpKwlN8x6eRlJxumTJLjf64oM8GpENUbX1swe6FweQjsxWXJuUFecWg0ik4nzzliQHwzhtcVwk1GXI3+z6xnfmnCDHjKIgp3p2wRizopElDKxuApXDwY9Rlc3DxlsLBu6Lnpk9AaJ4VWdsz1ZbhlbEK0pPEtcpV5HcaNFm7L9hSm2iYF1hZk7Y0LIEExX0CAjv/SOvGLeJf+1PcMjMhHJtnmpoLkqazJHkY0V+AqcXoWSrpAbzKKkmSEOVEIMdQuHOcdga64d6yBeb6wLo3Ch9MgLIvldKJZUrjjGXROZg0y4knCsgaRjCb4b5Gl04ZVABrQonhOssbm7xvuWWMq4dPPaLH3xFrGImE93+AqwkzuL5jPfSFSRrN5K/cefwpVeQ55KL6FFlfEBjVUrUItc1YDUuYvY1jWly1v9LrAQeHSp8zE6KSXIgZHrGcJzONW/tFS/nbYsHJMs3xAY4MIBmAQJQb63nAo9tOKgjGZS0VVRLKU3VmrqjOZpIz8cN6FhKF5PvhxZOZk0cMkjiZSPExrWAVNdV6fxPhSI7OYSZuvwgiCMn81BjneY6RiOISIZLOZxVDSyYjHhEkJPTNdRF846h1NeRJGWApJtMyVdvORU0bvGMmzIIvINyiHV4tXXExxMb4f1pwIeRaPWHw7L7v4+PLy/LL3+ezq8nP36viod3l+flXDauZgutUVW9fF4UvJ6xDtCFqunMXZd7Inh0JOhAyjhNeItGZ0XLMUMVOsU5TAeEJaWWGTAp0AmUhh9MyokBx+0GdKkOOfP/7rt7ef3nZ+qYHqZkMspFc9cTBtdrWQTtsONuWcjUbiUfni/xezO4uuj49tUHwP4nX7jKDvCRwC2Gq+1O6tdCUPWZxhthuo/UKkNiGbgmoLRVRYfIsXJyhRqppCTUcoCKgVV2C+boA3YEOuaVrWEowBA6EhdEh5psqd3fs8M5oeOLhKZUXmilhaWqYvyM/1kbDUVHkpe+CDUX9hHHOYgQK/qAkAeYHIoqX314KZGWnNdk6h5Nu8cIq5BpAhPN/ycTWFrL8NhrEcgdZAPjEQkJuxmerGljfhZl8yRW4AiyCT3LzRZ4Qm/wZ5424zoByBebRBFM9iPxzc0WYF3D4wyVBoHVROWJBNv37/g01Exy6YQTxliMbs3lAJXQdizliqCzd/CV4U2gDm4IpYH21ETnzKqw14Q0kkQycCpqk1zDBZmKJRocvWSIzZFk2L9VqJPgaIHk6+Konmbr8jCQYwsyWcHqXRVZGFwhUeU04pKJTcjPzKs0Tcq5mgeIxnKtKKfeA6HodGZoWUj0U6L5dnRe8s0JOlgwisds3AjqqJ7T7ReATZ+sFUbjcdn36Yv6Me3u4393fXhGR/qllPyGR1tnkExfdTYz2xP3JwGovB43idcq1TRo6zhNN1aOIGvXiS96qlU9aG3OHF51L1lEdxO8k0S9eFkz2ce0mlKM2zjtTjBy0puKtAWHk3uY+WNzNuKn+wRuQEgcFyREW4ItegIPVznmosKzSe8NRKzLDp9oDeoqY6pilYE4CKkGpFL7MnDXuYCLnQdeogpVqzbP6N6qn18+FwLCEsZWZO6zYE1Xw6YWsDe8RowmRE+7xXbzPmmdoyhl07gePoParIcD84oDEjrzrvT16vGUsIWagJv48wBUZFPLYn14RGUO1z7cqI0WI1NXsxwMHOu14sWKblNEx8XlumkV2KYoKa1wMjBmreP5sbrYf2hq3/yoc8o6nHR61Fgw8xUj3a5zVh8uVt79brlGf5Aznvrnm9ajyVLes9dTCvCYmvLq3XKwT4eK3HJQ5X/3GpbJLlAnA/HjW12ckIhTvDMJQos5FHmJdA4xGxk1UZqWS53LKp9bwV1ou9s7IFlRTzgVhhzaf+lKi838SQFj8kZEZljOoRuQkxjn64WZuU8WPGI769nPQvhUOVFeMRb6o/cusx7tM+h0gOXxy2VOAuDjMw14aWOXnEZBGHwbMw645olomM2OFJTNM4T224ddGCrB6cBikd1qU7Gc7uWmaHHQ2TrR2FGuPyKxiEUVxrQ8BVI+mJwUCxRfSmteCCs9WHjeJ/LrIuzym2VcHBF3Ixk60dlRo1igomWKd0zQjccalzmvYWD5d6lkZeQcLOV875qgGf5VlrCWTWy1rD1cOzF1c0YLK/WtFAjNeraNgxn6G3LbtJLVEdLT0rrhuVmmXNDBprlTYjKlnSS3lfUslri8dzNgFOR/x05RyMAtd1oadZOqgx984NT9R03Bcppt+ZLbxWA9NAW6cXA+6I/SwNwh7iNE9csELKKPydiPnNN+5dSV64/IFwm1IdAj+wIq9cvKymMhr++boBN0J+TJ8eDqXAvTyzV/0JebUx/HOjAfc+GzjCxuvqfc8kOCGWJ/qwtiCdC8nHVE5tGuvJEXn108nR6ycvTTfbrVZ7HfK3yECqG68w7GouTuu66uRZpV7c+kLJs0p50eKWs2B6m5tTWbXtvf2dtzvrWLcxH7M6L64+nXw6RgeZu9EOI8BsTbJgNYmQRhmDyLBBSeO3CSJkpPVEvdvaur+/jzjNaCTkcAtTTADDrTFLOG2CIyj8O3oY6XH6+0nHl2klxtbhMacpuo3+p2EvnN0NVUR+NSJojNEqRpXKbD4EVy4Qtm+LbPgxx0LpouJ9iLrLTl7HstXHmp+gcOOgxI8i1jQt2HV+JavN1v5uay08uWIIz5wIHh96Y84PkWDzmzWA+pWS/+06hIdncKrecz1yocQ+fqWyPO6EXM8pJu6z2m7yQWuACTZBjZNzk+TWJeYnVK9RiaszcfBDnqbEgBtGlTVm+MLrSnPCiEr6UBIE2jwvjGhrvaz0fKt9jpJpBjGSCk6MQJs8DwODEMsbmBVatUDBI/NxTvGf9v7O23UEv2gqh0z3vh82uwKAkdGMxaGm45Rnt2uxbWvUNIAN4Lh9hfsi4RJqtln4q5l25rE14JTXptBfuaJPRpf/DLq8JIrFueR6Gkbiv+rOKPooPmtS96XSPcXYIlbjY0f0FTYZwkLncFTD1uSZZmnKh5j36zrdSCgZaa/8FB+OdLn0F1dFGuYcXbm13Wq2283W3lX7zbvtvXe7b6JWq/XbimT4RtojrAb/36WfwiIo1rROy3ZZWBGrb6Ytw1rwqGsPLdjHYSUcvtnGDythVaNh89IpYolOESst5ktribW3lnic2N9Sw4l1IFKTbF6wQ8VKGPwntbSYT4URVaNonOzVJMo/He3BzdSKpiEMoUa0XROY3Y+d9jrh3N7brw/S7b39dcK6114k5m5JWPfa2+uDVSWM1XWF1O0eHR9frAPWFRqELFI/KGgxUyoeRMTAH3NPFA9qR9vRTrSi2yqlX9XAh4vZb86+H1N5y7NhpNO6eHLjStLBgMfk1KBMLqTQIhYpRDIY+9hCoCJyyWIxHsOstiQQoZK9q1Yj+3hydYyVy366PD4+swXOPr0/vsQ/L4+PKhXJfh1xvaIbzN2o9OgiUYrrYhk3a1hUxdPDa5Nfn3Mm7GtkdB5efC5nc6Kf2deynp/RuWo65wQqkk1oVld+9IlvAYTTVK77G66rPyTB2wzAJpYfmMG2hsorE/YV6u95GszYxkuQ4IKGHodlUTaT1pzw5HG+K+eiPBPf/WgnOthvtaL2m9323sqI8/GkzvrqHQzrsqjaJBlwEZCLY9zBpJMRCwVpNqEFDTxGAriI+cU253CFdgY8GzI5kTzTWJAEarTesYzQgWaSSIZ0tC3SXXd6Y082Ac/i/JU0U17AKjKi0IItzqVkScO22L7H/i1Q9MLGS0jqq14B9HhwlcuhSBtcQXUprGPAJWNTEDVb/VQMt1BZakqmmJF5W9ut9u5Wq70FZQR5NmzaPN8mEqdpJjSH90iP0zld4OL9t62deJcdbG+3zR9JTPcO9ncoTXb2k2SwMtu4lL0ebJwaXWl+16wiJbsXnZOzq+j4X8crI26LNtWNrZ1mFaw3/OEAhdBtmQj4+3zCsHIt6WIxwxXJ8uzues80GrC/nivQvITRsLu7syKKtppvTet+VXbgFuhvKrfgVe0ulX/2cpmOaL6iC0WyAZM11lC+dOOTz5enJOXZrSvq/3TZwCd1WydNbQBhUE12y7+01Wq12ts7K2qE2JVMTiFPPZrTRn99x+W5y4SGWaAgqWYZ+pn7VLH9XcKyWCRG8pe70tjtTRywROAeF5kqfHVdpsEtffwAFsglG/6cMzm13zXKRdKgDYqaiCzxNZ7gVgGueDDw/Cad9Mx3vriTImJiF7Sfa8e6xZh9vCk1R/Mdky6V0cASpGD42mFG0b88/qn3/uSsc/nfiLm3D6uH3m8/v887h63OLz+/v+p0Oh34jP/8uE4OwEyaL5VpXWr9D10QnzELzeqbjYLzubxfT8sLTyf0q2BizLw3YdnsEnpUgGsUz4Zpcbtgn/c8BFOSV2YNur81YC2O/3XROTvqdX97jewSLFwBA9eF4gbx6DCundLWr8Huejgh8LcZ/dPn06sTmAvGdsNBaTA/4h2VHCo6pCwb6hEOm+XgYwZcC4Y3Yx79en55hPx+/FPvZ/OpBHrAnAHv+asd1+K3HBoKrSzIzUZ742ZOGP3m7xuH766lpteSJT2tJ9d9nl2Pp3QyidgDW7l2cJkfqxFH6ykCqmmWUJmU2QGTrKwMckGnapYAyDFrQnJUFAVbJ36dfl+yO7zIg7PX+RXMfJXj5uM/Tz+tCZ9bNq0BnY/8jjWhb5VRiyC0TQwgx62CS/f8w9Wvncvj60JDdMfH2dX1oTF7Mm0rJlyfjOmQYejZMRTSNtx/DpOq63ueGUANU6+JONUgwrVQxweWhsGkWEj8luEJFHbsLi379cr08iJmDt2uj1g/Hw6DDPnVCBiiUVcUEBaXs9pJhb3Wg5CKaZYx2VOaLpQ1+ph1AREyBvDOL1vHR5e2FK9L/MSW69iQ13cxGtOUx1zkKoyfhILSny9Pq7bFinja24BVcDzLbatCcCqrGdW53J3CdrfAYGqwtaDALdfVePrtFWMFagyF3biyFWlKhuJMx/7DqaHKOWAOCgPPSPfq5F9kO2pF4cVC9fbBtl4pugU10c6wX0vNBzTW+MknJFRuK7ALZNPQCB+F3hlNmiQSPxv+wr/45G43+IFP7vbtx5kxxzQOnhvnmj3gn8ZGtn9hkWr84MpN46dcptcZCYf8AeJvmzSGqBF86h6lW9MJleYtm+IvD3utg2ZQzrdy1+LxWI11cpnW3AP0CDt0usZ+Mg0aPEMX6ZQqzeMoFmGv4JOMKAFdrxW4K4xBO6ZTAiY0mLR4wZklW0Ki2wLZI50WWSx0tkmxTWawnS6wSid0lyquStH9OBROj7/BIW4wliiEEBEygBUtt0nKNZM0JScXd/t+TJbFqbA5xDe/38ApePM/N+TVyfHVB3L54dAPuv1mZ/s1whQ+GI8olD2U3gxw8Uw+79Sm+jlw/YgIdkVzLlN+dR6qPf+36Prgqe0j1P3kRTUbX+pMGjMoKKsNDLhI8vBVdXRXHVYxTfiAcE2wkUjDMHMmNGF3TE7NFJBdTGfenxncTYtNyMk4V1jiuu+b0ydzGnCjSgAP9xnZmGTDoCE6ZB1H5rv/jCxow3kDSaHiQF2Md1F0vbcCzN57wHb7x00gzrSYbMws8s0/IDPIECJssu6AXrVgBRAgT9fYZLvOdKCTAbav/nx5ioU+8I7FNl+YihwaQRRyeRqwFhR6L3wIPCM3DvkbyDmDSyddKuUsWSwypSU2GTPnRKnqCHZYLJwyEBDwqP+zLDHf7e7ubOEV0n/98aP9Hj//Q4vJ6qvqBNj3sbKbnzMfQ+FFL2wVRRSD0IuC4p7Sc0QUz3znx7HIuBaSZ0OUfF6zdrpAnxkRaxnKJlxTFbKIbQmWiqENzjavGik+0Cwj/84xWsirsngFQvVotu2I5yjfwdW/5oelyhUVdoBi76yUaQgxzoSuSsClmMuM9sjPq/PdhCoVcNPaCzDY4Z0gtMfzipF0CPg6syJngNajGYADqW+XYmMdODz7gu/LVafM+fUo8Lu7O+u/wzOY/JGz2hofgC4IE9iNmZS6TOIv1uM9D3G/t83qzWygyvn9X3B+o8LIkkLBDGeJzMlGy6ZAJsy7IHVk4doAVSCEPbJ2BDaDoTBfP9f+qUYwGSJb6l1H0H8O3SInuoAHQMcnb+zbM1EdCR/AZaGGjIk+0/csKI8ODYDuBZpf61BS0MJlkiW9es3NK9vHm4Fsd5PCWYQTN4BIkwnzMkjlffxp5qqvpHcHY+HD4MbeGAgRXoluQBp4+MXsUYL6u12MhGkmx5C8NJEs5oqlU7ui2Lgx5beYdTTJ+ymPicoHA/7gR4RnXplD5N3WFj6CT0RCDl9H5EpO3Q3wZCLFAx9TOGi5gtpqfDxJp0TT23KQrFXtzfqntM9S23TS6LBwMN+zFJvHXZ0eqUIOxiLKb+dkv6+t/6jhIxWPWH3pZF0Y/XFRD8f1rG2FUQU37+Yq+gjvIwf/GsjhWLfO7eQnAT+Lz65DZ+4fOU1R77PPgFlpDdgg28239bcVltlDzCaoPY2ErXuKpf1mtpuVFxH4hCgQl9M0sEVnIYBMfe7a9jP3uy0c7lMAwQwzyiPMHNMsE4W+W9qbjYAChatnFqE+S8X9fFExX66UZU9IW/SLUaWj8dSOgJsLpQtVeiOa9UPZUUr2POCK979umoCZVd7fNgzULgmwRkkQFOCVWrlZKgRjbKDXzZxbWlKeFo6NOQKBqhUDSQ3razHpAYJf4UBhg4ENgzLqN7KQpcsrdnV69LqBDjmfXVisSGFegmBuuDZUIGJDSRFsnzlun9l5C/9e8aRZP+CQ7/vcgTPnsSOnWInFDh/4fnVmc6WDamKyz3b49VpFD3utg4imEOloSAbho7UXz7QtTYN5rYB81e2cvY7wfhMDXlwb63ntX2muR0K6qKWwsSFERxgdORbjMfhjnTgq3PhMNcjRWZeEGBPyymb1JjGViXqNHbpKbdZZ9RJ/84fAMl9RE4YV4UrlTEYIfZ0hvW5JcCY8514dnkF1FwMEBJYEhPUkr9Dg2J5Y3Y+dbfKRD0eko1QuaRYz0mXyjsmVaziUSQN51bWTBRO5Xx2+hmD1SnDN5+76UEq40jwb5lyNWFLnoh+FE9k1P1pmzQ9//NxtkPMf3dqfZHGDnH/+EXST4sBpkMOzH5/gD7/5auATKKBQlHCoi1HcNE6Onb6epdUnw0pGAv3C2f368BNySDP+55yaIGvHMZxKkVfnKwiJk2zFfPvHSEDTXp7xui565lGCpsTMaAjyeQmKzGyV9VFFaQotEHsQ+FtfQLk/1M18eIGC8/kj/apBumBwXVQ2xaExu4TM+IqVcQDxTOgeXLstgOmjWaF8DPlBmGc029YaXXOpyIaQvJQpnoAJBz2h54TsbLearTfN9j5p7bxr773bOfjfrda71oqRPB7XPhuIhRI+l0YWi6EtgGj7oNl6C4i23+223m3vrQ1RtBx6t2zao+nQ7KHRuCZO7rjxvQN0yDIm0SzxZs4tq27iy+46TqwA1TiXs3G2a81vgfGDmvWMsDQ1D8T2pwJZ4smOiQX+sOaq+MlXIK6QJuNKT/a22+ulD3uYiGyxC/3H4vSO7RCFt5tBidKZpfbJFAvgur+3t/PGrUWWsIeZ21AR92xE28wt6VposmJhU/Di8T+9fRlwgJrQGMudcl01frZbu2/XgIliktO0V+pQtXYbOuN/5IzgVC6HDc5Lvw/mn9rgbAE5qDTL4mnhAXPFu9B3AnwyGVGbAdEgPGxoghdxLqNBgHmaGl3K2Ji+KbQfuojoqtB8b+/D+/cHh2+Ojt9/aB28bR0ctbcPDzvrkEKKDzOqc0PMmuXtSVFRdGDIG1DeAxFKn18Z5hGPmSGXCh1DqGoMRJ5BlNtPgpzSbEgO5XSihe3SMY1IlzF/nT7kepT3IUdtKFKaDbeGYqufiv7WULSj9u6WkvFWDANsGcLAv6Kh+Mfpzs6b5unOXvVWFMvpNNdzGlgnyV/jA1DeCeDAmMUVG6BEw1T0aeo12Iyt6LWdQf2vsPFrMfEdSt+CjT8r5pw7DosLPmLkd69+LJT1Bjn9sUsz8sGY71zFInACNIxxF4HJ/zV45Jux70v0WCOCf7WB/5gAKC13Tfh+A9b8DPrrwPA/zzK3VVbqVe6CtsJmUqttVfh2qcClMdXxiCUR1WLM47r1Ibz9w7mCpBpUMSEE0sLjC8Sy7I5LkY3DWFWWJbZBufTxkhBbWSFJnyZNf4e8lAvK0Qce/jrkwctme5xVaDWPSgFlPLWeQSUokFOtybgUnWqr4T9DpB5PKmUsjB2aW9wNZL7Fy3ysBwc7lLG95tt92m7u0v12s99i+81WnMQ77Z2k1e4vVfjIU8LYyV+LGGauVenBU9ZnVDffRq2o1dxubbej1l60vdNstVqt9lJ+DkeLGnP0ZkihbcYezIybJaboCPER2BqShxDOIKreJ+MP+R0EbVsyzhLK/9CDSXoyX7z9xUDSMTNbsSZqhPmzNvHST1nUtMolFqmimhkb9E8sFRinVCk+KOe5aBprHmMpQRaP0M3gL+ptrUmcKTJaop3KjsXjcjVBG4Hhqwv1XT4s1JGA3EfVIMycB1hzQhOeDZltQwUHumRaCleYJ0zKocMhogeLXPUsfDq5ujwmnaur/3X4z9KaQDu8iKac1hUesHFlJLmZ4BVT3lLBNnwDIQmFpCoxIJBZD/lsWuZw5ru02TCZEriaYm2w+BbJSEsX3TbhwLc4Mb8Z7W8akV9HkP4kNMRZcMmSRjj2VOSwSrlihJZoBjWdEGaPSlTJzNz8nWx8okMWU6nJT/D0/gZZvBQFLkZtpwisRHF0PGsJgO4hrf7KJeBJlfY/tVreS7sgoWu00zdmy019v/wOYWkVcn84OVtYVUJq110CC0guS3WwnkH7EmuHPL8a7cNhS8uwGO1L6FQXwTk9qdY0vo3GXEsGnSzhbbUFe2Jr0WUqrDuqooVtuscuHqxT3nrjaQpl7fGMAtXep/3bow8zF4qvPeHwZ1+artM9e22M+T9ylgbtqxS2JXe6vsB1wWJ/rKrvtffa+wdLESZ0K6xZgtSZpncegD2/g8BPQgxTRk5PF+76UNAlFtnALEV9m3tG0y0mJLbMIjZvLW58XE0/vFII9yHUpwgGUDFNmYqus0drVJwJTbp4PWi27pnIWIOcivuSMP3EEp6PGxAEha+1mu2WrZ6djLlET2PXzEZetZv7r/G3o7MT9+Ve82DvdbUg9/GFe+Bk7LrKkiY5ZFJTnr2uiAUDwfPXsP6SxEelZC1nrFAoKOmKphQLaPXqWeROLshDBP8rF11JWMrvmHRmXicbpkyS438u7OIrKAFxoZGNC63fcnu0jH8pPrVclNNziBg8VZRzMuJq9H+WCESa6U6I2bRsEcX0saiUzTkNdc0CuqHn5gSbI5OmadDGWpFbxiZ4ICP3QJ4vlGddWM+exU5ryft5jUUnO1BlTwwQ7WK+AOmO/9L6cYseRP0pmaRUD4QcR+Qjk2xz03BNJrImexjRXEGodWr9wii5AjvW6jDsYYK1l3gWJMu/w/rvdwxTliESOmkUBXcahGVwXcqS4nacQdUt1iAjniQsaxDJaIL/Flk6bdgzuwH1q+akQG3+vuGe3WiQDXz6GSX7ZtYvFgnr+bvlqJQm+Vh9rr4QKaPZvNV6jz9BmyesakH4wKiO/vaaK1dPoBozrmXO1oSI+Ytnw15tBuEmGoT+vt75SMzE7hA122s2o4Qr/2ipE7vtZg7yfEyzfEBjLAoCerMrk2Trg0XBmCys3GDb7GpBOpNJysgP510oQTIvxWMcmTlZ9DCJo4kUD9M1UV5TndcnDZ6uyTtzjQugzF+BQY6pMakYDiE2FeIahpJORjwmTEohVeHZC0eFiL+wBZ6QxFhC2s1HThm9YyTPinqd3Femg1eLV6qXz35YI+HzLB4xYz5VF/D48vL8svf57Oryc/fq+Kh3eX5+taYVxFu5uhqPde3lelZq3JlgiAureE1XaiLxNKKa0XHNEsJMsU4xAeMJaeUAppR64WDLskeFVPCDPlM6HP/88V+/vf30tvPLmihtmH0hHeiJw2Wzq4V0GnCw4eZFcMSjcgbpL2bnUe1U3Mc2H77n6qRAIRL4PsGsp1IF6lJuJ2RUlUr0GlVciNSWcqTW7UxgP6PjA6VF9YRf4zEIAmdFqs8/0yH5kg+5pmn5dDeGBOQV0yHlWVBDzLwBnTCmrjBvuRdFVWTS0tJ8QR6uRjZjRKymo0NNVRjHHEjY82BBtRyqNCErlt5fGhvz9prtjULxNuJnzDTFJq7QcWS+BYK/+fLsMEzsSogbDT2fQLupm7GZyldpN3uOKXIDWBQl+WydbUKTf4Mscd1pFWRGwi2PglAOOxxEnmYF3GGfiqUpm7A7Xpuj5ggGtxWdUMzZdlsh6LN8rxbvoTyLjDNU6sLHjY9Fpgom4IpYl2ZETnw4sK1+gJJFhoY6djBvYCW+oGVthRZbIzFmWzQt1ujZNDET93DCVckyd2sdQUi97cn+BF3KFbTgeHEHeKF4Zq6xwkz9HYyLKcKsfSQ1HmNGBoXUhpjrqkRdwpkJNGTp4Gs0YPtE4xHPWLkJm90px6cfHmnA9na/uXgPtjmI9acQqVZfs5X3U2O52DYEBp9HcTnlWqeMHGcJp8tqxAaleJL3agy0OLz47B2WT67NSaZ926ul8LCHZy8415Y5/o4fNGQZJChwvNvYV7EyM24qfwhG5ASBYQreKJRurGzXz3kK0VS2IRJKvdgcTrYe7YDaGzLbRgv1AFGkOSxDDvYA7cwWIMQgpVqzzH87N2YSh2MJYSmD2CB0tYFajL0/lgd1xGjCZET7vOYud7+Um9sZVuwEjpf3qJ7C1deAxoy86rw/eb0GzCCspSacPsIUGDnz2B5bAXTD4XUpCND5BWqfB3DbeVeHnGVaTiGUcQH4n1Ud0JK8mKAGugt0wtS7HzY3Wg/tDXCE+QKkDodnVLB7AgvVo31eE/Rf3rpuXU55lj+Q8+4a1qXGk9Ky1VOH5QqAf3XJuvpGth0513WE4XD1HGEK7xEXgTVjgS9sxr3tmlTZ4jxwO58RlAVYyonGI2InqzJJyQq4ZVPXSKRoX1aq5ayYHRoLSydswG0YgMr7TYym8ENCmbmMUT0iNyHG0Q83K0kKP0484ou0yp8jqUsRN2Xlc8Sb6o/cekf7rqdbwhW6BQIqWjjWwQRw9IjJIob1s7DpjmiWiYzY4UlM0zi3LWS9drpOPAYpXaj1yzISw3Bt1zIy7FCYbC1g1xidWIE6DAhaCejJaKp4TNOeGAwUqyvFqwI/zrZeDJ6feO94P+xf+TTcbjJI0V8L+DWe7BXozVBrAfqOS53TtLd4yM2zNN0K4HY+F1qzVhyWZ5slEFidbaAXxdc68GGyv/rAR4xXP/DtOM/QmZbddJaQjn6e5dYBfs3yYgb0lSUGpJ73sPIDr7+iJUxH/HRBaR2uAvxWQUmzdBC04V83Km54oqbjvrBd9M2WXNkoq7vt04dSB6XFOjf9LRoezRB6WFtwx4XkYyqnNtfg5Ii8+unk6PWTF3Wb7VarvazchHm+Ci7ZTE//Ch6rXK/xrCgnsvawYDN20WLGcTverBUMbVMmKquzvbe/83Zn2fUZ8zGr8xLl08mnY3QMudvSMCoI1aBw1YiQRimCaKFBSasmGNVfaqLDaUYh+QUzAeB42xqzhNMmOEbCv6OHkR6nv590zjp+RDEY8JjTFN0o/2O7Cvmbk4j8akTKGKMcjHqT2Rh2rlzgY9/W7PZjjoXSvrBYCXXbj2NZ6TuujwU/GQ4MV4FnRMS60p+7yn2t/d3W0ry3YrjHnGgPH6Zh5L5tUbUsvWs0xs9mZFXQPRmytosT0JUXw7AlG5dQWQZ3mi1/4oj7rLabYTjVYYJNUKfk3FykVcTzertB1ZmT5Ttmh9FFjZn19/rLnDCTko6SBEEZzwsz2VqdZVasUojKHtQp5BlK+kCrOw8DRxCzG5jVDAoxUhvm45weAO39nbfLBkpoKodM974fdroCgJGhjIavpuOUZ7dL24k1agKw3HAcvkKeT7iEAgcW5momU9Am/Ll45LUp0FcjOMUl6M6fQXeWRS5wEDH9qjujWKMIXKN6LZXuqaKP11J1g40QgQKZWYJHKWw1nmmWpnyIuZIYy4cVhH0JDNdgPZBHYXf0OXpqa7vVbLebrb2r9pt323vvdt9ErVbrtyVQHzIRxVxP64r0P3Tl4ao1NURmlNMlon0AZmHUe5bpXlzR5dYC+NW9aNoQvbhiVPjZN9V85M4WLnb5GFo1rcdZKePCTlYFX0g9Ih0o17pEaCliAgUke1yJupbo0NaoPOmewxpVizQuvQoIe117wsI9l3MOaUaXCec1cEPkaMWnVIF5yEQvjIkpWwIiG3KdJyjHUqrhQ1UG/V+ykYps4x1pvtmJ9tu7b3daDbKRUr3xjuzuRXutvYP2W/L/lhRJNRoMm58Vk03n9g5+wkwaR8OGCy73fc+GkmZ5SmWY9wW9lmMqGaafBVrXobNTdbmUCZcEo+ZZppm03XUHqRDSXvo2fPVk353TD+oq2/mLL6xO2iCxr8JZducZOpkH0yk6X3kGNSLGkF4xZMJhW9X9+kJpkTWThVtSlBdwIpSmaV07f/MChkfJTJUSMaeugjQumsOrlP6TMxUq9aDmBY2vx3TqwzF9QzJKDCowkZDkt5OLsBwuIbYxkk01vucJS6d4D+8q6Grh/qwS+WC3tbtwP4AygSUbcpHVKV4vYYanpGvz5yXiwwPga5KvFvC54vXnnPXZkjxtlLo/RVaLtjHCBBZixnfncyGKXLe2k85ZJ3huLob21N7qyCFoK3Trfc4yoXodLtnCnTMLzKG44zjZq0kcfzrag1uVJcwsV3ayXRNo3Y+d9qqwFSUxa4Bue29/Vfj22ovEWy0J3157ezX4VMJYXVce3e7R8fHFsvDxRcDyDz27dknRkK9cuISIgT96nihc0o62o51oCddNSr+qIQwXg9+EHTym8pZnw0indfHbxpWkgwGPyalBk1xIoUUsUrglNzalhWB+JSVCJXtXLW308eTqGMsg/XR5fHxmqyV9en98iX9eHh9VyhvBS0sQyF4B9OgiYWnr4g03a1jpwdPAq29fh0Um7GuktB1efC6ns6Ej1SL7yH3swzL5bBMoaTShWV3Jn1g+DtR0mKZyr9woqrlS7VKjmpg3PYPhmkpATNhXKNTl8Z4xLpdA+4KGZvpz0DQT1Zw54vG8Kwf+PxPH/WgnOthvtaL2m932wmWzS8jy8aTGIKDNDsb9uBrZmJEAtjS5OMYdSToZsVCQZhMaqcNjJICLmF9sGWNfT5lnQyYnkmcaKyNALcY7lhFo2oe9fSbcBngLSQSWXxYJawKexWEpaaa8kFRkRI26EMe5hIKZ2M7uHruQQ1a+vYyX1JfTAejxwCnXZZD25p7qUszAgEvGpiBGtvqpGG6hNtOUTDEjw7a2W+3drVZ7C+qN8WzYtMmNTSRO00xoTt2RHqdV67gV779t7cS77GB7u23+SGK6d7C/Q2mys58kg6VYxeU49WCD1Ohn8rtjFanXveicnF1Fx/9a4sSesMhWg6kbQzvNKphueAEP9VttTjv8fT5htkhlFyudLUEKIVfpivdljZ3AXndFVpfQ2Hd3F27BEaBli3TWtL6zRZj9xJvK1wetIJLKP3u5TEc0X8LXUHeR48tSfeOUZ7eu2/7TNcWe1DGdRLRRZUG5yC3/0lar1Wpv7yyhpUk25ErLKSThRmEU19qPuXOX/gmzQPVBzTJ0qvapYvu7hGWxSIzELo6HD0K67UocsETgnhWZKpxXXabBB3v8ANr/JRv+nDM5td81ypWVYgF7SGSJLx4DbnO4t8Ao4pt00jPf3RTtKMXELmI/145FizH7eJ1njtQ7Jl0emIEliJX3xYeMwn15/FPv/clZ5/K/EXNvj1UPq99+fp93DludX35+f9XpdDrwGf/5cdVVx9SGL9VkXGrND12UlzHDzIpDNwWNhWAxIdLT78LTBp0UmLUw701YKrtsHhXgFMWzYVq4z+3znm9gSvLK0L37WwPof/yvi87ZUa/722tkkWCxChi4LpQsCDSGce2UtpCGwrZPMCHwtBn90+fTqxOYC8Z2w0GdIT/iHZUcUtRTlg31CIe13SwB14LJzZhHv55fHiGPH//U+9l8KoEeMGTAb/7uImExH1fiBckrFg3JzUZ742ZOfPTm7xuH766lpteSJT2tJ9d9nl2Pp3QyidgDW6o4aJkHq+Es66kEqGmWUJmUWQCzXqyscZGIahZp5JIVEBvx2X7Ca8Gp0+9Ldoc3UnB+OtvdzFc5Pj7+8/TTCjjcsmkNKHzkd6wJVf6NCgMxUWIAyUXV/p7nH65+7VweXxcanDsOzq6uD435kWmbGn59MqZDhvFLx1AF13D2OUyqru95ZgA1DLsCQaoRZ2uhiI82DCMMsfIv9IPO7NEwb3mvV6aRFxlzaHV9xPr5cBikCz+faCHodYWbYEUqq1VU2Gh5JFRMs4zJntJ0oVS8x7R8CMswwHZ+2To+urT1NV02XQ6Vsgd5mrq+QywhY5rymItchQF2UA328+VpVcdfAjfrEl8FrzO0Sswq8DHUzgzV2XIZeFtGHqNnwc5x3TzmdLh+Pjo1xkRuXNmSGSXDzJ+hqDIeTg0lzgFbONB5hv0MtqNWFLrXH+1lUHTYaKK+b7+Wmg9orPGTjy6veOyxoWDT0AUfhSL1TZokEj8bPsK/+ORuN/iBT+727ceZMcc0Dp4b55o94J/GJrV/YYVZ/OBqxeKnXKbXGQmH/AGCMps0hrAFfOoepVXTCYzmLZviLw97rYNmUKOzct/g8Xg+u+QyjZBidZk8RzC6k1C5TAtNbuP+/j5iKVWax1EsNgJF+CQjSowZiakCl4AxJsd0iv1mwJzEG7ws2RISXQPIEum0SEOgpIQacVHqtrw8luqDLizFXSC674bC6dY3OMQNBrCEECJCBjCbVWzgTLlmkqbk5OJu34/JsjgVNjHz5vcbOMlu/ueGvDo5vvpALj8c+kG33+xsv0aYwgeLjuxONXdBND7Jz+ZeOXD9iAh2RZstU345vqk9wbIou+4p7MOT/eRFSQ5fW0kacySoiQtMt0h25lV1dFcWUjFN+IBwTbB6f8MwcCY0YXdMTs0UkL5JZ96fGdxNO2GSi4SMc4W1avsuqYYlaPow1+W4ONbh4T4jG5NsuFG0OoC0zsh89/dNMzXcNpB0GPSZXTuzXQgZ3iKZBcX7AdhW/7gJxJYWk42Zhb35B6R5GOQnVBZJghboZTL5Aek8TdeHcJ25HScDYoA18hCrHuD9g62EPhU5VGUvZO40YCGozFzY7DwjNw75G0gUggsZXarVKlksMqUlduSBJtNhCQZsM1k4QeDC+1G/Ylkavtvd3dnC65X/+uNH+z1+/ocWk+VW0gmn72M1Nz9nPi7Ai1XYEoooBuEEQcdWR9054odnvuPZWGRcC8mzIUo1rxG7s73PjPi0TGSzW6kK2cL20knF0Eb1mleNhB5olpF/5xjq4tVRvDagejRb999z0ZhZBvWv+WGpcpVFHaDYgCZl2NQ8E7oq3ZZiKDPaIz8vx2sTqlTAQWvPZLfDF41m4bhdIsQLgV1n+toMoHo0A2QgxS3JN5aF+9kXXl8uo2POoEcB3t3dWc+dloH+j5zVVpEc9DaYwG60pNRWDX+xXuJ5yPq9alZpZkNUzt3/gnMXlTuWFMpgOEtkTidaVtUzYd4FKSILtwIc4SHskdXzsesChfn6ufZPNYLJENlScyfbthpapU10AQ+Ajk/e2LdnohYSPoCLNA1h9H2m71lQ8xi6a9wLNI+WVS7Q0mSSJb16TcArcMwORwzks5sUzhOcuAGEmUyYlykq7+NPM9dgJb04GAsfBjfwxkCI8IpwA/Jvwy9mjwPUr+0CJEwzOYbMlYlkMVcsndpVxA5mKb/FlJNJ3k95TFQ+GPAHPyI888ocBO+2tvARfCIScvg6Ildy6m5EJxMpHviYwmHJFRSI4uNJOiWa3pajNK3qbdY8pX2W2u5rRt+Ew/Wepdhp6er0SBUyLhZRfjsn7XilhnuGd1Q8YvXlD3Vh9MdFNxyzs/YO3qbfvJuriCO8jxzYS5LAsWid28ZPAj4On0KFTtI/cpqijmafybDjL8jCIKUpTR0ZsBQre4jZBDWdkbDFFbEO2cy2snIhAn8MBYJymgY24SwEkCLNXYN75n631YN9nheYRkbRg5ljmmWi0E1Le7ARUKBws8wi1GepuJ8vEubLj7KMCWmLPimqdDSe2hFwE6EUoUpvRLM+IDtKya4GXPE+1E0TMLDK+9uGgdolQdUobfgCvFIPJEuFYIwN9HiZM0lLytPCwTBn41O1RLCjYXctJj1A6iscFmwwsKE9Rj1GtrG0eMWuTo9eN9AB5lPIilUoTD4Qug3X4wXEZygRgi0zx+UyO2/hTyueNGsGXPF9nylwnjx2nBQrsdjBAt8vx2Cu1kpNjPXZDr+61fKw1zqIaAqReYY0EOJYe6U/288vmNcKv1fdztnrCO8BMbjD9WSd1++Q5nokpIvKCTt/QVSA0W2xfXzqq2UG7nGmGuTorEtCjAl5ZVM0k5jKRL3G9jel3sCseqm9+UNgIS+hwcIqcKVyJiOEuM5QU7cMYWv9V4dnUDLDAAFBFAExPZkreB/bE6j7sbMNjbBJR6lc0ixmpMvkHZNLJdWXyQHJsLWTArNvXx2+hmDpSvDI5+5qaCRcaZ4Nc65GLKlzcY/CiezaHi2ztoc/fu42yPmPbo1PsrhBzj//CDpFcWg0yOHZj0/wgd9Ya+IHyGgvcurrYgg3jZNLp69n6fPJsIyRKL9wdr8aTkIOacb/nFOMYe14hVMp8up8hU1/ki2RGP0Y2jTt5Rmv60JkHvY0JWZGQ4TPS1BhZhusRgmlKfQI60EQan0Bzf4ANvPhRQPO54/fqwbpguFzUWH4Q2P+CJnxJcqNALKZ0D24hloAu0czAvkY8kowP2W2Fyu6vFKRDSHpJVM8AfMJmprOCUnZbjVbb5rtfdLaedfee7dz8L9brXetJSJVPH59NhALJfstjSBWg1oAufZBs/UWkGu/2229295bCTnU1Hu3bNqj6dDsidG4Ji7tuPG9A3HIMibRDPBmxS2rbsrL7rKnS4BenMvZOM+15kjA+EFxa0ZYmpoHYvtTgSDxpMagdX+YclX85EueVsiRcaUne9vt1WnCHiYiW+zC+rG4smM7ROEVZlBDcWZJfXD+Avjt7+3tvHH0zxL2MHMLKOKejcaauR1cmg4rVl4ELxj/09ttwUqrCY2xHiPXVQNju7X7dknoFZOcpr1Si5i126MZ/yNnBKdyeU1wtnken3+qgrMCZJnSLIunhdfIVTVC3wPww2REbRR9g/CwYwFeRrmoeAFmX2r0G2O7+U6mfugiAqlC5729D+/fHxy+OTp+/6F18LZ1cNTePjzsLCtVfJvr2mXmSVH+cGBIGlC76LUdSJNfGeaKjpkhkQqdKagKDESeQSTWT4Kc0mxIDuV0ooUtzz+NSJcxf0U85HqU9yGHaShSmg23hmKrn4r+1lC0o/bulpLxVgwDbBnCwL+iofjH6c7Om+bpzl71NhDrlTSXl+jWyfDX2NPKG9QOjFn8sNtBNExFn6Zek8zYEh7NGXT/Cnt5beayQ+NbsJdnxZZzW2EVtUcM5u7Vj4Wi3CCnP3ZpRj4YU5irWAQGdcMYUBGYz3XxwjdjK5dosCJSf7Wx/NiGLi3rGnH8BizjGZSXxeo/w8q11S3qVbiC3plmUqsBVXhy4YAaF+Qf1Va9ecPovryIibATlpv82CQSqIEImSWkz0b0jgsZZhx4n/5YJCxlVn8hn06uLo9J5+rqfx3+8/qhcxyRX0cQJyw0XJBwKGwxFTncZOWKhWPSyusFiDyJKmkK3dbe3vazqVvjObYxm37/PVEYLi4rNO4kH3iWPJvIk5Rqsx/qusUCSvtJvkdye+Bniof9EqYudX7tVjJ9On/mLhEI/iSdI1tP7PAC/4C2yTa1iMbnXfzzDMNm8cP5YMDjEqw7+3v4U5dS+4bNd6zwxObvZMP9RhZPU/bMUXfJCGAOWaob8TwGCenieGVlBikxG/LKkwxSQqC6MZ3ZR7Wm8W005loyaAbkBtgC8bj17NWpNeFvZK+FjWbwzM3qFyAk5Iqb1S1AOOS8tYAufvOK/OFG+YQlkvDDlRBpZbXMl4uuhKax5vHX0QJgqnkrEJH/rpIpoE1IMRylUQplIa/YQ/Q4k+Irauuq02q1trfI6yrF4Jd5hKnzAA+Tnx2jLkykkCaVfb06kao0Kueaz5DpK0vZXKbfErHC4auEW3SUMl1ZPAIf69fZmm62lXenG+h55HRvqa2rdmvvYA73wfePUGi9e3TNmUlPyOAnFfpnr8gjx0ptK3IoxmOaJeBy7yIW2RBrvk0kc5e+1dX6i0TFwvT8gk5dGz0Xf/cRwqq8/7WkBoQ/o+gIZ11VEodjrUbeVqv9mBCJWq2F70cfIe43LXAelynPXKqntdWal+pC3DPZHbF0cU12/lr9NeJmYVKH5H3MSKuZ1M97/+nl8IuRojNGcw0b7xSvh6B98DuCmvZMyzrD/gRKSmG0ADVvGcMsw5qYkHembE0FRQYizhURmMnrxidk4sqmc61YOoDTiUN5MPBzp1NC7wRPFOFZM2ETSG+j6VRxVYRWIwgP0V7rwI4aXgpBg2B0vNp2Sb7vcriHHSViPhnV5hXuYh6idUi78hA4JbJdkkv/NZaRCklaEZCn3d7x4dHH495lt9P79eTqY69z3O21t9/2Dv9/9t5tuY0caRC+n6dAqC9sTVAlkTr7j/kmZFGe1j/yYS25+4vtmJDAKpBEqwiUAZQkduzFvsa+3j7JBhKHQh1IFSXRomU7JqZFsgpAHpDITOTh7fGluaJtuyHjlBKmonoc95Nnyp6833DlF6XCLNnAKWflqzwOCYlFcIJZWy3GJpc5MMkkV/DHBuRmSlOnFV3VQbqMx1BgRcJ1QxHA4AeFVA+TLGnyzrGCjIh6Ld/T0yhqfeMyayVLQvER1D3kwxKug8ltFawJviYoz6oXqR4ZsMR5tHgQDYp6MY4KWNkwkiJkxFQRgUi6MLzOyA9YVz3I/481Q5S1DnJ/tfdA2nUuuT3OcUkyFbXCjY9Kw/a+v4sSOiLmiqx/8tnTr1z9HcE1//1bphLAYzKBTNXxoD54mA3mg3qaAnhMqVAfs1Pqt1JU+n63v3e8/653vLv79l1/v39wcvD24N3O23dv320dH5607jIV0mSJjYHuJwq0DvreqXJ4sn243T/c7m4fHBwc9HsHB729veNe/7C72+vu9Lv97vHxydte63ieCnWW1xqpFX16u3vNFPI4DNoaPJ5CxaiGUk+zb/YO9t/t7e0dbe3unLzr7h9tHZz03vW6e72To7c7x2+Pt/q9vd2Tbn//YH/37cn+ztt328f73d7x0WGvf/SudeiwhdEEty+JaA1xO0FmmStBDitwn0CFazyISlX/AirVnBxFqstnzhU6PoKUmFM2FNjU78kFQRcETzqof/wPn4XZP/7HAjkCdvI/8fayjm8jBExRm6JYvZlXQvHuROvSY5OIPEUZEZrVNIudn59tFvo1QmPMEjnG1/WCRMkO2R10D5K9we5uvN/t7fcODrd7vW58uDfAvfaNDC06niJ7oI8V2YQI+0BHhqpiZpI2yQThzmyIu3/V2+p1N7b0/y4g3v7N1tZiLYQCeB+dTbAowNXkgvuA7R7ubz0FsFCUSCwzzu9IK94xTlMtLBk6/3BqZaoiaWrSqs0ibObFmEsFUkVx801wVjr5AGHJSpGJcXaai0NtTCHFI/S7qVZXimG+wTTFAy0SfACzH3dENOYzauzdq4RoAWfaktpCiM1JSAtXPnQ4N7LyOeVzTSIXktij5V6JPJma30AU93mcT3wR9CeSxDLPTM+5S2NLLyuyxJtVdppm3aFkxJtvxiRNeZPBMsOC7+3uXf7r+L224LcPdrQ9Uzx4ctyf96iny9qD7J+f+ebPl28ekuBHTzZvxMV3lmneAMMqhM1/Z2nmDVhcmbj5B+WYNwD03DHzS08wvwfmFYih/ybZ5Q1oeKFB9yGkLy6vvArcy0kqDyF7aRnlM2D7cdPJZyDkx8oln4GE7yGRPFz6zyzyb5hFXkL8zxTyb5dCXkL8C88fb4b1+0oeb4JhFUzg7ydzvAmDK2P+PihtvAmi57Z/nzRn/D4AV8DYXTRhvAmkH8Bw/S5TxZdpz8wIYCwsHNcadURvCLPXJB1zoYmzLKUxHqT1m2hJ4qy3uydaWy5EKjxIQbC3gHTAeUowawLorfkJDVNcAsuWFb84O0eMjLii5r7qFsugdaRWPL1KpQRmEpqO23hYhggDfUh/zhkjaevtxsidunShsd+UlD4ed0DgK1g3SSL0ydZrNzYWouX2EKdHH46Klr+vw840FDMM4clYai11QpiSmyqVG74ZmIZhw4w784fobqwm6S84zdiGW+MGTeR6JUTKdvoojIaU3xIBrSsaGzJtdqPWTCeIzCdLZTgqK0HUwHB2Xmg34qHV7HVnFJwql7ZmM3OfvpoRv3Zti0b81kF6rojfWStZEoqXGfEb0uJBNFjNiF+7zhcT8evI9D1H/IY0eRkRv89JlaeO+K1Q54VE/LakUDHqdxjxa2FcasTv+UKxvbWY3uKMMGutmXLfJLbXTv4n3l5aEFlzcK+Z+MmCe7cPd3Z2uniwt7u/u0N6va39QZd0Bzu7+4PtvZ1u+6pNBh9PdYUrFZ5ktVhXG9i5CsG9AbxPcqu7CMDfPLjXArvcQNPz1iGlFYHcIABqQUdLEwA/4yCfLw4yJMGPHgfZiIvvLA6yAYZVuAT6zuIgG7C4MhdBD4qDbADoue+Blh4HeQ/MK3A19E3iIBvQ8EKvk0JIX1wcZBW4lxMHGUL20uIgZ8D248ZBzkDIjxUHOQMJ30McZLj0n3GQ3zAOsoT4n3GQ3y4OsoT4Fx4H2Qzr9xUH2QTDKpjA308cZBMGV8b8fVAcZBNEz23/Pmkc5H0AroCxu2gcZBNIP4Dh+l3GQZav6Z96tR+MaoYyLPzVhrtuzrCQNl4LvueCjqhmPhOd1nCRE/VaO8cdLZYcHvhBYz+lf5HEhNDBFbaPDoRDJATzPhBdgdGZAHq2yzBz1ZCbYKpDNAOeEjSvrMpOC9XRdf3IMAM92gYayZibyv5aTCiBYxL9za78yDwsiL2wgvt9nmnzHEL1zCDYRIJiiN/rIJnHYwgFgCYTRCoTGwphBXZcvdNoTGDnYpRghQca2V9zIqaR4YuC+4fDQ3xweNAd7Mdxsov/1gKlBopviNMq2uCzqbsqTfnkLCWI3AAOU3pNQpTZQLUB0SYlUnxENKqM6eSu9OzIWJvVwiN2jFmSGhPMT0KZImLDBlSSxOFaVvG6Mxge9obbu/v7g+2dBO/h7Zgc9g6TLbJFdva398rodGv9xkh107bm1/AdamoojelorJEFS9bv3XJxjSYEy1xYixKY2DOlZWCP8pCN3SFRQebW1nBrbx/jrQE+3OoN9gPk5cIILFto+MvnM/g4u9Dwl89nroQwnHeJVlKh2o8x/rie0p6HWChtkH/5fCbN9aR90i1ewz8QBF9TNkIJv2WaPTiS8ZhMSAeZIk4dlGE1tu9z5MJp29QONgMsSSC/6sPojh1ykRbCZa1cZ2rNswBCpwxJPiEQAa2lkMbnBE9NCWwbp376SUO7qVGo8ZpQQWKVTjvev4DLoBm7OdJjg9NCj90xceD+EhndgrtixPUc+qcrWyPLYC5coQFIL8zeRet1plQRgVN0+ulmz49JWJxy60C8+uMKaHT1nyv0+vTk4h36/O7YD9rb3+6tmzWFDxa+EOdPgejfgcZPpmAH2H3llutHNMt+VT3YGip8+SQFF8e+LI6A0v56WQXiTBCtlq5u8gY1xG5hDxrwEsTwJi68LiU4MbtEBaS6qI9OJYIwAkkUoloK2VDqjuZLxpUW82IKddbHcAyW368M7qbNiKA8QZNcKhhkoCW5Xh9JyidBkZNgHh4QtJaxUVAGS7++Funvgrk+cGWjkG9NETgLF+g1ep3FKeVWKtFrZ7YqLKLRX+sdgNyPCWjDWkdnYUCgZ6zXa6O/1jpmPWaEtfU6P2XWO+WYaCjwaNLOCf0gHvrEhbJatxUrCK6ozCb45SoQMopnaxV6Xf1yZe6cVEkRdou24HlY8vQJ1dVna/xyOjQNNfQ5g6D/5ETLTczgUJzyHGq5F1JxGnCDVDwM7KIMXeUijfR4V5AnBeGmIFXNzqYSnJfMBDiRxBh+oH86UQWKlB9S8lzEzckuLiWnkFdvdna2NyXBIh7/8+s/7Pfm8y+KZyXqOfHxAij46gub8ESrV0khFYH1JZKEsBJmPUYbpAdliJnWiWjCGVVcGz9GKPEBKEeJP3EHREs/yzhAa0GwDFkBQ2IZSvlIdvyZCJ0OFGHoTy3fvPFhA4xBWSltypBzJsQypX/ND4ulltW3WPqFdkrKFOOqLpwexER6tBk/l/grw1IGXPPkOUh2+KJvBByCUWUNary0+dW4MncgWy2C1irL4WLB20fjNHljzfDGdfBCTtfWsbNTv53Y2dkuLQrs0mWqNDCBZWLz64AYzcb8YnP8mmDw+0DjtMJstbPrn3B2Gb0ndNeEs0Ra2uOycsq4fhd2qChkjwm9CNYeWc1WmPs8mG+QK/9UJ5jMAGs0Jz8i5B9ghsgkU8V6YOnmySv7doyZli7+jplCfgNTFCuCBkTdElJO11S33BgElQPaZHASQZLL5doyF4ElWkwKIthZYRreLCNFQ+p8YH4KyFjTBIOxzMNgJK4NOQ+jlNY0QdbCL6oS1GiUFq8JUURMKCOJPnljKklqk0MwJApaF0Zxuy3z4ZDe+RHhGciJfbO5aR4xT0RcjNYjdCGmtjoxzjLB7+jExHtQqe0cSSdZOkUKrNa6sqlJmeIBSSW6pWkKqhicR7ckTQH6i7O+LARNzKP8eq0u2qtBXN4fB8bxsvjgHEafLRbhwKkq7iaq4OpNo+pp1jvj6CpD5hhqmUzuJwFZbhVtowZM0dccp0YJsc+A+WENnUIO4DR10BlPP7mLSWaO8jHXFpJ+LWeJ1dpruzgCNwB2DpLAZqmuAHyQNvfdSCf4PTYeTu8zUq6nHMwcY8Z4oZmVdkwnwEBh3VcBGpDUJLvUN3Dzbi9LhBC3xhWCpYomUzuCYXmz57FUa1HV9WBHKdl9AKu0d0ReJjm+lPmgF8l80C2JlU5pexbLM9LdGgEu3r4YY804WvTBoASmaWEAN2xTLFtfmSqeXQIY30CYk+GQxJCvoDU+wygW+tfk4qy/3jGelmvGb5lGYYH3wnIBodhxnkoQb+HWDjZJgxOgOm/huAk6sMV8Anzwfct8kPezxH1BiXaCH74v8U0uiVhiOMIXO3yDIh6uAF51bmL3ebafGLgQrgOst9hpjogyoxRrAYEHPDeCEx41Nhy0sSM32BvR1mMJ1p/nEtvxTvPHGN8Q8PIQCA/hInAXMSUokVZthElArHABFiOD12jiJIVzaWOGMCTwW6vSnACBoJxYwrVqYTfGbERktNxdH3bDNh5jLqYFakHlnRAIjePDWTobZuisf/RJo/DIMG3fDxVu9/Yl1C3skJi0RAYuZz61r6Nkl6cPzycO+VlmW1IN8StZHPkdrSP4Xhk1i/EoHRCh0AllUhHKFkUOcPezcS/M/tzsa1CwtKbA9UtGX7cJoLdtOuVUKjLZzFKstAhdmMsNFEs8SkIqmskWXWKQ2P/kPPbFt5O1JR6g/4wwDUtLx9IQbv6N3GQIM86mE/pX4Cc26Pcfv0gyzFO9Ca/0SxFNrjQPmg8awCuvZsacDQ2dcVo+ClnSoLnnkiSLs2uVUeMiC+QpmdTdUcgiObj1Eutc+NBFLlPQno+5sPYcFyjlo+DCVzakRGOQtIviQvB0aanMvg6RCc3QMyFsVC5Fi91qdasKOK/+WLumA8zwJU4mlK110JogYNyx0aUecIHqPi9O+/HXyk7B/yEVvAL6FVXxigX+VPLmoucHVvOqSPheFb0qHCup6hWL/KnsPUbZK/C4wupescifCl+IjR9C5XsOjSCMbVrtw759eMwTaAJunS/1kC/Dt5Lnd3mJ3/5odvP/PHVnnroORc91oPp646t6VraXWY84SH30y49wRiosRkT9kK4DC/qK+g3s6lZfj3gGp4HFzUtVJhbFwEqqG4sCsZK+ArvCnyrLYxwFFokr7CWwK1xZtecbuggsKl6w7hMGFV3ikcuVCUKLUPFtiwAjM4YLM2KQJw91dCfExJBjNBD8NshM9nv0YkymNptDjvkt0ucJQ7dk4NJtIfdDD0XZqAhIt4n2uV+qCwZvHxOUED38txK6drYqLemnMWfkHstjKQsqUFcvvoSHWNDSolY+06kiEgP+uCzxRxXW9/wvmqZ4czfaQq8NNf4/dPzpi6UM+niOur3LrglufI9j/cV/r6OjLEvJ72Twb6o297Z2o27U3fXLe/3vXy/en3XMO/8i8TVfd6U8Nru9aAu95wOaks3u7kl358Cie3Nva8c2XvJIl9EQT2i6rNSSj+fIjI9eu5hIQZIxVh2UkAHFrIOGgpCBTDrolrKE38r1GgLNk7V1v4y8xo+mlAUbWQXPKfQsTAz2LTUElMQyamyNzwzrvOd/4htSxdY1EYwsywCrwWBm88s2lTjw7awdshPtRFsb3W5vAwpv0ri6+pU2zR5Na5fwH1B6FnH/u4oZZw58K8q6+ex+jglTXHZQPsiZyuftYSxuaW0P64UtTeWXJlT8ys5jayCA5o8VGXFB/zJP8CqQlCnuiatFtD3QBoLjBArxERFrJR5kGyUysAc++sclQUOepvxWj2w7+BU5yZA39tpX+Vl/g1LK8rsOmuAYMMroXZHaYPFaL+Dw8RxNef7qldDnP4YsBgiYt0k6NqU2pVJ1bMJ9kBVhkvz9kBnPcm0PJRH6lBIsCUqJQrmE/AE0mGpEMT0DZqbwppnq5Pi8o7GaCZ5xSRANsulwkkB3xnoEPIDZVl/mMlpuYakan7cVXd2tqFs9VJe71KBi1z1KllYEAlX8JrWHqFXCfzs7+tBG/dbPOcUbiyLj0ZqDU3Sw1Yu6X5HCo9dy3aRaZTi+JsqXDJImUwJLRNkIiopAHwvzJ4yPpeQxtXXx9BDMpUiDHQ6Guobab0zsi/Xayczh6Ho4+p3ywWSKRxr6JigEiblI9HCUjVILrcIjSMoC6ZBDYQZoHOmINzaFBvRCv25QtvEVERbjTOZmlbJj3QhNK0Ol7G81zWgcZIfZ3AQotoJ9mrskTHKBXpNoFKH/Sch1B/1OBZFjLK7XIYeb3pB0iryRBk4jgYdQy7iCCcoYETOpaoZA5iELXEFgiV67rAs7qv2tDP/6DCDng2fgs+MuCuUc8Iy0+5sT5+nUy1/KvITSsLMGXtGMbvoIEYcOhUcjkAV2yI8D1+grYG7HvVHI5fYUaOA/97gd0vN26CaCqil+V9hKXs65lFAZCwLOrOoOs2PCCoLxZtFlSAW5xWkqO0gA88uO8YHgBA1willMhFzACl6a4xQAOu0bo0KzRFEJ2mO/Lq/bnjlLNJI/ZrYuJkAATqZFYOC5kjS5p8a4l/p5yojAA+prtjrxX/th9jmgj4HSQC3yvXDD1KiW/OWaNhduqFbJVkaBW2pBBGjaxIdOIdDyXMRjqojpeAWAqBpeMAT/yCLb9QIUQVuKxGnPG35/vx6GNxh9sHT1XOdfzk/W9R+mFUEKD/pBixdc3UIu0Du7b9dLeZpFX+ivOU6ncpRjkUTmb6in/fWWDMYkzTaH/BIq6qSbWt9LSTIieujNEoCXTncmMhqryR//AwbyCysjo3j2P+uN1VJc9SiXiVdXE1/9sebgWuC+NU71YeFSqJfEJdBeoTSRL0lawoKMuSg0yxJxCn9OWOQF2m1A9+74RsrNelnZ385b18AOVryyBnQNq8EXzSiFzWfPLOmPcJzCaRjO1vT2jO0R35BoQpUgpm+6lmGbQ/wV2Dz9Jb4hl5B4ehksTl7GgmiD6Y9jKM7upw1lKyXmLD65y7jUkuP4t5MQwv/U6HvKtHX08RyZzi6oF3V70V4nLGtSRoe18j5/Ol6gVTaBPgfL3iBOigZ3R6D5mCtOKueQpr45mkjUsDtO2qJgaZqJhtxBbEXD69P+ukuyt80rSsUpmg5LZHKdI3QapiejvHwdZyewg7q74zpeq6dHW9a/HWN1SeWl3gI0Wbe8XuXxwuSv8vpp/z8NNNow3YK2trZad4yB6plkebW+j5AgpuzYbAFT0p+ttDFlSydU0ZExfzwuHDE89ycVulQR00yReEQ3BpTpb8HzG4/oP/Uf//B43Ot2F0CjZrzLpTK/tSK5QDLGrJlVG/tHdbe6B9EiTKHHZ0REN4QlfFlV0i9s0ZRZBzwsAZkl1MC6IAwP0vYtgWIuSDQomsnMA2aYcqwaVdhzPYypnCAwG9lb0q1oS2vc3a1oy9Y/0X+iAXE3DRMuFZLkhoiw9t5brWJKOyLX1qfW2KQkUk7gWhakdpZyqhxSJkQJGkv0GiuF42t0A4E4hUfTlL27o2raQZmgNzQlI2IrCNvoC0WEKaO83kF0kuFYFaOGsRR6DD+ufm0kYFg9lI2KgjXZ9qlQvHmGEtCgfjlVHVh3I+FxrkFer2mqu9HuYiQm7IYKzvRorW49vxGtT8Jl3Ud0zKbIF3UELrEU6qCHUAju7qkgeny5AiRSZJJxsUrUubAruo8wcE04wSo3iNYoTWhQUKpTOq8dreKn2xctMbxcXzkY8h9cF5KSx6MwnV9/+K2/Xhz2UH1LQRtojyMgA/AnZteUjcBFvXbGb9c6aO09SWg+WTPcvPYrHY3XgATaTEM3PU1ULz79iMAJsuqAhDi/Yi4FUxVjbUdbtorTFHyICRlSVi5sq0coHi7RKOAieIJKxG8ZSYz2ghkeGd/Tu9PP5xfRRzEyjWfQa/hCC0/05XzDdMpnnG1kgg9pYGoFLV866HbMtTCg0tWrVhyNSZqB3AePuiQxMKfWbEFOaO0r4yy4V1UETyTCseDSKM63XKTJDBZlN0nEqFTRiN+Az2LDiiJg17owMJcj7VjVkmSJ2oWneqOGAfWPNPZAULhDEEP/NGhannqcZYJyQZUlBBJkhAXEEQQi4GEYrCnxeprYT32PH/Jud+swdD9Ct5njShv1uTdRVGotIDWHg7mDMZaI3ljOIak3y12l170s9bMMPZXUdMJIpyjlo5HtxIAuzs6RFqbmJiehIwonoetyV7Su8xghca60jocGlGFBtR5zvvn+9P1JeTZmo9QHPIFn4ADF6VRCuWEohu5WycGjf+337O+uYnrYOMyEr0rTFUK/3YEa2P6eFyL+rvQP0FHoKoJh7IhjLMdEOn7rn3zeIEyfGuXW9VrM+MhyW9pfv3kFLVOgAH3pemVAimtkf+9n7q3MQvTLkRzj3u7e1boH7+TGEhWrIlw2bEJbcy+7u6PiYk12yktxqDB9jQw+wnqN1gGtqW1dWehKpTIKejBd2RYNdkT4OU4pYcoitP0tCE5ho+pjBTINlhX36RtW2aZywby27uPr86MP65GJ1NPzSHSDxVRL/riyHUE9cH00jaIQ0ARcOwNohKm3IURjGsoVDSk0l/c/nKMQYoRe66FuaZrEWCTSquWlBA5Sb5v56u9B9evWWobv3v8MbRp9l8aHNThv6GO/eP96D/9ztG6UVdDa9260616Fdo2LUc90a/TdGLUK1UEfv/yj0rMd+jPOobTfKw+l+Mq0aXyvmUJLhd8ouV0QiOfuzPiwjXvK4kfAuQINGhcDu8LZC4L+Qhs5Mq4uoaVLC3Ae3JefcehCQESb3vy9rY2tfejNv/2mu/tm+3Cx3vwaIHMftUyIwMfQBpru4cbWAUDTfbOz9aa3uxg0Qa/1ZTfOPvLd5V3Ij7nSV7WG9FUoF2hNHcADbf2XaKnC+AYWG6hC0lQ/ENufgm7zQT/wwAJDLZvua1s02+21vgoIkEDuMs7aNXCa1UT/xA5RdHggAkptl4lmwhnaAbS3u7u9783QhNxV7sF5fGkixKr34+0Bl/SvNsSfBTS4Iuhf/qIjoKXMcKwNMTSgqq6d97Z2Dtq7UwTF6XL72tqURTOVuxuFI8ezbfPpBq4REEBSERaHfuuhvbGGkuVA8WyMmWlJ20FUBdHdxlpV1qPAwUhKtWIB1xtZZoK+/dBFh7waYnd33719e3i83z95+27r8GDrsN/tHR8ftW9a79wWSxd0p+UE5lKHc7eIUCL8TiD4cTIhcOUTFl03R7Jzs6B/cXSG2QgdQ4N/lNKBwGIaoXNC/I3piKpxPoCIphFPMRttjvjmIOWDzRHvRt2dTSnizRgG2NS2O/xfNOK/nG1v72+cbe/We/BotXx3b2MBMVx0yn8Gc1N6e3NWQ/HH94P38D2HOflwa/Jn9//HUHtlTMmHdfx/kV3+X0hn/5fdzf+76eC/oWd+gwYErrAxi8dcmI8bsYtstPc2b80zpSX8F4x97DoN2TNJv+7vIdwVAtx4pqlt8gjuZ73URo85JDWNuVSBoDZ4win1TRwzrMbu4eDBhgXqf32SCRLD7cQG3BAUL8J1DHyi5fwmzFyCVWl9Gr5I0Qn5y+XXz16eiW+vPDyhIxN/+QYpkZPy6AYjpWE5bBb7lflw2cQ3M0D39IHwGrjyH+UCiGIma4KvBeo1hcLn5oIFgz6UpnNH1sjV6j6REWVSBU7Ue3EEbgnzLnLvIpq4bRGnPE+KHXCsP7p4AYEmROEEK9y8Kd7bX03QR1x6FQILC3sEJ8klPHDphtRPxkRKE1QW7pES5PBSRCd4FFSJLSqTTOgGHsRJt7fdKD8KBjnVI6DTvg9bNMt1GLHs8Qs60pSCh3iahIzqFqTXH5lVOVjvIXXjw3PJHczhFliENM6fxgPkn194phbcW5mrLRsHs01wPKaMXAZZ0vMnsy+EadVt5wqjsC5bCLT5b7WdNRMcpFhLwtnHF6ebIKNC65s/R+nRxvGdWEh4fA28auVC331u2F7mN9A79PmYpgTaSoNQML/pHS7HXKhLI5kLfcIdx2a+DS8TZhybflmo4Wa6/EpJiJjTASpY+R+bkBUgrPmVRqTNmEpLnMVnA0kXbKgFZ6282W7Sh09nG4WiX9DFx/7HN+hXfqvViwnOTJWAf9bWUjro0fzDHs2W58jLdLOEyHGuPn8Lvv3VfGoY5JQNecit9liA9pdO1gQMqr9vZE97bpwcn4cZx65Ho4xILKPpJI3scyZlDgvja2WcbRRvVqrcct+YcTanzyZNqa6bG2LAeUowa4neYYERSMwpyF6fl8tokNO0PmWdov70Xuse9Ltbh2vtlvPxHMEMYbxM80JinpDGfTBvLVIJouJx+8W4WUyBSjb1HHidD4hgREGIgOXDf4ffNYxb/O51rrICVQyKQi6cL1WLl+6VrKVFz+e5KsYznjSLnYU2c4CBjBu3Up24eqq8QYY/dKZPPEFfTvvNE9GsNk/pq/ZTnH6qzwBGeYbjp0NbMWJ9Mp7UDpVHTuZKNc2YrGIGPX5CN2BTNrme8f/+7/8jbW2m+pLsGfH3R59Gwc+XE5xllI3ss2t/byk6Apjs6TnBWX3JUDLTeNlWbt3B2poXL0kKKTGrt3S/suaFC5KlNMayXMsTPZp7i3FnbJqEZCmfTipOgcdPXIw7Y2JwFw7z9MlBDgaeMfU9WutDJ/bD2ruJhA4ht1KZfrmuSXhRDVPkTNEJWf9G2vyiUJiprXJhz/FCs/jkv2gY1/5Y6BTeodGkAxRjo4UUAHLXFjN2hqiIKp9jdlSn4beMiNpE4QJr2HXww6tlxbF4owoWasqiLk9aZ000z6fduLZWhYfLq6kw0qPX01TroDpnqeRAedbGnxqLCBYImFlMsBFHLSsVu3/VisVu0/zJU35N8QbOFU+ohLyyYgf9/+ZX1Le/TFH4HAocd/f6QBuGCpV4uw4/5KzbAftcZJzE5TSyBZzi7vbERojwoV9AUFOteU467+5mxnQnOB7basJjXMrltzFxthM+oWpc4DVBSW5KiCgsVJ45+pqBKBQtn5gyAt6tD6kSGRZ4QpQGTNjUQqAbUWDRm4bp8IX+2LG56rA0SEjCqR5CSRMYdPrJPGElFKJJB7JIINewtCTITFISMNOMQptkkQme5LFaHJEQiebFvx1GW5ketnnTPphdStO+kr5M4Otg5vV7pg7y1Bec2bzrgwg8+AEvSH1SQ5FGyprXkYv0YbN/+XyGxvzWBEGZ6Sy3wkrmIT3OReWms+xlmTHr72MC26CA7xZLz+LWI4VzNdbHgyvBIxDjyjsaqteXa7Z6xZhgoeCGcsIZVVysVWTXDLFjn555/s+8e4NZ7dvl+7bZSkPgx59FrzlzOrq5Sc1mbDSyn2ySEnWqLtOZJ+mcc3TmKQqJc38R8QZJyAqsA/ZYH0gJLOi18icf2HpzLvzVs1H0jIAmeamMD2qn911whVMHICSdE6maxpoHSC4bwQjCSxvn7rsDijI0obHgksScJbLB9IrHpLW3PhdpVHthlso8l/ZHJg1Nj2iXUM6ZvVJxdtWBhED9n7FS+qM+9uBvedWw0QJndRtASh13HgzIr84lxIe+3YFRBCzltRZwbMQ4ZGuzEThr3bO0TGD/kmb+008NUD7CI3j6ae4qT8NVlVfiHF+d0niQrEozV/g5dvEPJv1T8vSGJIhmLuewuPbOBZjCENjQ7A0o8b0tWpHU6PKQ+xpTa5QLTQQnuWMIK4ZUCJc24DChONTPK3o/1e3zMYmvL6ui4AFLO0KKXxPmVFZIOpZUCzvMCM9lOkWU3fBrkrjGRUMzuTSFf4uyubdQyMwVkkWnn8yFEDzsTnVXj7f/4dxWwaqDBiEfGa4LPo2mSyix0FLU0wmxxTlAu8lMwrz1oILWDbqzqfho7vTN37BmUEvgKa1EE5YED8PXTmVj5E6BPEnylCTm5cibYDKfTDBE1Tpl5b1lAPtLSx2lGAfdr6OsfRJEWjMCSoVjqWx0FplQCGG3xge26wWjoeBNQ2FPTMKSjFOmZAeoLgOqUzVGVxOegNhLr6K1e9SfBoaF2jElD8c9B3hh1/mFmSxzmccxIUlwAVhcoN/WOerpJh5impLEE90KooDoWmSjlPPrPGtJ8GKMFgQvlhpMVLpdnU2RlT3CnvocKo6EnBUX3yN6Q9isY0GoOmrmKmBeCXLnhykHDaREGLLowWHiDre2/rgn18mceJoyNSaKxoFnde3cf2mCOduKqHCsZnzNIFAwoSl/kbTk3VbGlHfz4vgaj8hl2VFw/3uQ1fU44XGqhzCNhQznQY1RUNBBY+ciMXLFh9GW6Q1ynEo4i+OKI7cAb5pyXLePavdOpnRdzQ3pozlTPqgNAjVQprOANb+a6iHVZYTDXk7o4napfseXHNWjIBuZWR5fKlKXDtW9Om+LzXQy1+y3WYyB5jDHHFVzHibmGoSz4awhsVEumXJ9TvlUJKv6lZs95HOk0nwMzFtXVUBVp/qT54KR6bemcPtrEC+S8KiKlbKJjkeykAOwfC0HLHzt+MJfQAnB778ZehBK5oE4IVKWbwVboFIqHF/f/0rhIxCEMDnm6lKQ4f2H8JThCY3rVyvzgL+liRq33r8VOv6uX3Y2AHiSiiVrbs7oHYTnNU08JnQ0bnYWtZj5V3j73qkbZx6kPL5uZrJ7ZciRUoIOcnutbywM45RO6A1Nclxah5kpQh9ZOjXtWeH8MueghPwcql4ZUwIKDXFG0C15laCvORH646LSKOg1W4eu+aawjlvoI6tVemMRa/vXXVRKewZD9OXAVEYiTEXomAtT5s60FgHIkSueiIpSBwEZTFvLR21dcpcRQQmLmzfiveT8rxoujgaSp7kirqWJ1UcgtaSYzZeqs8ZlHRbzz7T3uSGuBiLDN7aWsrWfjUE6S/DPRkCIhGFchXE+BmpYcMeqBvadK1ShyTrMU5Rhylx92oaBZi0O3XdEonuPSR/A9ZTwnWExIqsGYRKnTwdh3206CyI0uCGJNtcNuz0TFct6+SOBPOP48RDNUCcXgytOH60AxnmaT4ycSPGU5wrJMR0qU1nVlQnVUmgoSuXmUMlVYiTNpRL46aQhDGaFFLi5FJ2Y464oWwgnBRYECYJTK+xqA1nVzr4vHynxGjQ2tOgpx4Ky5wGYM+esmNnlORujYapUdjU0bOPFCRbXiAs0IRhaCgSZozMXAYfG43bRhDOuOLP9sSjTVrgEKxyQYI4l6KQ9n0boacRGg/em1QZ9oNyoULsKY1XdeiIg75Mh5RLKbd+ugHkLKjFJrNiwwJYEiuFwny2jVBa6Zy8uPi0YNGBHaKbHLOesnmYxD1cR2YFaOGeD/snooa7Zc+uOzUXqr8QtauqKbK2880M02QFPpq2l9rewAuqHgf4HtkFodTngAQB/4+orgsF9mXtG2hauiQlrSW2p3mb7UJCEChKr9l6iewFwVRL80LaPrVkrFlAGC3L7fcslbdqcYakQhV5pRddZdwP05fMZODEgyq02o0aK18ModGy8ZbPsYZyQStA8mu3ARLOdmK1wAQFfmJmDwE3uKFvcQdvdaujXwPqL3g1UbkD06CmeEoEEXEgoQTNz+9n2UsBdHj9M4/lfNbx4Z9yAqFtCmK3cN5gqEKkWH19zAg3eoC2xoEoRhjCr76lid8CjNsDQyBO7ci6iYFIoQs8ZwqkguCYRUFBvol4nOri7cf8+cKUPkGExmWugrIfXSwIO5cK4HzDKBBnSuw5Y+TOONHsBmHBiRtJiYFp4Q8wRCx590CJY+TKyQiYOCzE2tBYgCwq6Oc7XRsVi4XAd1MBqlwvJ6rb8xkMilW/OsGllUIqTaDh0zD+Dxp+cgJbICXrLk0srBh7ECXP5QNpIC9sKwNxPhJKnQWLUJYWRIPdIjBVGsuPwS3M4PQ2aTUCM211OxocI93Et4Zca+Q3C3RwDem8GpwTcbZfOTSv9NT0KytU3bkX2f7+Uc5rTAy/syzQCwaAEJTck8eHhoemGvO3WuBgQQE8urcPlubQBxyjawGTSdPiK0LnmJ6P51t0rEHRFFcUpujj+VGqmrhSZZCpCJyyxejOUXS7kd220hNrIq9IBscpnwapwsTWIVRzaw5ogoJu2NIZVvLAtrKdwe9uqwQsZxhkXi4QtVR5/lGUMbRBdv8Ontgs86h9sFtid9LB9X7eWGvc9kQoPUirHCFd37wJ6fBGUtSq7YQl21hyMFnqvuRUjWYE9cmfKjVbQuyqIcvFjPL6Wu2Hs2Mfjf5/v6nPhrnVoqxujGamzYsaCiSqi4809ouOxO/TsfLV2aE1pCHdnsDNvKHZo0w/ZENY5ypjfqMEgipdQvyr86M+xNIxk1KQiDCpEQ5fERY60dOEwxoAxFjrKgsrBl4yrS5AJ5cYMqBQGX+JTV/vyDdqPDnz/uzrmiiKZlKEhvjFJh9XuWFHRE+IqQidYpFTr+are5MGzxCtZaswG92GlFg/3QRr21LgPphlIeCCgMPNVhM6wekIon12+jDFL5BhfP9mJVZMwQ8q0eNFL9ZO1sOJqA6/ewVadx7DMYvTsEwUJAbWuhWFh0XmAes2jqdhweSFPUZJhfrlh969WdrgYq6G6gRPINJ6ElsXp8ftPLSWwfbMZ57PKnX4yeT7tBK/1YsgadRcK7v5gG1EMkQYOncRj/tkODI6Up7AR/Mjoc+B5+UwybWiWpURL1nrqjIL/FwAA//9nX25P"
+	return "eJzsfX9zGzey4P/5FDil6mTvkSNKthVF997dYyRlV3W247WU3fcSb4ngDIbEaghMAIxk5t1+96tuNDAYDiVTjujdvaeqlGMPZxqNRqPRv/E1+/P4/dvzt7//b+xUM6UdE4V0zM2lZaWsBCukEbmrlgMmHbvlls2EEoY7UbDpkrm5YGcnF6w2+q8id4OvvmZTbkXBtMLnN8JYqRXbz/b3s1H21dfsXSW4FexGWunY3LnaHu/tzaSbN9Ms14s9UXHrZL4ncsucZraZzYR1LJ9zNRP4COCWUlSFzb76asiuxfKYidx+xZiTrhLHMPBXjBXC5kbWTmqFj9j39A2jr4+/YmzIFF+IY7b7b04uhHV8Ue9+xRhjlbgR1THLtRH4byN+aaQRxTFzpvGP3LIWx6zgzv+zM97uKXdiD2Cy27lQSCdxI5Rj2siZVEC/7Cv8jrFLILa0+FIRvxMfneE50Lk0etFCGDC3rGXOq2rJjKiNsEI5qWY4EEFsh1u7YlY3Jhdx/PMywc//xubcMqUDthWL5Bl43rjhVSOYtAkyta6bCiZGYGmwUhrr8PtkFEDLiFzImxarWtaikqrF6z3R3K8XK7VhvKo8BJv5dRIf+aKGRd89GO0fDkevhgcvLkdHx6NXxy9eZkevXvy0myxzxaeismsX2K+mngIb4wv+r1f++bVY3mpTrFnok8Y6vQAu3PM0qbk0Ns7hhCs2FayBPeE040XBFsJxJlWpzYIDEOBpmhO7mOumKnAf5lo5LhVTwsLSeXSQfQHuuKoYjmcZN4JZp4FQ3AZMIwJngUCTQufXwkwYVwWbXB/ZCZGjR8n/3OF1Xckcsds5Zjul1sMpNzsDtiPUDTypjS6aHH//W0rghbCWz8Q9FHbio1tDxu+1YZWeESGQUwgWrT6Rw+8SeJN+HjBdO7mQv0a+Az65keIW9oRUjCNceCBMpAoMZ51pctcA3So9s+xWurluHOOqZfsODgOm3VwYEh8s90uba5VzJ1TC+U4Dsy4YZ/NmwdXQCF7waSWYbRYLbpZMJzsu4nReskVTOVlXce6WiY/SOthzYtkOuJhKJQomldNMq/j26kL+QVSVZn/WpiqSJXJ8dt8OSDldzpQ24opP9Y04Zvujg5f9lXstrYP50Hc2srrjMyZ4Pg+z7PLYzykLeb462PlLykp8JpTnFBLr4/hgZnRTH7ODNXx0ORf+y7hKtI1IuHLGp7DI8E+rS3cLuwcEqIMTrqSl4GoJNOeO5bqqRO7sgBXC+b9ow/TUCnMjbGBXDWw217BS2jDHr4VlC8FtY8QCNjaBja+t7k7LpMqrphDsO8FBDuBcLVvwJeOV1cw0Co5UGtfYDE80nGj2O5oqgbRzEJJT0cpj5GzAn8vKBt7DbwGugn0CUmguELdkfoZA3s6FSaX3nNe1AA6Eyc5FOlVUEYAAirix1Nop7WDNw2SP2bkfLgdNQJd+0rBlYKvaQYtfBqzASBWZCk5s5Pfv+N0bVEqkXTMhWnFe13swFZmLjLW8kUrfQouwPih2UdFgsoSTncPYcL4yNze6mc3ZL41ogGB2aZ1YWFbJa8H+Dy+v+YC9F4W0yAG10bmwVqoZQQ6v2yafM27Zaz2zjts5vDx+94ZdADsZIpnfiMjk+O9WXWl3x7SRVZEFOUWjrO7odXv6zl29upPOPjqhCjieYagOyUpadz5L5RcpMogt0E0qAuB03IVcLdfAw53GPcG9/hFBwg6ojb6RhRiAQmJrkctS5sAtC+5Q8ZGgS3hVgSiYSJqFcEbmwDtRGf0mO8xG7BlfFIcvnw9YJaf4s3/88yE/eCGOyqPyxah8NRrtT/mLly/FS/HqZXFUfJtPjw7y6f7omzyiCPNx7GB0MBqODoajV+zgxfH+6Hh/xP7HaDQasR8vT/5CLxei5E3lrpBGx6zklRWdZRX1XCyE4dWVLLqLKmg5HmFhwxhMFiD5SimMlwrS0v54Jks8WPD0sc9Xl1iChmIWqPUFxZznRltYCOu4ATE5bRybILhMFhPcZqDX9FfoiL8EQpcdQshiGzz9o5K/NOJz5k2y6xglj5dXSK9b1NemggELZbK4c3pFZ3rw5zYmSNoogO8I+t4KWsbR9KFTzmsWM3kDtooGFcivnH+bFI+5qOqyqUA2ggSgGUbA7laz70lOM6ms4yon9XTlmLEwMJ41wCSkJbFWSxI1NyicI2xpmRICpJFW7HYu83l/qCiwc72AwcBsSuZ9XoL8CAcKTtWfNOGRLp1QrBKlY2JRu2V/KUutO6sI0nUbq3i5rO9ZPnqGAzBe3fKlZdbBn5G2oOLbeWBNnGuwshAeKmnhLGVwHIejOFK1fdezOA00Fe0rqJnIsrPwEWaPATqLv+D5HEy9PolTOIHOJLi3QOo/0ZHQJfYKTofZKBsNTX6Qaqe2o5o2Tiu90I1lF3jSf0JNHSvG20+8csCejS+eAx/yoHQSYrlWSqAj4Fw5YZRw7J3RTuc6nPvPzt89Z0Y3eBrWRpTyo7CsUYXw5zScvkZXsL4g3bRhC20EU8LdanPNdA0OHW1AjyWIUzHnVQkfcAZqTCUYLxZSSetgZ94EnRn0l0IvwE5FQULuCD+JxUKrAcsrwU21JMCFKNF2idjqSuZLkDmAqKQJZhvrQapZTIXpcsbao7LSaraOA+hI8HDAv6DBmisCRr1lIjUyPiaYQcUjhGAx3z5nDQKvlu2JY71NFEkPdBNxYXust/9q//DbzoS1mXElf0XxmPWPkd+iJqD1eZVSuR02mu1rLHn4D/QBm2o096o7K2vwQzInnGaPDr/XelYJ9vr1SbIH80qumIgnldzARhzTl7DZAj+C1YIMKJ2EveBZPywTbUHSfQNyYAuBxjPjpgBetqDya2UHyfveHphK70aVWvGKlZW+ZUbkYC5HyQ56xeXJO4LqT6YWzR5u8ABeTzDDDWiFipYgvHPxH29ZzfNr4Z7Z5xlqL96JUZMI6Q3lvYWg2nUGJZjaoK4twOEUjKxAJWe4shxnmbELvRC0J9AngG86YRZsh6wWp81OwFQzI0phOqiolQlav/XoZzLvPR9NRTRv0bwPYOcBBQZoqVlY5naIFH8kfcZOOgPA6dXYBnRdgtra1VIBen9tFOLnzWywNqOPaB2wlr5Kux5IUKz8eg1xRxM/RDYheHthnOgBxs3jVTVwMlqx4MrJHBCEjQok5oqJj15fH3glioBKG3U7p8E13/BK/iqCQxq8lSwXBi04K13DaTnOS7bUjYljlLwi7ypj4UQAaTrTZjmAV4NSYp0ER66yDfoVeHQ7g+JSCOuAPYCkQLBSVlUUaLyuja6N5E5UywfYy7wojLD28YRlV6Qgt+NSBd6iAUn/iWJmMZWzRje2Wnpuxm8IJGO3QBarFwLc5eBcsOiOPH83YDycs+AFh4PlI7Pg0HUZY//RUjbqg612xHAdDb8NOAW+n2T0YOL5MzIZWPJCgW+FoML+arxL2Nvzk0zWE5Bsk8yjNQEHWS1UQWo+shfYkBEkemqy3e6q2Oy/3AHObfZf/AyHM7zFarp0wn5CtU/W3vt9up91EPkO4HmnXQyc0Z4klvCis79URy87iHnG/gRmnyMtSIZ7+FlnzJnQWS7d8qrPFY8ztHTL9avzBmwEwas+OhrCi0K5q1wX28Dp8lYPK+GcgIOkEN2gZhx9167H++34q08w6vrJbInAbxPPSxysj7Q2bs7GC2Fkztcg2ShnllfS6m3R/MQPwc4vfkCi9zA8Gd+J1rZYk1Bau8onXPGiT6lK56mf6C50ZkJf1Voqt27c11rNpIPYCygfFXf4jx4Gu//Jdiqtdo7Z8JsX2eH+y6MXowHbqbjbOWYvX2WvRq++3T9if+secIDk4wr4Du67P1phhkG5SH7y5ksgz4CRQwcJBL/NDFdNxY10QatlIcZohA+RJdrASVACorvMc7g03ueWC7BfyZIoK60NnaIQUvP+1aCnB5HNCL2K1fOlhQyCGIXLg4xqjSPG3mqXpBqA+wq0GDjcF3jaz4QOs812V9duqq3TaljkvbWptXW82tYu232H4HGHMW6tzmUbjwNaRpTbif6JgvqtnguhjpCQA6GVGBScCnat9K0Cq4YzmAoOpA376fwdS+bEMOaPyuUNhJ9vZSGqpT8eaVeDukR/7dPv25ejl6OHiFkjZlKrbQqw9zjCffJr+MeTu/DakgQjnNYKsD82Yir6/Ad6/q9abQMbsC4APAP44UgKDDeIkcjz8dtx8t5a5Omg2hsb8I9Kxfe+a4TS9mosjbCbMoasPzFLWa+bx/m7aLeEc9XrT8/O3928BBvk/N3N4fOuHrXg+ScG+xyS7r4Zn6xHJpFUQHelXYyULjgpou+/P2HfjF4egD+H0togn+wM/IE6d8KxZ2gxQwz5aDiVrWIOui66hqNqRFlTt5r93NS1MOC+/wubi4+8ELlc8IoVciYdxjlAjQJMMV0owiT0/cAgQBRrlJUzSiwRM2EydtHkGMe+oRcp2cjHZzwOPEKcL+t5DPsn3DMaDUej4asz/PPF8OBFZ6UUhM3qDc7H9dyxe2m4st53cv4OFoU8CT4N8e34Mrrl2DORzTLyMfOKVo6AYtJOcD93Ap7x0Ek8UcwZjkEJNWOV5gWb8gqCHcYOWCmNuAVHCHr+wM8tTMhWSydda+M2mPYa08c602YW3EkNgP/PQg/v8bJdctxnBXZm/c5//Vk230EXj96abGKK3r0e72gNUkGRjgfnkXXCiOJqnbW5liE+S3CBUJrL2RxSadtBA4382AOcSF1DkLX0RGumwUglqD7zhsjn9b0EHHmoQF+BnMGM3oO83h0QXzvpg5Sn2oxSCjVDspVZYKSoNiKXFvQVVJu494ph3g0MXzfTSubMNmUpP0aI+M4zSC8+3tvzr/g3wPfyPGOXZgm8Ck5RULQ+StAivZI1XTIrFzX4v/l1u66oHzNITsZ4p0+d9A47SBtCZ9CtqCqc/eXr0zbXZyfXWXO9k+2uMl9CjQ5XRLJvkxviICgooslQNuCZ/gUcwKVslxTYNeSohW3KwNkWWAVegGzFXNTe1MDwPjxN4pA9ds8w9sxZzY2TiYud9TBAYYrGhLdC6HevzbR2DfwEU0BKgjO89bGzLl8NEgpQdpLtT2gqIFazls3X7wnm7qLtzu3tbSa4ddliSRA8Y/idwa3bCeIppmQTFEjGjqmhOFfIVmiHabW5HdtMDzLbTPc7m28QAXfR8wYFOXmJCgmMnYGPaSgNAl5WsGVqYaRek+YCM9tUE3S6vsJpfAGpJ8oSDu0bwZyuiVFo9s/E5evT5wOfYRktqZbuBJORcBmEQBwKAWDZwCsEDyaX9QXk6rgRbJJEA6sE4Hf+uSUjSsW7hGK7EpuJR3ze4ZvGCkPxhm2xTOq/8zFbbXwkFAaHJeJsITDUoMv1ImAAuvTr0/E7EFljP+PTCCrlla4SBANkYsFltaXJgbOI4QDBiOlqI4gASM81Lr5/ypgETHjXtgcCuqP4DZcV5Jn1lMFxNRXGsTNIXRJS9WmDIca/GwPi6NvnQBwm21r6aT8FM2QT48AhoOaDcXt1xR2o2WsYFV/fpnM1XQk/WB+JObfzLQ0fklVhslCONQcLNdfGCLB2e/nYnASUYlxptUwLYrylkrDKj1ZQHucEPsL8XIjl4j+AopOYsJ1rVfrkJV51xgSPYl+/AsfsOqbaSjpvn5VotXAefST6vPK5aPzdJNrFHCxKGAi2dqVnUvUnnYg0jiKtTwqjK2G7tHg0xh0bw7HECZaB4Ugh/oB+xm7x0wrCuz/vXMspV/wK8w2h5MwItFDU7AoA+iKhe2gW5plXuim6yWHhwd25Yd8D/SGnq0pzCBAUEFyq0vBYN9ZOw/vFfN4xYQd+iOyeCpiSvWkrE6RNU6Q51M4e+GIc2GalcPlcWIy1JNAZ+PfgpZDBBUiCWGjtmV7Rk4SaIp9620WB4JpGUTWTEQvtYqIu042zshDJSKuYeZw4o3KbMCECTCkn+CnFibplffhLAsjN28GDA0fmUHLaokoEe0gaUI5Bju0db7uXLYH8WMA3acIHk0WskSPRtWSFLEthUvcb/OAg3QTCXD6tY+iE4soxoW6k0WrR9Tu3vDX+80UcXBaDkHhxglj98P737LxAc9onAjarUjTbXd2Uh4eH33zzzdHR0bfffruWnFs8hdcQNIg/Xklu76FlpCHBZb+RljDuGmoW0tYVp8B1j3YCrEWZDwtxc7/cSqjqNVRZQSJIPzr0aKQdJ+P4KJEMiVto73nZYoT3ICPLtLuQUZ5VT4I3dgi+gOF+N9oV6gG2t/XOaQR2fhrORJhBkCI9ROVw/+DFy1eH3xx9O+LTvBDlaD3GW+TuiHNasdPHOqAUHvYLTx4NozdB5i7rexBKyOgOsoUoZLPoYEoNIb6IoKWxUhG2bit3Nu67+M2AjX+Fw7x90heAi+WQBtl0D9PrX0gy0miUIbXp3OHt1dmvF2KLZZjQA+YPNZtmS3NPbbNIAhwwC7NO2yPwWztg/NfGiAGb5XXrDoXyFQiU8krngqtsdeL81namBV5grbY0KcofeFQh3MGePvxCTBnQDPV1aT1wISHHe9ZIOw/TiSowgWWgh7ZnefAE+D4NeJCHJR8wMcODGrTmG8te88W04AP2+5N37PcnZ+wmqIyMjeuanamZVJHx//SG3Vh8TjXY60QHr2sm6DP4O6E8oJmaRg1Yyc2MOzFgFQ7f30T++f0bKKwUJO9cQTSeu8aIjhUDKT4XnV/uNmcu58KK1U4IHSse7YKpVJAWBIOyOKjNNtaqfblsl6N6pvVU60pwtY5pvvM/AWPkvIZ5oaOuxQXYhzIfeqy+Cw15du8na4sqgJRqtsXyatBX25MoqqcwMB6m1BtgTUVuT5OlDgahFQtbcNWUnHqWTJeMty0sboQqdLT/MYGfLCywLUUlbkDbdRo4vRLsdz9cMK2qZZ9Lc73IYEyRfazzDGKjy41p67hr7LboOi4KSQVUfQ4GSkGFig8JCkJlPY3B9UW9JmZgQOZmWTs9M7yey5wJY6ASMqbopVBveCWLNGUSPJemsS6Mx14LfiNYo5IaoTIk3+Cn7Se6XIUfwUIPjUblc5Ffr2tpcPb+/Q/vr358e/n+x4vLs9Or9z/8cLnxGjXYR2hbSbwXHnyaJNqKFWFWZ/JGQtcAXTp2ok2tO0Xfn5yKE3yx5X0MQzzmZkZ42tBupfLasIWpIU7W7t0I9IF7+OyPf/j3n47eHI3/tDEtgYvFJrS8R4zvXkAXKu91SrfFGlaHfm6dEPqfYGtxF3LV7toi/jtMgZ2iwy0UJUHQrUCNKYLsBLdB2HWb4kA9vNYVoAvdRzBOB1lNsOtwWNrTu7/twMGN/xvpuv58BBxJee2elDfCALsWjM8gq6H1KcEX8axXruvzWCu6eIf4n5BLmxAmkIWUEWG6uk368G61Zje+GHQb2D+4OUGw9xqstWdEaB9DSEYsGGVeJu3xgGsTIJFSHZ0KyiaToAy6L33mYQRtyTGqlqDgggc8291Ys5LFFoQ0xU3aycsi64664LOtWgmp+YaDxXoZjxAwmm+ro1cKpPDtzPHZljBrOYvw4rOVKHnSQ/D+4ZNegvd0E1wZ/xxHpcZ8nXG3uBztpNv07zAs8eyWRn7voYNmy1EZAwneMkJP2S+g5NUkcgR23JWFyrVFKklOYSNetI/vkSWXc5FCCTsbephhXho4jbHCJpxNStxSq6oWPgNqwQs2h+ZKUVicq3WfdF9uk7PDlkhfJXSo6R+BpbhUqmWBWcCrzqcUcyl1VWnshrjgSglzzCb/mUw4A27927DzCP5uhVt5CsPZmufib5OwMNgeaCE4xZoCstBCEQ5cWkg4tbGXqQmnrSGNGHLWKJOKIOIgMKlkJjZjb7RZqfHHwz5kRZW6UQWsFD4MbWUx487737Nc700rPdvjaiiVi70Hh04P3VwMo5ueOz708x36VRr6VfoZviYcodTmL3GNx4qd+a+t4Cafd9Yg18qCcbzae2XKc0ihYlIV4Pvw6kt0DiQAgB4Yv4+EQkqvfE/1Uuy0gTw3Io1WEJuUWgV/V0pStgANC1PfPIMAKuJjYE3o42Vk6LvQyS1JoBBcHJyyAWInw8mHyYBN9uCP38Ef/xv+2IE//gX++F/wx/+FP9iEPUO2atnkecB4Mpigy2vy9SQLrYOt8BHBLtGxf4QA9z+oALw1Fu9ghlkjC7EnVGg47NduL4LZyxsDQaQ9ovAwN4I7MUQqZXO3qL5e+YXXclhzNx9CdcTC/pyS8C8POPNp820gcYG5HFfuqit6O/Jtp/UEwF4JO5KrJXjRQKJx8O1Dx0orlBXBvCGT5QPBZOxDohcFIZV9UMFJ1q47+Mk+ZhwrR2B9awMNDeaiwX8JVWAp5iSFLFzumazDoYgaBilvJbqxnO8qh88L3616DkorUYxZ4VKotyI2HkFfMPuwg803ZP5hJ2YdhW/xjYxNsCFDRk8nZD6kUHHE2MgKPwMpNlkjPyfZB/WdWGpV9Bk2BbnmaMiNdMJIDpOExr6gtfi4+STi5seG5OCW3VOwKQMef1CM/Y69gU2fdheeDCf+l7caS00wU5srtj8aJVJ7Z/UcTtf4foW/5et4fDwWZ49heU1s4RPhZ+xt+Gu0DKi3CfR3uvX7T6pZSiw6cbIP6g00qQTIkA8OTemWIX4vqOiVNj51AOTLYHx6Tkyhrj3vlb4N4QrqIjiFuowaiA0Oc1jQQM6MATopSI8Z5tgGUy2tDkXH1YS+nmCPFqWJVcgtgV1TsVdLChdOFNj87bd3M2/3rOjyagqT2HYSlyZlWqBXIqfv4dYU5G9kXHKyPMTNtKwfi2N3x4rpG2GAhEA4+LIjiIhfomrB2Ik/hSoo+a30rSjSsNYOtMvdQebb8X1O7U7G/gz9K2sI5iHDW991fAe6yYtIEF8SvGOXys0FCKWdoC4qwQ0rG3AmZLur5IMB7ydcIFvSxaejmK88vkcxT16NWjB5P1YVQRGayXf7dkXsfdOjPV9mlnXDIkDI1udBSUyhvQt13PcfgiZCIPFUom7IxJGwoAGvFIlOW6ywsGvmljSa+lSLKX8OU4spgng5F3c1UUoGAC6FkBm4MVGLtNhyP20Y6TsIEdRpaL8EDieuujO2dw0YyNAlJoHsdAnrXD2AymiEHdyuFPvQZVrVByN2WlRjmiEekGr9e4HMcZa+gD5S+gEK2pfrJZUSlUalrR8OvdWGUgSRutM9WkOpCBb6vomnhlJPDaX+azeUSjem051LVf5eXaXSIyVUKD61lnpqLfXUWuqptdRTa6mn1lJPraWeWks9tZb6RGupVK/7x+gvlWD01GTqH6DJlKxhZVI++URnpRjJwZZKtZE3kEpz+uan5+uaKmESCwrxf6i+UtjIKMmNoZkCl7mWNk7DYgElTgVkqGePP8NtdIp6gDH35dpFJUh1RU+viU4Xg7WL/Zg9o1JqPTWOemoc9dQ46qlx1FPjqKfGUU+No54aRz01jnpqHPXUOOqpcdRT46inxlH/tI2jiqrqZCy9fr1BCUGbTxQ2QIfnsQAJvMmsklPDDYT9iyXkeuaJ9QIun3CvP1UWYqSCfoZMQLqsM72CnG7O02zHzjlY091xdryS11bSAxPYoNhPQyI9afQC+kOXkGHodGrVxCKC4yANfsdO/QSGlVTXNN6SPZtkRVVNntP9n8HhoxX7s1SFvrXt9xce3R+wTgY+tHrddz8q+XGIXU17c+/h0kFjWcnpOoALnv9wsXm6T7cWP/snKnZfwfyp9n17te+rpH4qhf+HL4VfXbL/fyrjV2b2VCj/eIXyq6R9qpvfUt38CqGfyug7ZfQtncDAyxbFqw1o8zm7+83pK2w+nD0IHzvn+1tC6OIP4/3Pw+jg1eH2cDp4dfh5WL3aP9geVq/2Dz4HK1sIUW8Lq4vTs7N3D8NqS0dyx2VGhkOyky/nndvQF7y2ITycHuKlrARYFYW01/3NfA0ZANWLgyxYlRtMF4pIu9N9NOp/Dx5NxBgG6c19BfmT4w9ktH24QLfai4MPnzUhkWHJkxPYu2pLczt59yNLh2GOm5lw0U0I0+5N8ePhywfMAhrOcLXc0gTO401cfpiOrgjYD0LZYAENtQEZWYkhJHplj6o/1iJLENv2bJOnnznZdzxNwP305AD8Fd5Xtv3Z0TCfObPD7EX27eFolO1/83L/1QOmKBf1Nl3MYxTgYVJyAY42alX97gxKxkTGxooRFmw4BPvQv8YSvBj8QvHJYAeUEoo5ayMVNSYEXxlUMzFewh3RRniKUVlYaGMN+uIQd2SEjZlJ0Ty2vnJb51jYXwyoougW65F8gaBvzeAMj+Y14EoVl10dzyj/MnedBgOQpCWWKCh8uwk3h7YBQ3DngGzaOxjtv9wb7e9BNSY0gBguoN7OiKEnzhAGlGqGDQb6p8koPzwavchfim8PDvbhL0XOX317+ILz4sVhUZQPYBBt5EwqXl3BYj1y3G79Tvgt0uzi3fj87WV29u9nD5gi2YnbnhcN81vmtxPF9YeP47PgKcW//xB9nv4I3rmfAGH6hbIdJ/bbi085senqA8qmB2/Q6dsL9ksjwImMBQxc2Vth2o0Av9P1B2QtCol7MWaQgtUu1awSEdaS1UbCgazZTDicF4EloM8mhbLYk+YYxl5OnoPocHOxDCZpCh2CzrG+F5EM7ncXayERTKxJ5dYnFvBO0g7h4G3aW2FEu3YxRR7h9LH0n06eZ5t7lLsz3riHVHe9xopxvGmDZuxJSV+g0oN1hX4sZuESNK2YEa4xKo4Cab+d+zHjc3CiYrj3WizJ1if6T0VwT1PdpxU0arc0dbpkZycXgdcZey9ybQqChbIYJWjqwVy00/HiNQwOLZG5A3gEPvUkwd3gvKqQx6ABA2Vv4uW3Alc5Lj7GYeA9WoKMjR1bSCUXzWJADyPcMCnslBPQii04JiA1sL9CbxrStskCA7bgwZZikGm1gPQV6C2HLmmYEfRA0tZKfBt4mBdwvcYy7Y5AwSWyP+5AlFuWN9bp0DUq213Hdlle8a1VLwPbIHzYWXFBiHiibdEUGlpD4b42/QbW52/Xop5cgfLYmMdGYPR4GnxqAdXVzSG4v38klCH5T6Fk2YZkBMDGS6VAkhRgmHvvmN8fZeG/tVTY4mntqdAmegCPJt3IV1BntTAQ7U934zm6u7BNpy7ZydvxmzNwy04FtZXS1Q24HRPhtLtrfYuQ0G6H6sEiSGichVIDsxhsrVWRhCUSIMAe0PMkyipIJqPUs1WYpP+wyS+NsLFwegLlDyJpCJAsC+Zhtml3a5fGuWqDlbkrPz0W1kBxhLnB+A6IbpwwUmDtKgS3Ls/ncSC47rREwZQK7kLanJtCFBn7SRjqC2ORlwP80ImkJeC0pZofordb94/WM+oW76m4DNtLl58rY5A3O3jPBS+EuSorPrNbwns3ZjkcMCpNBjHpR2Y4crKZzrCRS6fryzEbjwfs8mTA3p8O2PvxgI1PB+zkdMBOf+jz7O7PO+9PdwZs5/04JECEyW4tIgRLA3PytRppWIhbKv4hraM20HcbqjC4I1dbG01hlK8tjG9tkQLCJpG1bLsyeLFg+6r14cH+/n5n3rpeUzn46JOnXAUNSeIFiS/qPkuhnmupCjgScIakShFExhbCWuh0mibySihccUFjIwHmQnjMg8HDxlMG00hSmHfS6I8/nr3/jw6Nokz8YrqCIe3QnxMwGSk+qRZ0RPeWsMQTEYZbRW015x7foU57oS5AaTVEVwaogklfKvbMFwi8OADrBjFg+weHse8h8L62nS9aIR4NILCcLRM253Bp+5RbwfZHobDOsmcfTk9PqXAR/vsOOnzZits5GXS/NNqJFDKBytgln9oBVGwbCf1ovdVAXSqrtg8pY6UQbVMdOIOg6aShYrAPbsA+GP/VBwXHFkgz7LP9sNM1rnOvEGSbi76u+Omp4OkfqeAp8kWk/zb5IQ7CZMd5QDO8r1ypJyz+iQp0bm9v1xP9qRrnqRrnc6pxWgb6MuYBWUn3axbj8bjblyaYqle/pXB83PPQVRU7fweKHPRDU2wSTCUwuiYdlhHxx0nw9BHvyLKUeVOhA6mxYsCmIufQ6pYY+QYSjN0y9DSNML2jzYLrKekFDDemQTjCtfiFMh/RIgqOXdgEDD2fCXEmEbzvIixd9GbB675TqJuLBegqKWivF/iP8HfBLej2TkeIN9JCueavgtQV0HBLbfpMtvvzTuI0AXun/ef+quET9OAvYQaEsda3Enn7A97l08Fui5tiN90V0XsfkqGKAVEYNFLkyoQdz0u21I0h12rne/B2VUt0tlp4KY0bDPABHUP4mm+WHeEWykYopcdtNQCwKRYtArRtgq+/g8TK+OBawvHh+KP5P9NIL8z64NDkUscThWw1vy2eQ4yzYJw8NBEmUbW76e+OQgQ/vi6j36TH39HhG7hE5J34ztnJp+I7b4Tjw9RJTcZoTl7ozW97Wxs4TxJyjPilkUYUeKuQ+O1MCy7yEEXHAyzSFyYDOTnQtju3Gb00gROYRzQIJs0FBQk69DGPH0QwrA6CTL2Yf54L5dkBFxCic4mmFhpAD4fkHKXABSAE9LSVnM1dte5e52Q2+H1SfFFBawq03gwuEfT1/iugSj4ObB/Nw9cRIgl9mkKPdfazUTZKOacqO5zz+nv2B3Q+fYKB1ha5vJaq+cjOPoq88Sbua6mu8S/f41nDnp29/v453sgDB9zmTPYF8oXCTbednCEi5tnr7+/IFzo6HG6eMgQ9da602d49qN8tHVyI8EuDNx/o8m7EX0vnKsHOVCG52hT/vG6utngeQcZWOI7upTpkRm2cbYYSHnpxFdxtgnnyWgc70oqgHw8Ig6jUxL4xgOqubZmbnYNY4OBSuZ2LdoNKl15oZEIqQIGBClDEQsu1kl+DH2fJKCnET0Ubm206d/ERskg2cdiVFXdOtJHgztRfg2jUJfQR1+jMEZVYxNoxlKEAZXO8vHs741O55byrP3XTrYCjxkkV0Xf+9jPgJ1PClSPPxt+dP3/oNLbpFPWyuBswXN0Xm+K5xWgpXrDkRX6CJI37QDQFtAZMm2huGDr7dEMlImY7wG+lKF3jtV0e3t0Zfdz3N5TEKGVAuD3gN0bZXvGp3BKqn95bgeJeU/jhInsg+ls8fog77juBNsXyiws1GndTNClDcwP8NjoX5OKRzgVKS9pEmCph3XqsNk2FCjlQvbUmoOCna1OOPiffyTbTIc41TIh5r7ASkG0/EVWZhRlnv5tsvpXjR/lcHnyekOy0xe+Q72Quh/aXBvSN2ugpn8oKqjPhinYjp01KMsLjwcubgQTW9fKxUb+Yw51jihF4lvMqbygzOKppn430NtMAQNBcED+itkWR/4fiuMXAZw/FtIHr5hiGJsxXuiw3u9bsUZD1o/0GdK38VXwev955k0APyTAYg8EejusWz8YeqjDWwzG8kQaupbwip+8GyD5Iv+thSeMFJ/PnI/z5q/8Z2D5w9Wdw5H6pIxMH+3sfmYjEQ49M+igs7Qb0+tyNQlSjIVtmejCuW97QK3g+bEtjs5ar2FZmS2gGDZNa2cThfEcBTH2SNpnMxvg7UZVJ5dFj4x3AM7tcTDUVDoEF126ZexENaGLPjY5/Nj7YuAERV0mNBDXxwODCEnPO4ub9EW77hMAb4EnvUXJ+XQtIuYdC0VI48JPOQphFG9jT0Asc3VwmTavFV5h0VlRlkCyQauShZ7sbu3/7kblHqfg9AzQwFLea5O0RvDc/8lEwuLuL3RoMKAnwE2gkTcLWTDYkEnYAW8fz6ytwb64A32A//N1amIFxhogzRDwm3iPhgCfrCjJfAIew2TD4vAJS51eYQNXBI07gy2QkxPUepNkjdHEdJESmtWS+N3JiwUS3+F/5Dc8qrmbZ26aq3oGDS5iz8HoqVW5CCCpIlfjgfqlCsVGSLHSXqq+Jgu0tPro7OhC1l2UjD8LdlATLS4cohMas0jOI2AWdwIdEezHUEDmF9sV4n3C8vNhLqzal47WOsgoDfeFi4thHjrtY0gBPAVCEweJ9xrpMJkHwAige+tlAsxNsMQcVH5zNtXWD9sbDkAHtM09i03mCGeqUIGDI07bq2P6+BZJrpUhrnAp3C/kYPL3fkHdvQvSDSSUd3PBSAKy80hbmNg4r8WlyQ1yczh3mS7FU4++UqqBawDYGAwLk81lL2eQ1jH/AjfqRh1Myp+zR0nghFtB0FM4xGC2MWrSUpnsn4aYBgurEAtOu4e5SdkH3hNOVznD0TegmfyRmKBMgdW3l3nCCGAP2WDlGmMK40KJnJei6UVwz75fUP8rBsgvyBZJ4tIoJHSFVnDomdtPR6ACkZPR0F1OVm/8KYlRwxz+wQJsyMOcqXJWdcydmGvM0GFtZXBAYEyTIkBcFXQYO+2aI+0bgI9CO6A71YuIz/UO+e4QIBwfmYwS2pZlB+ilymFiTFARdH4c1txaIOfQl3t3FgDtnrmRxteV2bTCMN3ziPCgvyAcUtQn9lYI6MuHwTSaLNrsKuSlcU05ZpL4NIl1+m+hoUB7B6xCduen2iOpe0TLl1BWRN3B5qgve/JQhgopCQocuWY15CxDCTGqEQiG/EWUFpajwpW5crsORxl1ESd+RMB0aMyEZoLQrEYLSpriGy0QWl68vgjCKEAlhaL4UhJtn9/PTcLriCGmVm++bhRJLWtvAXNJgbnd1AqeSBLUhRY+aO4XWUavtuGABOxCpvGfBnReecXetsOhx7Erls1yKoi2BQQzafOD24vzkkiZ8uHqVdGu0w9IlCxLvDqe1giFrYYAxiCzwB3FBC0UvpHMdQLCn+/dXx1aijE3aaQ3ZZZfECeN7AkE/iE5dFNNTKv1KjleGXJKknrSDLaRFQJ8YrNACKjhcuywr40aInfHvHddKNaNhje8EpnR//FQCMxeXIPW7TGCUqzDK1V2gO7iALkDJCPR+us0j6FaLQ7zY+Wlftob1+uoTOnwQteGU6MrYxzr5fCdB4vMS8hQhzerEjxnukPUNDnzWqYyZGqm48Hl8lOVNawCAAvJsLv3l7ct0IVaOwdb2RuBsZypnbNpAKZzdAamTQJTCdjPNI9RSVk4YUixXhjimQ3TClqSXxww2aHdKkj62EI4wQTu4kW5JRWWxRSqqh3gmxWucaETQaCahU0ro1cjbY5mD+y6gldCkCz9kONK4ePUNSgPAENIu8+5CkYpPU4pAUdkvIVtPqjYVL3wrbJ9Ju0faJxwMj3fen5NxTycpuhtiFwm6UV6WcJe1T0JM8w6Ty79DFwNI7A76eQHqWtIrk6x7yxqV3AQ+gLuVuCmqdPV1GYoKGZiMDdR1aQP3DxXALz7VEFQpyzTclw6mEiS4BnJGeztRy4C/qVmJNynXyoqXhy+PusT3yl6X/j1ZcFde1i7tBg+ke67DN3uAObimUiEKkraUJuniaATcrwYVk14PRKtruoRcYcNqWePF+HfyNERHpcrpFrF/gyGt44saVAFk1ORRe7ko4RphRpNUxFyzGLaMqR+r2vs5tB6yjlnpGuQwfzsqOPNuNYvD0kabijXZqLATRfxnNJ/Yai+mEE8VdCVZhYXm3gZNE7GpdJeCCCuKSMdCxGXBT5HocOebdbHZecGkIymxgslCK+miQcoSEKAd6nbF4J8hhO00uxaiZk3tdUT8KN1cXaqCTxMnukJHUNz9jst5NUgXe0Vz6nH+7sFo/3A4ejU8eHE5OjoevTp+8TI7evXNT92CBDjBe6HRx29xSMOkky7jeoWlxHIjrBBFq9/NoaeMal1X4K3RRMVw0SHPO+dMpWcD7/EFD8rzQTp42vHXm5NLOl5AHLb7FayFFiJsihRtB6sMZT2LBTrnsI09aGoh6RvBg4nZGRuo3faNWOiiqVrWhx8H1Oc+tBgvtBu0em4KZs1hU0NvhCyhRVzeptN+7671XRNLXPlSqrpxV+FHxZWm3hD0u25c+gK3b2RVybXv+FpR5JH9tYxzSkMH5iHnnUqH7XISvgGdz433S/l/C+hnY8J1wq4thGv3jlsvi4KggZ8Rive6wJrK3g00QhVd8q49zu86UlpUe6fJ6kHi+U2b9nlQqwiw71mOdXR6ip65IuugusUo1B+gXd2zWpg5NCut9Mw6eJK01HsO62n4LZ1kcGMnXMpdibTsqhALrawzMH3Y7+BXnhnQHFeZfv/gxctXh98cfTta97fxdyenXyykcn4Kmz54tdoV6+F8xF+Wr0ajoouZmqXB8gfrJJfxTEB+iVIVKuhvQk8ScC0oZ3hFLVag7feajuhhL5ByMWkPnFQXX+HLoC5Uy9jiMCNJGQfAPPNV6B1tKh0AmsK4tFk2TMCf14BQC5UUKGb5bUr2+MK5Ir8X6Gq+tSK0ErC2WYDGAJcEcvDbSjWjUtsw3+ibyOdGK13pWee+FLgxRV+HUllpjzu0Yv+yOrn2SVjuNqHgvjP7VbY/2v9pd1NbFzzNn+Cjv6+dGxobfJahC7ObULEdABoGKK2/0DsQsGNbUBvSn1NUwmnvpa6vStdNDJkkNWrAGNRuPSbGk4t6rQVNfqZgtXh559X2WSPtnPEK3IKkyOBeIPc+OfXTjUkeumgDpY7PqM/4ObK5viV9HEiFuNIgnpkj2Klgc66KCnbq5VxA2ceS3UJajnLxQASdBlrMY1yofejVDNhQzuiqnbV07SX+c1HVPv/UOmCG27lA91/QZaAQBagMNXDQTNGIWVNxCO/75lMRqDbgButvFaRgh/U7OtXWFFk/StJ2DZwYfi6rmiIVjJL5AG+QrGpqSGG2dA+HgpgprJQH7S2KqpmhXdn3pNB6QlIF7gQVtGevD49RFQTl1z4fhH3jIccWZ8TyEWTsIBNYzL+/hugIvEP1IPsfRPcued+D7IZwbvARANcqJ03cZD8Sl9+pHBBcRspRODCaqgJKRMDPqC+ZrSvpG4BKBxZa6tSBrgz2OXByBDoVUbkfMKQyuFSMwBD/nWY62AT4BkQ6VZFE/1EpLaTl06QSC14Hg1mA7BNFu63AvqCqeUTEGSlugrU+ufKrD53nSpAGeGmOv3cPXClGFsSsPB4MlJuQSJMBqysBFqgVgk1QiHs1ATaNnQQxPTkD1VLmvp8wey9IbV5zkl2Imu1/y0ZHxweHx/sjHzM9Ofv+ePTfv94/ePk/L0TegFbn/8V8q+IFV3wmjH+2n9Gr+yP6S0T/Fkpdrb+6H7qwLpl1Gu4ADx/4/1uT/+v+CEpQs31WWPevB9l+dpAd2Nr96/7Bi4PPTdSggNKDOP5LH7MQ7/jcU5bmNwmtOgqhNBUWRhmOb6buZh4WBLZgqPxirOSyggh6dC3VwoROTPEkVQ49JnPuqKGxKNpBEvzeakfdzFABjQ1+sdk2xZ+T6HLR2deIsR1gbmmEiA9hr8dLZpLDrz3FVwgzYDzPyXfptQPZeoeSCSaoj0H9VRF/WhH0+qAkz/Wi1k2wXNmzODeKw8x5q6lEoHFupJzSHJ8Pkq0dZH7nHp3oh8ApIkoR6BS0M1K7kafQJQuu72SBN1rWmOcD/9HCpm1+v28M8lNLFpCBbUm8dyJit15wlFqrc0or8etwh/KVzL1ziQYAb0lQruQG2UE7qpunQYhj0K0mLXzgb7UMbwMc70TiKoaak+jddbs6Vii75lAlsnZEDHWANl0Z82i2+u5FbGKxbp95dzruKq+ohEY7F0tLPri+9x1Sn1pvMzjzW+cRdYiKgwYTNVYhT8WKEiLaK7fuadBImwWP5IulXYCeCn2JiufoUYeRIEpEqQoEePUmxwjxmb9fZNBeYDGkKQ7DcTUcN2BEqtnz/jr6rzvLaAS3Wm1rEd8jdHY7XybhnZhO1hdStAD3J+MANKQb6EHQ6YebkNqjTWRwkg/daHHwdmAHCxOTn/zXk65MIZBRflBoij7xdJu0qEXIgF68/EurJLmrIwwYZ7diCqfJx9Daqk1rwC/b5Ba/ewuhJB07oOsJmxgzQWqsohfFaGedAeKSmHIyrTQmY1jpxGQN01xiAz1gbMCwUTHI31X7P2n3m6CLbY/ZaAD24/vXrJLqmhgraRTet8NbvlzlugAFbRzwR3En8zRFLtgiJCjGicU8iEpPmAT2kqfVAcv3GM3DyQA1bU7XsIJ67I/cGBFFevZXJVyr4QVE2vRuD8fY+3o0Ql/jxssj7fWVTXTEu7TGstLcrVuA99JeM4QAkg0vMgClSZc9QWhJVjGrK6ygtLHJAN6iTdEznNqubWN9XhcA46nrM25xvwJXWncCaxnszknsvgU/DTTVKpj59IQGkIDAmc05hoAJImMj4Jn90WiVpyBXkEu6bpcuC4f2S7Du3YgSnQhekmBjYJsgFG8oZmi4AYhb8keCgcTJpYjT8FSjJj2gu9D1wNluh4ihT8oG1HtQXdfuRWjA4otsO/Tr0Ecwu/IqJHHRqofIG8ae2gQIOjEgyKuxW1rXf/aR545hixmQBa2vKUkISNMBAm5OLoR1fFFT+0FIL1+l1o1IzPq7NsvDKHU5j4nEcYAOuboH5n0B2z/HduXRWIgQyWoAD3ZQbVqTIsSVQn5FapQH6WQzCjI2dTi4k1TTuBIW9G8aVZJPIYe0TgsuuwiVODNoq+G8tXyxVh8QQceL84FmwWoGudFsUulZZvH3LPye5boQkywcjeFxe7ym3vxoL1JxNA3RU1RSspNUg9uNbnmVuBvPTy+ex2zUzhdR/Sa2hqogBiHAMOIAZCCafW27tQg3h2puwO/u6SZpSuGHNT6Qb7o8DeHDLkN/RpzQhzg/GSmkFOc0VthLxWrzUu4IFkI441etxAZIf5ZKcfkJI7UzJdgQMb6CK0wwobInKbQgnLsxgQoSboJORod1YPQIND0m/QYMzNHmsyZ7ZZyD3xZceO2gockltsrnsP21gqgROz+lwXfOGsg72xsvoElvwRc7Sd9tPp0aceNt3PD6xeUO3kLEFfvDH44Xi1aYSF6Ft4ajV8ej0U7QL++uKOqJ0L+vl8rNpfnMnEeY26TrgMq7w+9ARwyf/LgDJ7+D+KJQlEiYnB2sVeQJeECgk5MLHgIF622TDEmSqwVIF1BkI0g/KWyAWxtYUjiiglMn9BZdzUL/grmL5FcC/+4K1zSm2taOXzUdFMLGeyeDRqYxriQVZrbfQLPeWZhd18OzgVWhcN8GZc8XjEo1LETt5j3oyH2hyiRCpXg2dbhrB4a9jYYnqyueizvtkzvskgj/t9kni+UaCwWH2Ht18M1+IYrpsHw1HQ1fHuwfDY++KUfDlzx/efTNiL84KsX91kvgB6iRSev3vg//vqd8bwxbRKzWeuEVEr2ALJbRweULQq1kZ1I52pxD0r2EmLk3CAA2TTysPyBFZdkxfy/xGOIGxxhEWKFQ4Rb+zVWxp0072RhoQxE7oDsRont6uvRDnocIFHvThvl+/v78zV/oXdA8ghsPDlmZC/s88x9TaSM5+9oGrW0OPPa7hliPrHrzIaDtoR89mg+qCYMgiig22PF35p+85pSWQRUZlS8uCKDXOvCDp7ddSuvzJSEX9Rq2I0WZ1+RbceebAwm7AdafJafGoSkuoJeMl0xlHB9SAyp0Vt9Ah7/pEoSCA2UgY38QRoAuAUajGoqPc95Y9JJX1G3Any0RLlIHpEL0BIVaQdqecB7KGzEApwYcfhaCkoU0InfaLOGMys2ydmkEUWCPVDFgc1kUQoFNxgv/J/RFHpCEHLBbI90aD/XuzzvhXWhv7d8Ona0ffp8+7mX7CcXknsv019+lz7s36JNekPXm8qAb7lcwhxGkml3JYluct3LXUExXlTMVbi2CbbHG8xEzQUJ+PrzOkx55C66akuexOpO3LvYboYrQACDYgVENMaISN2TAQc89wX73wwX23e5zSq4XGYwpso91nqH79XNJvd2yyPudlp2CObDcXHMHycHLBKd1pWczEP249+BiqXoOkSVIRKaI0ipUrKtaSV1wBorGaTy46vdGsEa1JhFVJoZP2090uQo/ggVR3CgqCuyvGDaBv/rx7eX7Hy8uz06v3v/ww+XnLlmD5++aC317u/tzFu3Cg+8EiQAD3PfCrE7sN91CvjIzJ/hiy5sehnjMnY/wtKGtjQdqu99JNcnajR6BPnDDn/3xD//+09Gbo/GfPpe0wPJiE9LecyLsXkA6K9qWtCH8HlqzL+Baq86lOH/qVijftZ/oWslw3aFeeMEKhy/Yt26elCZ1fBogKLuOjSlUh+jKtoEsuJWK4RbFYUkA7D7q2YVC4zeSef3JCygXciYdmHFhPJCPoVAzlCITTLD7hb9ffRnSKpMFWSv2eGctPiHTHkinjs3xWRowavOh97dXWTdVei1wLhKk+31X5c2TrOfH0tFbtZYsB1+n63sVrNfa/W/xKmCYR1hA755oasCATRYw1IQcF+C9Bg/1BGeRBF6pRsyX/wGbuNY3Da8OwF+SR3DBJxLwDrsU0FihV6czx2PL61METok8FhN5yPGcIrTKlLbgXRSDyr4tLAP8NCGSjPJKwzYq0ttqowMVNjPUDRBMRjeFkvMscWj1Zrg31wuxx6tA+ThTAHflwfzWya6b6e4pDBBuNL1ntl33GgrmcNYRXAYOBkr6WJuETdSCzOu6FgbiNf4A6DihYTfrKoa1EhqdbCqVoPng/x8XUcBM/tkvo4A5/FNeSIGI/1e+lKIqs3/Uiylgbf4/uZwimco//AUVCa7/6JdUJKj+M1xUkaD7z3RZRYr2P+mFFckUtnhEEafcd0o9BNMvLvBo3Ieg+o96eUV6ncMGyP1XvsCi8+E/2SUWHdz/mS6y6CD+D3yZRQfPvuN6e2g+6EKLDpb/HJdarEf5H/diiw6+WzxDf9vlFh0s/1kuuFiL9D/uJRfptQ8bYPhf+aKLzodhiTeg2eduHKIcDRkOJZt9Fr5b3uS/5cILxPOf/NILmMM/+MUXCbJAzm1aqhghiqMMmPgIruIQdKwEx7KDQru1cZeYCg2TQvrCXJNkzgAYuqJRKqDjJpv9CoWQKklux9EgFgTpyq0goWhewZ7tzH7dGWBK9Y6HELOKExdkrWYd8s22Fht/Z+QCcqow1xDTqH9/fhrt5HSNI3K7+6PRfjcc1ObAbRvDNDlhLXb98AhskGxRvNoSfm9OXwGzzLu5/fjEzvn+lka9+MN4/55hD14dbm/gg1eH9wz9av9ge0O/2j+4c2hbCLEtJry4OD07e5cMvYHMk2p7fWjPAXabnR/kHOwIUlaSIHhvj+wevDp8cfSiu4cXciG2GX95c/7mDPkuiMVOOo1XPtOdDYEL6wym2ZQd8wRKuWDGIUv79vY2k1zxTJvZnq82BJXA7i1EIfkQxuz8Pfs4d4vq5/Px23GEqMtS5lDxgW/8ZUBhzhCDyaDkWq1rmwkxc58EOIW66LT6wndyjW2ukqmHpqKbstJie5z0RhcdgQrso3NINYncRVUUq0w0Onw5WmGh35hFsSaJImY/wHGsC8jdWqmi2qJTIe1LRbRJtYpE3QitzeBxzBXukYz+kq0e7/pWCbOlOaBihAPsYkKlSR0j95yaNXfzx0Pp73ax1PdNVeFc0pyawcpCRq1vTcJGHDEmbnxWwsbeXWtfiy+RhnDy7sduCoLjZiZcvP1jrSr1cfMchBpLY2qulluaAATlsY6JhukpgoNQ5oFpThTxHoJAyh41c7UWWYLYtmebPP3Myb7jbUhzk8kB+C2HFePsaJjPnNlh9iL79nA0yva/ebn/6gFTlIt6i0bz7hjVxDApCnDBscrZuzPkVWy9T1iw4RA0Hf8aS/Bi8AtVWoUM5KTZr8/vhLQ/KE5ivITcLGybXUsKLkBaMhboQUrwEHdkhI3Nh2JivvVdoKgYrwiNYm+pPyskJZLuY3hM7AdcqXIzMapfC25IUeKuo6JBhblYoqDYm1Z6tueb0g1BhQXZtHcw2n+5N9rfwxIlqWZDykoZeuIMYUCpZhlob32beZQfHo1e5C/FtwcH+/CXIuevvj18wXnx4rAoygcwSAh2X8FirUm3f/yd8Fuk2cW78fnby+zs388eMEXKUN/2vGiY3zK/nSiuP3wcn4XkQfz7D7HT1AUaOjubEuDhzvHO9HZRqQIgsK3RNEl2wA9p3qNXEiawphmcfni10w78c6fPwvuHL45edhD1x/TV/yfK2CXOxqtjoHnY5QKqlcm/HFHYng2K6wafs2ewBFjXN2AtJs973BfrVQN2zdZccRCEAAUdvXA/ohfOtM2GyFqG0pVnFysuOui9IUwP9zWOuo+vRt9mHIrrFYcqSRRuW3d9U2FRMi7lZTy7GL99nnk7C8axscAzqUYg0P7OLw29QDAhOK1XgG+xj6sv9G5vY2Hn70I4TdgBO317wdIZM/YMQN3Kqsi5KaCjL/xbLLiMYThh+4T9XSZ8p9Ys17tffWITdGiP184ZOAMXWm3zaAnEp9J3GIk9O3mLfANIgO2TkjAStzdbuvERPX/sD3I2Z2NrG8OhZv8CbydlJ+PPI0IDOXFbJwCOwp6dPMeSHbs6vx8vPgf5pNu4KLa5kKfpQEhF9uz0c9bx5F9/vBiwH/41rOe5ygfshx//Fdxn9BLw5YCdvP3Xe9acwLLftvZQM1BJt+3FD8MEefP6+SpV3ugGY8fsT1Lcfs5MtJlxRS1CtjybdCjLnv3wGzbzucp/62R5ddUo6b7gnHnFYESY+o+fMfcVRv+c+UPloLjS5grV8836zf2W2eN4oKCE8eLBeTlgF6i6vOux9AmvZKmNkvxBU1TaXaEZucGc7vLgXvZuik6XRuK1lZAkg0apstCCV2D7H1lkq9M4GB2MhqNvhvuHbPTieP/V8Ytv/8dodDwaPXhWviv9Nqflr07ZYEr73w5HRzil/eOXo+ODV58xJey6ll9di+UVr2Yg7OeLLfHhOMCPRdChUTBSwWMCMq031fcX48+dVN6YG7GlCYGSjfCT/AvBRFXBjHP6qZ0WiwT2tZYEErvuxZ9ijKdHBCWtq18d7H8uJaCyRomHliR0JnxGIOICQjfJm97yUSXpRrM6fPXqxTf0cOPm/p8x+99opcNSA4hgKSWramueQ6sqNpWur94fjF4ePQhnK4zk1VUnBfixGZduwvJDUbZxuMR6uuzJpPYUxL7NsXFlvmzb1khquBVuZGe8quecWngOmEwz4byrMLRGglBXrivQQsBeiqWaEXQ+51jKZvrUffXq+++++/bkm9Oz774ffXs0+vZ0/+DkZPwwaRHL4LcuAcOFr8JgsCSlcVuLH5HI2J9Fe3mhj1MTVEZHd4nXEEjFfq/Za65m7ARbmlBm2DJjF0JEv+lMunkzBbV8b6bhate9mQbn6XRvpvez/Zd71uR7vifKHrAi/pHN9NevX7z4Zvj6xasXPfqDGffqcPhQ+UxG/N/HcrXRdA1orM7Kp9dls0pPeRW1PCXcZ07y72GZrs7px4vPQv4fwTJdFUeEG11F0ls9b5peXP5rq7oO2Ot/veCKfQ+OBmlznZiuA3au8gwN1cdd938Yq7Qz88+aSmo3bXk6a83SgMfqzDpL+Jtn9g9gg65M9GFz+f/ZnqTo7nbVoqQKEZiE9JQe1724H/OA90zotBPn74X+VCPO3wsd2kxCrDbnxizBjOTU64xHNRp3PSCd3sUfOyR0u62iMj4TOn6SNlGjmLDX4+maMifyOSqI7V0ygNn5u6Dtwf3nPrwwtA3kq4niAV0sc+mW22o7dhIEYW/R3sAFiIJXXVSwe61Q7io5Mh8Tn8tbPaRWJ3kvyTKOvmvX4/x2fD+nrZvIlgibZq/FwfoIa+PmbIw6f7eBDqknV9LqbdH6hDSg84sf0Dffw+5kvBalbbEiobN2ZU+44isthsL2/AQqM6Gv0s4CnTFfazWTrikEBrUgc8M1awix+59sp9Jq55gNv3mRHe6/PHoxGrCdirudY/byVfZq9Orb/SP2t264r0+nRxO8uz9aYYahBir5CViOR2E3CE2nkG3gt5nhCm49atUs7Fe2ZDk0IEGpmQTTT4IBunJ9lTR0zSle2gAXaEAstNJwnSmeN4No3vYvOfLoVW0lo1dLByyPSlmCwlvtkou30I0CyTyN0wsU44mc7of0p9o6rYZF3lmXWlvHq23tqt13CB531GpTY6RTRLed5J/o4sw2m3GlSXZ7W9M0XPjOoesuTAUH0ob9dP4uNWT87S5t395bWUAfPjywaCfDGUh/7dPu25ejlxt7Ro2YgbKxRWH1Hke4T1YN/3iyDqctSSvCZ62w+mMjpiLf4LaJR8Hkku71Zr/SjQUpkw2iRgIJ98l7axGng2hvbOAqX6n43neNUNpejaUR9n5m6FcjBT0uPrhbk4M54K9BnQNptOZiFXwntJY0Iib6EGqUZUzq3Kb6VaEX7X3Bjy6pU0UgJqcjmogV+rLZQoDUZLpMJF/3xjfFXp+O30HIaYwtNZMOeB5/JH+225mZLLbrD03SYkIus58U9Hmch4uo9mK/8C91PKY0R4SyhEFjlifx5x/Cv+8xNIA/4bvAni1HJrcfSQeXqGAWaeuTTG9B8ifnStIm3iNChiAQkRxvAEVQf3725vTVALIB9p8j19dG0NGfsXFRBKTK2Irf3wxBIKZLvC8XXMQh5b6LIg4OaFJlj7/YHGQTs6Lmhjttwubn3VPqmVVwS4RvFIio2jl/cfVq/+B5nGBb6NmeZ3BVQujt2580bu+kF20D5yfc0xqQYAaTYkGfgYCYzCkNkJ2hKjGMVh8BDDLwr/wFpfvCD0BLgojXXFAzeuoZbIXDVqAx6ohphWDAP3NwjYEqWC2gzXy4GbZahlrSTYTOly6J/PLVkH+fQsi/Tw3kP0j5Y0Bnrq3riLjw73tE3NjfYr56qQndMEz7EAQI9DqGVKS4weHmbfg2+x1xehK5atsco/7bvwQEPoS2pHGbEdDV617h9hJQXeN7bCG4bYxvNJVclPoHTW1H7EoR0ZybAu4DGMTGGQvfHhRSE+EeUxPyFoWhe53+TzOFNhJ4DQYkrz1gf99dVvQoSt8PK9f8duqLenrdx6PDq8OXHfzyuskay2diA6bFKyqLq7svvnwnTA7LAIVOaKL5VZI2vUuS4qQUPNUl9gTNdatYeotFOi/d6Tq/Ecjg/chrjF1AkEDNGFz2oA3smtFovdZ0jP/gzN2CmWM8r9Ht4av9boGhONwEPguKQ4QYPreDeMHhCDHdJ3ZgeIcn3JyR4nT/Tm0XopD2OoMLSLK0yvZzQ+5OuzZAHSp32bMZb2biOd6JEi46LxswqJ/x2QzusGybvDBPd15V0OPr2j6nNtWxtwLdK57rqnpg2yyAl/lbWLY/VxjHCfX3nO6XsytwJKBBKx6DNA/Gxfo9MkhaQvudkadbAoDA/T532BwRojbsrXDfnf9wEXAB5vZX2Pt2j33Y9KIu05EiRLR2qEzFxI0Wd/bJD28vf7j4YdOlmAmd/QO50RGd6IH+J3eldyezJQKn3B4He4A73SP5D+dST9HaFmsSSpu61QGl4D36BDp/P9c6INmn15N7/R/BvQ5r8+Rif3QXO5D1H9HNnuD1j+FqB4T+/3e3t/MFHW1LlN/9A8EO84Sxkk117sjAa2v+LLsN18dPAmYT8B4sYK8Y4RqjbPAPwwvBCs92O7OSxTbmQ35rHFemtwCNbaQjKM3QIQ7K35cW8o1+acQAZCO5btvwA0QopJrBNXlSwa3rhgl1I41Wi+6tT5R3FTPg4YZrhuY3UHYyFdxlSKlVKtSfoIKs180Tlo3JerWIMkBd8PwTYD+bWdib8Uk6LL0ISTUC+u5R42XME/KC8v33J+yb0csDILttZjNsOHrMzqB3qM6dcOwZ3UU0YEfDaUw3Y2jbPmcy8caTl+FWs59jVvRf2Fx85IXI5YKDSTuDEqaZvAm+cFzTCJP43A8M8l+xRuEVfAWTcBuuMBm78CYlRGHwRR+uIl85XT8ZIc6X9VysOTx3f94ZjYaj0fDVGf75YnjwAu4fXX34cucvXZ7Y1l5/e+8+z7kKW9zv8GR3J7v6RyU/kksq6C3oZ/ilgTQ2GXUzltqJ6N3jyJwhYav1F0HWFJR/AIEhMMFgKQu8dAVM3e7yOQ37dGUT0WXImZgBVz6K6+EupwOYVwxcm3CTc1XFe5hluAkhDMzC9ICKj+dyWJlqzfNr4R5vsgTvH266Um1vaY3IBab6hUn/g8x122sb5/13mq+2WckXslpuMMPPkXc/XDAPnz0LOpsRxZy7ASvEVHI1YKURYmqhIY93kPUbVPg3e3g3VfV4WP/dGob0Ygww5mr3tthFirxMaxXdNzxnP1ywN/qv/EasUusaQizVtlZ5dQ5+tIg2HPHM8Fvqu97D/GX2MhsN9/cPhhRpXsW+fxr//7TWaWdFItldi/vvq5QJWR+PR537MQ7j0X4G54m2A9ZMG+Wa+/YwN7dSrWK/xV6skLyJAnNC40zIAwG+Au4EVLP96mWoXp2kVE4TTLjNMSpsU6N5gWaWMNhJFWWb7KjgP8TXLZQUVpW+hV1MRk0bC8MI3rOQMyKeH7MKXPgDsNSQokp+bOsYia6ptohjAE8sdbO7awQrhI/ZATsF84pyLSrpY3KUdRFCcvDGNCwAa68Xzti7SnALGa2ONRZzIUGV0rUIFxBgWaa/lfzs5GIA8UNIq9RWMBnPSwZGilzv1sJpfvWJ/ZOwCm2MLXFLj89puE+Krv1Rtv8y2+9g2+fqx7EdLunSrBW7AcI/J5VuitBwzIQgk6+igGUn8x9HZ5W8FmziDjJoGNwsJhk7L9nNouW2fhiJjBK4w7XsxLVC/720eqM12CPEdYZ7135o6g07696laF2IXKvCtkoStLefCqFYU/eX7cXBq+7wYAB9wVhijPEF62urKYowQIYtn7Y0OXDld3tKdZ0hiABEh9cEYP4pj3KY8K5Fu5tOcbhh/IbLik/X9JkdV1NhHDuDtB6xcg4ibTBnKPv/N0k2meQ/dL5sgufjcmoH07WpsytIJH1SH3v44KGFcTDjDY7vXBtKvUxlOUh7TgJKMa60Wi4g0YjAMiDxImGVH61AR23JJvBRJosJcIr/R3BT41kCjq7SrxWvOucH+LBzrpRu9yopTOuYais+7D4r0WrhPPpI9Hnlc9H4u0m0i7k2jgKkhlV6JlV/0olI4yjS+qQwuhK2S4tHY9wxXoWlSxyJ4UghOgzaQ8A3KU1IEN79eedaTrniV7xYSAVuYCOg6y4klQHA4AO+k2ZhntC6opP5eXn57hOZn9+HlPZYF/uHy8t3jEPKD7QLYNFcaUwVTBVI4HaaWZfwEvCgqcJMjYCC2AeUYYQPprpYfo4nL9ygln7amehF2jJ2BU2Go66uy9HRN3ejSJcjbIDkP/r+uiQ3vV/4eynyB1FVmt1qUxXrKbOFdbvEnEZ73+o9A2Qx0cTfgrvGzN9/+eKbtShv7dDfHbOmJ6zjvXgdWncOuUrPbEg1JbiM5ZWEW1VwjhZ7P0J+s+8kzsNFcfFtWFFZtDUUeKxR6SRTWg0hhbvgpvBL7onWBq8n/z587zEbnp9OIlQ4Lf99eEKISq3g12y3R+mDF+Llq8NvhuLo2+lw/6B4MeQvXx0OXx4cHu6/3P/m5QOyY8MiLYSb660tVGct/FAJMd8ZCcqaxkT3/ewwG9GlOcGDMmtkARnxeO8/WbrFcQtg5zK6N9DZwhaNBfdGmh3tdKzSAeeMYb80wizBJbnTAhoj40c0vN8kjo7pQLUR0IIK0opy3pDkDi3VsVCH9k4E6ucbeKUhLQl0Hb3g1ZIVwpHrnrEfOoAw82UKJrwqOim1UiGtDrJRNuqxx+/PLgfs3Q8X8OeP8Ie+uFy/5lu+E2n3jaTOx0GcoBTpipbOpoqJ47iA2Oq4U+Mw5eA6I2U+XJ7ThYcHTevPAD8XvT858R8ML9ElSNd5sxO4csMEd/siRZlHoODtijCT0SB3NwVLOz34X+aiqmm1aZVxGLgYwLJYTcbYAloAqVLO8BY9EkX9jS8XfCb2ZnLjbv+EZWZEKYzZWpuS9wS+zfhKN3zvpAjtv+CahNjUCJqAreBua62s+OL6ih92U4UlRfL/X43lPprcrbIE2nxpnYWw/TylhZD+ewtHQuPxpGOyhI8oHgnqGvnof/kcAdmRhhEqKWWPIhWJuNAvqrFrUj1/+2XS3X3jB1qf8fly1C0c2260A/GiIfq7AaMZAZGYiJDau+edh3cbvSBAIgAyRzGLKzRqhTaSBhRmzCxBtdsn1ayMyzr+IbxvxJvulAcGF2J71qBabriI55ZX1YAZ3eBNZhWE7qa8AiXOhP5fFB5Dkf0xbpMIa85VgSE1HhMzcq1UVNTO6XOv7xFMDolGsyoB05LAIxdgWaEsXGAE5oKtuWIwI0h9qZYdPEI2yhpStPHEKAE29wXwSnK7JRaLLAL37kEQzXZWrPXDDtZkxofVI8CMSdgli3BPIzQYNQxJKbEb9QCywegvhhWLX9F9BWn5LekVX6yL39GHm0oNWWydXuenq8TqsHdLrYu3b961EySgjJ2frjnhNjYFt+j0bqcIg9zNET3shZt/Av+AfaVnqZx6rWefkFC7p71aaXQewolV6dkMNv9C5HOupF2QXxQfOsOVBeyj6QLCDnTZWJ8Ngq5drU/WaPeGI7hBVuZgMAiQkXvatOMnDs9unMYubaVncaCpSI4ubD7BJoCufy373aQzkfBV7PHgNAVwYSRmdWNysTJDUCNgEqJI4f9uEhQNuLzGcIoWswnSOfsdhgfA1Yw/gEHryZftbizH4AKj7HHvj+oySe+GTSAsjOrtHJwJy4GpIdl6JXmLILJ7r+Dc6OrN1dwPP+4tt2p31/myYxgfi5NmdAdZoSEhPHJfUorT16H2brjZg1vrykbhRWU2CxtqA8mRXr73G2MgXepHdwhQPRaBhWUgX/8qbYhD6UV8KaRHWMZTUAaNqAYMCXEj4FYN8Ph0++ADRMgDwhz5mRboFUT2Rnx8BgXuDxq30MKvit9AS8giahXupW7QE1Q3Lt1VcU+D9AnIsLkwQXG4wL0af2q7vzB2oRcirKTPlJ/ccqMmAzYRxsD/JP7R6g68WuNVFMZo011W2NFmC+t62S3Ho4HoRIcoM4cenlRiFnv3N7ZBYZNurBRKXnEbstalkhBbhHBlMgLqCGR5cJY31unF+sohbWbhEix/fWM21dpZZ3idfRf+1iGWdwFmsPWySiqxgUCieoe7KARQkvzheB0aeZuDSUZsB7YFTZ68kanDcGXLrMz25cGdU9miUrC7ygaPNbv4fF1npJAeF5uf5byGHiIRCGDh6yEwLJ47/1072PpPAC6KhXgkrdljkXWyv/Ibvpbojcr7lcGPRvMeyWk42Bjkp16l8ip1V6YkQ4Pq7kT4w86DLmOctl52UPeNWOgb1OiZEh8dW/C/ahPsZIirQsIiLFlYYwKK95jYphbGCriLc7pkQfcISE5WHSxB4tPvOPGFsFhJBjYqsaeNpTedNzpX8TBm60o6zC6VjkGWjfIePbifrObGpRGlc4Wsb6A+hVSNCYENMWG/MmmhEFdgtuFdFAVCbG3RdlcQFGrM4kF1phEmO+hNKKPKpAgTL9LlFSgcS2bh4PHX1udknaHgFoXPMxQq1wXMXhumxC1kuQrQ/Bf6Jt28muWV4JCrt4ry6uJ0NjDezQJdlFSRXMqC518hLaRiFcxquOcj53geTwXGfNIaqSkp4D5qRCeDgZIKEZtPT668DFqznS9Ezfa/ZaOj44PD4/0RlkVXmID4ZhkNkgfcIhO2kFfMuxtorQjQ2Gd93R6CjU46w0I4jt1kac/jDqAK96AfQWANdZAFNHVpCXQjOYGJicFWCPb++xPLXr08eAly48X+4ctuEhMZFiXPZQUZDttwsO0mM6RLXVgYMEi3KLVWU/QIIGPjHLxQwKNOJ7OCnQ7TuqOZEVehVrHtWBRBwrcHL/rMcvDiXhpt8aBNKAX67tD7iTcm1so8kMm/WTeXGuK4bWeGx1vqlWUO4wTMf/MSixaktOyI/a4lzv+IKnfWlUWkmPnzwqDcZ+IjtCokmz2IaOKeyCg48v63+30O2X/xah1ZIwIP30af3DEB9ieZYNXI6jgD8O4pvL08ERipzdU28VwdOML1VFp14Z6fXjwfpOYV2Ec95GlnzjQQnk748OMkuxd1sNbwOAnWGiALN8zkLsJHBDCkq5GUvIpFc4zluvYeLJp1+GgtKr0lXysTwvtbV74J5b8bM8QBu0XGGzEBSLK7OCCxzv+Oi59g0Vv3MzK2w8pTXCD1YL5NHt3jxYRNHaIK3d6SMN1cLxaNIlPa+7GgzyupkrxtZIl3aAQ4aW/IVkdNRvqsTpQBekh8JLCr/WVAp71pi0Q2ima07oJtbZcxLhSbyRuhYHW7TgpyKNVGO53rimyR4GkwU+kMN22pLbT5pZ4HlDGhZtbrzAuZGw0Of5nDld6goGLPHFCssdlN+rK9XtaJb0nmvwzg5BJTra8HzN2CLmcImduwTiHSYqVrSGu/hROMahBVoU2akEa4BOW4EHAKFTGTDVXk1lDfKyCz8fydv7PdDjCuZQdprsutNOHCvUSS/KYMLrxUDpAodN7EWFGEbX3Uju2ch1gSnFRnJxc7/TOYy0XnDF6Tu9AzZR+St7Drky0RrPfkY+oMxr6mGvYNVlSspBuel2ziCeyTKSaoREyA2GCkQyw3PDfUXGnAJmGz0k9eVZHtSthm0SfAi8OjDgFIgrjl1dYCYLtjX4mgyxhdAJuunRw7f0cNoT03cctuRVWRkCOQLG6/yOK8K/9oJ2C1ldO6GvKZ0uDiYzFb0+mQS9ru1bLqll++FtwotgCFj7t1dxkCg1RyNnd7kXhDWWDT7D6994/nP/wP+/blH/7Hm9+/evMfe0fzc/Pv737JX/70x19H/9pZisga3XX4hGtlszXYOQ3Aw+kfxLUzvITbXz+o9+HmR0G7FL3Nxx8U+0AgGfvAfhdi+h8UY79jIvm7VFO4HtL/Qzcu+RfYU0bxij76GP6VQma/Y41C5v6gPqg/Q5BkwesaNjOeWCSN/KlGVs5CK+m0CS0ZxUc3SEGuCY60Ig3A7FqGHfiAKjdS3A6oh3v0Glj2YSdMeCcFrQ37sEOz38nuxTeQGm4uE0YuhBOmh38KO0zlfvw7iK8uaxyoQ4+1k/PLtDNgH3biouG/4qLt0GzDsiWEyD6o1g3b+YT8OHDe4agRI4YDciMFtYmWFppRK5diinf9ghCZrmo5wdKCvsKwhBb1Csr3iINk0JoP7ja1ugPWo9nOJA7eGZE2xZqxQu+qFGiAFhx7CRKXbb1tUl2bJArD0/OLd5AumoL807u38Wgm3drYbGdVutDidcRIqc0tN4UormT9CUki63WyArtfnb8L5Z4+XJk465OfyJ1aG/2xnzi4/+1Btp/tZ93og4Qyna3eqoet496Fw+ItDsWeBUEO9+gDDpk2sz2vp4HKYPfC8TL0yPUfZB/nblHFFAzGLuhYQfUFCvFhE4avLC0+r+RM0YEGjAqNfr+v9C0eeBb/RqVDES4WMHgVPmSgr5tTj+CHXUIrJcxvcjKSiZIhpDT3gRegI0oVmwAA55PkyW4qruhlAsq6ewtTx5QwC+CzP70ev/Uc9stQquEv/oHjPmNCWkatyTI2hnKBhEqETwizw7CZ9P5i/DvF4xH3BKeV1IbGJiARD2iTRXkgcDCidGn9+kejg2z/FyZUzmsLshlUOZhfK+Z98lcE6s3dn4S4HrA/Q2PCOTfX2fNNg+9I/Ixmt8Fyfs6OQZr3s5M6mWqrzLY/+owZbNHj8QOZ756B7spDunM6D8wW2+JE3raGqG/M4S+QAR4jSyekQsto0vem83vIzGd/lqXsoL226dV9Bs864yZ0uvoc84a+XWPgtL+sMXHCjxFkMHbWGzkHL7uzJrn5iWl/zmLtvv4meHLiMJQJJD5mDA6dAavw/Pgrz68HbSZIfP0f0EqOVbCBghHrbZDwgvZqWOxEQ/AeEmytwMP1SrCN/48fJ+3lGHtPthSu+BLyKpuiHjCX1wMm65vDocwX9YAJl2fP//Eo7/IVwvcqFB6H5pTf/MPFOXujC1Ex13EiwWQCW78GKmZAu5eegolHqrYiH7BaLpCg/3jkBKQ79PxnPkf/fzhBw1wClNQj/kP67B6X+DhJmu66xOm+ah67LQ5A7DXgsgcf5RpHciHQxAqZuL5IZRDg40eUnftJiMOuGk8uADjnfD/S9kRMjMI0Uy1csOTRhGoGHIHRVNHyjJ2PehU0cM9jozYnALO6dDBcFm61X73wKURo7IDdiimcVx/RZJfKmQZzc6imR6u92uB84WHsXksoJD4OAuwVZAKbopSMiBkNlbaWrQMNVB2/e0OkoY5EQNiEP5MYBlzGc3cIQ5edogVIJVDLIOSQ6n6eNvKFDbnanjcs4xvQG2dBUH06lpF5xt74VBw4xzG/RRXs7PI1+DxqDdeb0C1/UsECYNvk1r8UwQSNDmwbCHjlGnMtQTML9LBUVfyAuItIa1M+z4QMe5o66rK5BhMsrXPBsEhSzIG6EtAXkWjPGpB+fuGxIXwKArL8IDkUQnw0DhlvGWMXvmSHm0XH3RbhhkgHv794J0TCsIQHrPLVEh6WNBZMuxASIveLxVWaZ5Eg2VMpz4NLeXo0lMXWCfj3re3pzXiLakI750cv9ulN6J9ZXUun8E+utfUm1b8b5NHmE8yOcD1ICEkESXrf7O6SwXPRCTdyIziA7p4VdAHvOUUwBuyM3PrtGXT65qcB+8P7AXstZvAGGJGrBH0HyVL5lQcj3KaEfbph7emGtacb1p5uWHu6Ye3phrWnG9aeblh7umFtoxvWVi9Y6+q5AQEy0bvjf64nQ6ov5MqQqqOf/vP5MqRaNUufnBkPdmZI9V/Om9Gfcl96/HO5M6T65/dndObw/41DQ6ov7tGQKteLNMPo8zwaIZeanBk0kSikg7TqeTPQixGBfsKbcfrmp40p+XnZhm02Ydvir7u4W752s3PjZh+Dpxs4v8ANnI+213ZP2q4f965lKBTAFzHIRxUwaQlQ/LJT8BOaGiYJvRGwLNtUwaBTtBFGGGuB6RaxVR7YsnD724wr+euqSXheMqXTRiaAsxLQ7SC984nwqkTpmFjUbo0ht38F4dvlxe+f7gh8uiPw6Y7ApzsCn+4IfLoj8OmOwG3cEVgbXTS52xKqkOJEI9yh5KygaA9Gow5+VhjJq+2W4ARnGSRowU5RScFKwKO/+3NtxFeMMcbY/2Pv657bxpF93/evQPlFySmZlj9jp+o+KLZnx3WcxBM5s7szuWVDJCThhCK4BGlHe+v+76d++CIo0jbliEmc8Tm1k0S2Gt2NRqO70R+PX1p1PvD7blvOKDaptDKVfac8MBToeafqUhVKq8ODtDP7vGJLe0pIi5TJoKkzny2+ylxvTEKurSGo2vRFUv2Rqj+UUab+glnlqpmfzj7C38oEt4beRBZmhaWV8u51MvV3BbidwI0Wc5rkSyHvxvO7FtScqJklAj/LNAtnDA3ZclHNNF3+/MHpTiYN0Cthyph2wG2llmm5xbLKqlbHzWmCJD+kYIboFWbv+DZpeDTlV438W9txHV6cOZU3ZjgYrjUtzbIFseYJ9cuT3YbfbI9ZTrfbavMwLmTeOFitkyF8drlV0Suyruzljx/OLXJgvGW1EZGvwblbKbksDdTHMhf/lWkZKV43iu8sfJg++lyWcgvnOaefId5pjKjysiB/LsZsUxuBbQnyHfeOaHrvLUHoZMJCL06okorJC4QiVNxhM2cJipl9HWSAEiJZXqQN1rnphNqWZqv5vtUJtusZ1aS7IZYq1CMkZzLfTEW0GUbhrVyZng79ETvguT0tpvq7LREd4v7ekyV74hU408kJxwapbqgNSMScRsh8D2NRRBMk7mWLusSVX7mfvjuPmbvIlz6/p2wE6suHY+5awpIMekJZhsqj8d9jy/ZGSMiniY2UIfSnslYqVuVSpoezLC+1O6jXQ/gQXhuhWUYTbRRMeIzGCAoHNRPSBgZVb0mEY5Ufag55GXD06Vmlr3pnT1B3D+r1Ua06Aj9LeMcj0IXo7MpCVsTW+Zuj8ra5W3Qhue9t93zXebBZTJejGe3NzCcZCX4OA7cOAz/hGPDPoiHWHAB+wtHf59Dvc+i3TejXnIeORKUm4W3VVRn3tYiiQwWdMv+Wv/A+uvdyl+zhu131pEbKsu57rkt37aoWv7O87Pyu9Ojy+H1lzVL7tTIXFDT0LbNhkGJatAdVZR070AYRjZ6poi1hIbMVIMoU57YWCM3CGUelbZGxjnbc7Ellqdrufjk8uDrYq6A2LngcdRyF6w3NmWncNZxhhUW5TRPTS8mIhYFJSqlwn3jjo1xLqVDM5zwno1+HgKTHWpthC5EDUTu9uweTvckrdngURQfb48HR4eF4e4exwWAwPjo8Ojg4PHj1ansQRm0PeDhj4WdZdHWHHRvwNWZZCpV/gq6+dshBTRoODse7O0cRPTo82mW7e4Ojo/BVdEij/XB8FB7tVR9XvMU7ouik/Iclym7WMubvU5bYNLE0E9OMztWrR0yTaYFTkAsjUlKlu26hryU66G+xyYSHvKxXJ07lVF03w84rGYrO7vOzJFJbk0zJTNz6BKt54W5HTf1eIVm2Cd0T98k0FmMa1/iiP24ihEUtiMCs7iZEL6H4VAuxRvyqnIt5yBLJWiz3GJ71zjV4M66tjIT6mNnD7ukJmE4UtkqWmztB8RTfNAhXXHtkv40uTv5J7HLneClT7YYdyBTtH8cxKzvwyTT6orrvGZBy62VdzwxTGs6YA7wTDDr0CBqvCG+JUnJEBYsOJ9NdoJd32bjZ7huvCZSH3VYhMeUtpPHWMYtjmm1NxdZ2sL0THC3P3lYd2jsLw/+Kh9HUPHi5xfyHD2fBqL6dXJYmiZvzS/yW9EuUWlGaCugyCFPb+waGTQuqVxpgYSWmMq66hvPBzs7u9jdzgmzAuW4LqFRG4wcYk64iYhhwpVbu25mO+YxWf0U/VZUPCyCnbCPzmmTpvE+i9PO0T8YZmuom+GCKkbBJoT7+H5rVz3yWzttuY7eWmN3Q6ioOT32kfOO/avefkl/VFOzHWP7/0P4euRBZDtEnp19YWOi/vrg4fYmGL2rczw9lVh9ffKwsQ3KaTVnugr8T3nCIvxzstd3uavB93djbkn+7TCXPAaj37XyLiCBRWMxTHjM1R7NG1FuOhsdikpNjkaUiK58mWpDpYdU1qd6nj6T0gvp11Q9QBtgdu0+ONLPMI8k6CHaDo4PBINh+tbe935Y+Pk/R474j0rwO+qCIz1HFB0uAUGgbUBiQYWKxIJubcMD1rxEPL4KfmGxxm3Yw4cmUZWmGXuJjnqi23Kq/FKETvEll6Aqfct3pFmD1OF/U3m76g2GJ6Qdq3Vaph8mJMCwwAKFvnuN1i0HMQZ4iFx69eDPq3F7gaiJmD3bkRyNnZEGxBVNt+ceYb5vP0JFrE8UU0EdbO4Ptva3B9lae0RAv/5tzGsPu2NTM2cSCCPCgs3P9QhqEB4eD3XCPHe3sbOMvUUj3jw52KY12D6Jo0lY67AS+K+xUQxHy+s/A12iw0cXw7N1lcPrP07b0mYTErokyy3wNcRtOP3/6Mjy1t636exkM1I9yG/dT79Ee2tpiawB4H919/ffaRv7sEu5EVL9Ik/JJWY00RSTX9oWrwFNBWweO8GjLE0Uzq6AyHVK9PF7b5VMeXRMxyVmCgRoLaWPMeilEf1mM3nlud0FVyrWagSBqv9tEomEaWHTLOHE7e2YqOxK13jDL6MK0cVdMotlUNdOVfRCd5S7ODoLoWIq4yJmdIG5Aqo4ahDnDzVNlb+kC3Rv0e7/mDPoAMzWRKpE8R0mWt2d1ndT7c0P5eWOebEk5Q8HVZoz/IvCBP7cHAf5/+2C57Ap8u1JdH1pw784e0OcsmebuKrKyAdgqoWHRPNSzvHRs5ZRt92qmYoBi8HZcoNMzoQmNF5JLdOGbiVsHck6TRbkn5Bb+sTv86JCNPfKODHmrbg33BbTQQM9Na4So6Z22QyMG1BQy5SEXhXRzrOpbsHe/Zig5jkvySvJpQmF7B+wLl7msMr+WAzsWAuNSm3j/Rv/IH1GMZpXEreD3y19GupdnBes9EnP8jSfTDmcEXc4qoSVrnWDhiqAtSRc37TG9wT7q1/3Gm3OaFBOq/JIIqTS0jD7ohOmgoW+xKsqM2Y2ZDDJMETr/r/cj1TShLhKhmAdYkwVf0jBQad2PZXVO80J+tyeGkGXYAnRPw2HKiztYbo8xZrvj3IbZIs0RYk5nPNTD5mWpKH2oNzTmkd9+CD5ihk7nZj3YezeMFIl7lrTDbO1Xy6+IyTJ8BxYhziJR7wssqu/Y6YcP7z9cfXx3+eHj6PL05OrD+/eXj92yQnUN6aq7zEiDr5g9wECde5YtE/ZVHugSZTmj844PPZZY58lX8NSbDo427invvBsLMigPugO64oE//e3Xf/5x+PZw+PtjWQuRZ21Ye8+N0BshWVCavvflGWo4FyScUb7Ub4pH2uAtv37X9+zFCc9BdZCGR4cONvmMW2VFqjkGUJTVrsvIWRYitkOUcL+yeEHUEVXLGgXQW+vdpZTGV7K5+eYFyqocn8bVO1i/J8LXmuLBvHxFwzeUk71QD/XqnnY/bFR7tLIXD+i0Vfk0n9MkusLc9hb8+T7ZVdV9+AUD7A3eSJsxpfvKMmeRry6Wk+esqe7WMib7kqmuhZrGcWkzejukCr5qxuRXGPO+JU82Y2i0jDgDvu1GsngSfIMg71sazsDySqDXKIzT81/uCPIeHmy2j/OCEryeXInMxs/XT8ebBWwb9u9CPUqJyd3In/M8jxk5TSKbd9qShjAtrjp83UG43S9xvJMAxETjlRBH+Ayd8rxH7se8hZ9+QcQNOkw/iNtHYDe8EOj2ZPmaYZ/5VT0ds8wmhOfqlR9pCjnMPhPdiQKV7EQT1xBxQj/rxiUmwqd784tMBqvQz74gLNjG/J7ENM9ZwqIm8s9NxagGxyLC9KQSxWn0ZIlMDekquM3Uo1JAx12XA/5eDaBDuoaeP/VGX2Bl46wXwzdnLx9Dimp11xER+glODwa465ysgiuktCNUT1D2oa4fH1Gz7iNQZWjm6be9bRnoefil2zC1XGAdnNXTIzqW6d7G4Mv2hnqQtNF4h3Rpca6EtryiY94Rug+fN8v5cyQPk/ejx3C+wyvKSMp9t9QqmH5zhWfWXQVV8yzXAsdWdwefr/HuMEOz2lxsCZN5M2a9YaJjvPa1wqSE6wOszhZD0zM7oau27wYoAkkMUmU8QeMwwlfEw11is9dVZ1KA1lNHbTuG8YLIYry5NGJUZbolDFb9tU9x8F9l94SVWBWEM77Tgl8NCrTSW6PCwuMZ35T/LkBOmokxHfMYRYQRh84dFz7bDB6P2uYAGlqki3WjP5rRJBEJMeBJSOPQDEEtTbuvQnwS085ed6CARkY2lYWmFnscnvVYX3do+oVeq2FpW6xficlEsvxbIaxX+0qUH5+pBw9StkLULlapd1gN3w7v0Bq6WOtxWN7wLC9ofGUaXbZAeCW7sIapWc821vw6pLvI2bwT40dIwhTX87e6WtVi3/tqVUg85mo1X7Rb3IJnjz04hnNmSXspyeBR+HZ8yJdwXf2Yz2jGEMgdZyp/qyNUrWWqlyNuOYQtTUcSLj2CVqIhZ/GkwxQ6C57IxXwsTKYZLNbyCLVFVvV96PBV7O4eCk1PYWypVT02KDcPZGoY6ISX7WIQxDAVQXq0svRbmDmoYkJu0fDKzShVG6yKEaAtXHqFLtZW/S4+fjw76ZPRQs5FYhOgyN8/np3IspYV3a9cIqNauVCkxgv3hAePwht9KSblYh7VxyKReVaE6vGKmrwidPOvcQ5NOZARBqxSRCQx7TUXZM5zPi1jl4RcnJ2QjKE2jkpyy/AAICtvi2a2LPp1G4SIUA26OUonKd5c5XJ7DmKHNIB7QuYNT1vhTri3vx8dTY6Odl/tR62F0L1YrE8Kv1td/HApjcqXdY/SYJl3/uvJEnd43jB/5iGroXoMcbTYF45TELkYhsGqHG+kBCxnSF7yxrwundDKu+hYPUbhTlIwyo6D5WL2vJvuTqoGy6zs4Ko3r4bCqe3dV397gP2WTTiKwTzab8Glxyiytyf76rRXC9/UJ3JGtztadfTrcPueZXf2D7pbeGf/4J6l97d3ult6f3vnzqVlxFja1dKjk9PTC2/pFnK3Xt/5u6mtnr3msJZ34vFKjMxqNIzXpcYZEqNsU3oU18953FQAtazHUppBmTwnvK6W8NpCBD3OPqfEfsuUWMP4p5sZ20zAc4Jsdwmyd3D8OU/2h8+TvWPnfp502WYCn7Nm15c1eweHn5NnO0qebeb3cw7tHTm0jl3PqbQ/RSqt2c+fJ6PWI+ipJ9Z6pDzJ/Fof/79wmq3Hhh8129ZD8SdJuq1T9MPn3tZR/tFTcOsYP4VM3DrWTykhtwH7J5qXW6ekwxtuHem5dYS/uYY06z4C4x81WddD8Tlnt1XObgPHnlrqbhMJTymDtwn/HziRtwndejCyO2xXyudtQvZppPXei/mPm93bhHaHN/HXJfk2IftUcn3vw/3HTfmtYP2c+dsi87eBY27DW7DusYfKMNAsae80GXwN2h3rga/JA/bRfeLpwB4pTyYr2OL8nBz8nBz8vZODrSy6p5j1CWOXT1JrSwNehUXPicItEoUNt75pvvCKaH27jOLVEfuGOcerI/cNs5JXRe5Hy1s2yK03VNDpI39nGcrtuZWy4CcYQFAS8xcZRVAS7OHXNdHep99iKEFJ488+nqCk9HlQwfOggrsHFZRy8tOPLHCUmuzWrskzy/wYwwvqfJjyaDUf6OF461npaRt6VV9/r9jIJN+af5Exg48FzzpYFX0ePZBJvhLm1mzi9Rlrezt7O6sil66ftxcKtOVjj6TNqG6viKryFVvgelc+GnxYnNDKtpqU0hp+vZ3B9sHmYH9zZ/dycPh6sP96dy843N/9o7ci1kqXRsH6uXypAJOzk3WIgcGyQ1Vq0PWfFx3CevXNwapIY6LJ+tD9Js6OmsLi3cqXRhbV530dW4SQauUjoY+pdNIKZAJy7KU8RnyiJhjmFmMlOpa1hJJxJm6RbSxZrlQwzw0SNoh1y8Z6HKKahZTksZ6o7aWft92PIgXmLTbEk/MKl0YsFElU1bszZH4ylpAircnN9u7OqlbmrchgwVxFPGNhLrLF05AfiIlBnTjU7c1lWFVjz9ZMzNkWxSTT1lz6ORziv44n/FO7wH8B3/fZ6X12eu91ev8C3u5f3s39Ef1bh9y3917d0t/bN7WI/Eiep8Xpe/qVSzj8CF6jQ+mH9gnvUQY/j8No+fP93EGLwdNx9toLxho8QYtnxqZo7b3wx8N+8D+7ez7sL4pwNFlROVS5sDehAwCZyKiX1dhmeiqK6AI/h3oNO1XBu/feGFNErUJuM45BKbrJyZhKdrBHWBIK1JN7h+4XkTkCszqBfSKLcIZzN2L575gQffpF9cL/wKa/YZSo+axfbaqgJszKVMu4KNPcVMmcLmS9jtMrfHYduBYZIjUWL7LgjN1Swhyz3JreNyyzdSA08RN3yv4DCBV9OP371Zuzd8MP/9KUs8ia0TWj9o/f3hTD48Hw99/eXA6Hw6H6N/4yHP6fvz0gxpUt1vbB0ibXDItHbfCxbrugKojV9uKg6PVstZP5HiEXjhEU5VAm1bnpm8Da7pEVgECJheTJ1N04xP6+ExK1JHkBJo/+6BP8efrPi+G7k6vRHy+1PPgpVQ4Hnpeel0iYgWuWNFXsEtacWVAJMKC//Xh+eabWUrAtuDgm4xLLG5pxtEEgsRqYq8EmxZxlPFSplqVEA+bJP95/ONECffr3q9/wrwrqDm5FuFzjoIiFfE5jkjGT7a3UgsoII9cb2xvXDQlgvT83jl9/ynL6CdnCeZ5+GvPk03xB0xS5gyt0UwM5DbnRa5G2UU6TiGaRkwkFS1+oRovYPiBymUIwdvRHWypm/KYLAobjccZuuNovLORCcFivdo38+t/nb9si/JktOsD3V37DNtWtg54bqreHmGDr6nfe6P0vl/8Yfjj9VHpsVoW/u/x0rG0XU7b56WyOCPgvPGbkVCVzQkDfq12Wn255AsmA3LWlHph1QL4aMQjYftsTbFUf4NQJVbp7mRfYuE9fzRADlTQx5tMJGxfTKcvacsjHc50seuf59moNe8fXBKQdxhZfY+pUbaXyo3tH6bu2ipLluMLnzLSkmtAQFzQ6/6T8Rqgbh2aiSCKkqnOmOpVY/KDH7N2lOtSoX1CXgN8GzgTpJIxkNRs1WZA0pvhNnuCGOT0emfxecumjYEDr8BcwMbpgjmabIvNuJ3TgimO9hOKxtV+4GZuvjJrSvzRN+RJybbgYXDtKhlCQYcZyl80PDp1d2IotJm38z0YfMUtHdazuEzGWLLthWd+WBhigEZO5SWXukzDmLMn7xP4qTknCchjRwURktzSLWHTF04CcTchCFGg/yEx3oLMLq7dzUWLP0+u++k2glMNc0ExT2pOSKUcM9OyC5Bm/4TSOF31kRs9Rqp3BMHFiznO1GEpU+jD3XJNNb6nX20c7wSDYCbb3bV1TG1O6w5jyMI6x2fDFZkxqMRAJGJJZwTKWFaRI2QkKQ9gMXluXAqYTZijmwuefgQrWzlicIlQteV6ozVTzFzOGpXoZugtKvB6hHbyDahEjNJ6KjOezOeTpBTYd0Wc2gSRrgYLKBLNKBNoPCoT8yQ75C/hYSZZxc3zkVYg0M/5Uq3wDllR+X18ZjPzy28k72SeRmKP/m1qlT3AcpCnEMR9BmGNO5QodAnjagic8vYtqo7fPLhqJq6xUSJa1WOtr5BtLkKVNUJ81bYKrdHiAVxb/rIhZ5TKx/77nJvlQxKYMQ9dH2ocY27IOONhaH3Uv0GThdCQRll90ihAoEEDQiObEFkMRGrMs96hNhKpq0fwvPScjZGoJrzDLQLtVfqb1A9SGZh7iRgpfWx1skYrmXML0wH2QZyLGpZXjWpN9+6tATJ2Cs5PR1tnFqPzBhGfslsYxLgs2tiC9fijeLxRZbHq5yT5hSaTcbRKx3JTfQlXoK0wy8uL05MNLIlVA3xWEsTxcQRPTIp+JrmQV5k6fiGxKE/4fc+GJjKSSFZFIFnN7pDQSYKD+GzSp0GNoHRak3CsrWU4ylBavyLezo3p/boxymm2eiyxawS/DPN3pWmNyFcYM7QKGLTqoYUF5ZaDMDE0x95FlgYFJ1J1eCoeY3MeKYZ6zeQpn6syzyM4Z/dyWKx4NHTEGAUTvAysgoNlut+VDM5FvYhF+JhmCEDJXll9ajGMekpN3I11Z+Ovl5cWIbJHL8xFCkrkIRSzbcoBHHRE+1DSenWg1hRbIqohTBf/0UEciQ5HC5oadrNSkZ2MamKRUj42Cs5LAbA9aZ0EieJtI1hFzfLfJrGQsbW0r3a8ZDERiav3g4tCIEXpDedxYFjlMaThjZCdonfPX6SMTqzwJKzpF5rV8bXcuzt8f//fVybvRFQ7B1eX5qC1tGVM5fGFXBPY+2AXIxw/nOLH0oRbi/l4bkKS655YL7qdQLAAPy13fqSZgqvu093qSRCIsynrv6mrK/cLJ7PVKeUpEXkpRn/Bqv0dKYp58VvToHA+NYKzzGTULxtYHcTBtXzFl7AS95W20SSIsCW75Z56yiNNAZNMt/GvrUdsLS4vl3+Dkgo+S5X2SipiHi762TGAfmPxHeyku4Fapk73S3Q9XipI5wwTZmvzbYOjVhVH5V79oK6stn4riB9H9ePsEz2zKhIFoLGdZ3gmyv3QZ6IFzD18HDmKzKtneHgz0/9ryrtscORxtmx63RRAx9jPlFJljBqqV7OACtANB6qQFD9BkKdK3ru8ijcpP7nGShub3IKu2gQyV5uUGVj3CR4iEOechFElitmfiDHW1MWggP6UZXgOJZMo9kX3v9/X+j7l+iNX6dBKLW/X+lkWlx4T3lcvjC+NI9U0jM4sm/pWxkPGbMl2HJzznNCajf70jKQ0/s/yFtP0bDVAALHHRjzhaFp3RtbySUZDxosYPAxMfW77kGU0kNcBVxNH4QZi1UiCuhRvCtOjP5mTDwduA/lC3mgfWYpEsIS7xtul+bLxEo7yhxXPKY1leTQaiRgWYYHOoXFrCp8OERkaVBbT/rKgwEMuXK55gj/+nSBTtyj40UUTz7SZgJWsTkddA4kzobdxUh3PZpT7W4LcsCdW3MkxrShARJZLNaZLzEAgiqQCMpglhX3RepImVGqBcqhAaxnzkgtxwWdCY/4eVL80glGU5rcTYbBw0c2tM4FlbmDCUaXmR6ECoecKUOY9jwhKpoxHo9KQiA+plwgvKqujFhMex0000TTORZniJiherONet+4g9Ru/1lNSrrbIb48LSlQ5VdD7m00IUMl5oaVbfMSCJfn+VrrA95hIjk8jZRZ9QG4eD0sSt9IVIATkJCPlXyVnkni6kDsQ7sNjHjN5anKzcXwfmg2vNMidkKnsogRVloKKApLBjHyBK1wFPr6HTrgON1nWfRCxliVKBwtgMRLhJAqo7N7e5Am5XZFDp8viY7B/TTEjDQTBeOCxNQEMkYi4KaaIzmu/lxwam0xQG0Ivh6N3LWvse3Nuqk5nVGSaip1NHWcMNvb99cLRMsx+GWXPTxu+Wb/Teo6k5D+/vQkxjRs7Pjyv8aEjjqT3wNSQm+l+rIPIGP8BcttzvBaq0sBEJraLrW3W4V0FMC/YDmD1GW5g7QcOvZtFOmQhCNN+qS8Valj5GAKtxd94imspoXEdHJDlPWNLUY2gtOF3eis1YpyNhhfJKs1kRavWeSVJcxvvd8G8PCGozMR0x2Pey3GI1Zr8TWT4jQ5VHQxuQLNAR/YpL0RXPj/US5Gz0XtVZ1DA8Ht6JVleiaVBq3OVjmtCoziml62vuTA2dKRNXfgP7yrrnIpnyHO9dMD7wGJkXDQzp/T+yEYtk4zXZfLUbHGzvHe4O+mQjpvnGa7K3H+wP9o+2D8n/r15wQHK9Cr6Ce++jZNmmNS68H0EEKbHs6aMcAxKpGISfTTOaFDHN/NFs+YwtSAhrRdnQnjVwbI2AvBoB45nKNiAhw/VnnIhJLERmmjeXncOsnW5VNjHoxWWDW1yw+aJPQqujSquXkHciB594aN0JZX3jFp+r237KhKU26C3v3VjIXCSbUVjbm1TInMZdnbLehQKvThihUoqQVzPeHMoloSoFVPp2rsmvcMkhGMRlI1ufE3GbIP2OEpCiFhIZ+ePsgng0EWVXK+PyhmZI84tg06jr0ZxqGHXmr3X+He0N9loHYCHySHMTSZcKDOnDIrlPf23+dnwXXh1pMINTowL7rWBjVpc/2Pn/EUkX2LhiEsC3V5IVuDJv82z4buj9XiPy5qLaGmZ46uAJ3XpTsETIqyHPmGwrGDx9gMrm9/4yxccSYay5F2cXN3vwQc4ubg5eBpW15jR8YLHHsLT3dnjcjIynqcB3vIfbONGcGkP0wy/H5NVgbwfxFYkcOEz/e01O4U6IMGc5eWGCjn1yuDnmpWEOW/clvuZMI/MoeSvIn0Wasiykkv1fMmNfqE2ijfgUJfxTfmOjjH4mHbHo64WhQBKkyWC6JDRrzqYsC8ioCFEngBxK9Ys6giFZSjM7z88OsyFktkhnrEH7Dgabg8Hm/qn67+7mzm5lpxKaBzxtcT82S0fvMqOJNOEYxGAr4QNk6kfk3fDSReVMf0lu/DUDUqVtpRm/waPFyds/XnrbWb10lOqOBY3ImMY0CdW15yUNiIxkosBtGPRqdKJYtgWlK5Vh+QwA/B+YBTquJascuM/XqxB6ob/9KM+uWo5W34Y2DufdW3Bh2O6rA3893DpSTQ6/avIpG2XgUeoJqmfGpzMmc29RyyO9NpI0M56mLHIoF2PrihqoOg5t2Nc3IWAHzsShYJVsTIQIzO8FoZhvQElt+B9UFCMmYkIJ2RRNpEtkcxUYSzMWcgmrRIkXXuxkTmL+2RRD6swBWUwm/IuDqH7nBR7iXm9t6eQC/Rt4h3sZkEudJInQJ8ypL3zunqnQKh5tpRckp5/LfVVWMIkpRhjcChLTMYulDsvhiVE9A6jeyaD+8vxEunt0IxRB8Xkj6C0Ln8eNilQ4tncpDW4RJfTOMZgUsKL/jTDvhJdbCnG1eVf2mBKE1Kyo4Bckgsgs1Q6FyrLCpyYJoCoqRtwDQs7wjpLSLOdeIJ3UMFDKwwxUBSjzc5Ob5bwX/AgkKE5CmMpIOqnKVd/jgBlNIOsEjRneYhrFvPlMkPwu3m7c3t4GjMo8mC8MBC0Y+mRQmW9Y9UQQPwcgA2VGy/baWhpU4pxbprTZNmQx3glkMd6uHL6yD3kVvUpDZsMFD8ZGX79cJAKNEniMI5OyjIuys49Z5TUBZW3tvVykV4qMb6D12GSCR6IbRnKRGjfXUP+CXZ6fvOzrTkjOXyr5bmASo1z69qFNKQGIrJUVAw/EBXUFubxuU9UsdgngN562ZlRa8S6lWO5EO/WoPq/IDVJnzatCVyLjR+nK4liXy+tlLxAxaVYBqA8g5yfDC6isoab4xIHyZaVqBGGBgM0pjzsiDiEhohawrkrVGlEIQHs2BPKe5MsDCO7J8kJQQSeXDFQzBofxmGU5OeWJzBlP6rxROQPfTQDV6t1LoFqmXVeIxxB494QPkytjUmnUi9yWzeBuEFT1612GUP2d0IvVkeiwRsbOQgGxqlAGnqiqjYJLV0m6AwepUVDIPkfaNv+Pw0F7Kp6ofJQML/p8Qq7xpYBH+sVW/QMcvbYmEf6c6BfO5US/JGqwrxB+bRIqHj3gVq1HlMxuKTrqSNRl5bFofDeNNprBo7R97GMx5UmdaE+lUaXS6qzIRDnzZt2CO7QVftgGolayrwwqmmjwbc5b6/258ZmPaUKvaDTnyUYfOUHKQ0mmVwD4YH2ApRO+LQ8rFTIj76N78r9sLhEPWS2ZyHry6mdI4850CK/McfbGON9SSUIRxwwzzN35u5wx6QAju0blv0w4CgaTyDvisZhKUxDo5u/YtRG2N/l0K+S6sHTG5iyjcYcjnE7tGrWDyaVD/wWfIAWEsC9c5vKlp5t0k4pItTiBR2jatUk7ZihjqpeS1DOcrg1ApcIiwSRJRB70lqXqkO5N9geDSYUZneikhglWRt6zIklgWVuMrY9n/o2LHX3RMi7dLqhY7BwlWImImHlFq5BcZuG43j1KYOAB4ysNjDVfqY2f8pExvULm9DNqfvNy8r5/BTnISk4hkHOWZ6gbAwoiKSvJLNhqESsODLwojgmtqBTzXqXYHF1VIl9RuJ+9E7lJDeO62jZhOvVIMlZ+QepzWUFDxSTExKe09Iy9JDRd26Vr8mhOrvE9ZWnoa1L9EwKnDEXa4AxHu6/YPhtP2ICyg3Dv6NVONGZHk8H2qz26fbD7ajw+3Nl7NTmoyOP6rqe7LUpDtcnd87ST4lZFWqoVDfaLXJYnE+pYlyobeUGK1a3efjc32BNmAwNONEX1oKr5dXENcFVWbRwsbMqYFa8RVZc6bu2AmjSrpREwZ/pTPH6AglO47Dw0NcKVU2TNHT8Cgl8I4wLhHgexdO7fMJrL6lHED69xgscLey2p2Wup66vkfhWa9dpBNXXtExwMAKlMrqvLFfPp2DTHrSpEePKsS9L61LuVJupEAge3IjlVSchvhcKqfBcDBPtlqxXNNioNBknwi0b8nmN49o4gbqYQu+9tgiXdqcUyLWBs5+05oOY6cZjZonsLrZ0sLalkx/0miVpCAL+rNs2vIKgKqpHBAEFSiLItXq+cZMFk0uuV9qXqfGqSjFQ0VhHnVusvRWdFZpE0pco20FT4pywX6kTzZFpwOXO7Vh5KdaRxX5AirVz15p4TEqh6ic3Edp4yfEnQbE4/wTmVUIIXkwrRValxEJ30vCSb+IHHY0PUnCYqYRv1GfXjZdfbHJj/265qaOk1uVinijadU9DQMV/WuFWns6MuRCpSaquaVr4n1Bc9qYESV+Z1kz1bsRPcDe0Z5pYSbxFTLf4aoqSMDZE5GHh2rmK3fELvUL231nK6rmjV67pYVH5e2Q5jgXexI6aNzvKGuMT7W3rvrpQ6OBckFuIzXDBqhqCiNimJF8u+haGmot3r3NgNdoI9389S+fkVN6v85B4vS/+W9YNsA4JasQZedVFfopBy9RimWGFLPxwHTZ4VBMOrnoCoeQDgfPZNPYVfgoXPrUIsH/8sVhUk/OIWy/oqUV6FyAO1If67vCkQMRBxMu8ogfBWCUUieaRep8AzmEgxT5jftk/n/xuoY1s8oSKiSZVuedeClg1VZhqQlVof49tosOp9xcG2npF5ujPybYpjsKJXtkV0+EDdWUnz71leOyp1+ptj9wresQmKV09zN5Ughr/PlSDPlSDPlSA/SCWIPpNGJDy19x3LQTRKNt/guRzkuRzkuRzkuRzkuRzkuRzkuRzkuRzkuRzkoXIQbT/9IOUgoshCFvztb4T8L3vfwty2kaz7V6a4VYdSLgmRevlRlTqHluiYtXo4opzsTbRFDYEhiWMQYABQFvPrb309DwwIyqYJQHHuurYqK0tkz3RPT09PT/fX38tBvoVyEKUdXyiDQIs+ik0oolQNoSskNpZCWLgkSJulqFo4/eZLQ54Uh1NSHt9gacj2V71nrA+hSeWtUyFhPj/4RkWotD7EvoB+rw/5Xh/yvT7ke33I9/qQ7/Uh3+tDvteHfK8P+V4f8r0+5Ht9yPf6kO/1IX/D+hBqPZzaeUu32W+ezltqqMalCIMHPEmQOa8SzqHyqv8Jd4Heqx0lNRZL+SPyDFZ3aoZ3xsmBVl4Obm/6rHd7+19n/6R24JOYzwV8JOcuLKQ2YU+D39xMMsJqHhRPzG4tfqyu9DrGNTgfttjVT29/bVFLkn2di4rk5fk8Cs2UnYw0vGbJkJMCPNd1fqAZmZ5kdjMZgE4o79YAh6sFljQyunJGdw1/vuBuetfYd3JDCXdG+9n5wRZDYVBKKsmIfkRZDW6ueCZBUoafWJ076L0JjTkobwrTaUGcWL35IkCmK3iYRjyQ8sro3jWsvi8hjB8uXDLREFNvbF+TE8a+O5tbDR4/t+FCkWTtVvLbSqYCRRP7AckPPd/lKbq1ROP/FW6aqPF0rJdUF/6ApsnMizRwe2mpeGpI+lF4kE3Y+fxO28ijY6a0Bbdyzpu4vaa/6GMI3GzmegO35Wbt/CflI5UR0t8ngalcjtLOMtKuk1uhZHKsNAZGAe5G6UzcjbIh70ayG8ndCGcYHnPuRuOV+pjKzI/vRnf5Xnt3o+Ht4F82HZa4PBAJ2sYtpB0ltIrk9V3I2A/0Gj/U9SwtdhWFopXRvGMX0acWuxSev5y32Dt/OpNf67S7HflTz5v7MQ8A/DjESGyv2z7dl387vxroX560X53s34U2afYD+7X/Xn9gMDc1bG12JhHL9xvrS4sZlFtUawFqWtVznSDBUitpHwex7HzmLV396pednuuMDt6zR4f+R4nkqhsFsn0D/0HE2l720Jk6Zv1/OuWkQkEFnUlak1wyZc8cLjMD9XCZC26wPZ8ajeKtzrRGZrgjmGYM+wXBLWZ+Mvuf9cj8zoKZ+IFwOL2dCm8LwXhZAUqO+eYFYo5IEqB1A1laWE16Y/kkQnDwDfFp3ZfhoxALBHPdjxCE/Dq55U6zCk5TVRRYlxI0zeUC47FsPEsAPfNLFcTP8l3GK9Mz0GHvRCzQcoizMArb4nHGlwk0xfhZ0tIZuiRT+KRCm0I/tNJxXjOUo1CnZXiX2AkwiSaGg06CbrxC77csHC+olbRosZnveSJssVhwT/43CtEVVq5bi1oU63CNpa7N3xv6s7gqyU83/l3FWiKbZmQaXDmykju/rIXr8ziKAsHDTSv3Rv7JduURjLNaaPkJU0kNhV3ZTOOlqIEpDI6LZW3RLnp4tQIUunwKA9NOXsQRtqClvhTy17eYNMrXb9sNK1HrNkGflVglYDB07P4EDZX9tLQY8Yyg7pZ4Y4hFIB7Uraq3wJvVD9dDKhgq6pcbzR2MKZzHhevgPWBVwyqkPF0mda1A70uNzFwRq3oVQRV6yydWA5AquJUH0XSKA5Q2czSN+WLmu0zEMe4jJqPPpvrAA9+zMyyRxxcvk1SPxy4EqjqXoVVFZBqU01ezr0STdfqGLE6EZejOhPtReMXF7N/cXN+MPlzd3nwY3vbPRzfX17c1rOaSrm515dYNJXk7+5QyHMmQiHidZ9PJnp1F8SKK7SzhCplOBZ/XbEUwRJWmhOhFsbIVqihQG5BFHMHPdDLLYYh+pQXp//zuX7+9vHzZ+6UGqWMPiW2k/pmDqTlEnZTytq1NuWGjAask9/D/CzZ21vXxqQ0qv0f5umPEXua6jIoCM/l2b7kneRjlXLUbuf1RFKiCbNwKHhAKoD1PwyqL0nyuI5QMVMkV2OwbgBvCsuZB3kvABQb3TcanyNqwwgEojfRDeHoIHOVhRTaaWJ5bpi/Yz+pEmGuqvNN94C3cX2rOjMOMHPhtrwAJ9J10Jf/9SjjDTMrwteGekzn5OATnIuVU5SwrhDfffDSmkIq3ERmlETI7YbmAZNn9HPO9V/AmwL5Em6d7l35r6NI3xoJx73/J3ujXDIIjwEdbLPFD15CDjvIwu5xoi4BpVCJlT1jV9FUfNudE3HTBtPIpbTbW90bi8SoY05elunjT9C2gDQyMFVMxWocNTMmrSnijj3mxHUSQZWropSdCu0SjIJeDWTQXBzzI1quUfDCJkRy8rIg2yad5jgEUd5+TEe0Xc6OgY0o7BYouQwXwr37oRZ/yLonKP9cyBqaETlyXxyFsli15Nwo21fKUjM5i2R0RTBy6teONaBmLsjJ9Qu0uOZ4oBLOH0kahf/F28456fHnaPj2uiEkU2I6i2BNxTSyi4pYl6PgOQxhNnubrwk/TQLB+6Pk8rIg9d7EcFaFTKmPu7P0HE4j97JqhGWBQFU/qcB5ZZ+UuR2r/EeE+HFtkrEyY3GTLg5NmYg5WoBGRgBE5mwm9RFS8DwdpvPSDFCcx/Fg/UBbTbro94R9lRGIOJIJY+RlRnDgViUY8IiNgm4jBJAAaXSi8TZK5UHE+SU54TAQCY6qwIWwRqFQ27ZngnogdPvZHG4FlKtPXNWwZqGvPChy9kS4ylDWecKA19t4M9ivmklIWauLvHQ0hsyKe2pMVsWGhfVbNxTm82BSZiDYPatxquRAo57QLn7d81P5yhYtaimyAmtdDZgzUvH+ajc5jt6HwX/2pj9ih5ie7WlfFUTLiY78mTr687fV6Xfjh8pFdDyterxpPZaV6nzuYK2Li2a21GrciLvx5pcelP3+e4zJRRZZbzPvprKlmL2Sc3gztVKJQZUvRFqeuzmqwoiIpovLm8lGsVOQtu72oNysFqJQIk4hlYz6htcZy3CZR6IVgsnNOKHg6Y/c2x84PGUpdVVJ03Jl/uIUoN1j/XDpUTrpnM7+d/LEEp4s4GvOxT5kcBhzWkqiaR9XK4eDkiRarqjkbzoB9HzJFnrk8AIwxlMqqM62Jp0nAp0lN1gaaPVTKTg4wDVY5C8XXn/o4sLO4KmNAo5GMoskkEelz8SJHq4+bxP9T7LZXngTbKvCgB2MYrHJWavQoCpxgrMoZePDjdMmD0fbpUl/lkReYUOPla75q4Gd31dqBmWpVa1o+PXt7R4MG+6sdDZpExY6GoqkVYwtx7rpJlVDVkPocTpyqWanZ1qyxUam1mXF0HQ38ccxjXyQ1caHvBHI4ZobL12BkvFbFXiqCSY21d5o8S1bzcRTI9ky4K2TbtQI+YGDqjGLQG7EZpQV0hmDp6WSFQHD62YvSjW+onzQkL/glbvI4BIYwao1UvmzKY2f6536LckGK5eEEBW7smXrq99heY/pnA71DUtaQFBr7TnM9tr4IpxUIfVpbks772J8jrEqlZWxwzvZ+Gpyb8IqtNIalZrfT6VZhf7MKpLr5stOuNvJU1VOnb/WLqZqlQViAF9X8qNPVSpgortrhyenRy6Mq1m3uz0WdD1eXg8s+qbTedrkMMIVJZq0mi2J4lpQZNsnB66oCEQbgiOT1wQHATHwecuAYH8gSE9pvB3Ph+byNMXM/O4+zdB78DpA5QzGaTHwXpXX06X+31IOzfqFy2K8wQXOZrcLRTUbVQyC/QgY2xwpkw9Cco7eIQby3WdfVyVUsW32qeRl5uf0FfYxcYEcadd2MZNXsnB53KtHJkik8GzJ4TOoNzo/IQ/5iJevwTMX/ah3sw9M6VZHxq1OJTf5KYXnUD041p1j0KRRxTZyT10ADNCntObbDK5Wb+QVPZ3+PwsG3QBHBdO2sstaaXhhfaUMakRnJpBPtlEZ0UK0qff2tPSeVJqkLiMBS0YlheZPXdmKQ5PIeIpKtWpB11cA/N4D/dE+PXh5XwF7K46lIR38fNbulCTNMmIIGyWoe+OHHSu62NXoapAagz/YwFNU9tVg2/31nfYXxsQp4Wtbm0N8qUA3y5T+QLx8j1LCM8cahPClk4u8N1xx9aT5rcvfjJB0lQoRbcP3UEQ3O8Df5ZoejmrYmUD6DwJ/Kul/d6SYmyEj15Jf401maxeqQco3EWTO9AsuHncNOu9ttd05uuy9eH568Pn7hdDqd30qK4Rtpj1Bu/qYFwd+8n8I2LNa0TrabZgbbostCSa6+mbYMlfBR1x5SPHypj0MpHjSO+Rfm//yNH0pxVVyR750i/spOEaUW83tricpbSzwtbNXY4ZtoOFEFI39th4pSHPwntbTYLAU8Gzlz7yQvgcpM+eX5CZ5kZk4Fs0xmvFvTNIfvet0q53l4clrfTA9PTquc60n3sL65nnQPq5tr4glR1xPScHje77+vYq4lGoRsgx80eL8ZPIhFE0WSfQ48qOscOkfOcTkWA/6sF/yAf4v3+zmPP/rh1EmDunSycRtzvD+xC4Q02Ps4SiM3CiiTAfdjNYPEYTfCjeZzGlWBnwEO83URjezd4LYvkct+uun3rxTA2eWb/o388aZ/XkAk+xUFgSVlpV5URjzdQlZVqYx+x7FBVRRdlnmT2SzXGa9LcxbiOSo6URFoD8NknFkx/sTT+GPZcs4F0BfmCx6uamJrYFoAyWEKz/0t3dWfiuBVBWAbnp7zDMgrC/EM+HtGBtZvdxTBe25HHHZlGYPWXPBkeFbD7MjvqXPkvDrtdJzui+PuSWnG/fmiTnz1HjkkmlVVJINXLM7e90nbHdYLmZoFa7fxnCE/xqx5MfxFNefQQDsTP5yKeBH7YSoBSZASB3BaxicIx8Y4Uha+qicw3elxn2zTnja005iHiTGwCZsBxCRy3WUcA12O0JDZJ0qpkqAXKl8i5gb1CnNVB5cVA7gQPFbJFTzNpXWga5dYkak5GAfR9EACObcRO4bNOzjsdI8POt0D1BXjlG6rOt+2FE4bA+LwRsZH8aLfcU9fdo7cY/Hq8LCLHzyXn7w6PeLcOzr1vElptdEleyMs4YYLdPW7poyVHL7vDa5unf6/+qUZV6BNdXOrhinDdcMcDgSErmAi6OfrhcBREU7ZkNKvGiXFEsXbCOSp5+0vXxpkfz0N0LzDpeH4+KgkiwrNdws2d1n323wAN2O/megFL3p3QfznaBkHgNQsx1wsJiKuEUP5RtNnH24uGF6qNaj/52EDP+vbamuqEgjV78mgmi8ddDqd7uHRcVnxoCtZvKI6dcdO2KtaUM1rZVZlWiABkqYipIYC1FTy9BgApxFSYaxjBq3R1PZWLdTiFYvkHo/CJIvVDUVKYen+I91AbsT056WIV+p3rTxIGrVBSRZR6BmMJwiA0ROPxJ64DxZAzuAG3Clh0UIt6HiZatXNaI7lSymO5gcR61JGzCVLIMyww+Do3/R/Gr0ZXPVu/q/k3NwPi4febz+/WfbOOr1ffn5z2+v1evRv/NDr/VilBshKmjUdqCTk2jzTSXxIFcDqY6PI8XTdr/oeY++NnGAidWHMpm9iifQSGlaol1HiA5zakFSf15+RQ7I9rMHwtxbD//f/9b53dT4a/rYv1cVauGwOvkkEAkKRUPNQQyr8GtldTw5I+g3qlx8ubgc0FtHW5IKAjbNZPvDYJ0SHQITTdCbJhkt6eaaHr0zhQfP81+ubc6nv/Z9GP+NfuakbujndM087usVvPjWUWlmw+0a3cb8hjb75e+Ps9V2c8jvUh6Tp4m7sh3fzFV8sHPEoSmMHm0UEtxsKaCpRxmHKQ4/HXmZSMBDdvrUN0giAyboAIPfhbxUxOfMf6uCvNx7H4gEPcx4J28QVMF7huHn3z4vLivj5KFY1sPPOfxBt6luFpEdKbYsmWPikwMvw+u3tr72b/l3mIerj4+r27gzXnjBViAl3gzmfCpl61icgbZzQ16Qjyd0nP4ReQakrEk4xibAS6ZjEUjuZFAvdAjmyDnbH7tyy35WWl6LKNsnt7lyM0Z88rkiANht1ZQHRGNo7KahXNQwlaHwm4hGwVrfxt566XZzhMRwT7/1y0D+/UVC8uvBzSeD86Me4yroYzXnguz46x1j5kwyRiQ83FwV2j0vyqV4DyvB4JW9GuCf66Kibd53z3SlUdwvov7prEcCtn64KnB2WzBWoMRW2casQaTIueZKd0dI9PVtBKtfEOTkMfsjQe4UdOh3ThgoPC8XXB9V6JesW1Jb3DPVrwNmivxb9yxQkFF4rZBfINtRcfpF6Z7RRJC7/Df2SP/mLh2PrD/7i4VT9c43mnLvW5+bLVDxKCrgjq58kSLX8h8arl/9axsFdyGySPyBrJ26jXdcyVAQ+SevW1kal/VGs5F8eTzqv2hacb+GtxfBRTnWWcVBzD9Bzoq4t2jIOMq+xgcIrgUc733XcyO4VPAhZElHX64TCFbjQIo+HrtDwsdUDZ+gdRLEMW0j1CFZZFQtX7UENVVXMoDpdgDY8VGE/lcrw4zTSfvy9JHEvWw7YM5QMYWKqSB7zDPxUxDxgg/cPp4amCN0gUjXE97/f0yl4/+97tjfo375lN291hg1jhy+ODsnhF7kPujNOsIexuQbofCZTd+qHuekainLaBc85L/nyOmRqiOpSo6zrg5G2jtVlBUwZmo2BOotxDbJgtUkBtykevi1S1+iwiUiR2OenTDYSaUGZUfErHkS8YsuYiqwZX/v+GnE9rGxCzuaAWweRsWlO7zn5RriZS0AfHgvWWIRTqyE6vt5AWVbjP6MKGpo3iTkBJ9SleO+zrvfKgKl3D/zr/h/3ljlLo4UteGjQ/T+oMiiM8k3W9aSdZgUCWAZBdczXWQ40mMj21R9uLiTQh3xjUc0XVtGSGkFkdnllqRYBvevZM7xs3Wvm7/F0JOjRycr6pNYObhQmaSybjEXxGuqI7NpqSMqEgCfjn3mL+fr4+OhAPiH99x8/qt/Lf/8jjRblV1UbsL/HyjY/hCaHwphe2ioJS4QIcxJXqRcbTZQfms6P8yj00whxK3kgGs9a+wIIhRmFUgXXXLv3pCKqJVgQTVVyNr4KKz5BtBcA+DlXFvE9urzmNrGtUaaDq/maIcsTDSqsJyp7ZwUCoPkxbKHTrES5QO2JP5fXuwVPEkubKtC7nKK8V+S1IVTHs1PFxNNZbZNOZ2sTtqy+WopGFTx89QPfl1GncH49Ofnj4yNnXSdLv+FBjf7Ae8cWrOyyHNRBiAZQG9MUV9BmlH9REe9NjCuajFZvbQMVzu//pvNbOozCyxxMexQHlwGevwqEEbv/73uyOiYoxEAqjNR3ae6OukfIZjAc36EXHf2pljUYfUG5nIYi7jp4lRDzRZrNh6YuP3mvvr2W1eH5E3osTBGYZWORfsqyNKV/l35Cd7vEegEqoQ/yhisQpq/3unmr+ngLsu16UPLJ5cAtEtJiITyD5rkcyz9Za1/wuy1a8sMUxm5MoshRn8OTaAOr2LB/kdMnP1H+u1oMRMLiORUvLWLh+gnaH8kVlY0bA/8jDkbBFstx4LssWU4m/qOhSJ/ZwyHy+uBAfkR+woniKVqSxyv9AozymEd/jsUmJwnYaugRs2Ip/5hPklWuPdY/4GMRqKaT8GHpYP4k0IczitntxXmS2UE3cpYfN1S/W9Ior0eJOxO15cA0h0T9SWvZgKQTe0lxt5JZBfevNzr6cr5FoRClCsShVbcuidza+4MtsruQ9LxW7I8lDyhjV8VayBLoC2zmjGVt/RXCsnh0xSKlX8wihXsqMYTXtpuyFw5jA2Sz4RblowjOkF6fAVXqq5ZbIKX+roDDTQkg/gQWaD4MQfAo83dze7NlScDY9wJDYxFEn9bmrma02a6w9CnZyrgYT1JnvlIU5OaChjUET9KGsx6HUlRy93niFd5uNoylzMlyfOgky3E3Z8CyYEJ+erlWbkoKFo2GjLqxMEJDXj/IAhsbDAJP0vKqn0aLETH4DAeKmExUGhTcbxpCC3xP3F6c77dkQM5UF2YromgyZbpbug0VmVjbUih6YHtD2Gd9XEN2kn0S6wfyjb/3uUNnzlNHTrYS2x0+9Pvyyqahg2pSsg+K/NrJ45SbON4QHB5QNjSUl9JHk5pYMOCZqqWpNa4ykHvD3tW+I8GoME5i2lhb7xyKNENp8yyKddaS9QkSM/nIeEsCNqM2ylYYXyQtdn41ZDbHjO2pql7P5bGXYNOGXr7Negb4bzSo+YN1M29WsCJ+kixFjM0631gTW/mSyJFoYmzv7IrQXTAJbFFbsEbkBRn01Yk1fNc7ZO/86Yz1kmQZc9TRDPHcGZfGcMiLhkqsaxcLjcL2zvap9jtZ5/rDsDqW0EXAD6dLP5kJr85FP7cHUmt+vsuan/34Ydhi1z/qtR+Ebotdf/iRfJPswGmxs6sfP6MfiiyrQ09QJB34ad2KoofRduxif11Wl8h9gDfwiy8+VcdfFE956P9pFxvVxaM9VML2rksYiUHo1iMCHoyWoZ8+oyR4wDAiBPJhB4msbZXqpIJcHTGK4hEl/taXUK5lQuPhWqXHM0f6bYsN6cL1vrApznDtiuLQL4mMQ4yHUTqiZ7ctOH2yKhSFoPQMijqj9bbWMjSH0CpBTIUJagFknorvOevMUcFn50W7e8o6R6+7J6+PXv2fTud1p1MRr2MxieJtlnVnZiUY2haMdl+1Oy+J0e7r487rw5PKGJU3h9FHsRrxYIpjaDbfguVdNLmn6ZsA6FSESNzPXXM+iuImvhn2qmXVXcYPoiY2Ea4h+hZmvWAoel6kvqv+lDHLjNgRMrZqEvwk+5NBIC6IJvSTdHFy2K1WPuJxEYUi/MqHkJwY+opEFu0Wsf9QWGpTTLEFr6cnJ0cv1C/90BOPNoOMeZE7Uhlt+d9XI5OSwKZQC5DQ90tLA5IFmmYC7tRPi5efw87xywo4SUTs82CU61BVtep/CP0/lmhjhaF0DRt5EWYfbD61KdhCdjBJReiusgiYBu+ihUVkhQeLGVcVEC0892b5CfIhTlc0IPuMWj2jYsTLmkIb0llGV0HmJydv37x5dfbivP/mbefVy86r8+7h2VmvCisEZHOODJ3a7a2pNYzppcCWvJmEbX1+RdBDJYdKdHZFlSlXYxItQw9v+j9F7IKHU3YWrxZppLp0rBw2FMI8p0/9dLYc43pyMI0CHk4PphHKf8cH06jrdI8Pktg9cInAARSU/uNMo39cHB29aF8cnRRfRXH1PTltV3MaqCDJXxMDSEwQQE9jnVfAhgjPmQbRmAfGgw1FWinrf8Udf53TD8MKWfoW7vjrZk7NTYELFlZaXvKHtz9mznqLXfw45CF7i/COn7iRFQRosUHoOnTlfw4d+Wbu9zl5VMigfdesmcmNF3w9j3V+c8tdE7/fwG1+jf0qOPzPu5krlJV6nTtV9AWOoVDK2yro7U6JS3OeujPhOTyN5r5bEwMW9gJMtBzLKh2SLialQKr5GIBYET74cRRaOc6MidBTDcpjky9JuZUFkYy51zZvyPMy8qEPP494aCh9nBVktUlKiiizpfUVUkKi/wZMxp3k5D+TkEa+yWDKZONF7lLxDmusSxye4Hry6ogLcdJ+ecq77WN+2m2PO+K03XE996h75HW645NSksA9+bmEgbHKysMPxFjwtP3S6Tid9mHnsOt0TpzDo3YHABfdMrKosUZvTRS6hzzZEVn44XIZCNGco7hpLNQ8hZdPJoQApz7wnYwY1wVl/jCiQUbxMhDbCmcS87nAVqxJGnb9rCq8NEOaeNBkGVO9AAL4CP38Keie7gY8SfzJKp8HibpNl/6eCncmwwxqAIM1KUdy2FszlKLl5yDdmc7AUIgdFJpAFqALh57J2sekxQTOA6qYJ4yeqVBtqOhAj0UaRxqYxy7K4dMpphLJRS5GFi4Htzd91ru9/a+zf+bWhNrhOTzweVLTqjRucephgD2RmJsKjYvDnHEqqoomjCrrqZ4tjZdUdaXLZu1iStJqVJAQbJesrOP2JVcXHJgWJ/gb3nJW6MRG5U8REkT/WPrAHrNpr6IlrdIyQX2BLTPCdJJzNqw4hcrM5u+sccmnwkVyz0/06dMG2x6Kggao7xShlfC9nZaArIktq79yCXyvKPufOp2jF18n6Brv6Y11uKm/r75jjKK43w6utnaViE7tEFgkcjMIpeR/hextseR0vpzsbbK5ZdhO9jl2nMZTNUQ8Tbn70Zn7aQzcwOkBfTs5oD1xsO0ymaPd4Ymz9Z3uqYcHFZRX0XgeIKIDiavi6qzsXx19eIayf61oMvVnA03XG17t4zL/x1IEVvuqRLYl175+JPeEBAQVRX+ve9I9fbWTYOywQsUWpM4yvWtr2qS6BZH8FEXTQLCLi7Ovl4sbhRPfq3Nzr3m62YBMwSzC5ZqG2cuncrNQV+qHU3sfEj6FRSBxeSAS5y58EqPiKkrZUD6FYuteRaFosYvoU86YXgrPX85blCQnkRw67W5HoWd7cz+WkcYhRmN73fbpvvzb+dVA//Kk/epkvwBH8Wv/vf7AYK67yrI2OxMxch/3C2YBM/j6NbTkXdMinueKtfRlBdY2CjVoSraAyq9eZ27wnj069L886IonAv9BxBJ0RbAeUNdi1v+n8/WSoLxQR+WF1iQLS5+5vqysw/jn8lPzoJyKJIMAPwPKuZj5yex/dkhEyoRB8RFZTSu8LYTxVFZKc0NDXSygJr2xJhhHJsotsjbWCfsoxEIeyGBe1fnihuQ0d+UuTWN/vKwRdLJHKHvRhOIMLBvPYrpnfqniuFkPovGKLQKeIvLpsHciFs0mCgbDKGyLR2CTQiNkZn40UZbL0DU+jHjEvVHjhejS5tcMheuE0oWSZYCWwMQZwJ0WMCHxXCq87HVcEEqZaLGZ73kibLFYcE/+F1nZLXVmtwi/akMJVPP3hv5so8Ua8tONf++6fnjeG5m3ZSdXJvkUPtc4igLBw02r9Ub+CbdphWoByA9uvV77icYTKOy4ZhovRUWMYEA/nI5quxA25YVQWaLYxEgwsD5Esb0sNdV5M/qj6tvSZKtu5lB6NufhcsJdMKL8Zg2TpPDBnDW8FVVnb9rsphHrLZB+/MP1kCBIinrkRnMHYwrnceE6KHNcVST5lKfLpC6p9z6Pybv2jEtT2bwCk6UsjQmi6RQHn8xrmMZ8MfNdJuI4ipMssmdTpdTG7H2VkNdwE0r1eOxCAKJ9GWZ4naqwTn81+0o0WadvyMLCL0N3JnB9Ki5g/+bm+mb04er25sPwtn8+urm+vq1oBeUD34a3+IIp2GUNh+px3b7iQ4fISIh4nc9STSQ+z2gq+LxmC4EhqjQTRA8vX2QHID7LOChYdiezCoboV1qH/s/v/vXby8uXvV8qkjT2h9hG0p85XJpDoNkoD9jacBs2EXNn+QrSX7Dz5G0n+/pT39M4KTjVySjjWKdItp3tmavthJHNw0PjLhVFgYJy5CrszGg/07DKWjTrPAbJ4JSU+uYzHRx4/tRH40kzHk43XCRw12N8ipJf6+Y9E7ITxkoD81rrs9Fk8tzSfMEelhMb7ktiG0k96aMTBi3RwYFETvW2bjmhNJFO5L+/MzcYvQwvG+4bmeONw2suUg50Z+yXcPrEDUT+zcCzExm18rJQdbmANNn9HPM1KO3YcyJh9y791tBVONuMe0Aggn7JAz/BMYKPtlhCRRaKHHSRh9m89W7HNHaWrCce/NoCNedEXCE6wW6Ydlv21Nf1PvH4rszoi0pd/Gj6EmTKsICVUSFNhw1MOrBCP6CPebF9UQ8Il5+wqkK7ZW1BFgezaC4OeJCt0VfLBAOP5IBlxbJJJs1zDKA4+pxc8ghadLzoA1zRBXi9bqxg7b9bA3eo5DoWWSa1PMZgg2xpU86101yX5g7BTNIrEUyeowHbJUemhsg3YVM7pX/xdvNueXx52j49LsEYWl+Mori+ZitvVqkwbQhgtJ7k5cJP00Cwfuj5PCzBkrtYjmpMtECjPB2w/Oza4MUhKMOHOjxH1rm2y/HXf0SIDD4IGRwTNjYoVph9MzGHILBxSaiIPM0sPCiFbDde+kGKU1M1RJJWz+WhwaOdcPVCptpoST8gysocdhGHeESWxTY38UmAGoxQeJukoXMmJTnhMREIyg2CPuGI9XTvj92nOhPcE7HDx37NXe6sjEitij0r8PJGuqdQxHiCCp+93pvBfgWcUVpLTTy9oyEYDfHUHisxdWh4TTM/hweZEva5NW81bvmZC5RKUCrjFvP/KnRAJfJsgBrkHlGUpOb90Gx0HrsNcJxhP2sevgLB7jNcJCM+9mua/Ze3rl6XCz9cPrLrYQXrUuNJqdTqc4dliYk/u2VV45aYuerIucWUtzrC/Hl9R1iCx8Uo3Oa4DUWSbp5os6ebVClwHsSreMikLaBtSqkaarCikiii8l0A3XVUIxFzE9D4uzL5JhGKtASW9sTEV2kAyXLcJvY1Q0zCzIUCrXvubY6dH+5LWQpDx535h7tZ6lzGTU6iZzO/nfyxBHeLOBrrnm4oOqOwgCVFNY8qlMDByRAtVlVzM5wBcC9kijxzeeAuA2kAjXdaJR+TgE+TLbjYxWJAa4dKkcnJpMEqmXbx5aK+WdsJQaUmvZitEt/lwSiaTBKRPtf85WjVcvD1hfda9+3+lZ+ftx6MYbBKpl/jyV6YPcaqZNIPfpwueTDaPuXmqzzdwsTVeDq1plIedlebHRgorzbUQOO5Dnwa7K8+8GkSFRz4io5WgC1EuOumU4JUQzKjclVMv2Z7sTb10haDSs9HEvnBF0lNM9e+tqx0V0ATqDfLoHX8xOKvDEupCCZWG/6qWdHkWbKajyPVRR8+eLb9dpy77hhU08Sbb3MdlLbr3PT/RcOjNUFPa0vueB/7c4QKqVqADc7Z3k+DcxNusJXDsNHsdjrdXe0mjfMsvNhpORv5KPO85ocZnEjVbAxAO+tMo7UdPKjTz3p0L67O4cnp0cujXddn7s9FnY8ol4PLPi2x3ka5rCDpBtmrxtDLOo0pW2iS86qZzOo3BTCAW/R5yKn4RVYC4LxJDubC83kbY+Z+dh5n6Tz4fdC70pg+jEWTie8CQIo+8W/VVci8nKCYSoRsLrMcEM8IVQ473uhlEG+sMLsNzTnw7TWwWI511Y/D2XWp6lPBy8jL7R3oXeSmhf7cRe3rnB53dta9kukeG7I9TJoG7L5qUbWrvGu8jNtVy0re9kFnnYAaXgw9Ik3eQ2EZ1A/O7idO9CkUcU3c0qlOAzTJnYrt0EMl5rnablB11mSZjtl2dpHdPduohOpeau5E8iOaKMvSTXZKMzkorzJff+vNSUI6eyACa0OW3vLqru3EEcnZPcRCbfmp90oD/9zQA6B7evTyeEeWUh5PRTr6+6jTLU1YKhQ8/GQ1D/zw4873xBo9AVpu0Gd7GIrqTVosm/O+s76SVpvwr+VjWZsDjQcK2DDynT+Q7xxntcDKu0HG9N5wzbGWJrBC9zpO0lGS9fHa5QgFN/ibfEvCUUpbzUdGS+BPKZVH5fJJBGEDgaEbrCuqbK07epFNwlDudtudk9vui9eHJ6+PXzidTue3HVifishx/XRVV6b/mYaHK3BxGYVwTnfI9qE5R3DvRZiOLHTCKid++ylqqxQ9t3CpMKM3k83MXfXKslXTetjukhmsOP0oTmesR3CtO6SWSk4IQHLkJ1FdS3SG5gF48B9eUx+KAhtnvXJzL7UG/4+9b39u21Ya/b1/BUaduU6+K1Nvv+5kOrIkt57jJK6ltOdr840EkZDEE4pg+bCj3Ln/+51dACT4UKIXnTjOzDmNJZGL3cVisVjs48t4F0pOj7p0l3BewBsjanM+pRzOc8bHekxMCrkb7s7tEMJCQY/BxWIYFbD26P+SisPdygU5Pm0ZJ432WateJRWHhpUL0u4YnXrnvHFG/t+OKinP+YPtOkfQM+hYub21n2BZiNJxME1VFVwe9z2b+9SNHOrreV/hgq2ICX50TD/TrK6eOqeqRmdy1dk+HMIgrQHCVGR33ZnDodIaXvpW4+rJcXfOGKiqbBdffMEWGa6qxIyrcGooQN59uPLgQWcFpwVMXoUaEdBVyiJzxhW1edtvyoOQu8eWudsEejwIqVPWyj+6RfC46gkNAm5CLLiVJOvFdCXc+ANLEuhGPd6tJLm8mPKjwjHjhmQUXBghDsR98tf1rV4OlxDZGEmmGj/YFpTVwHt4qUTgcCL/zDP5vF1v13djMLTV5G6Z6vUOR/icdj3+vbcX8iXpV4l4oXr9PWJTtqNMg4/lE3fLQBmMRwBPAL7an5UQJ60HweOmPVdIody1a11/DtrBpbXLiLk8GHdtnwXbUw5XHsbS6qSpPpg6ft3vwNXCwtgRs2BBGyWhNvyt29gXt6QkZgnYNTsn++LXaTTLw6/TaO6HX2Ax5pWF37A/GNzuip+9CVq2VzTwJrVLrm+LC5cQPpMgyecKlzSMptEy2tuT5dBHPQg79Fs5By+pD001jdApS94qI5/CHQm5geM+ufV5yE3u4C05nCklBsWVlCA28yJf2ui369FAlEH69W4weCOrJb2+HNyJP+8G/Vx5I3xpBwbJK4AxDTdg0KFkQ1086JUeJFySmG8JllliDykiHnuMlDZIldKHIcKRKoldcx/7cZd8Ng9SxZcedVclkYI5NJBOIIdJVXQEOqpJNVfIFRapUcdg3xgllYDw2CMU6orp1r7dkexbqh/TtyETBio5cySmUw6zI40nRss4P6nXjcZpu9HZiVh76ZUYBHTURStBkSczEvAsTW4HYkWSrkskFuT4GHzx4jGi4UXgF1nGWFX2mEGNYt/zodg6VkaAOCkGNaSxaZ/o7ePZMsAbcnRE+WVusWNcrzHs0KduECvJgCygmgI3zciHOr2ynd0DeiVEVr68jPdpXE4HcJUbjuZGuGHUlzf3NEzFDMxsn7EVqhFoEjSviUpsx+AoBR1Wa9Yb7Vq9UYNkSthej2Vy47FgzjEMCLsuhBPkT8d18+Ss3jLb7LzZbMAflkk75yctSq3WiWXNdhIVleM0hmkrOIEefnXso/WGt93rNyNj8O8ddmyPGbIaTNkUymH2obQSK3isFS1z2vHvtx6TRSqHGLdT2YEV3A/3uF/9ssVOQJylA28ni73dbu1AlizSuQFpu8xvtghzPPBRoCY2b3E5/qdx5DtQV297guKCvSVRdKcXBCZwVaq67X++pthnbUylEWVUmfwelWL8Ug3aIDRb7V1YModEpZUBXmNDj+I6NHOO3qr0TxwFqw+GzBVO1SkN2EkbqhlyCzR2sj1AE0C5XIlClnCxZrkbJM6rIQvRBzv4iNb/HZv/HjF/Jb+rpisrmRzXEHetuHgMMIDgvYWIIp44HqT507hqTEC4JycRutxLEU1gTsV1Hmyp98xXeWCASxJVlhQfAoP7bvDr+PL6TffuvwXl8Xksv1n99ftl1O3Vu3/8fjnqdrtd/Ax/dLuv9p11kdqQmfeD+B2PeirKC+6qYcZhQYjxVEKkfI+Q25g3oPJU1kLRmzAtatpiUrBdJMQdxqEIRD2vnhFDkhfA9+FfVQL/Dv59233THw//eilERJusBAc7jjKBkihMwpVDykIaAegxOSDKNEB//e5mdI1jIWwFznHINMHynvo2pqg7zJ2HCwFWdrPE25xEyAFm/8+3d30h44Nfx7/DpxTqMdyUvMV3FxYz7WUuXpC8YMacTCqNyqQgPvro70rv4r0f0vcQ4B+G3vup7b5frqjnGewj26k4aDxxQGFBpsNBBHAYUteivpUWATztKl2jSoUFWaKB18O/9iBsYd+XQVN3OvXZPdwwyR6D6uwO4+W2j9/+dfN6Dxo+sFUJJPxm37NjrPIPUXEYE8VnMMFBDv/h26vRn927wfvEglPbwZvR+x4cP9xQpoa/v17SORPxSwOsgguS/RZlIXj/YLsgPyCwezAkH3F2EI7E0YZ6hCFMaBXA4WrHk1OWPTC97/fmkYRKinj1vs+m0XzO/D2YpqNeVrgJjqGsipwY7U5EYFLXZf4YiiluYhuts/IxLAOQ7f5RG/TvZH1NlU0XYaXsWeQ4qu8Qs8iSOrZp8yjQA+wInP6h4USWxPYOtEmX+D50vRGnEjiX2VCuJ23OpsvAyzLyINvynKO6eeSoae5wcV1iTGRlJEtmJJTRINlDhcnYWwEn3iK1uKHbruhn0DTqhu5eX9vLIOmwcSzsffk11KikZig+xdHlOY+9aCh4DCIsHsUi9ceQSSs+gxyJv2zvvq39YHv3J/JjBuaSmtpzyyhkHwUEOJPKv0SFWfFB1YoVnyLfee8SHeR/QaiIf0xNDFsQTz0IbXWsFMbxB7YSv0BXyWOtRmfuviGmY3txiXzHEBwrSWiO+ghdaajIdxJLrgJZMcyhQWibhskrmiF87ZKALxmBcnpQSh6a5UDwCB5fwe6VN3iuVYMGo+AaECLhrJI0BEpSpIl23VBHTJiKABusRqbfBQr33Zwr23oiQExEAIuOoSAIEJNZxYCnY4fMpw65vr0/iWEy13S4TMyc/D3BnWzyPxPy4nowuiJ3VyrEg5DmaauJRjhLPZh0ZFemuQqiiZP8bDeFbgxRoJ2zZtOc301u4sSPskQnKbsec1j5vZKsk6QkR1xbCXoYMa0mLgrdJtmZozx0VRYSWmLZM+irL6r3V0GAocQju2f+ikQ+Zq4Smnk/A1wN6zHf5hZZQn1kADJVSTVQlBKOPnKiqtq2jg9PGal47ryStDqA1yuQS1P5ftNMQdpmPp1rfWYPLmy33NdvkWBCxf0AfJr8PNHUVsg9ndkgNZOfMc0DJsOD3noSikLaONqR6MhxDkdwmbkd1zMClht4/UTVA3H/ICuhr3iEVdkTnbvSRAgrMyvsCdz6TBTxE8xyxAsZLYwQ66yb3A1CP0IrkfuZEgyizWQMUlx4r/UrprXhRbvdqonrlV/+eSW/F59/Drm320wq5fQ0ZvPonRvHBcRqFZdEQALG3BSXZThBofqx3bjj2ZK7dsjBNyS0WmwRq70d3E2xEMns1rjpJ4qF7KXj8LmM6oVXQUPPwIsKFatT5ij40PBAmVqsuhQtmRTQ+LUYLA1A2eumu2hA4zCocu2DnjOODiJQAG3Nz7vJmkeDQJOgA8haSjhuJXil5OR2a+yKbLgoDdFwkUFS0+KS5ZVd8d76wuvLZXRgD1qLcLvdMrLyttOdFojIP3AvsAH6u7AdW3LgAHKhxVH2uLjEL9JLXESshElwljILIrfv/oL7rjDumJUYg/ooBhjrNG2qu5xMfpmgFomdMARAuVy+i7gb0s6HdgygTSa/TPDmQz1V1QbDF6R5GEOEswh48tnSCxN8EHXx5ES+nYlasOwZXqSF4OQkUxY+JCGDsvfzA7R/CrSbki1lQJw0Gbizyz0CArULe76ATtHJoGgzi4GryBjPY3Hr3yCaip+0+c7ZxRos8TC6gSszzg35HFwRVmDmKvoXKRmCZhroNpMTAJ4nf4kVGj2fmXYA6QZiFkUHM8f+AJsbI140dWyTBNFsZqtW8kQ88wI2gotaTTwinjC4P39pkJG/UjeikCfx0YZMEXEghQJR9tJzViSkH9JRmtL0Dh84ceiUObL7GtibuLk+MGhCx30yuukHiY4zuRF9KEg71rixm+wE5oKVFtdxNEToazVhBbgb6NMI5x1xmz65KDTEBb55RiCkHVmgRLQsLoz0dUC85HwirKQV+SeiDkaMSj+H6viLujAxnKjjKDbAAwEUKmJeiHxZcFlcURQezSwrqRcMAj4Tigy1IdMpBp3FAFOkZd8aACV/l9WD4zwv+AlIEAiDc5kntmlqDVY1DsS6O0fQlDn8IYO7xKhYf5BwHW+FT4oGobFcSQhiEYFUVRgNwoqR9QFJKKlzNdIKlmkyjCbAQTRtGkE0baQUVXKoT6OX6oEkuaDBqAiPF3E5dJ20ncTBULDwaRDuJu4h98ZI1CNsFmw2k6E9YB7jEIrJL9jopv+yKor6xylkySxImESq5arq8YLqU9cIEh6QWuByyY4bg50lT8KcAfjK095TcD9Zt50kM7HZxoLf7yZgqtZKSYL1ToLP7CrG9siCP96gDkbmgpBiiGNQEtpxpT/Zz08bVyq/F8Pum5eGuAeEcYK4J6t2ZyBBE+hfvuC+isrRnkDWom0L9zLYCkepmsQ9zoIq6b8ZEp1iQl7IFE3LpL4VwOJ0rXRv4KTqdywpR/+lnZCPdpwFOwgi5sNCXBYmOx58GvTW+i96b7BkBiABy09nZszmHN0DuQMNf+s2sRU36QZB5FPIyxjCFaFPet192YHJsKWzAkchL3ovMVg6yFL6brgfGVA+3HbnkR0smFXm5Pb1geTc9neZ296rd8MqeftKzfG1a1bJ23ev0KZINo0q6b159Rk5kGDJoeQBslwdOyxbINQwSi/dvMzy5zVcd4JG+cNmD/vRxP05de1PepJKWXTpQwXkxds9Fv21ax6ObOqMI9cOH5F66hAYEZjwbgcuZJbBfpyAWBQ25v4Yg1DLC2hWfMDx4Hijxou331GVDPHgc5sT+B4cf7jv2juUG0FiXR6O8RpqA+rWZgRCEiBeBdpmvtGycHmBm5JB7yw3gJhzEZNhW7kpxGS/+ulx44TUWxeNzkXr/H/X6xf1+h70TdmM+5tM384EimpQGxDXOD+unyFxjYt2/aLZ2Ys4YamPP7DVmDpz2DIWyw3I3EVKuwp+7ECcMxcCxFPHig8svyjvht39yTMj/56VRBq4QRC+VtyaEUhm9ULblD8lBJKY1eBm1eLd7SD5KS55mmOHaweh12k29ucJ++hxl7lbXhKkSB9IEIlXmPn2fW5K4+D8Deg76XRap/JL27XYR50oQixujmU0Vvr73fmwZ+VFmH4Aoc5t2kwHHnSPg3qMdpg/YDTr7bMdsQ+Yb1NnnGoRc2ixfufa/0TQgxKGUnlNuMvHMl68q6KzAnVZEDLXXCVeI1XVCCcQPBPU8RZURtFX4TozuXMXl1EqKh6ipbA/KWQdWEkn0xh0EoGU43Onc3V5ed477Q8ur+rnZ/XzfqPZ63V31Spxm+vSdWacc+aj51zndtJrO0bCIH+C00AGLYryzhIqkabAjEeuBffUv3JyQ9056fkrL+SyPP/KIEPG4iviuR0uoikcB2pz7lB3XptzSPGc1ua8YTTatcA3ayYCqIFQ4n+MOf/5ptU6Pb5pdfK3gXCk7Jwc767RpZPh65yng/hArdDI0gd1HJhlzB0+pU5sSbos3Jvcr3FezlL3brgnGd/CeTmrtiRu0Eur6EQgDszD0avEUK6Sm1dD6pIrcInYgcm1A3WVXLumgcfnsmThmzkrp3iwJ1H6Ga5kwgoPywqPLI2paT0gjd/AyThD8q5UPY9TrqxuUa7BJZN/gEoQHGkB5WRy44AaFeRvlFa9uQK2r53ERMgBtXMQXm5hEgnWQMTMEjJlC3pvc1/POIh9+ktuMQeCL8B+Ia+vR3cD0h2N/lfvX1AJAHpIYJwwh0iNfyIbC1useIQ3WVHAdJg093qCom0ZuTSFYb3TaW7N3RL3sUo2/f4pcRj4kudx17qyXWtrJnsODWE9BGVyOh7kKbI7Rj5TPOwPPXWp++cwl+nT/RSpRCD8k3T74tOvvVvxB7ZNlqlF1Hw7FKlFb0TYrPjwFsqapXBtnXTET0NK5Rsy3zEnE0d/k4r6jWyepqxIL71kBApHPAiG6W0nIDpflKzsLSA6UCkrnxWQFAFGZV1kMA1Dan4wlnboQ6WceU0BqKF6rG09O6Um/C1kEwQ+iwfcdLHGE6Azcs/FqiZAB1k0F8CTzDpFn4hMz3stSiSJDyPOndxswZebzkQI+Zvm41gBOFTRDBjkv/Ns0nijc0wgXE2FskDyj7FeSMUrQW3UrdfrzRp5mecY/lLEmDI3cD35OW7ovimTdJ5k+XUAJuV5lM41z7Ap1h9l8SqtZSH49htilg4+z7hNoaT5yswF+lgfZ2mq0TbgZY6BOvUxoO3Yqd4KaqNGvXNeyzMRv1/DocOu0QNnJn1GB3/WoN96RvRp0KW7rBnp8eUS3O3w/6GQKncuar55PlOXvvnZ+kqqYmN+6nzMb0Xl8XPzd9cwNoimj6U1MPxZnO31UTeQVZ27Od7qsPZjb73eKGAx/mbU6xvfj8ZwDR21b1vhrNcpW07V563Vkqfqlj8wf7hgjrPnXH0ddbMxq3X2alx/TFZv9/7npyOeDEc4Y0I7hCFGN+J6CFsUX5DmTzk2XgEcSIwWxfUgGJyMboZwSHYhmp67EBABzelxscJ1pRlBdQM8aiv4BDy/omy6HQbMmeGFMFQEdAEEZH8Qes9tC+o1HlvMgyB2lzqrwA6S0GqBwkejUz+XUPVLISiRIO+RZbukuO+yvoYVJ0zbW5TmFR6KPETpkFblIcSQQuysyI+/FqW2dJZmRWF0MxwPev3fBuO7YXf85/Xot3F3MBw3mmfj3mVvLK5oPy8BGuWODb3DNN6VxIXbwetjVX4xgHpxx9SBIn/6rHFMSJTLkCnccjE2URChkCyjEP84xtzMAMJW+IxM8iSNzQUWWAkwqC4JYIiBYqqHSJaEy3XMK4csuxzvX19fG4axO3MFJiWxuIt1D/ksxWttcFkFa0k/MBJ52YtUCZEQRPFzc7HTHExi+GoWaCjDSJKQERhJRtIlb6pKAIhXbkaO/q6ISalUifprcw+kxLPk9jg9jRi9VrjwUQFt0EDHsueQvspnpD+4i+dPXlxJsAS4u8GSyQTwiOwtjkUutPrgejaYHeRmIwnggTIHbhKzk+q3Es9E/er0pHd61ex1OpdX/dP+2eDs8uyqfXl1eVXvnQ96u8xJiY2Bvjwp2Droqc/K+aB13uqftxqts7Ozs37z7Kx5ctJr9s8bnWaj3W/0G73e4LLZ3XF2ymuNtNH8QPOkwhmSEImaqcPMUAIVJiWoHmSG6idnp1cnJyfdeqc9uGqcdutng+ZVs3HSHHQv273LXr3fPOkMGv3Ts9PO5eC0fXnV6p02mr3uebPfvapvOXMYAOg/XtxOEqmgjDeBgfqEJpw+NxIuUfV2crOUZaCW6nLHeUh6XUyJuXZnPhX1e+BSbMToskr6vVfyPfx7ixwBOfh/aKsk3skGFbgx2kmxejFugMW7LbClFyIReUU85oOogYgNhze1xL4mZEFdK1jQD/mCRFabdaaNM+tk2umYp43mafPsvNVsNszzkylttreVpkNkD/RpyGoYYa/ZyFhVTAyySTKBhEvWxN1DC6HGcR3+N8J4+4t6fbsWQhq9e2cTbEtwNrngS8Q2zk/rhyAWixL5Zcb5dcHwhtamsJ25ZPjmWupU6LcN9iqGL0OGmsi8gGICgA5cB+I3EijR9ANwDNTv0gtlyrI8TJGQw/0fc7MxzPfUdqCSqBbAHMOFdvIm96AAQcjJxGKg4DAETFa1nOT4ny7NtC3Pha4sid8b6eecRk40sYRJvqyRlyvxG6riPjejZVwE/UCaOIg8qFjBrLE4SwdlH6vkMMW2Q+oQj5QTcIzxHG+O/q6sOcE3OyfjX3uv4QTfOmvDeSZ5cNDrf+5ROQghlZ3OPz/yzb9evrk+Bc892byQF08s07yAhh9p5lunmRdw8WnnmBcQpEd3l0xUYcx86QnmX6D5uWSXF7DhOw261yk9xMnwm8orzxL3/SSV65RpabVxYuIGNH6zGeVraHu+6eRrGPK8csnXMOEpJJLrqP/IIn/ELPIU43+kkD9eCnmK8d95/ngxrU8rebyIhh+Z49tkjhdx8GmnjRdRpJ/MSqaq8Pyr8MgSmJrQQxH4BBPGi0h6BgfXJ5kqXuZ5Zk0AY3LCUa1R5/Y9XNriNQl2V3GhWrBjm3C5lqMtYKbX7Jz4G59cWBDSqYM7yAaUTjl3GHWLCLoUP5GZQ1NkybLiENLqsjmHxghwXwUdWpLWkXDLIIESqIbtBth0XMbDutBjGgwq+By5LnOMTclz2cdwrEJjNyDwcFMZx+NOGX6FeEOfsFtZrx2vSiEcV8IU163X3TddWQ7dX5EXymYEH7FNXYqR5DQAKxXu/oJa6ATHcTMwEMdjAXftD8bHRbh0fqaO5x4rHI9tqP+r4QEdmYWAJocGBwLTsXVFTuoAy1rD2FjofBZES2ZtMB+7CpwdZIKosZunHBfbjUiQBK7IMUoVqM1I6cZiJu7T9dDMDWh7pIhfidu2Eb95kr5WxO86TEpicZkRv5KUTSN+85R/mxG/Es/vJuJX0vNVYksPFfGrz8n3EfH7NWfl0BG/mdn5TiJ+N5yhJx3xK2ksNeJ3KJ0om8X25mJ6JUiipCzLqseJ7ZWD/4e2gpLYtCa4Vwx8sODe1nm73W7Q6UnntNNmzWb9dNpgjWm7czptnbQb1pb8ONQVbhDSpafbvXg0lIGdG9zofinede/gXo3eg9zqbkNw9pL3S8TuHdwriZUenQ0oPYBa+LIiUDKXpbf3Jh90VJoC+BEH+fXiIPUpeO5xkIW8eGJxkAU0/IiD3DoOsoCLTzsOsoAg/dKiZKIK74FKj4P8As3PJQ6ygA3f6XWSTul3FweZJe77iYPUKdOiwr6LOMg1tD3fOMg1DHlecZBrmPAU4iB11H/EQT5iHGSK8T/iIB8vDjLF+O88DrKY1qcVB1lEw484yG3iIIs4+LTjIIso0k9mJVNVeP5VeGQJTE3ooQh8gnGQRSQ9g4Prk4yDlEiXhO0bYZoRj/rx1YYcEb6DOzyI18LvuW/PbZc6MjotR9JRw2gebUlW2eGBb4D7jv2JWSKEDoMM1JiISorML5EYOsHnCVTkBR51VTXkIpryFK2hJ0XNkTTZ4/vW+F4axkM7WgYaBSYXlf2h83ToU5MZP0nMu+Jhn8kLKzC4Cfeg8a3NYyBURIJCrXjuVkkQmQsM1sCOMBCrALVSXQzHkXBBqdgmw5VLwTVC4Q6Y/BMxf2Uc/ZTiY2s2O6dn52eN6alpWh360wYsFVQ8Ik+zbMPPophsIMong9eU3SMPHfsD01kmA9WmDLxYJORzBqwSRyfJBnVsohBz7MeMhUpX0O9jukoGgVqy/rEMqGSW4nWQ5Wt7Ojtvzlqd09Npq23RE9oy2Xnz3KqzOmuftk7S7FS4PjJT1bAby6v+DlRSWjCysOcLkEJEGWBD3x+yZDSIfHmiRCGOhVIKcMxyXYzVJpFhZr0+q5+cUlqf0vN6c3qqMS/yHb3Q8Lu7my8UGoYGPbKEMPZCt8BIxWo/sEHAUcRzmNwPqR/Cgfzd3U0griflk0r1AL+mPqMfwMVv8QeX2G7ISWAu2JJViSjiVCUeDRfyfU64u3ntYAFADlYkE0VSsVYuspLRR+hKHCLfSZRLJV1nqhKLACHXLgn4kmEENGgh4OeSrkQJbBmnfn0L1NbApAC+WrbPzNBZVWP/Ak2TJs7NBsBGpwXAhmJdTLtEJg8YETXnMAb8NJE1sgTndAwFQYCYvIsGPB07ZD51yPXt/UkMk7mmw6UDcfL3BLAmk/+ZkBfXg9EVubtSYYaENE9bzZcCJ/3BxBei/ClY73cK/PHAeYFuAB3dGKJAW23Tn6vwpcQhjmMvSyLAq4poJYwD1KHidTJ4gRkil7CESaAIepVgDK+lwuscRvFvi4faVI3y0KEQNzS+ClgITiw7lKHUVZBL6K3E7pm/giEg7InQzPsZ4GpYj/k2t8gyCkLspjYFRyHgx6z0TpDkJIiHp4xUPHeulcGC1ysGfKeN9YaHMgoZ449irsHEIZ7JLqUwDaB6Ow5qhNQ35p9eVpHyGCYAAUEgENmeeOtiwXpRmX+qVJGcioBQeZmXJ8+dp4Ro5tP5cjMn9E4ydMv90Oa6WiF4RYWMnfw80ZRMyD2dhyAMk58n4KwEmnRDWCFtHKVpiRzncHR8tcYv1zOkBHrHBQQ6gNlL2I+oi5viikc+2CWJVlxp0hCEXA/ssl0yiXzHAHgTzJMCY0dEliJlwF1wXroiwIlZuNEJ+1OpKjSkYpABj3wzHw+o3JdpfXXRbrdqAaO+ufjln1fye/H555B7qdlT6uM7mMGjd+6SW2CzWolWRNEPSMCYm+JszNEC7WG7xBWtE8mSu3bIIftIbDt8isaRFe+4U+gWoQQH59pnsV2FokAxsYw4fI7RxWJPBAU7C5lL/gP6LT58yABjNFZSi1KXnCWTQhm/FoOlAbFDTC9SiFZTxpTLw7xy2kmIQGLX/JySL48GgSY1B5Cv1JzfSvBKR8lN0MjgEC5KGz9cZMbWdKtkUCWDDvfDDdDRLt9E+PmFPIYX4sH9cC0e7Xb+dqLdbqWQwnPpBljtwqQj2FRwACnEYgqnTFg24heZ41dEg4RJgJZKRthye9cvuHcJu0f5MbKjGGCe0rRx6nIy+WWCKzQOdiAy9ELD3ZCWLWwQsFInv0ww8lI9VdUGwxek5RRDBOsbXAxs6YUJPoi6eHIi34YWQFN5tR5yYtmYgeJCOiAjUxY+MJaY7jBo+ACldoP4GKymVmRwQlzFuNyzzEg7iSaDopWoTmFAr+expCF1NBU/adOYswQ1WOJhPCRWZpzHdw4mX1ZgQir6FynRiK9hJV8t6I62tF1mQc6CaQfMkckhcEyBs7T9IXWlH0Szmf0xhojPYE7sRa0mHhFPGNyfvzTIyF/J6sTU83z+0V7CvKENMF2RwF56zoqEeGrNG5swlQ6dMicgD7bjoHmJ+9EDcxykfnTTDxJFY3Ij+lDJq3aNGymREIfjsuRgiNDXqqMKMC3QZwcMdxFOMrkoND0Fvnn6EFKaMiVQZRE30qWWeImhLcyAFfknAq+8nQgrrEJ10EksA+o4ijp4IICjGvNCJBeqYCM9JHIt5mcWgVzFBoGjOlUOEu3MksUAfZAy9x3Ay98hdYG7ic8oVD3lcGSTui5PLLPUiqlqHIgVaI4gcPY9ZHCXGBWvdhKu461whdAgNJYrCUGIPAhLhdEgrBhZ14OEkjr3Ia2BvCOKdZKSyyCaNqE+dyOlVpJDZxo9od3lIUByQYNREY4W2D5Cn9pOcgAuWKY0PrevNXaVgIfcGyMZj6DM2WwGbb8gtIl7UlAk9S/Y6Kb/siryrD+44HajgcZ3CZMQoRSrylMJh5HU0pbwgLgCJ0B23Bis3oHN5EsAX3naOh/1/Tp1n8zEZoofv0/JDTjVSwxHeCfBZ7S+oXuJA+an3MTq83o/MUohYK68xcpyJLYrjGLwctApj4TixEfFGQ5O2A67p/EhWnos8VwdS4nseAfysaDQ28plhEEQCqjMxF3khr7NAmk24iCoVrgPOzrcF7ng3FeaQrm0qUsoJvALjOQOoGn+pXG0sRvaXFB3zgKj3FWvd8MWHmPurxLWQjAjWTK4LyZ8VqzFwclObvrdW2BhVwhtPwalL/ejTXWeoh0Tk0oiHQQ4nflkbIsebJ4HDvk5sDMlR/FRkGz5VXD1xr0yjKxK6TpT5odkYLtByGx3W+bgIv9q0oujf23xRSTU7eLhyc/f3MZ1m2Bg1aYzWAUhW9Y8h4agQreWckFFiVuJPotisG1R1BL7D42cuspVm8ACvMMm90XD0tS2BNyXuwW4AV3urpYQeiHBEjjHLTUhfBewWeTAIpzAS4ZtTUAGxQcgcKKMbfh3Ji6TqZPeCl2rwHIHH8L24poVVDPJAjmkkMqZRiq3RTEvhbsiWaaiHS7AOwewYD4dPrfdIqpjTUtR027LC587LEgz4/B1iABfgiNBuhJQENrJapW2VYaco78rH+wpdemYWkvbhb43PsODszsfA8Atqvt8d9aPIiw28J+lgZdQ/42aeAmCP4y8AiMvYc8zNvOyTHiqhl6WjsMKe4qS3U29BMkfxt4+xl7Cx2/Y3EuQfOYGHxp8CTeehcn3NSwCNfa3v9l/hoGHtwQUnt/rJp+m75vcv9MoHlY0N9ma1fg/dt21u65i0dfaUNX43+xeubnO2mMjVfg9iz0ypP6chc/SdSBJ/0b9BhK7H06DAqeB5M0z9hikOPBNmhvbEnFYGU+RscYg2RzDHybLWpNlcyZ+LaNmcwy/WbPnUJbN5qz4jm0fRSk8PKZzlSujhRaR5NsNAowEDBVmBLMHIZWQPAGJT3xJKJn6/EHLTI7X6GjBVjKbI1jwBxJBZWjywKYqLxmmNwBQEBwWB6TLRPsoRlUFg28eE2QxAP9YSleOlp1L+3bB3bT4PRJCCetyAjakM+rbTyvTKUXnO1eTj3FKPrK0vuafbMehtY5RJy/EbPwf0rt9J2eGvB2SRnPcEAHtr6kJX/z7Jel6nsP+ZNN/2WHtpN4xGkZDdUsh5MW/fhu9vqmKd35l5gf+UpXyqDWaRp285lPbYbVGZ9Bon0l2107qbaORZnpgzOjSdlaH43qKTW+HRMAnL1RMpM+sBQ2rxGJTm7pVMvMZmwYWhOO6Fn8IXho/EUIIIewj/f/sfety27i25n89BSr7R5JdMmMnnb6k6syptO0+7dm5eGKne2p27ZIgEpLYpgg1AdpW1/yY15jXmyeZ+hYAEryKsuTT3rtOkuq2JRLrgoWFhYV1MQw0Tzbw/tfIa/xsSlmkC2vgOYM+9RODXY0Tyr2PTER6U86M6HyUv/FbUefWjchSkTzWLNdpMNCKTiCUgpzxu64V8k3wTXB8dHLy+mghUhR4qWN/WIX11ObaJfx7M901uf+zzhl3HDgcd/oxdvDseg5FqqUas3yWpzrvW8M8u4vTOvYQuUfC/PlXheBfwaYWztRmBCAejGuBQoV/mCdknUgUqLBjMoodthvaLJM8gqGwElkY88ToNkQel+eBz8XjCn1pkkTeYWTbwa/MSYZ1z14UVX5evmNJnOb3Y7biIXE0je/L1AbL12BUz6L4fMU2Mn/+PMP+zymLAeLkknRsSi2SoUzmWyUrAk/M3AQwtpbrHFFyaDyYCK5QkABFVCl/AOVj5VqkgMBR2ETlwmRQnJ9ejXGeWmdyLZVATZRiSB5F1J0xeF4XCCJztGX9eKJiF8YjSUtDzi24rarr5Dg4qW+qj4uqV7Fri5EFQ8AzxW8TnvpG+C8f3n8aYn7jOWd486zMeLTHwQ37/vh1cPI703zxQlGBNyQ9hTdCO/nlymRKIP05XcBBR4GQwvxI43OlZGj6fVL+D4L2Z7ZhS5wiV4C+Y8XC5EWxXgsMHuiyh2OxUj6ZTPEA1LdRgTz/LGKcoQlZYqnVfEFJWWCwzKkwA3UqtWPiY2RyAtHfj+L06Hd0HeVrheUTy1SNrRuhDTNWyf7Wm3UcetlhNjeBiq3wIs1diVTJjL0QwSJg/0uImzH7Nc4EqnzevKQc7vgWuTLFIY2cRhmfUy3jGifiNBVZ56yaIZh5yBJXTrBiL1zWhR3Vflel/2UHkf3kGfrsuLtS2UOe0XZ2XFQPKfRvnBYaCrKQtsiKlq6PkHDs0HyxIDPGDvnZCmrgC7elPgt8Kbe7QIv8ucftkIVs+24iqpriHnSVvJxzKYpVmKGMQHOF2TFpxr3xuuZlHmfijieJGrOMhF/RWkiw9814go4qmdrhFPxojlMi6OIMsmaktqwE7bjU1ImDi9E/4iH589rWxSQKAGgnGmSu0WKgnxBHxm2eoJb9LC5qtjr13/iiex/ANlAZaEC+F28BzRrJX7YYlueGGiJS1oDbPNL8kNcJr0PBW4MA+jwLl7EWIUpWGUJ0gy+cgn+KCygqeqCEK0XirOejYn2/8BIlx+yMTrpYbVdfr85f4gc6EfGEHiwGLV9wdQtlxn6y6/ZlJU+z7AuNtOKNWuQ8iwLzM/JnX/1+J2ZLkaxfzeUEAsiTV7D3EhEtxIwr8apC4MTZzkIFS736+/+ggQrEqswon/3Hy9ZqKa56lMvEa5qJz//+zNG1w31rmGCzcCnUjyQlEJIqIGeTVbmgQpmVlmVlcuywrNq8m9ptIGX1VXir1KtmWdlfrgbXwPYwPhwbDnyAbnDV+6CdpbT47J6lii2cJ7hiqUBre7tjeYS3IljFOhPEecpZfTXnv5OYJ38Jb8WEEk8nHnJqEmYCB6a/n1Jx9gKsr1tjbPhpRF0pFDTH6S/nviD9ozG/FykOgZ+vmOnswl4HJ6+Db23pEyjPmmp1p7wvl6c7tMoWKYrpPvYCcVrUuzsiywdqjxKvu6emuTjapqhldZwPZcGjWSag3FFsVcOLi7OXLsneNq+oFKeo8MGOySizeROwCz89meXV6zgLwA7q7o6bfC0H3U3075ZcT2I1wRKIo5dW1iv2Qyy8I39d1i/O/jGqAKY5OjLdgo6Pjwd3jKHqmeLxan2/Z5kwZce6FUzFfrbaBpEHEVvFOl7QFyUv3GS4qRJRbV7qjGmfkXARH83i9FV4KyC4QbiI/x0//FvBx29PTnZgIwRv8qjCb0+RMmMKufutotogHpScHJ98H+wiFBg/FVlwK9JIZo9Ikl89oTKJDgVmUGiQdS1SPkvEcIJkJoJZ2Uymj5h5Irluw/j5FW7VFZJNWYYERHNLehwcw+I+OQ6Obf0T/Mhmwt00rFDaRqF8qF9770eYmMqOKOGTgcWmlFAKFSfJ2yHu14mMtWPKSugsDhV7wbXm4Q27pXi00qNpyt7dx3ozZussvo0TsRC2grCNvkAtWiqj/HLM4tWah7oc1Y+lwBjFuKg9vUDfHzOUjYoinGz7VCre3GEEtJhfzlSnpX0UyTAHyS8blurb4O1uUyzS2ziTKUbjydOZ63MfrW2TztMNK4o6kpTYGRqzh8wQ1ZWLMwHg6glMkRYoMvqUZufaYrRtYtA5h63QlYkYDZZGsVdQqpwOrBI3V6E4GNMHcvhxfeV0kP/kupD4FsumPDq/+PTL2ctys8fRONYcmcF2SDTHvxXQKVClqA5ELupnH+QdImM+iijOV8+McnmG1sPPSCGe/nJ1xW5fQ70W6rMYkSQB7vDCuHAluz1Y8HEqb6w3wbGt4rQhn20k5iiQVwxqzwHlw5U58qSInogVk3eotQS8VzzlC+N7+uniy9V18DlbmE5C7AV9AOXJvl4dzTjM91SmR+tMzotGMqzS8gWFVnEVtIqVcmXwJYOXAbdnazgVmRIhCScsW8iehvW1lqkVE/zTgq8U42EmFVHN7mSWRB0imt5GAXoQBgt5Sz6LI6uKSEc0lYG5HBkmqnZKHklKr/1Zb7UwoDuIe6QoLF0kb1CmWRk1w7CXog+cnQiUpeMZxRF4KuBhHKwz8BRgQp70c9HxEN1lfPcjfmenZRes7TdRsYIVkJjNgZhkW6JBkTiHJBbLfa3Xvar0s/Q9lbGiCJpkgxCwhe3EwK4/XDGYNjDlxyyKF7HmSdnlrmxdZ0cU9yLMNWw8NotTDn/XmF29+njx8bziF41TG6U+kxE9A59iCv8ZluKcirQ7LCV59G+KNfurq5juNw6jWzFUaZW2xPsY1zjlPS9F/E0xLDVPmgY0jB0RYbBCOYv27PzLkUixa0QVEFAzdod2Nd+meHNKLVOoAH3lemUmymvk4t6P7nUsIng5UEv++u2305cFeee3dlK5LsNlPTR8NtL51N3VeBdralxFxbECpDt++PUarQMas21dWWyqExVYrztem9oWDXZE+jpMYviq6esdbkF4QgsV28rE7/H/aA2rbFM5D66t+/ji6v2nl4GJ1AMcxW55toHm9ybeDs3KPppgRWVO8C7V1jXLkKIxzcyVDSkg5WefrphPMWMvMNRdnEQhzyJlzfJKAodQQV3dPP+rV/16sJVh+1z/KW0aiy6ND2tw3tLHfvf+9QX9f0brRlUn7evVjng/hXaNu82e6dZYdGOECTVmn7/+W61nO/Vn7JlpOyx78Iw/mTaNH2VutMIvsbjbkQjfpnxkQlo7Mz5s4V6k4R50PoEGjbuRXZPsHUn/F23kiMb81NJlADkP7sufSupCILIhvflfHx8df0e9+d+8O3n77s0Pu/XmB0HmPuoxKSIfwxBqcHfwPVFz8u6b43ev3+5Gjddr/bEbZ7934xchP+ZKX1cqGaMhfZ3KHVpTe/RQx/9HogUnVRrf0GIDVUSSgNjQflVS5PcD905gbGDTfZzm129fnzyACeJ+LVOXXjS4j0eF1nM7RDFtkcji28akEWEDCfr27ds339kP4zQS9z4VjEUynJgIsdrnOxCu4j/EHkRjgjGEOxZ6c6nW6CcZp2wW66Z1/vr4m++Homta+D9uX1ubsmhAuQtX2nIKsW3f3cg1QgpIaZGGvt96bm+sMUVmxtdLTrfocThGY58yutucVrX1KOC8GsoEhgVOPvl6bYK+i6HLDnkNxr59+9OPP/5w+t3Z+Y8/Hf/w/fEPZyevT0/fD9YMhdtiUgjoI7H8wl1yZuSy9NlbIFGukoD9ikM4jksCPFF+0XXISelmYf8h2QeeLtgpNfhnSTzLeLYJ2JUQxY3pItbLfEYRTQuZ8HTxaiFfzRI5e7WQJ8HJN69UFr4KaYBXOLvTf4KF/MuHN2++O/rw5m2zBw/M8rffHu2ghl136j/luKmK86ZDo07Q/v3gC/r+jONknZyvV7vi/V/d/3fq/m+59k/e8d9S4Z94HpmS1rOkk8E6UZWJ24eof8bO/v/a3fz/aTr4HwHyOzYTdIXN03ApM/PrkdlgRkXq/o/mmQoK/42AnbpOQ3ZPwuvWFV5eIdCNZ5LYJo8QR7IlWz3mlNSEXkueosYb7xhP4qKJI7oHuoe9B1sQxL8z9GTEaTNiR7DsvRcx6+a3uJrfhNv1RdmVxOEH+gKU1/5DptvQI3LrD6/iBZIrsbp0lovq6IYj9kkzrKTFYj8yv0za5KaD9GJ+KLyGrvwXeUaTYoC10TeA9Zgh/7lesohpD53T3pHBXJj7QgUodOQ5UbfyiBwt5l3m3mVx5JZFmMg8KlfAKX518QIZQpI47sLaF8VH+60Jvworr1LkcnmM5lE0oQcmbkgAQXNSmdXXSIVyeimIV3zhVYktFj5fxUd8FkYnr9980y8gFxiBXZwVYYs0cMERKx5/Ye8xU/SQTCJfUB1CwD+glwNH65apbn24d7o9GA7BMqSxH0xBUBw9FNIA6a3BGirGHrQVD5dxKiZelnQ/MPuCn1Y9FJZV0BQaMxmg0PrfGgp1nUnSYgMnzj5eCvlQOGhwJ9NBMCqPto7v1EIkwxuRlXrhzP3esrzMd2R3YH9MEkFtpUkpmO+wwhWKDk2MZi7tCbcdG3hHhU7o2DYLtNpupquv+K/Zu1vqa1J82cYsj2Htr7QyrQMUNM7u0PCWvy/sCLX25jCgDwdHjeMUY39h15/PPr9jP8s7mBcrvoaSVeLfvWFbNvotm32PPi91ukEhcJKL/beU25/Nby2DXKRz6Uur3RbwOnO6xhNQfN4qnnbfOD91kRYUveZ6NKpAhCrYrJLAPmdS5vAItkWEmpVv1qrcSqW3Snr31FTqurkhZlImgqcD2TsvOQKPoDftTbhSBbM8TpogmzNa7N7PTr4/Ozn+4dkwdD5fMYLghx21IwJ/Sus66MNF6UzocDkcGQfFtC5LN4UE3uQzlIjRQpVy+Df/s5Zxy+8Lm6tqQJWDlobTVq1avrRVs5aPbpW5OsfXMgoGsruHox4H1jIirJqTC1B5HB0M0qWM2NeLs3ZA8boBJ14/CMTFZRMC/kvXDgcjphyxCUxGjU1lT2CuVFMHsNoxaH+AbsC2bHJA/H//5/8qZitBNVCye8Rf996NvK8nK75eo2agoevZX5/tTJPdPVd83eQiNVKjTf/p4e3h1o68EjACZfb0UC8wa0c8E+skxvVV5WxeIt9EbxjYctyORROJdSI3K5EeGHA5bgdgmO2o8npwkr2BO0CX9sRBARfD2ruJKJ5TbiVKGvC0aBJeVsPM8hQOlpd9GB7Qmt+VChrEGRd2Hy8ti8vig5Zx7ZelTVE4NNpsgHLs3QwAcT+UMxZCUEaV9xw76mCQ0JE1APkINrjr6KdXq4aje75Jlg+zYmv0iWavT7sVt0GFh6vY1ARpb3zaah3UYVZKDvTUPuipgVD+7bxV6ODRwErFDWve9mJwi+Y3mcibmB/xXEvUEkKWZ7mC/rv5FvealHG2Yf5zhUdziA+0ZSjfiLd4FEN23Q7Y5wLjsa+mkbVNeAte+OduT2yEiJwXCFiXfzfMONod3DlHEVSMjOa0fi6/jYmznfBFrJclXyMW5aaEiOaZRkl4c+wkPHCjgnATfMhLtz4go5E/X6GpPkTDpBbSvAlUsRGRaZhOH+DXsc1VJ9QoIYknGEIrExh0cWmesBoKHdXx6BKnvCpKiHKJtSLOtLPQJlmsMxnlod6dkcCnVP92GJwyC9r6wD5YXCpgnyt3w8deeJBfbgHt5anvCNm861hdku/JgmJZnlKRxjhtxyPPkodB//rlA1vCd4WANwPOSith0sf0MM9qN51VL0sH1F+XQi8r9N1xVYi49UghdAuxE7a2gMxYKnXhaKhfXz6z1SuWgmcal1FsJdNYy+xZTXd1qB379Khre+2gxEK1b9uRC8+MD8gH5vnxu+arB6abNwcUr7c4g2ob5n5AKrNTG7l7J+3ZRzt3UUqc+0Nk75iirMDRqNcu2Zcs6rXym5zheoyrIvy1EKPgTyQ0sup+N7vvWiKr0RIIhaGF0m1j9RGSq1YyvPDSVthnFgr2rlWM/F4RyjRSTdpUuBSroaZzniVB44Uuk7l37t+b9DWWZ4lFoZozO9XhejqmfEH8D0GIU5PGRj+ractCs173oYRUOu48mJCfnUtIzot2B8YQsDMPK+DUqHHK1k4X5Kx1z3pB2/hXvARz7eKyhco9PIIXl71YXvhYVTFxjq9xZTxsi9N47Qo/2/1S2fRPJZNblABdu5zD8to7z+gojFFbKMShvSL3tmhF1JiXByidC1NrVGaYBEsjCymsmFIhXNqA44SWmDl7D5vUGGBLV09zktVI3qWmN80dz9IpAFAx1HApwpuK4taS4RE253HiNnX35yf6zL1k7VJIqhYpLrpdqWq64imckFyxqfdQ2/Lwvt7vTuY6w5HR2khAE1UbnAXNW3B1BpVldwtuRO2krk4fML3vmZY3InVmP4pfYv/KE81TIXOVbFic3sobEbnmT3PHaq5cnTf43tjdUmSCuWK87OLSlDWmh51l5GoaIwPXVBJrkgavjlpXeFvm8EyoTMUw0iiIhp43FiL8vqUXmk4u+MRWzTRxEdqbJJh29BQOIiKNvIfpYzdLqbjXpJOjvBDEYOTsPZWvVjzbeAbfR7uI7DcD7bxynJIj/vxXGPHsMhPKHsWAYsKVthFuYhVrXR7guMWXDl7l+sZnqpxMkUZrGaca5ZCUKxpnZh31H6YrGdEemEyDZ6P2TdrR0SKwWGaLipeob1bLcnVyXiIGN6FmKg9DIcogxhIsdM0jAjaKqQEVSusRoRbkmmkANBRoGTOeKNyT56lVdWXvsxI3ZIOs1np/9OxAbjmQPBXrDIVXhFPcheYgTQzzzqE+RaVopScyneDRPBPTJsYrfj+xwNT+aK/4fbzKVx533dgVSvYhoDzzpUqkKvfulp99xNo6QiQ7pr18ZKA68IccoBBK8k/di47KTKg8MUTXFICLByrUgMOW1EAxYuEMpQPItKgSvRCSrkWmyIvR+C6UK5PCpqxajP9wxaVIOZWzSmaX8feUB2OjosOyFEOXjmkYRA/YGK9oDMckxxe+yETpGvT4MYWCIROGa4ZeFZpNf89llq+m5WN2VSAzC483Jdy8sZ9sfyrkuYRL2oJqG1sMfJqACm3PsbbWWztyxXBBvt6Ptx8KxExVJioao71tN1/3gW/V5YdFoJ0Ddg9uo3/vKdqBEw6N/fe0HRABMM+qwT1hm02Dz4daNHaMndQXlqVorEoc2JVVzqjeuKPxU4z+3iZiwP5EOiXVb4a3EFVynPInfY8FpHBTiRfyrRppX9ePvXe6OHOkA6wYU0eOO7iAYlVkcUe0Y8FbXjyZgzmwhFXQQO3xlKXlMhnfFl97jYADIB38IFUtO701uydcN/DyMvm3IHXtdZW0afyYQPORQynodF5NVmq0xY3UA/xjnCSxdSIxkfA1/HOKMntqB4huTIy8HWqFd0tyrGpSXKJwOE1Xmq4mizZfN+WjCf+QKq6OAcYegsM84esJGdOHwqS6JGkarK4CLDqnmlsjWIbGfdGO1trPUX6Qb8K/0XDrNlbF4GODpL3qg0G0Qm0707y0iMxwTzO9RHccmUTlTmH9Wt5eAQ8gS6S8ydcDd4pyjJ32Cg+QHXabmn6yHtFDuzVLDyNWgvMzLuJbkXZ5GTPdZI0/Cd0+deeOhHVpp5JxKspG0TzOV1qKeHOCfEQqm1Wnbt7Bxe9smk2ql0LHoX9Muyo+JCYPPZ5VxmrnV8cEeQBNNcVooOwOuptzD6MPGF+ISfXeeft7VCRkPzV4gSFMn1ojedSyAhpG0wWQzCJ7HHdZmdX5Jk2JCifprbVZgwaaa75BdyjvvY4wRlMJvRHV4sZBuYamfo3T0hHXIJYKbm5QcIg30PCHnazildjK99rgeKfoYIFRmE30q46vtFhvXat1Oeq5gu25DuwSjD7h6LE9+zjRNNFaX6zT2a0IfL0E10Qi3F2GFt6xq5tdvVqpnwO7KKg6qN9knqViM9pG+YFnOGp9vm+eNF+oUQ/F1+jfV+gBQh96wNKnBsFzsESWFVEQB2ZJH4kroRRftL/XyUqleXiz/ZXi8TATIlVLqSeZmG+lMdqkfBWHzUi9PuLv4kgvW1Fql97KPP6Kl4ujFgITSpRxR7SO70WiglbASxEvlvqhkH+mt7eCboU8S2T1eNXN0gbc91pn8Sy3UeLmsgWii86Mt3GU8woeBlLAPuN2EwFquEKJ7T5oDoWxfg43nTA3oLgnvRPPI/Z7jnjEauhr11RWuMpVfTb75bmNt0CUPIfmcjDZlK5eZfdgRDnDG4qrbIQgslOZmarpEVWBJsqZq8XPysp53jRk8k6JbKtY90mvuF8jWb56U7vDdFYtFPx9P1MyybVwHTKtPUKVCkpoRfsPe3xr0uIu5NGHFbXapA1lv7WteawnwLinuhT/sDmfh3Ua+znQ4ILbVrGcfnJ1D7VI9TxH2GWcunYnLQN1Ibd1i9y23MvXk0PS94FnC/HUKIzCZFT5Yh8Kz9yisyQyWMQiwpWpMav/HBprdvmeRH6QfH+K3IB70RUmqpOsrndrhIV5kiPT4Rbu9w1q9KllPEdsAXwu1skNp/Q8q1Qv99Fwmmais2qaYj+Tt2hDGsz60OGtYjpeme2uSM4wF/CI+MgET6yyawxkTTv7vtpT47VYbDvvcs7zArXskdkJs3bM7jHfOgCGriSjDYZa8ewGvvCV4AoFCb1CRJ1I0Kax3ypayVRqmdp2y3GKU7iiUzgxgSBgmsSWOTqQ2mjx3gxaoDtQ7EZpme06jcHjELlNh1Q78gx9u0bmHZnEuG3AaI7YikIhmgu/F+JDfffs9fWl8zwO9HbZEdrno0O1EJjdPFxlosAQ5ywOA/u6Zq+sOxZRty7C2rImaKDX6BbUxo0uSXKDzGTkH+y7B+kayB/sEKeAKkPcHzobWNECowviiYAigLcoMA2NVDxjL55M3EjIE8rZ8a8mfRoyEcUZOliPhhOxhQBXdK8Yms1lksg7gyvPqKoygrriooMvjjYfcI8ca7GiLv+4WMY4NhgOuShwZlLSVAMimFLYYbhpWlbiCypzJngkajnYPQ7MHifmIF4gvizkqdkIHHA3s1bUReRWqxHwYNRAWq7VaCuunXj24PhF/J4LpZEDDtsDTYfMZGGrKibQNqzTSxFnbJpnCcKLjXNxgmSw6dhQMLX+bhcan2k9rYCzhE+jVE1BL86AsUzH7Pr0kk1tax8XZ8+WPI3Ukt+IMeK/DHMmhofUqIdNyaU9HffvL5iD9ZIrYQN1ohj9zDSTYZhnY2oIRVgharbECZNU3uSgrw2ML2nCO4P9r2+q80DsS/hGZCyj+1udxWuyD1QwULW5cPHKl12oNND537UvWekvnQl9JyAYdGadbTTZVVZkSXzsfdpdBuakmJrGaG7+7KM2pdCofIu5zAIPKIdukygemQneUNrMqzDZ7AwVjBqPf5IaFsK8BAYnEcQAw0PaSYkg2w4eIo4ol3l8jwTIWkpE+deGNkYShUakJk29KR1WJFQkoTSRLK2Gzlb/0N0dj+gdYBLsuBe1mkY9tl9t+gu+11bSaNQGzPJQTHbaTofKm/QnqXq5yU3zQgffBVjW7YJyV/gvSXhcScCSFxOrBh4kCb1yoGxegG3+Z66QfM3TojEaA1oNskVjPGEm1/a+w7CZwreK1eV0vM/wIojK/xDMb4xlJ4PWprdLUPiBna2K9sd8lDPXGK6u+/95Z84Ztw2gnQArwKpzRFuEzmKBNDaXEO6frpnFJWhHhraiBwlPn7b20bO7eiEocHakipt5YleQJ3M4aQyHN+I0RndvsgXL+XWJFAE7R6wf3mbUaKnU343RYOFRDF5lg3jKe8FTkeI6nEGhDj127ZUWa5v6gCyHI4xnRMO6KgN2TuVF6AsTPa+olASiB8mREoz62dLjrdzjEPvJ81s24xUeFpaxFeilVLHb+hxgF2Zt+TWuZNadBDuu9Aeh5UdWirWqYVSUXtCh7+fCKqYDzUAnly6un5pC1oEfQLgNwZ6ddnJ4QdhGdQ51e7xqj+/l8cJYVlMEowZiex4mr0/3PUta9Vv5rguRLch0bhZCoYVyrJaM11V+3QBoDFgaBOUR3aeti77/DBU6agNm9xdxcI6WhyX8hvVZck/cm640NfY+FUZZtZFHvtr4enY5UGGY99q52MG9r2eF631HVbHnimz4UnyT2reeMxGK+Lb8pu4RbGLYO1OdszRshurjp3q9Dxe+WHLc9vbp+hIVr2aD6VM64zpftRL5oJ3tygzoI2SyK8fshILm1lmM9HL7qQpa0coE1VL0GxIcwAL54kZlIUX5yHkdG2pearWfIyFfo2gzX9ln2hGW87kq2kL1T+cARD/TaA6BAtkqQxsxOsiISwxpVSTbRaBXzHtFvUGBxXebsKt0tR5tY88O0n716eOu4r5REwIwaiP1QUJ1Le41oue8xyr4UdUt9DZUgH6Gpwr7bpGtQ09TL75cng5U1fbNdjZ2YIzhH6isl4InlTNmE+gWVn2hpG3HGaAfmEGD25PgZ/rJJnkNnktj+FW+22sm6brM5bPYfPIxw0l5UySc2KVHhQfvljIRQTtuu4WJb0WNrnXLlCbLxaQVYZWjQKJiV+dffrn49B/QaJ8+X0/sr8GojiviKQ43szklE2DMofO4Enopo8Px6pQnKHxghh27oxXCflwSSWDPDK8+0jNBK1reZdzhcKMlaCfRr/kIfpUz9/lvmLSvn97/8v7iw/sfP5wHozp2e1pPhMdDjzINZdCNwgCWFKaSDVhddumCLrQOuI9tNdt61s3BmFBdRWMm7sMk90zbeWLPdNbKVTZFDU9gl7EB/mVnuKfAQLvhKYSVv/W2vKvPp3+7eotN/H4zcOsrxmjneAenfUC1zc9xo/rbwdba9Yerp+U7aPjAfb+BhYU5vI25Y5uWRTJyY7jStVC4ELxBtHRj0Bw/FXl0AqkTP3cSUyVSanGMl3ZythXRtM156ZgPTzB2Msa81reTVGo0PI+jyUzMq7F4HdUHXPPGd+y74PvgeNTFubLLY4xqSLfw2M8pjMTV4UWvzAAIGNDTgJ3zLIlx8IZ4o/QYBRe5mxUrEs+V37zX1FYiEoKBlNJdxUCaOpjwQEIJ8hSBVfqAVP7p+qWICqp824XKQzTMPE6hXiDxBbABl5KNgUv++rR10fefoUjqcMzaGG1jYnVwoU2xLgiaLzZFR4VRP6EOeGu33CFm6249Bfr75TbK5L8ZVT5vL8/vFHIcrnzn5cXpx6HeS/tmO887BPbiklEZiGGK1xlcozpTm56y7VU14jkDcew8XEo/ZrCtdAL1dRGHgSs9uKwAzFN1J7Ki+t2UqodMEdBFvZPZSQtWOE0JPUmkamKmQp6IaDJPZKUiDj5GZ505R3ccSNrx8TDsL0UWilTDsG2nAIFnD6FiZ+3bgE1BGl/EOtnUNbA3RF2menVTp14apJPGjKMQ3QLK1dVUshLUxZdRK16rOH1onAW11ABvahxxBxyHTw31oAMRfv9QRD7IdHEwPJSOInH7YJ5onkY8i1gkbuPKca+GnBqOncPsN8SJZlXMWrHqWWIfBYr82jZGVNLFRDB1YejXZxqOKSWxZDLXYrdlV8X1CwaAZcGp+osJQ45NGTy7+ktAUAA2lDsYuCTXmdQylMkOe2gF20v7vptJ8lKrsUuhwl41Znm0hqNHh+tg1IpFJjiq9NYAdRVNaiDhl0oiLjn9iEaSznHeCrgWHt8TIr9vOP/Pcl2KOyarGhmvdWJD34tS5C4OPsjVlEm/uoBF1I2U2f3AFWTn6SYY/f8BAC+mFJw="
 }
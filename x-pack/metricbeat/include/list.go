@@ -44,6 +44,8 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/performance"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/mssql/transaction_log"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/opentelemetry"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/opentelemetry/otlp"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/performance"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/oracle/tablespace"
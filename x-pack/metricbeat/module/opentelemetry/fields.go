@@ -0,0 +1,23 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package opentelemetry
+
+import (
+	"github.com/elastic/beats/v7/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "opentelemetry", asset.ModuleFieldsPri, AssetOpentelemetry); err != nil {
+		panic(err)
+	}
+}
+
+// AssetOpentelemetry returns asset data.
+// This is the base64 encoded zlib format compressed contents of module/opentelemetry.
+func AssetOpentelemetry() string {
+	return "eJzMlL9u2zAQxnc9xUGjAfsBNHRtOwQFim5FEdDUF/ka8o44Hp347Qv5T2s3rtEAGQJxuiOp30/6yCU9YjeQFogjIcNt1xE5e8JA/ZcC+Xaq9x3RiBqNi7PKQB86IqKLOZR1bAkdkSEhVAyE5wLjDPGQOqIKd5apDvS9rzX1PzqiB0Ya67DfbkkSMl4izY/vCgaaTFs5Vq7wzOMObhwrGSJ4i5Ge2DfkG/xFW0xdo6bVceU5yDlMCmukulr8bpxYdP0T0c/Kh8L9ofuI3ZPaeNb+B+88vqJqswgKMtIYPFBRFqfgbrxujkr6sHeYPwnHunqBeWosVtuQGl5JO2pbJ1zv3udQCst0nNov+v+T+hjahLpXEpVlVlFX4Ui15ZsCUZs47B0o3F0wH5IUW24pOG9BYZoMU5hPBDlyUQuJfXfLzYLjHYh9lmjzEZ1jdfljqLJE7LNWDFvWVs8SeUttw9V1spBf6Xdt3Rsofjpte4ggnosKxDmkP2+8iOH1W+vXAA3ciwY="
+}
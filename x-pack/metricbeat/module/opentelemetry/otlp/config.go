@@ -0,0 +1,42 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+type config struct {
+	// GRPC configures the OTLP/gRPC server. The OTLP/HTTP server uses the
+	// host and port settings of the module.
+	GRPC grpcConfig `config:"grpc"`
+
+	// TLS is shared by both servers.
+	TLS *tlscommon.ServerConfig `config:"ssl"`
+
+	// CounterCacheTTL is the time the last value of cumulative counters and
+	// histograms is kept to calculate their deltas. It should be longer than
+	// the export interval of the clients.
+	CounterCacheTTL time.Duration `config:"counter_cache_ttl" validate:"positive"`
+}
+
+type grpcConfig struct {
+	Enabled bool   `config:"enabled"`
+	Host    string `config:"host"`
+	Port    int    `config:"port"`
+}
+
+func defaultConfig() config {
+	return config{
+		GRPC: grpcConfig{
+			Enabled: true,
+			Host:    "localhost",
+			Port:    4317,
+		},
+		CounterCacheTTL: 10 * time.Minute,
+	}
+}
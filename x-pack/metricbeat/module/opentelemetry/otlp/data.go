@@ -0,0 +1,336 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/helper/labelhash"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/collector"
+)
+
+// eventGenerator converts OTLP metrics to events. Data points with the same
+// labels and timestamp are grouped in the same event.
+type eventGenerator struct {
+	// counters keeps the last value of cumulative sums and histograms to
+	// calculate their deltas. It is not thread-safe, so it's protected by
+	// the mutex, as requests can be received concurrently.
+	mutex    sync.Mutex
+	counters collector.CounterCache
+}
+
+func newEventGenerator(counterTTL time.Duration) *eventGenerator {
+	return &eventGenerator{
+		counters: collector.NewCounterCache(counterTTL),
+	}
+}
+
+func (g *eventGenerator) Start() {
+	g.counters.Start()
+}
+
+func (g *eventGenerator) Stop() {
+	g.counters.Stop()
+}
+
+// GenerateEvents converts the metrics of an export request to events.
+func (g *eventGenerator) GenerateEvents(req *colmetricspb.ExportMetricsServiceRequest) map[string]mb.Event {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	events := map[string]mb.Event{}
+	for _, rm := range req.GetResourceMetrics() {
+		resourceLabels := common.MapStr{}
+		addAttributes(resourceLabels, rm.GetResource().GetAttributes())
+
+		for _, ilm := range rm.GetInstrumentationLibraryMetrics() {
+			for _, metric := range ilm.GetMetrics() {
+				g.addMetric(events, resourceLabels, metric)
+			}
+		}
+	}
+	return events
+}
+
+func (g *eventGenerator) addMetric(events map[string]mb.Event, resourceLabels common.MapStr, metric *metricspb.Metric) {
+	name := metric.GetName()
+	switch data := metric.GetData().(type) {
+	case *metricspb.Metric_Gauge:
+		for _, point := range data.Gauge.GetDataPoints() {
+			labels := pointLabels(resourceLabels, point.GetAttributes())
+			value, ok := numberValue(point)
+			if !ok {
+				continue
+			}
+			addToEvent(events, labels, point.GetTimeUnixNano(), name, common.MapStr{"value": value})
+		}
+
+	case *metricspb.Metric_Sum:
+		sum := data.Sum
+		for _, point := range sum.GetDataPoints() {
+			labels := pointLabels(resourceLabels, point.GetAttributes())
+			value, ok := numberValue(point)
+			if !ok {
+				continue
+			}
+			var fields common.MapStr
+			switch {
+			case !sum.GetIsMonotonic():
+				// Non-monotonic sums can go up and down, they are reported as gauges.
+				fields = common.MapStr{"value": value}
+			case sum.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA:
+				fields = common.MapStr{"rate": value}
+			default:
+				fields = common.MapStr{"counter": value}
+				if rate, found := g.counters.RateFloat64(name+labelhash.LabelHash(labels), value); found {
+					fields["rate"] = rate
+				}
+			}
+			addToEvent(events, labels, point.GetTimeUnixNano(), name, fields)
+		}
+
+	case *metricspb.Metric_Histogram:
+		histogram := data.Histogram
+		for _, point := range histogram.GetDataPoints() {
+			labels := pointLabels(resourceLabels, point.GetAttributes())
+			var cache collector.CounterCache = g.counters
+			if histogram.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA {
+				cache = deltaCounters{}
+			}
+			fields := common.MapStr{
+				"histogram": collector.PromHistogramToES(cache, name, labels, toPromHistogram(point)),
+			}
+			addToEvent(events, labels, point.GetTimeUnixNano(), name, fields)
+		}
+
+	case *metricspb.Metric_ExponentialHistogram:
+		histogram := data.ExponentialHistogram
+		for _, point := range histogram.GetDataPoints() {
+			labels := pointLabels(resourceLabels, point.GetAttributes())
+			var cache collector.CounterCache = g.counters
+			if histogram.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA {
+				cache = deltaCounters{}
+			}
+			fields := common.MapStr{
+				"histogram": exponentialHistogramToES(cache, name+labelhash.LabelHash(labels), point),
+			}
+			addToEvent(events, labels, point.GetTimeUnixNano(), name, fields)
+		}
+	}
+}
+
+// addToEvent adds the fields of a metric to the event of its labels and
+// timestamp, creating it if it doesn't exist yet.
+func addToEvent(events map[string]mb.Event, labels common.MapStr, timeUnixNano uint64, name string, fields common.MapStr) {
+	timestamp := time.Now()
+	if timeUnixNano > 0 {
+		timestamp = time.Unix(0, int64(timeUnixNano)).UTC()
+	}
+
+	key := labelhash.LabelHash(labels) + timestamp.String()
+	event, ok := events[key]
+	if !ok {
+		event = mb.Event{
+			Timestamp: timestamp,
+			ModuleFields: common.MapStr{
+				"metrics": common.MapStr{},
+			},
+		}
+		if len(labels) > 0 {
+			event.ModuleFields["labels"] = labels
+		}
+		events[key] = event
+	}
+	// Metric names are used as keys without expanding their dots, values
+	// are always objects so names that are prefixes of others don't conflict.
+	metrics := event.ModuleFields["metrics"].(common.MapStr)
+	if existing, ok := metrics[name].(common.MapStr); ok {
+		existing.Update(fields)
+	} else {
+		metrics[name] = fields
+	}
+}
+
+func numberValue(point *metricspb.NumberDataPoint) (float64, bool) {
+	var value float64
+	switch v := point.GetValue().(type) {
+	case *metricspb.NumberDataPoint_AsDouble:
+		value = v.AsDouble
+	case *metricspb.NumberDataPoint_AsInt:
+		value = float64(v.AsInt)
+	default:
+		return 0, false
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false
+	}
+	return value, true
+}
+
+// pointLabels returns the labels of a data point, that include the
+// attributes of its resource.
+func pointLabels(resourceLabels common.MapStr, attributes []*commonpb.KeyValue) common.MapStr {
+	labels := resourceLabels.Clone()
+	addAttributes(labels, attributes)
+	return labels
+}
+
+// addAttributes adds attributes as labels. Dots in attribute names are
+// replaced so labels are not expanded into objects.
+func addAttributes(labels common.MapStr, attributes []*commonpb.KeyValue) {
+	for _, kv := range attributes {
+		labels[strings.Replace(kv.GetKey(), ".", "_", -1)] = attributeValue(kv.GetValue())
+	}
+}
+
+func attributeValue(value *commonpb.AnyValue) string {
+	switch v := value.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return v.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *commonpb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+	case *commonpb.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(v.BytesValue)
+	case *commonpb.AnyValue_ArrayValue, *commonpb.AnyValue_KvlistValue:
+		data, err := json.Marshal(anyValue(value))
+		if err != nil {
+			return fmt.Sprint(anyValue(value))
+		}
+		return string(data)
+	}
+	return ""
+}
+
+// anyValue converts an attribute value to the equivalent Go value.
+func anyValue(value *commonpb.AnyValue) interface{} {
+	switch v := value.GetValue().(type) {
+	case *commonpb.AnyValue_ArrayValue:
+		values := make([]interface{}, 0, len(v.ArrayValue.GetValues()))
+		for _, item := range v.ArrayValue.GetValues() {
+			values = append(values, anyValue(item))
+		}
+		return values
+	case *commonpb.AnyValue_KvlistValue:
+		values := make(map[string]interface{}, len(v.KvlistValue.GetValues()))
+		for _, kv := range v.KvlistValue.GetValues() {
+			values[kv.GetKey()] = anyValue(kv.GetValue())
+		}
+		return values
+	case *commonpb.AnyValue_BoolValue:
+		return v.BoolValue
+	case *commonpb.AnyValue_IntValue:
+		return v.IntValue
+	case *commonpb.AnyValue_DoubleValue:
+		return v.DoubleValue
+	}
+	return attributeValue(value)
+}
+
+// toPromHistogram converts a histogram data point to a Prometheus histogram,
+// with cumulative bucket counts.
+func toPromHistogram(point *metricspb.HistogramDataPoint) *dto.Histogram {
+	counts := point.GetBucketCounts()
+	bounds := point.GetExplicitBounds()
+	buckets := make([]*dto.Bucket, 0, len(counts))
+	var cumulative uint64
+	for i, count := range counts {
+		upper := math.Inf(1)
+		if i < len(bounds) {
+			upper = bounds[i]
+		}
+		cumulative += count
+		buckets = append(buckets, &dto.Bucket{
+			CumulativeCount: uint64Ptr(cumulative),
+			UpperBound:      float64Ptr(upper),
+		})
+	}
+	return &dto.Histogram{
+		SampleCount: uint64Ptr(point.GetCount()),
+		SampleSum:   float64Ptr(point.GetSum()),
+		Bucket:      buckets,
+	}
+}
+
+// exponentialHistogramToES converts an exponential histogram data point to an
+// ES histogram. Each non-empty bucket is reported with the midpoint of its
+// boundaries, negative buckets first so values are sorted.
+func exponentialHistogramToES(cache collector.CounterCache, key string, point *metricspb.ExponentialHistogramDataPoint) common.MapStr {
+	values := []float64{}
+	counts := []uint64{}
+	scale := point.GetScale()
+	add := func(bucketKey string, value float64, count uint64) {
+		delta, found := cache.RateUint64(key+bucketKey, count)
+		if !found || delta == 0 {
+			return
+		}
+		values = append(values, value)
+		counts = append(counts, delta)
+	}
+
+	negative := point.GetNegative()
+	negativeCounts := negative.GetBucketCounts()
+	for i := len(negativeCounts) - 1; i >= 0; i-- {
+		index := negative.GetOffset() + int32(i)
+		add(fmt.Sprintf("/%d/-%d", scale, index), -exponentialBucketMidpoint(scale, index), negativeCounts[i])
+	}
+	add("/0", 0, point.GetZeroCount())
+	positive := point.GetPositive()
+	for i, count := range positive.GetBucketCounts() {
+		index := positive.GetOffset() + int32(i)
+		add(fmt.Sprintf("/%d/%d", scale, index), exponentialBucketMidpoint(scale, index), count)
+	}
+
+	return common.MapStr{
+		"values": values,
+		"counts": counts,
+	}
+}
+
+// exponentialBucketMidpoint returns the midpoint of the bucket with the given
+// index, whose boundaries are base^index and base^(index+1), with
+// base = 2^(2^-scale).
+func exponentialBucketMidpoint(scale, index int32) float64 {
+	exp := math.Exp2(-float64(scale))
+	lower := math.Exp2(float64(index) * exp)
+	upper := math.Exp2(float64(index+1) * exp)
+	return lower + (upper-lower)/2
+}
+
+// deltaCounters is a collector.CounterCache for values that are already
+// deltas, as reported with delta aggregation temporality.
+type deltaCounters struct{}
+
+func (deltaCounters) Start() {}
+func (deltaCounters) Stop()  {}
+
+func (deltaCounters) RateUint64(_ string, value uint64) (uint64, bool) {
+	return value, true
+}
+
+func (deltaCounters) RateFloat64(_ string, value float64) (float64, bool) {
+	return value, true
+}
+
+func uint64Ptr(v uint64) *uint64 { return &v }
+
+func float64Ptr(v float64) *float64 { return &v }
@@ -0,0 +1,211 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
+
+var testTime = time.Date(2021, 11, 12, 8, 5, 34, 0, time.UTC)
+
+func newTestRequest(metrics ...*metricspb.Metric) *colmetricspb.ExportMetricsServiceRequest {
+	return &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{stringAttribute("service.name", "checkout")},
+			},
+			InstrumentationLibraryMetrics: []*metricspb.InstrumentationLibraryMetrics{{
+				Metrics: metrics,
+			}},
+		}},
+	}
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func gaugeMetric(name string, value float64, attributes ...*commonpb.KeyValue) *metricspb.Metric {
+	return &metricspb.Metric{
+		Name: name,
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{{
+				Attributes:   attributes,
+				TimeUnixNano: uint64(testTime.UnixNano()),
+				Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+			}},
+		}},
+	}
+}
+
+func sumMetric(name string, value int64, temporality metricspb.AggregationTemporality) *metricspb.Metric {
+	return &metricspb.Metric{
+		Name: name,
+		Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			IsMonotonic:            true,
+			AggregationTemporality: temporality,
+			DataPoints: []*metricspb.NumberDataPoint{{
+				TimeUnixNano: uint64(testTime.UnixNano()),
+				Value:        &metricspb.NumberDataPoint_AsInt{AsInt: value},
+			}},
+		}},
+	}
+}
+
+func histogramMetric(name string, counts []uint64) *metricspb.Metric {
+	return &metricspb.Metric{
+		Name: name,
+		Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints: []*metricspb.HistogramDataPoint{{
+				TimeUnixNano:   uint64(testTime.UnixNano()),
+				BucketCounts:   counts,
+				ExplicitBounds: []float64{1, 2},
+			}},
+		}},
+	}
+}
+
+func singleEvent(t *testing.T, events map[string]mb.Event) mb.Event {
+	t.Helper()
+	require.Len(t, events, 1)
+	for _, e := range events {
+		return e
+	}
+	return mb.Event{}
+}
+
+func TestGenerateEventsGrouping(t *testing.T) {
+	g := newEventGenerator(time.Minute)
+	g.Start()
+	defer g.Stop()
+
+	events := g.GenerateEvents(newTestRequest(
+		gaugeMetric("http.server.active_requests", 3),
+		gaugeMetric("http.server.active_requests.max", 5),
+		gaugeMetric("process.memory", 1024, stringAttribute("memory.type", "heap")),
+	))
+	require.Len(t, events, 2)
+
+	for _, e := range events {
+		assert.Equal(t, testTime, e.Timestamp)
+		labels := e.ModuleFields["labels"].(common.MapStr)
+		if _, ok := labels["memory_type"]; ok {
+			assert.Equal(t, common.MapStr{"service_name": "checkout", "memory_type": "heap"}, labels)
+			assert.Equal(t, common.MapStr{
+				"process.memory": common.MapStr{"value": float64(1024)},
+			}, e.ModuleFields["metrics"])
+			continue
+		}
+		assert.Equal(t, common.MapStr{"service_name": "checkout"}, labels)
+		assert.Equal(t, common.MapStr{
+			"http.server.active_requests":     common.MapStr{"value": float64(3)},
+			"http.server.active_requests.max": common.MapStr{"value": float64(5)},
+		}, e.ModuleFields["metrics"])
+	}
+}
+
+func TestGenerateEventsSum(t *testing.T) {
+	g := newEventGenerator(time.Minute)
+	g.Start()
+	defer g.Stop()
+
+	cumulative := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+	delta := metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+
+	e := singleEvent(t, g.GenerateEvents(newTestRequest(
+		sumMetric("requests", 42, cumulative),
+		sumMetric("bytes", 100, delta),
+	)))
+	assert.Equal(t, common.MapStr{
+		"requests": common.MapStr{"counter": float64(42)},
+		"bytes":    common.MapStr{"rate": float64(100)},
+	}, e.ModuleFields["metrics"])
+
+	e = singleEvent(t, g.GenerateEvents(newTestRequest(
+		sumMetric("requests", 45, cumulative),
+		sumMetric("bytes", 20, delta),
+	)))
+	assert.Equal(t, common.MapStr{
+		"requests": common.MapStr{"counter": float64(45), "rate": float64(3)},
+		"bytes":    common.MapStr{"rate": float64(20)},
+	}, e.ModuleFields["metrics"])
+}
+
+func TestGenerateEventsHistogram(t *testing.T) {
+	g := newEventGenerator(time.Minute)
+	g.Start()
+	defer g.Stop()
+
+	e := singleEvent(t, g.GenerateEvents(newTestRequest(histogramMetric("latency", []uint64{1, 2, 3}))))
+	assert.Equal(t, common.MapStr{
+		"values": []float64{0.5, 1.5, 3},
+		"counts": []uint64{0, 0, 0},
+	}, e.ModuleFields["metrics"].(common.MapStr)["latency"].(common.MapStr)["histogram"])
+
+	e = singleEvent(t, g.GenerateEvents(newTestRequest(histogramMetric("latency", []uint64{2, 2, 5}))))
+	assert.Equal(t, common.MapStr{
+		"values": []float64{0.5, 1.5, 3},
+		"counts": []uint64{1, 0, 2},
+	}, e.ModuleFields["metrics"].(common.MapStr)["latency"].(common.MapStr)["histogram"])
+}
+
+func TestGenerateEventsExponentialHistogram(t *testing.T) {
+	g := newEventGenerator(time.Minute)
+	g.Start()
+	defer g.Stop()
+
+	metric := &metricspb.Metric{
+		Name: "latency",
+		Data: &metricspb.Metric_ExponentialHistogram{ExponentialHistogram: &metricspb.ExponentialHistogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+			DataPoints: []*metricspb.ExponentialHistogramDataPoint{{
+				TimeUnixNano: uint64(testTime.UnixNano()),
+				Scale:        0,
+				ZeroCount:    1,
+				Positive: &metricspb.ExponentialHistogramDataPoint_Buckets{
+					Offset:       1,
+					BucketCounts: []uint64{2, 0, 4},
+				},
+				Negative: &metricspb.ExponentialHistogramDataPoint_Buckets{
+					BucketCounts: []uint64{3},
+				},
+			}},
+		}},
+	}
+
+	// With scale 0 the boundaries of the bucket with index i are 2^i and 2^(i+1).
+	e := singleEvent(t, g.GenerateEvents(newTestRequest(metric)))
+	assert.Equal(t, common.MapStr{
+		"values": []float64{-1.5, 0, 3, 12},
+		"counts": []uint64{3, 1, 2, 4},
+	}, e.ModuleFields["metrics"].(common.MapStr)["latency"].(common.MapStr)["histogram"])
+}
+
+func TestAttributeValue(t *testing.T) {
+	value := &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{
+		Values: []*commonpb.AnyValue{
+			{Value: &commonpb.AnyValue_IntValue{IntValue: 1}},
+			{Value: &commonpb.AnyValue_StringValue{StringValue: "a"}},
+		},
+	}}}
+	assert.Equal(t, `[1,"a"]`, attributeValue(value))
+	assert.Equal(t, "true", attributeValue(&commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: true}}))
+	assert.Equal(t, "1.5", attributeValue(&commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: 1.5}}))
+}
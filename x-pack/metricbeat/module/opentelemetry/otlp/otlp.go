@@ -0,0 +1,225 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	serverhelper "github.com/elastic/beats/v7/metricbeat/helper/server"
+	httpserver "github.com/elastic/beats/v7/metricbeat/helper/server/http"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+)
+
+const (
+	metricsPath = "/v1/metrics"
+
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("opentelemetry", "otlp", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet receives metrics pushed with the OpenTelemetry protocol (OTLP),
+// over gRPC and HTTP.
+type MetricSet struct {
+	mb.BaseMetricSet
+	colmetricspb.UnimplementedMetricsServiceServer
+
+	config     config
+	httpServer serverhelper.Server
+	grpcServer *grpc.Server
+	events     chan mb.Event
+	generator  *eventGenerator
+}
+
+// New creates a new instance of the MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Experimental("The opentelemetry otlp metricset is experimental.")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		config:        config,
+		events:        make(chan mb.Event),
+		generator:     newEventGenerator(config.CounterCacheTTL),
+	}
+
+	svc, err := httpserver.NewHttpServerWithHandler(base, m.handleHTTP)
+	if err != nil {
+		return nil, err
+	}
+	m.httpServer = svc
+
+	if config.GRPC.Enabled {
+		var opts []grpc.ServerOption
+		tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig != nil {
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig.BuildServerConfig(config.GRPC.Host))))
+		}
+		m.grpcServer = grpc.NewServer(opts...)
+		colmetricspb.RegisterMetricsServiceServer(m.grpcServer, m)
+	}
+	return m, nil
+}
+
+// Run starts the servers and reports the received metrics until the
+// reporter is done.
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	m.generator.Start()
+	defer m.generator.Stop()
+
+	if err := m.startGRPC(); err != nil {
+		reporter.Error(err)
+		return
+	}
+	m.httpServer.Start()
+
+	for {
+		select {
+		case <-reporter.Done():
+			m.httpServer.Stop()
+			if m.grpcServer != nil {
+				m.grpcServer.GracefulStop()
+			}
+			return
+		case e := <-m.events:
+			reporter.Event(e)
+		}
+	}
+}
+
+func (m *MetricSet) startGRPC() error {
+	if m.grpcServer == nil {
+		return nil
+	}
+	addr := net.JoinHostPort(m.config.GRPC.Host, strconv.Itoa(m.config.GRPC.Port))
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on %s", addr)
+	}
+	m.Logger().Infof("Starting OTLP/gRPC server on %s", addr)
+	go func() {
+		if err := m.grpcServer.Serve(listener); err != nil {
+			m.Logger().Errorf("OTLP/gRPC server failed: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Export implements the OTLP/gRPC metrics service.
+func (m *MetricSet) Export(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	if err := m.publish(ctx, req); err != nil {
+		return nil, err
+	}
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+// handleHTTP implements the OTLP/HTTP metrics endpoint, both with binary
+// protobuf and JSON encoding.
+func (m *MetricSet) handleHTTP(writer http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != metricsPath {
+		http.NotFound(writer, req)
+		return
+	}
+	if req.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil || (contentType != contentTypeProtobuf && contentType != contentTypeJSON) {
+		http.Error(writer, "unsupported content type", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var body io.Reader = req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		m.Logger().Errorf("Read error %v", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var exportReq colmetricspb.ExportMetricsServiceRequest
+	if contentType == contentTypeJSON {
+		err = protojson.Unmarshal(data, &exportReq)
+	} else {
+		err = proto.Unmarshal(data, &exportReq)
+	}
+	if err != nil {
+		m.Logger().Errorf("Unmarshal error %v", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := m.publish(req.Context(), &exportReq); err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	var resp []byte
+	if contentType == contentTypeJSON {
+		resp, err = protojson.Marshal(&colmetricspb.ExportMetricsServiceResponse{})
+	} else {
+		resp, err = proto.Marshal(&colmetricspb.ExportMetricsServiceResponse{})
+	}
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", contentType)
+	writer.WriteHeader(http.StatusOK)
+	writer.Write(resp)
+}
+
+// publish sends the events generated from an export request to the reporter.
+func (m *MetricSet) publish(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) error {
+	for _, e := range m.generator.GenerateEvents(req) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case m.events <- e:
+		}
+	}
+	return nil
+}
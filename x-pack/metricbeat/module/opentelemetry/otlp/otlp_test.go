@@ -0,0 +1,94 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func TestReceive(t *testing.T) {
+	httpPort, grpcPort := freePort(t), freePort(t)
+	ms := mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":     "opentelemetry",
+		"metricsets": []string{"otlp"},
+		"host":       "localhost",
+		"port":       httpPort,
+		"grpc.port":  grpcPort,
+	})
+
+	var events []mb.Event
+	done := make(chan struct{})
+	go func() {
+		events = mbtest.RunPushMetricSetV2(10*time.Second, 3, ms)
+		close(done)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, net.JoinHostPort("localhost", strconv.Itoa(grpcPort)), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = colmetricspb.NewMetricsServiceClient(conn).Export(ctx, newTestRequest(gaugeMetric("grpc", 1)))
+	require.NoError(t, err)
+
+	url := fmt.Sprintf("http://localhost:%d%s", httpPort, metricsPath)
+	post := func(contentType string, body []byte) {
+		t.Helper()
+		var resp *http.Response
+		require.Eventually(t, func() bool {
+			resp, err = http.Post(url, contentType, bytes.NewReader(body))
+			return err == nil
+		}, 5*time.Second, 10*time.Millisecond)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, contentType, resp.Header.Get("Content-Type"))
+	}
+
+	body, err := proto.Marshal(newTestRequest(gaugeMetric("protobuf", 2)))
+	require.NoError(t, err)
+	post(contentTypeProtobuf, body)
+
+	body, err = protojson.Marshal(newTestRequest(gaugeMetric("json", 3)))
+	require.NoError(t, err)
+	post(contentTypeJSON, body)
+
+	<-done
+	require.Len(t, events, 3)
+	received := map[string]interface{}{}
+	for _, e := range events {
+		for name, fields := range e.ModuleFields["metrics"].(common.MapStr) {
+			received[name] = fields
+		}
+	}
+	assert.Len(t, received, 3)
+	assert.Contains(t, received, "grpc")
+	assert.Contains(t, received, "protobuf")
+	assert.Contains(t, received, "json")
+}
@@ -7,8 +7,9 @@ package collector
 import "errors"
 
 type config struct {
-	UseTypes     bool `config:"use_types"`
-	RateCounters bool `config:"rate_counters"`
+	UseTypes        bool `config:"use_types"`
+	RateCounters    bool `config:"rate_counters"`
+	IncludeMetadata bool `config:"include_metadata"`
 }
 
 func (c *config) Validate() error {
@@ -16,5 +17,9 @@ func (c *config) Validate() error {
 		return errors.New("'rate_counters' can only be enabled when `use_types` is also enabled")
 	}
 
+	if c.IncludeMetadata && !c.UseTypes {
+		return errors.New("'include_metadata' can only be enabled when `use_types` is also enabled")
+	}
+
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/module/prometheus/collector"
 
@@ -29,8 +30,9 @@ func promEventsGeneratorFactory(base mb.BaseMetricSet) (collector.PromEventsGene
 		counters := NewCounterCache(base.Module().Config().Period * 5)
 
 		g := typedGenerator{
-			counterCache: counters,
-			rateCounters: config.RateCounters,
+			counterCache:    counters,
+			rateCounters:    config.RateCounters,
+			includeMetadata: config.IncludeMetadata,
 		}
 
 		return &g, nil
@@ -40,8 +42,9 @@ func promEventsGeneratorFactory(base mb.BaseMetricSet) (collector.PromEventsGene
 }
 
 type typedGenerator struct {
-	counterCache CounterCache
-	rateCounters bool
+	counterCache    CounterCache
+	rateCounters    bool
+	includeMetadata bool
 }
 
 func (g *typedGenerator) Start() {
@@ -61,12 +64,13 @@ func (g *typedGenerator) Stop() {
 
 // GeneratePromEvents stores all Prometheus metrics using
 // specific Elasticsearch data types.
-func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.PromEvent {
+func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily, metadata *p.FamilyMetadata) []collector.PromEvent {
 	var events []collector.PromEvent
 
 	name := *mf.Name
 	metrics := mf.Metric
 	for _, metric := range metrics {
+		metricEvents := len(events)
 		labels := common.MapStr{}
 
 		if len(metric.Label) != 0 {
@@ -80,9 +84,13 @@ func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.Pr
 		counter := metric.GetCounter()
 		if counter != nil {
 			if !math.IsNaN(counter.GetValue()) && !math.IsInf(counter.GetValue(), 0) {
+				data := g.rateCounterFloat64(name, labels, counter.GetValue())
+				if exemplar := exemplarFields(counter.GetExemplar()); exemplar != nil {
+					data["exemplar"] = exemplar
+				}
 				events = append(events, collector.PromEvent{
 					Data: common.MapStr{
-						name: g.rateCounterFloat64(name, labels, counter.GetValue()),
+						name: data,
 					},
 					Labels: labels,
 				})
@@ -135,11 +143,18 @@ func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.Pr
 
 		histogram := metric.GetHistogram()
 		if histogram != nil {
+			data := common.MapStr{}
+			if isNativeHistogram(histogram) {
+				data["histogram"] = PromNativeHistogramToES(g.counterCache, name, labels, histogram)
+			} else {
+				data["histogram"] = PromHistogramToES(g.counterCache, name, labels, histogram)
+			}
+			if exemplar := exemplarFields(latestExemplar(histogram)); exemplar != nil {
+				data["exemplar"] = exemplar
+			}
 			events = append(events, collector.PromEvent{
 				Data: common.MapStr{
-					name: common.MapStr{
-						"histogram": PromHistogramToES(g.counterCache, name, labels, histogram),
-					},
+					name: data,
 				},
 				Labels: labels,
 			})
@@ -162,10 +177,78 @@ func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.Pr
 				})
 			}
 		}
+
+		// Add the metadata to all the metrics of the events of this metric
+		if fields := g.metadataFields(mf, metadata, metric); len(fields) > 0 {
+			for _, event := range events[metricEvents:] {
+				for _, data := range event.Data {
+					if data, ok := data.(common.MapStr); ok {
+						data.Update(fields)
+					}
+				}
+			}
+		}
 	}
 	return events
 }
 
+// metadataFields returns the fields with the metadata of a metric, its creation
+// time when known, and its help and unit if include_metadata is enabled
+func (g *typedGenerator) metadataFields(mf *dto.MetricFamily, metadata *p.FamilyMetadata, metric *dto.Metric) common.MapStr {
+	fields := common.MapStr{}
+	if created, found := metadata.CreatedTime(metric); found {
+		fields["created"] = created
+	}
+	if g.includeMetadata {
+		if help := mf.GetHelp(); help != "" {
+			fields["help"] = help
+		}
+		if metadata != nil && metadata.Unit != "" {
+			fields["unit"] = metadata.Unit
+		}
+	}
+	return fields
+}
+
+// exemplarFields returns the fields of an exemplar, or nil if there is no valid exemplar
+func exemplarFields(exemplar *dto.Exemplar) common.MapStr {
+	if exemplar == nil || math.IsNaN(exemplar.GetValue()) || math.IsInf(exemplar.GetValue(), 0) {
+		return nil
+	}
+
+	fields := common.MapStr{
+		"value": exemplar.GetValue(),
+	}
+	labels := common.MapStr{}
+	for _, label := range exemplar.GetLabel() {
+		if label.GetName() != "" && label.GetValue() != "" {
+			labels[label.GetName()] = label.GetValue()
+		}
+	}
+	if len(labels) > 0 {
+		fields["labels"] = labels
+	}
+	if exemplar.GetTimestamp() != nil {
+		fields["timestamp"] = exemplar.GetTimestamp().AsTime()
+	}
+	return fields
+}
+
+// latestExemplar returns the most recent exemplar of the buckets of a histogram
+func latestExemplar(histogram *dto.Histogram) *dto.Exemplar {
+	var latest *dto.Exemplar
+	for _, bucket := range histogram.GetBucket() {
+		exemplar := bucket.GetExemplar()
+		if exemplar == nil {
+			continue
+		}
+		if latest == nil || !exemplar.GetTimestamp().AsTime().Before(latest.GetTimestamp().AsTime()) {
+			latest = exemplar
+		}
+	}
+	return latest
+}
+
 // rateCounterUint64 fills a counter value and optionally adds the rate if rate_counters is enabled
 func (g *typedGenerator) rateCounterUint64(name string, labels common.MapStr, value uint64) common.MapStr {
 	d := common.MapStr{
@@ -0,0 +1,167 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// +build !integration
+
+package collector
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestGeneratePromEventsMetadata(t *testing.T) {
+	exemplarTime := time.Date(2021, 11, 19, 16, 30, 0, 0, time.UTC)
+
+	counterType := dto.MetricType_COUNTER
+	family := &dto.MetricFamily{
+		Name: proto.String("http_requests_total"),
+		Help: proto.String("Total number of HTTP requests"),
+		Type: &counterType,
+		Metric: []*dto.Metric{{
+			Label: []*dto.LabelPair{{Name: proto.String("code"), Value: proto.String("200")}},
+			Counter: &dto.Counter{
+				Value: proto.Float64(42),
+				Exemplar: &dto.Exemplar{
+					Label:     []*dto.LabelPair{{Name: proto.String("trace_id"), Value: proto.String("4bf92f3577b34da6")}},
+					Value:     proto.Float64(1),
+					Timestamp: timestamppb.New(exemplarTime),
+				},
+			},
+		}},
+	}
+
+	metadata := &p.FamilyMetadata{Unit: "requests"}
+
+	g := typedGenerator{counterCache: NewCounterCache(time.Minute)}
+	events := g.GeneratePromEvents(family, metadata)
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"http_requests_total": common.MapStr{
+			"counter": float64(42),
+			"exemplar": common.MapStr{
+				"value":     float64(1),
+				"labels":    common.MapStr{"trace_id": "4bf92f3577b34da6"},
+				"timestamp": exemplarTime,
+			},
+		},
+	}, events[0].Data)
+
+	g.includeMetadata = true
+	events = g.GeneratePromEvents(family, metadata)
+	require.Len(t, events, 1)
+	data := events[0].Data["http_requests_total"].(common.MapStr)
+	assert.Equal(t, "Total number of HTTP requests", data["help"])
+	assert.Equal(t, "requests", data["unit"])
+}
+
+func TestFetchOpenMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Accept"), "application/openmetrics-text")
+		w.Header().Set("Content-Type", "application/openmetrics-text; version=1.0.0; charset=utf-8")
+		w.Write([]byte(`# TYPE http_requests counter
+# UNIT http_requests requests
+http_requests_total{code="200"} 42 # {trace_id="4bf92f3577b34da6"} 1
+http_requests_created{code="200"} 1637337600
+# EOF
+`))
+	}))
+	defer server.Close()
+
+	f := mbtest.NewReportingMetricSetV2Error(t, map[string]interface{}{
+		"module":           "prometheus",
+		"metricsets":       []string{"collector"},
+		"hosts":            []string{server.URL},
+		"use_types":        true,
+		"use_openmetrics":  true,
+		"include_metadata": true,
+	})
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	require.Empty(t, errs)
+
+	var found bool
+	for _, e := range events {
+		requests, err := e.RootFields.GetValue("prometheus.http_requests_total")
+		if err != nil {
+			continue
+		}
+		found = true
+		assert.Equal(t, common.MapStr{
+			"counter": float64(42),
+			"created": time.Unix(1637337600, 0).UTC(),
+			"unit":    "requests",
+			"exemplar": common.MapStr{
+				"value":  float64(1),
+				"labels": common.MapStr{"trace_id": "4bf92f3577b34da6"},
+			},
+		}, requests)
+	}
+	assert.True(t, found)
+}
+
+func TestFetchOpenMetricsRelabeled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/openmetrics-text; version=1.0.0; charset=utf-8")
+		w.Write([]byte(`# TYPE http_requests counter
+# UNIT http_requests requests
+# HELP http_requests Total number of HTTP requests
+http_requests_total{code="200"} 42
+http_requests_created{code="200"} 1637337600
+# EOF
+`))
+	}))
+	defer server.Close()
+
+	f := mbtest.NewReportingMetricSetV2Error(t, map[string]interface{}{
+		"module":           "prometheus",
+		"metricsets":       []string{"collector"},
+		"hosts":            []string{server.URL},
+		"use_types":        true,
+		"use_openmetrics":  true,
+		"include_metadata": true,
+		"target_labels": map[string]interface{}{
+			"env": "production",
+		},
+		"metric_relabel_configs": []interface{}{
+			map[string]interface{}{
+				"source_labels": []string{"__name__"},
+				"regex":         "http_(.*)",
+				"target_label":  "__name__",
+				"replacement":   "web_$1",
+			},
+		},
+	})
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	require.Empty(t, errs)
+
+	var found bool
+	for _, e := range events {
+		requests, err := e.RootFields.GetValue("prometheus.web_requests_total")
+		if err != nil {
+			continue
+		}
+		found = true
+		assert.Equal(t, common.MapStr{
+			"counter": float64(42),
+			"created": time.Unix(1637337600, 0).UTC(),
+			"help":    "Total number of HTTP requests",
+			"unit":    "requests",
+		}, requests)
+		env, _ := e.RootFields.GetValue("prometheus.labels.env")
+		assert.Equal(t, "production", env)
+	}
+	assert.True(t, found)
+}
@@ -78,3 +78,94 @@ func PromHistogramToES(cc CounterCache, name string, labels common.MapStr, histo
 
 	return res
 }
+
+// isNativeHistogram returns true if the histogram has native histogram buckets
+func isNativeHistogram(histogram *dto.Histogram) bool {
+	return histogram.GetZeroThreshold() > 0 || histogram.GetZeroCount() > 0 || histogram.GetZeroCountFloat() > 0 ||
+		len(histogram.GetPositiveSpan()) > 0 || len(histogram.GetNegativeSpan()) > 0
+}
+
+// PromNativeHistogramToES takes a Prometheus native histogram and converts it to an ES histogram.
+//
+// Native histograms have exponential buckets, whose boundaries are determined by the
+// schema of the histogram: the bucket with index i has an upper bound of base^i, with
+// base = 2^(2^-schema). As with PromHistogramToES, the value of each bucket is its
+// centroid, and its count is the increase since the last time it was seen.
+//
+// https://prometheus.io/docs/concepts/metric_types/#histogram
+func PromNativeHistogramToES(cc CounterCache, name string, labels common.MapStr, histogram *dto.Histogram) common.MapStr {
+	base := math.Exp2(math.Exp2(-float64(histogram.GetSchema())))
+	counterName := name + labels.String()
+
+	var values []float64
+	var counts []uint64
+	add := func(bucket string, value float64, count uint64) {
+		// New buckets are considered zero by now
+		countRate, _ := cc.RateUint64(counterName+bucket, count)
+		values = append(values, value)
+		counts = append(counts, countRate)
+	}
+
+	// Values must be sorted, so negative buckets go first, from the highest index
+	negative := nativeBuckets(histogram.GetNegativeSpan(), histogram.GetNegativeDelta(), histogram.GetNegativeCount())
+	for i := len(negative) - 1; i >= 0; i-- {
+		add(fmt.Sprintf("-%d", negative[i].index), -nativeBucketCentroid(base, negative[i].index), negative[i].count)
+	}
+
+	zeroCount := histogram.GetZeroCount()
+	if histogram.ZeroCountFloat != nil {
+		zeroCount = uint64(math.Round(histogram.GetZeroCountFloat()))
+	}
+	add("zero", 0, zeroCount)
+
+	for _, bucket := range nativeBuckets(histogram.GetPositiveSpan(), histogram.GetPositiveDelta(), histogram.GetPositiveCount()) {
+		add(fmt.Sprintf("+%d", bucket.index), nativeBucketCentroid(base, bucket.index), bucket.count)
+	}
+
+	return common.MapStr{
+		"values": values,
+		"counts": counts,
+	}
+}
+
+type nativeBucket struct {
+	index int32
+	count uint64
+}
+
+// nativeBuckets expands the spans of native histogram buckets into the index and
+// absolute count of each bucket. Counts are delta-encoded in integer histograms,
+// and absolute in float histograms.
+func nativeBuckets(spans []*dto.BucketSpan, deltas []int64, floatCounts []float64) []nativeBucket {
+	var buckets []nativeBucket
+	var index int32
+	var count int64
+	i := 0
+	for _, span := range spans {
+		// The offset of the first span is the index of its first bucket, the offset
+		// of the following spans is relative to the end of the previous span
+		index += span.GetOffset()
+		for j := uint32(0); j < span.GetLength(); j++ {
+			var bucketCount uint64
+			switch {
+			case i < len(deltas):
+				count += deltas[i]
+				bucketCount = uint64(count)
+			case i < len(floatCounts):
+				bucketCount = uint64(math.Round(floatCounts[i]))
+			default:
+				return buckets
+			}
+			buckets = append(buckets, nativeBucket{index: index, count: bucketCount})
+			index++
+			i++
+		}
+	}
+	return buckets
+}
+
+// nativeBucketCentroid returns the centroid of the native histogram bucket with the given index
+func nativeBucketCentroid(base float64, index int32) float64 {
+	upper := math.Pow(base, float64(index))
+	return (upper/base + upper) / 2
+}
@@ -405,3 +405,40 @@ func TestPromHistogramToES(t *testing.T) {
 		})
 	}
 }
+
+func TestPromNativeHistogramToES(t *testing.T) {
+	histogram := func(zeroCount uint64, positiveDeltas ...int64) *dto.Histogram {
+		return &dto.Histogram{
+			Schema:        proto.Int32(0),
+			ZeroThreshold: proto.Float64(0.001),
+			ZeroCount:     proto.Uint64(zeroCount),
+			NegativeSpan:  []*dto.BucketSpan{{Offset: proto.Int32(1), Length: proto.Uint32(1)}},
+			NegativeDelta: []int64{1},
+			PositiveSpan: []*dto.BucketSpan{
+				{Offset: proto.Int32(0), Length: proto.Uint32(2)},
+				{Offset: proto.Int32(1), Length: proto.Uint32(1)},
+			},
+			PositiveDelta: positiveDeltas,
+		}
+	}
+
+	cache := NewCounterCache(120 * time.Minute)
+	labels := common.MapStr{}
+
+	// With schema 0 the boundaries of the bucket with index i are 2^(i-1) and 2^i,
+	// here there are positive buckets with indexes 0, 1 and 3.
+	result := PromNativeHistogramToES(cache, "somemetric", labels, histogram(1, 2, 1, -1))
+	assert.EqualValues(t, common.MapStr{
+		"values": []float64{-1.5, 0, 0.75, 1.5, 6},
+		"counts": []uint64{0, 0, 0, 0, 0},
+	}, result)
+
+	result = PromNativeHistogramToES(cache, "somemetric", labels, histogram(3, 2, 2, 0))
+	assert.EqualValues(t, common.MapStr{
+		"values": []float64{-1.5, 0, 0.75, 1.5, 6},
+		"counts": []uint64{0, 2, 0, 1, 2},
+	}, result)
+
+	assert.True(t, isNativeHistogram(histogram(0, 1)))
+	assert.False(t, isNativeHistogram(&dto.Histogram{Bucket: []*dto.Bucket{{UpperBound: proto.Float64(1)}}}))
+}
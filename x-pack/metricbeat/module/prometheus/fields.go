@@ -19,5 +19,5 @@ func init() {
 // AssetPrometheus returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/prometheus.
 func AssetPrometheus() string {
-	return "eJzEkktqAzEMhvdzih8vQ5MDeNEzFLosJSi24rjxC0tTmtuXZIYw9EGzKMQ7+/+QPgmvceSTRes1sx54lPVHI3ccAI2a2MI8XSPoqbFHZu3RiRkAz+J6bBprsXgcAOBZSQXiOp3Zfa8ZhEUNLr7VWHQzAJ0Tk7BFoAEQVo0liMWLEUnmAeag2szrAOwjJy/20mGNQpmXzpvV5p3SyJcYF02Luntjp/PTdNlOia/jLvH3ZJuptVjCjJmVmZkfxjyfxVSBxsDzZn6XdHUsyv1+mrPAn6Kd9I7LPHf3N7seomgNnfKNwl/5/3G+Vl36Ts7LX/4ZAAD//xMCFRw="
+	return "eJzElc+O0zAQxu95ik85Vts8QA5c4AASCCTghNBqGk8TU/+TZwLt26Ok2dBliTaIZSv14s4nz+9ny5MtDnyqkXL0rB33sj0mag4FoFYd1yg/zCXoKbGBZ822kbIADEuTbVIbQ40XBQB8VFKBNJmG7D5HD8LFHhxMijZoVQCZHZNwjZYKQFjVhlZqfClFXHmDslNN5dcC2Ft2RuqxwxaBPF8yV5uKj+yTo1w52rGTajNGMSLXiLtv3Oj013lxe64c+PQjZvOwdOspJRvaKVduyinzB+fh93bsi7iHdow7nGF9T/98eDdw9sCwKtBMDePNq2W17+R6XqljYr9z/LDytzYXxC31LU+XvgzZxD4o5+thTgCPgmZSvh7l0N2sZu2saGwz+ZXAv+efhnne9VHe+RGq9SxKPq0EN78u5R+YP911XfkKl0WazMNFPSv9y6GnjQHD4S3ODRLwMUVhAxvGUfM+cXg3VgX7mD3pslcfrK6UerLB+DlY/U86Hbv03Dqv2SUoH5ec5uD23uft5wBo9Vfd"
 }
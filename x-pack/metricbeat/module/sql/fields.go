@@ -19,5 +19,5 @@ func init() {
 // AssetSql returns asset data.
 // This is the base64 encoded zlib format compressed contents of module/sql.
 func AssetSql() string {
-	return "eJy0k0Fu8jAQhfc5xRPLX4IDZPEvqi4REuIAlWM/wMXJgD2mze0rEoJSpa1AVb18npnvy0Se48C2RDqFAlCvgSVmm/VyVgCRgSaxREU1BeCYbPRH9dKU+F8AwGa9RC0uB2JLtXsm1NTobcI2Sg3TVTijpjKJBbD1DC6VXfMcjak5wC9H2yNL7KLk4zUZ1497XPRnxls8tB7Yvkl0o/wL6eE8dzOQEx1UwHfarITuiVNmbBcTahf/Drq+jBhYHddKCLQ6LG5KHS6aXDN6u/g3MZDqlVZHcR+89LdOchV4n96qZ9z+4lWO7nutpNE3u4etHtraSpr59fNxNiHzLrNKJNA0f6v21EN+1Pr8kj4CAAD//07X+ko="
+	return "eJy0k0FuwjAQRfc5xRfLSnCALLqoukSVEAdAjv0DLk4M9pg2t69IcJuqpSJClTfRt2fem5Eyx55diXh0BSBWHEvM1qvlrAACHVVkiYqiCsAw6mAPYn1b4rEAgPVqicab5IiaoneMaCjB6og6+Aaqf2GUqEpFFkBt6Uws++I5WtUww89HugNLbINPh0syfj+uMcGeGD7jXLpn9+aDGeW/SOfz3PdAijQQD75TJyFkRxwTQ7f4Qe3j+6Crc4vM6rnaO0cteXFXqJvz933oF9UQvv4acJpGvmhTw2D14mHUfNi+r16pZRQPwWa4NT5VjjeqDozMzHI017WiBNtuJ1tN26Bv55fxcVIu8SazyntH1f6v2tMA+VPr+w/9MQAfHSFO"
 }
@@ -0,0 +1,83 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	Driver string `config:"driver" validate:"nonzero,required"`
+
+	// Single query, kept for backwards compatibility.
+	Query          string `config:"sql_query"`
+	ResponseFormat string `config:"sql_response_format"`
+
+	Queries []queryConfig `config:"sql_queries"`
+
+	// MergeResults combines the results of all the queries in a single event.
+	MergeResults bool `config:"merge_results"`
+}
+
+type queryConfig struct {
+	Name           string `config:"name"`
+	Query          string `config:"query" validate:"nonzero,required"`
+	ResponseFormat string `config:"response_format"`
+}
+
+func defaultConfig() config {
+	return config{
+		ResponseFormat: tableResponseFormat,
+	}
+}
+
+func (c *config) Validate() error {
+	switch {
+	case c.Query == "" && len(c.Queries) == 0:
+		return errors.New("one of sql_query or sql_queries must be set")
+	case c.Query != "" && len(c.Queries) > 0:
+		return errors.New("sql_query and sql_queries cannot be used together")
+	}
+
+	for _, q := range c.queries() {
+		if err := validateResponseFormat(q.ResponseFormat); err != nil {
+			return err
+		}
+		if _, err := parseQueryTemplate(q.Query); err != nil {
+			return errors.Wrapf(err, "invalid query %q", q.Query)
+		}
+	}
+	return nil
+}
+
+// queries returns the configured queries, including the single query of
+// the sql_query setting.
+func (c *config) queries() []queryConfig {
+	if c.Query != "" {
+		return []queryConfig{{Query: c.Query, ResponseFormat: c.ResponseFormat}}
+	}
+	queries := make([]queryConfig, len(c.Queries))
+	for i, q := range c.Queries {
+		if q.ResponseFormat == "" {
+			q.ResponseFormat = tableResponseFormat
+		}
+		queries[i] = q
+	}
+	return queries
+}
+
+func validateResponseFormat(format string) error {
+	if format != variableResponseFormat && format != tableResponseFormat {
+		return fmt.Errorf("invalid response format value: %s", format)
+	}
+	return nil
+}
+
+func parseQueryTemplate(query string) (*template.Template, error) {
+	return template.New("query").Option("missingkey=error").Parse(query)
+}
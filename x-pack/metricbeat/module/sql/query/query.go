@@ -7,8 +7,11 @@ package query
 import (
 	"context"
 	"fmt"
+	"strings"
+	"text/template"
+	"time"
 
-	"github.com/jmoiron/sqlx"
+	"github.com/joeshaw/multierror"
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/common"
@@ -39,11 +42,37 @@ func init() {
 // interface methods except for Fetch.
 type MetricSet struct {
 	mb.BaseMetricSet
-	Driver         string
-	Query          string
-	ResponseFormat string
+	Driver       string
+	Queries      []*query
+	MergeResults bool
 
-	db *sqlx.DB
+	db *sql.DbClient
+}
+
+// query is a configured query, with the state used to render its template.
+type query struct {
+	queryConfig
+	template *template.Template
+
+	// lastRun is the start time of the last successful execution.
+	lastRun time.Time
+}
+
+// templateTime is a time that is rendered in queries in a format understood
+// by most databases, in UTC and without zone. Methods of time.Time can be used
+// in templates, like `{{.last_run.Unix}}`.
+type templateTime struct {
+	time.Time
+}
+
+func (t templateTime) String() string {
+	return t.UTC().Format("2006-01-02 15:04:05.000000")
+}
+
+// RFC3339 renders the time in UTC with its zone, for columns or databases
+// that don't assume UTC, like `{{.last_run.RFC3339}}`.
+func (t templateTime) RFC3339() string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000Z07:00")
 }
 
 // New creates a new instance of the MetricSet. New is responsible for unpacking
@@ -51,72 +80,134 @@ type MetricSet struct {
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	cfgwarn.Beta("The sql query metricset is beta.")
 
-	config := struct {
-		Driver         string `config:"driver" validate:"nonzero,required"`
-		Query          string `config:"sql_query" validate:"nonzero,required"`
-		ResponseFormat string `config:"sql_response_format"`
-	}{ResponseFormat: tableResponseFormat}
-
+	config := defaultConfig()
 	if err := base.Module().UnpackConfig(&config); err != nil {
 		return nil, err
 	}
 
-	if config.ResponseFormat != variableResponseFormat && config.ResponseFormat != tableResponseFormat {
-		return nil, fmt.Errorf("invalid sql_response_format value: %s", config.ResponseFormat)
+	// Queries using {{.last_run}} get the rows of the last period on the
+	// first execution.
+	firstRun := time.Now().Add(-base.Module().Config().Period)
+
+	var queries []*query
+	for _, q := range config.queries() {
+		tmpl, err := parseQueryTemplate(q.Query)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, &query{
+			queryConfig: q,
+			template:    tmpl,
+			lastRun:     firstRun,
+		})
 	}
 
 	return &MetricSet{
-		BaseMetricSet:  base,
-		Driver:         config.Driver,
-		Query:          config.Query,
-		ResponseFormat: config.ResponseFormat,
+		BaseMetricSet: base,
+		Driver:        config.Driver,
+		Queries:       queries,
+		MergeResults:  config.MergeResults,
 	}, nil
 }
 
 // Fetch methods implements the data gathering and data conversion to the right
 // format. It publishes the event which is then forwarded to the output. In case
 // of an error set the Error field of mb.Event or simply call report.Error().
-// It calls m.fetchTableMode() or m.fetchVariableMode() depending on the response
-// format of the query.
+// It runs each query in table or variables mode depending on its response
+// format, and reports their results as separate events, or merged in a single
+// one.
 func (m *MetricSet) Fetch(ctx context.Context, report mb.ReporterV2) error {
-	db, err := sql.NewDBClient(m.Driver, m.HostData().URI, m.Logger())
-	if err != nil {
-		return errors.Wrap(err, "error opening connection")
+	if m.db == nil {
+		db, err := sql.NewDBClient(m.Driver, m.HostData().URI, m.Logger())
+		if err != nil {
+			return errors.Wrap(err, "error opening connection")
+		}
+		m.db = db
 	}
-	defer db.Close()
 
-	if m.ResponseFormat == tableResponseFormat {
-		mss, err := db.FetchTableMode(ctx, m.Query)
+	merged := common.MapStr{}
+	var errs multierror.Errors
+	for _, q := range m.Queries {
+		start := time.Now()
+		rows, err := m.run(ctx, q)
 		if err != nil {
-			return err
+			errs = append(errs, errors.Wrapf(err, "error running query %s", q.displayName()))
+			continue
 		}
+		q.lastRun = start
 
-		for _, ms := range mss {
-			report.Event(m.getEvent(ms))
+		if !m.MergeResults {
+			for _, ms := range rows {
+				report.Event(m.getEvent(q, ms))
+			}
+			continue
 		}
 
-		return nil
+		switch len(rows) {
+		case 0:
+		case 1:
+			merged.Update(rows[0])
+		default:
+			errs = append(errs, errors.Errorf("cannot merge results of query %s, it returned %d rows", q.displayName(), len(rows)))
+		}
 	}
 
-	ms, err := db.FetchVariableMode(ctx, m.Query)
+	if m.MergeResults && len(merged) > 0 {
+		report.Event(mb.Event{
+			RootFields: common.MapStr{
+				"sql": common.MapStr{
+					"driver":  m.Driver,
+					"metrics": getMetrics(merged),
+				},
+			},
+		})
+	}
+
+	return errs.Err()
+}
+
+// run renders the template of a query and executes it.
+func (m *MetricSet) run(ctx context.Context, q *query) ([]common.MapStr, error) {
+	var rendered strings.Builder
+	err := q.template.Execute(&rendered, map[string]interface{}{
+		"last_run": templateTime{q.lastRun},
+	})
 	if err != nil {
-		return err
+		return nil, errors.Wrap(err, "rendering query")
 	}
-	report.Event(m.getEvent(ms))
 
-	return nil
+	if q.ResponseFormat == tableResponseFormat {
+		return m.db.FetchTableMode(ctx, rendered.String())
+	}
+
+	ms, err := m.db.FetchVariableMode(ctx, rendered.String())
+	if err != nil {
+		return nil, err
+	}
+	return []common.MapStr{ms}, nil
 }
 
-func (m *MetricSet) getEvent(ms common.MapStr) mb.Event {
-	return mb.Event{
+func (q *query) displayName() string {
+	if q.Name != "" {
+		return q.Name
+	}
+	return fmt.Sprintf("%q", q.Query)
+}
+
+func (m *MetricSet) getEvent(q *query, ms common.MapStr) mb.Event {
+	event := mb.Event{
 		RootFields: common.MapStr{
 			"sql": common.MapStr{
 				"driver":  m.Driver,
-				"query":   m.Query,
+				"query":   q.Query,
 				"metrics": getMetrics(ms),
 			},
 		},
 	}
+	if q.Name != "" {
+		event.RootFields.Put("sql.query_name", q.Name)
+	}
+	return event
 }
 
 func getMetrics(ms common.MapStr) (ret common.MapStr) {
@@ -0,0 +1,198 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// +build !integration
+
+package query
+
+import (
+	dbsql "database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+// newTestDatabase creates a SQLite database with an audit table.
+func newTestDatabase(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := dbsql.Open("sqlite3", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE audit (id INTEGER, action TEXT, created TEXT);
+		CREATE TABLE settings (name TEXT, value TEXT);
+		INSERT INTO settings VALUES ('max_connections', '100'), ('mode', 'wal');
+	`)
+	require.NoError(t, err)
+	return path
+}
+
+func insertAudit(t *testing.T, path string, id int, action string, created time.Time) {
+	db, err := dbsql.Open("sqlite3", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("INSERT INTO audit VALUES (?, ?, ?)", id, action, templateTime{created}.String())
+	require.NoError(t, err)
+}
+
+func getTestConfig(path string, config map[string]interface{}) map[string]interface{} {
+	values := map[string]interface{}{
+		"module":     "sql",
+		"metricsets": []string{"query"},
+		"hosts":      []string{path},
+		"driver":     "sqlite",
+	}
+	for k, v := range config {
+		values[k] = v
+	}
+	return values
+}
+
+func TestFetchSingleQuery(t *testing.T) {
+	path := newTestDatabase(t)
+	f := mbtest.NewReportingMetricSetV2WithContext(t, getTestConfig(path, map[string]interface{}{
+		"sql_query":           "SELECT name, value FROM settings",
+		"sql_response_format": variableResponseFormat,
+	}))
+
+	events, errs := mbtest.ReportingFetchV2WithContext(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"driver": "sqlite",
+		"query":  "SELECT name, value FROM settings",
+		"metrics": common.MapStr{
+			"numeric": common.MapStr{"max_connections": float64(100)},
+			"string":  common.MapStr{"mode": "wal"},
+		},
+	}, events[0].RootFields["sql"])
+}
+
+func TestFetchMultipleQueries(t *testing.T) {
+	path := newTestDatabase(t)
+	f := mbtest.NewReportingMetricSetV2WithContext(t, getTestConfig(path, map[string]interface{}{
+		"sql_queries": []map[string]interface{}{
+			{"name": "settings", "query": "SELECT name, value FROM settings", "response_format": variableResponseFormat},
+			{"name": "tables", "query": "SELECT name FROM sqlite_master WHERE type = 'table' ORDER BY name"},
+		},
+	}))
+
+	events, errs := mbtest.ReportingFetchV2WithContext(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 3)
+
+	var names []interface{}
+	for _, e := range events {
+		name, err := e.RootFields.GetValue("sql.query_name")
+		require.NoError(t, err)
+		names = append(names, name)
+	}
+	assert.Equal(t, []interface{}{"settings", "tables", "tables"}, names)
+
+	tables, err := events[1].RootFields.GetValue("sql.metrics.string.name")
+	require.NoError(t, err)
+	assert.Equal(t, "audit", tables)
+}
+
+func TestFetchMergeResults(t *testing.T) {
+	path := newTestDatabase(t)
+	f := mbtest.NewReportingMetricSetV2WithContext(t, getTestConfig(path, map[string]interface{}{
+		"merge_results": true,
+		"sql_queries": []map[string]interface{}{
+			{"query": "SELECT name, value FROM settings", "response_format": variableResponseFormat},
+			{"query": "SELECT count(*) AS tables FROM sqlite_master WHERE type = 'table'"},
+		},
+	}))
+
+	events, errs := mbtest.ReportingFetchV2WithContext(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"driver": "sqlite",
+		"metrics": common.MapStr{
+			"numeric": common.MapStr{"max_connections": float64(100), "tables": float64(2)},
+			"string":  common.MapStr{"mode": "wal"},
+		},
+	}, events[0].RootFields["sql"])
+
+	// Results with more than one row cannot be merged.
+	f = mbtest.NewReportingMetricSetV2WithContext(t, getTestConfig(path, map[string]interface{}{
+		"merge_results": true,
+		"sql_queries": []map[string]interface{}{
+			{"query": "SELECT count(*) AS tables FROM sqlite_master WHERE type = 'table'"},
+			{"query": "SELECT name FROM settings"},
+		},
+	}))
+	events, errs = mbtest.ReportingFetchV2WithContext(f)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "it returned 2 rows")
+	require.Len(t, events, 1)
+}
+
+func TestFetchLastRun(t *testing.T) {
+	path := newTestDatabase(t)
+	f := mbtest.NewReportingMetricSetV2WithContext(t, getTestConfig(path, map[string]interface{}{
+		"period": "1m",
+		"sql_queries": []map[string]interface{}{
+			{"query": "SELECT id, action FROM audit WHERE created > '{{.last_run}}' ORDER BY id"},
+		},
+	}))
+
+	insertAudit(t, path, 1, "old", time.Now().Add(-time.Hour))
+	insertAudit(t, path, 2, "login", time.Now().Add(-time.Second))
+
+	// The first execution gets the rows of the last period.
+	events, errs := mbtest.ReportingFetchV2WithContext(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+	id, _ := events[0].RootFields.GetValue("sql.metrics.numeric.id")
+	assert.Equal(t, float64(2), id)
+
+	events, errs = mbtest.ReportingFetchV2WithContext(f)
+	require.Empty(t, errs)
+	assert.Empty(t, events)
+
+	insertAudit(t, path, 3, "logout", time.Now().Add(time.Second))
+	events, errs = mbtest.ReportingFetchV2WithContext(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+	id, _ = events[0].RootFields.GetValue("sql.metrics.numeric.id")
+	assert.Equal(t, float64(3), id)
+}
+
+func TestTemplateTime(t *testing.T) {
+	lastRun := templateTime{time.Date(2021, 11, 12, 9, 30, 0, 1000, time.FixedZone("CET", 3600))}
+	assert.Equal(t, "2021-11-12 08:30:00.000001", lastRun.String())
+	assert.Equal(t, "2021-11-12T08:30:00.000001Z", lastRun.RFC3339())
+}
+
+func TestConfigValidate(t *testing.T) {
+	for name, cfg := range map[string]map[string]interface{}{
+		"no query": {},
+		"both settings": {
+			"sql_query":   "SELECT 1",
+			"sql_queries": []map[string]interface{}{{"query": "SELECT 2"}},
+		},
+		"invalid format": {
+			"sql_queries": []map[string]interface{}{{"query": "SELECT 1", "response_format": "json"}},
+		},
+		"invalid template": {
+			"sql_query": "SELECT {{.last_run",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := defaultConfig()
+			err := common.MustNewConfigFrom(getTestConfig("test.db", cfg)).Unpack(&c)
+			assert.Error(t, err)
+		})
+	}
+}